@@ -2,6 +2,7 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -14,6 +15,7 @@ import (
 	"time"
 
 	"github.com/mizuchilabs/mantrae/internal/config"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
 	"github.com/mizuchilabs/mantrae/internal/traefik"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	"gopkg.in/yaml.v3"
@@ -134,6 +136,14 @@ func UploadBackup(a *config.App) http.HandlerFunc {
 				)
 				return
 			}
+			if err = a.BumpAllProfiles(r.Context()); err != nil {
+				http.Error(
+					w,
+					fmt.Sprintf("Failed to record revisions: %v", err),
+					http.StatusInternalServerError,
+				)
+				return
+			}
 		} else {
 			profileID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
 			if err != nil {
@@ -150,7 +160,7 @@ func UploadBackup(a *config.App) http.HandlerFunc {
 				return
 			}
 
-			dynamic := &dynamic.Configuration{}
+			cfg := &dynamic.Configuration{}
 			content, err := io.ReadAll(file)
 			if err != nil {
 				http.Error(w, fmt.Sprintf("Failed to read file: %v", err), http.StatusInternalServerError)
@@ -159,12 +169,12 @@ func UploadBackup(a *config.App) http.HandlerFunc {
 
 			switch extension {
 			case ".yaml", ".yml":
-				if err = yaml.Unmarshal(content, dynamic); err != nil {
+				if err = yaml.Unmarshal(content, cfg); err != nil {
 					http.Error(w, fmt.Sprintf("Failed to decode YAML file: %v", err), http.StatusInternalServerError)
 					return
 				}
 			case ".json":
-				if err = json.Unmarshal(content, dynamic); err != nil {
+				if err = json.Unmarshal(content, cfg); err != nil {
 					http.Error(w, fmt.Sprintf("Failed to decode JSON file: %v", err), http.StatusInternalServerError)
 					return
 				}
@@ -173,10 +183,178 @@ func UploadBackup(a *config.App) http.HandlerFunc {
 				return
 			}
 
-			// Write to database
-			traefik.DynamicToDB(r.Context(), a.Conn.Q, profileID, dynamic)
+			items, ok := applyImport(a, w, r, profileID, cfg)
+			if !ok {
+				return
+			}
+			writeImportReport(w, &importReport{Items: items})
+			return
 		}
 
 		w.WriteHeader(http.StatusNoContent)
 	}
 }
+
+// ImportKubernetes translates uploaded Traefik Kubernetes CRD manifests into
+// the profile and reports the fields that could not be mapped.
+func ImportKubernetes(a *config.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		profileID, content, ok := readImport(a, w, r)
+		if !ok {
+			return
+		}
+		result, err := traefik.FromKubernetes(content)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to decode manifests: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		items, ok := applyImport(a, w, r, profileID, result.Config)
+		if !ok {
+			return
+		}
+
+		writeImportReport(w, &importReport{Items: items, Unmapped: result.Unmapped})
+	}
+}
+
+// ImportCompose builds the dynamic config from the traefik labels of an
+// uploaded Docker Compose file and imports it into the profile.
+func ImportCompose(a *config.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		profileID, content, ok := readImport(a, w, r)
+		if !ok {
+			return
+		}
+		result, err := traefik.FromCompose(content)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to decode compose file: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		items, ok := applyImport(a, w, r, profileID, result.Config)
+		if !ok {
+			return
+		}
+		writeImportReport(w, &importReport{Items: items, Warnings: result.Warnings})
+	}
+}
+
+// importReport lists the applied import plan, so skipped, overwritten and
+// renamed items are visible to the caller.
+type importReport struct {
+	Items    []importItem            `json:"items"`
+	Unmapped []traefik.UnmappedField `json:"unmapped,omitempty"`
+	Warnings []string                `json:"warnings,omitempty"`
+}
+
+// importItem is the JSON form of a traefik.ImportItem.
+type importItem struct {
+	Protocol string `json:"protocol"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	NewName  string `json:"newName,omitempty"`
+}
+
+func writeImportReport(w http.ResponseWriter, report *importReport) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		slog.Error("failed to write import report", "error", err)
+	}
+}
+
+// applyImport writes cfg into the profile in a single transaction, resolving
+// conflicts with the "strategy" form value ("skip", "overwrite" or "rename",
+// defaults to "skip"), and returns the applied plan.
+func applyImport(
+	a *config.App,
+	w http.ResponseWriter,
+	r *http.Request,
+	profileID int64,
+	cfg *dynamic.Configuration,
+) ([]importItem, bool) {
+	var strategy traefik.ImportStrategy
+	switch r.FormValue("strategy") {
+	case "", "skip":
+		strategy = traefik.ImportSkip
+	case "overwrite":
+		strategy = traefik.ImportOverwrite
+	case "rename":
+		strategy = traefik.ImportRename
+	default:
+		http.Error(w, "Invalid strategy", http.StatusBadRequest)
+		return nil, false
+	}
+
+	var applied []traefik.ImportItem
+	err := a.Conn.WithTx(r.Context(), func(q *db.Queries) error {
+		var err error
+		applied, err = traefik.ApplyImport(r.Context(), q, profileID, cfg, strategy)
+		return err
+	})
+	var importErr *traefik.ImportError
+	if errors.As(err, &importErr) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to import: %v", err), http.StatusInternalServerError)
+		return nil, false
+	}
+	a.Revisions.Bump(profileID)
+
+	items := make([]importItem, 0, len(applied))
+	for _, item := range applied {
+		items = append(items, importItem{
+			Protocol: item.Protocol,
+			Type:     item.Type,
+			Name:     item.Name,
+			Status:   string(item.Status),
+			NewName:  item.NewName,
+		})
+	}
+	return items, true
+}
+
+// readImport validates the target profile and reads the uploaded file.
+func readImport(a *config.App, w http.ResponseWriter, r *http.Request) (int64, []byte, bool) {
+	profileID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || profileID <= 0 {
+		http.Error(w, "Invalid profile_id", http.StatusBadRequest)
+		return 0, nil, false
+	}
+	if _, err = a.Conn.Q.GetProfile(r.Context(), profileID); err != nil {
+		http.Error(w, "Profile not found", http.StatusNotFound)
+		return 0, nil, false
+	}
+
+	// 10MB limit
+	if err = r.ParseMultipartForm(10 << 20); err != nil {
+		http.Error(w, "File too large or invalid form data", http.StatusBadRequest)
+		return 0, nil, false
+	}
+	defer func() {
+		if err := r.MultipartForm.RemoveAll(); err != nil {
+			slog.Error("failed to close request body", "error", err)
+		}
+	}()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Failed to get uploaded file", http.StatusBadRequest)
+		return 0, nil, false
+	}
+	defer func() {
+		if err = file.Close(); err != nil {
+			slog.Error("failed to close uploaded file", "error", err)
+		}
+	}()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to read file: %v", err), http.StatusInternalServerError)
+		return 0, nil, false
+	}
+	return profileID, content, true
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/mizuchilabs/mantrae/internal/meta"
 	"github.com/mizuchilabs/mantrae/internal/settings"
 	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/util"
 	"golang.org/x/oauth2"
 )
 
@@ -194,7 +195,8 @@ func OIDCCallback(a *config.App) http.HandlerFunc {
 
 		// Find or create user
 		q := a.Conn.Q
-		user, err := findOrCreateOIDCUser(r.Context(), q, &userInfo)
+		role, _ := a.SM.Get(r.Context(), settings.KeyOIDCDefaultRole)
+		user, err := findOrCreateOIDCUser(r.Context(), q, &userInfo, role)
 		if err != nil {
 			http.Error(
 				w,
@@ -209,8 +211,12 @@ func OIDCCallback(a *config.App) http.HandlerFunc {
 		}
 
 		// Generate JWT
-		expirationTime := time.Now().Add(24 * time.Hour)
-		jwtToken, err := meta.EncodeUserToken(user.ID, a.Secret, expirationTime)
+		jwtToken, expirationTime, err := a.CreateSession(
+			r.Context(),
+			user.ID,
+			r.UserAgent(),
+			util.ClientIP(r.Header, r.RemoteAddr),
+		)
 		if err != nil {
 			http.Error(
 				w,
@@ -315,6 +321,7 @@ func findOrCreateOIDCUser(
 	ctx context.Context,
 	q *db.Queries,
 	userInfo *OIDCUserInfo,
+	role string,
 ) (*db.User, error) {
 	var user *db.User
 
@@ -352,6 +359,7 @@ func findOrCreateOIDCUser(
 			ID:       id.String(),
 			Username: generateUniqueUsername(ctx, q, userInfo),
 			Email:    &userInfo.Email,
+			Role:     role,
 		}
 
 		newUser, err := q.CreateUser(ctx, params)
@@ -1,58 +1,337 @@
 package handler
 
 import (
-	"encoding/json"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/mizuchilabs/mantrae/internal/config"
 	"github.com/mizuchilabs/mantrae/internal/meta"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
 	"github.com/mizuchilabs/mantrae/internal/traefik"
-	"gopkg.in/yaml.v3"
 )
 
 func PublishTraefikConfig(a *config.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		format := r.URL.Query().Get("format")
-		urlToken := r.URL.Query().Get("token")
-		headerToken := r.Header.Get(meta.HeaderTraefikToken)
 		accept := r.Header.Get("Accept")
 
-		profile, err := a.Conn.Q.GetProfileByName(r.Context(), r.PathValue("name"))
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		profile, ok := authorizeProfile(a, w, r)
+		if !ok {
 			return
 		}
-		if urlToken != profile.Token && headerToken != profile.Token {
-			http.Error(w, "invalid token", http.StatusUnauthorized)
+
+		// Determine response format: prefer query param over header
+		if format == "" {
+			switch {
+			case strings.Contains(accept, "yaml"):
+				format = "yaml"
+			case strings.Contains(accept, "toml"):
+				format = "toml"
+			}
+		}
+		if format != "yaml" && format != "toml" {
+			format = "json"
+		}
+
+		// A pinned revision never changes, so it skips the long-poll entirely
+		if value := r.URL.Query().Get("revision"); value != "" {
+			publishRevision(a, w, r, profile, value, format)
 			return
 		}
 
-		cfg, err := traefik.BuildDynamicConfig(r.Context(), a.Conn.Q, *profile)
+		wait, err := parseWait(r.URL.Query().Get("wait"))
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
 
-		// Determine response format: prefer query param over header
-		if format == "yaml" || (format == "" && strings.Contains(accept, "yaml")) {
-			w.Header().Set("Content-Type", "application/x-yaml")
-			enc := yaml.NewEncoder(w)
-			enc.SetIndent(2)
-			if err := enc.Encode(cfg); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		rev, changed := a.Revisions.Current(profile.ID)
+		etag := liveETag(profile, rev, format)
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			if wait == 0 {
+				recordInstance(a, r, profile.ID, profile.PublishedRevision)
+				notModified(w, etag)
 				return
 			}
+
+			// Long-poll: hold the request until the profile changes or the wait expires
+			rc := http.NewResponseController(w)
+			if err := rc.SetWriteDeadline(time.Now().Add(wait + 30*time.Second)); err != nil {
+				slog.Warn("failed to extend write deadline", "err", err)
+			}
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+
+			for etagMatches(r.Header.Get("If-None-Match"), etag) {
+				select {
+				case <-r.Context().Done():
+					return
+				case <-timer.C:
+					recordInstance(a, r, profile.ID, profile.PublishedRevision)
+					notModified(w, etag)
+					return
+				case <-changed:
+				}
+
+				// Draft changes of a staged profile don't change what is served
+				rev, changed = a.Revisions.Current(profile.ID)
+				if profile, err = a.Conn.Q.GetProfile(r.Context(), profile.ID); err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				etag = liveETag(profile, rev, format)
+			}
+		}
+
+		cached, err := a.History.Live(r.Context(), a.Conn.Q, *profile)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 
-		// Default to JSON
+		recordInstance(a, r, profile.ID, profile.PublishedRevision)
+		w.Header().Set("ETag", fmt.Sprintf(`"%s.%s"`, cached.Revision, format))
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Vary", "Accept")
+
+		writeConfig(w, cached, format)
+	}
+}
+
+// publishRevision serves a stored config revision of the profile. Revisions
+// of a staged profile newer than the published one are drafts and not served.
+func publishRevision(
+	a *config.App,
+	w http.ResponseWriter,
+	r *http.Request,
+	profile *db.Profile,
+	value, format string,
+) {
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number <= 0 {
+		http.Error(w, "invalid revision", http.StatusBadRequest)
+		return
+	}
+	if profile.PublishedRevision != nil && number > *profile.PublishedRevision {
+		http.Error(w, "revision not published", http.StatusNotFound)
+		return
+	}
+	profileID := profile.ID
+
+	etag := fmt.Sprintf(`"r%d.%s"`, number, format)
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		recordInstance(a, r, profileID, &number)
+		notModified(w, etag)
+		return
+	}
+
+	revision, err := a.Conn.Q.GetConfigRevision(r.Context(), &db.GetConfigRevisionParams{
+		ProfileID: profileID,
+		Revision:  number,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		http.Error(w, "revision not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	encoded, err := traefik.EncodeConfig("", revision.Config.Data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	recordInstance(a, r, profileID, &number)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Vary", "Accept")
+	writeConfig(w, encoded, format)
+}
+
+// instanceRefresh is how often last_fetch of an instance polling the same
+// revision is refreshed.
+const instanceRefresh = time.Minute
+
+type instanceKey struct {
+	profileID int64
+	name      string
+}
+
+type instanceFetch struct {
+	url      string
+	revision string
+	at       time.Time
+}
+
+// instanceFetches remembers the last recorded fetch per instance, so polls
+// only write to the database when something changed.
+var instanceFetches = struct {
+	sync.Mutex
+	seen map[instanceKey]instanceFetch
+}{seen: make(map[instanceKey]instanceFetch)}
+
+// recordInstance registers the Traefik instance fetching the profile's config,
+// named by its instance header or else its address. Without a revision, the
+// latest recorded one is assumed. Repeated fetches of the same revision are
+// only written once per instanceRefresh. Failures don't fail the fetch.
+func recordInstance(a *config.App, r *http.Request, profileID int64, revision *int64) {
+	name := r.Header.Get(meta.HeaderTraefikName)
+	if name == "" {
+		name = r.RemoteAddr
+		if host, _, err := net.SplitHostPort(name); err == nil {
+			name = host
+		}
+	}
+	// The URL is polled for the runtime status, so only web URLs are kept
+	var instanceURL *string
+	value := r.Header.Get(meta.HeaderTraefikURL)
+	if u, err := url.Parse(value); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		instanceURL = &value
+	}
+
+	served, _ := a.Revisions.Current(profileID)
+	if revision != nil {
+		served = fmt.Sprintf("r%d", *revision)
+	}
+	key := instanceKey{profileID: profileID, name: name}
+	fetch := instanceFetch{url: value, revision: served, at: time.Now()}
+
+	instanceFetches.Lock()
+	last, ok := instanceFetches.seen[key]
+	if ok && last.url == fetch.url && last.revision == fetch.revision &&
+		fetch.at.Sub(last.at) < instanceRefresh {
+		instanceFetches.Unlock()
+		return
+	}
+	instanceFetches.seen[key] = fetch
+	instanceFetches.Unlock()
+
+	if _, err := a.Conn.Q.UpsertTraefikInstance(r.Context(), &db.UpsertTraefikInstanceParams{
+		ProfileID: profileID,
+		Name:      name,
+		Url:       instanceURL,
+		Revision:  revision,
+	}); err != nil {
+		instanceFetches.Lock()
+		delete(instanceFetches.seen, key)
+		instanceFetches.Unlock()
+		slog.Error("failed to record traefik instance", "profile", profileID, "error", err)
+	}
+}
+
+// liveETag returns the ETag of the configuration served for the profile,
+// which for staged profiles is its published revision.
+func liveETag(profile *db.Profile, rev, format string) string {
+	if profile.PublishedRevision != nil {
+		rev = fmt.Sprintf("r%d", *profile.PublishedRevision)
+	}
+	return fmt.Sprintf(`"%s.%s"`, rev, format)
+}
+
+func writeConfig(w http.ResponseWriter, cfg *traefik.CachedConfig, format string) {
+	switch format {
+	case "yaml":
+		w.Header().Set("Content-Type", "application/x-yaml")
+	case "toml":
+		w.Header().Set("Content-Type", "application/toml")
+	default:
 		w.Header().Set("Content-Type", "application/json")
-		enc := json.NewEncoder(w)
-		enc.SetIndent("", "  ") // Indent the output with two spaces
-		if err := enc.Encode(cfg); err != nil {
+	}
+	if _, err := w.Write(cfg.Encoded(format)); err != nil {
+		slog.Error("failed to write dynamic config", "err", err)
+	}
+}
+
+// ExportKubernetes serves the profile's dynamic config as Traefik Kubernetes
+// CRD manifests.
+func ExportKubernetes(a *config.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		profile, ok := authorizeProfile(a, w, r)
+		if !ok {
+			return
+		}
+
+		namespace := r.URL.Query().Get("namespace")
+		if namespace == "" {
+			namespace = "default"
+		}
+
+		cached, err := a.History.Live(r.Context(), a.Conn.Q, *profile)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		out, err := traefik.ToKubernetes(cached.Config, namespace).YAML()
+		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+
+		w.Header().Set("Content-Type", "application/x-yaml")
+		if _, err := w.Write(out); err != nil {
+			slog.Error("failed to write kubernetes export", "err", err)
+		}
+	}
+}
+
+// authorizeProfile looks up the profile from the path and checks the token
+// passed via query parameter or header.
+func authorizeProfile(a *config.App, w http.ResponseWriter, r *http.Request) (*db.Profile, bool) {
+	urlToken := r.URL.Query().Get("token")
+	headerToken := r.Header.Get(meta.HeaderTraefikToken)
+
+	profile, err := a.Conn.Q.GetProfileByName(r.Context(), r.PathValue("name"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if urlToken != profile.Token && headerToken != profile.Token {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return nil, false
+	}
+	return profile, true
+}
+
+// maxWait caps how long a client may long-poll for config changes.
+const maxWait = 5 * time.Minute
+
+func parseWait(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	wait, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid wait duration: %w", err)
+	}
+	if wait < 0 {
+		return 0, errors.New("wait duration must not be negative")
+	}
+	return min(wait, maxWait), nil
+}
+
+func etagMatches(header, etag string) bool {
+	for tag := range strings.SplitSeq(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == etag || tag == "*" {
+			return true
+		}
 	}
+	return false
+}
+
+func notModified(w http.ResponseWriter, etag string) {
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusNotModified)
 }
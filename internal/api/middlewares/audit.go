@@ -33,6 +33,7 @@ func NewAuditInterceptor(app *config.App) connect.UnaryInterceptorFunc {
 				params := &db.CreateAuditLogParams{}
 				params.UserID = GetUserIDFromContext(ctx)
 				params.AgentID = GetAgentIDFromContext(ctx)
+				params.TokenID = GetTokenIDFromContext(ctx)
 				if auditEvent := extractAuditEvent(req, resp); auditEvent != nil {
 					if auditEvent.Details == "" || auditEvent.Event == "" {
 						return resp, err
@@ -90,6 +91,24 @@ func mapMethodToEvent(method string) string {
 		return "update"
 	case strings.HasPrefix(method, "Delete"):
 		return "delete"
+	case strings.HasPrefix(method, "Rollback"):
+		return "rollback"
+	case strings.HasPrefix(method, "Publish"):
+		return "publish"
+	case strings.HasPrefix(method, "Discard"):
+		return "discard"
+	case strings.HasPrefix(method, "Instantiate"):
+		return "instantiate"
+	case strings.HasPrefix(method, "Clone"):
+		return "clone"
+	case strings.HasPrefix(method, "Promote"):
+		return "promote"
+	case strings.HasPrefix(method, "Revoke"):
+		return "revoke"
+	case strings.HasPrefix(method, "Enable"):
+		return "enable"
+	case strings.HasPrefix(method, "Disable"):
+		return "disable"
 	default:
 		return ""
 	}
@@ -114,6 +133,16 @@ func getResourceType(service string) string {
 		return "agent"
 	case strings.Contains(service, "UserService"):
 		return "user"
+	case strings.Contains(service, "RevisionService"):
+		return "revision"
+	case strings.Contains(service, "TraefikInstanceService"):
+		return "traefik_instance"
+	case strings.Contains(service, "TemplateService"):
+		return "template"
+	case strings.Contains(service, "ProfileVariableService"):
+		return "variable"
+	case strings.Contains(service, "ProfileMemberService"):
+		return "member"
 	default:
 		return "unknown"
 	}
@@ -142,6 +171,16 @@ func extractProfileAndDetails(
 		return extractAgentServiceDetails(method, req, resp)
 	case "mantrae.v1.UserService":
 		return extractUserServiceDetails(method, req, resp)
+	case "mantrae.v1.RevisionService":
+		return extractRevisionServiceDetails(method, req, resp)
+	case "mantrae.v1.TraefikInstanceService":
+		return extractTraefikInstanceServiceDetails(method, req, resp)
+	case "mantrae.v1.TemplateService":
+		return extractTemplateServiceDetails(method, req, resp)
+	case "mantrae.v1.ProfileVariableService":
+		return extractProfileVariableServiceDetails(method, req, resp)
+	case "mantrae.v1.ProfileMemberService":
+		return extractProfileMemberServiceDetails(method, req, resp)
 	default:
 		return nil, ""
 	}
@@ -175,6 +214,40 @@ func extractProfileServiceDetails(
 		if deleteReq, ok := req.Any().(*mantraev1.DeleteProfileRequest); ok {
 			return &deleteReq.Id, fmt.Sprintf("Deleted profile (ID: %d)", deleteReq.Id)
 		}
+	case "PublishProfile":
+		if publishReq, ok := req.Any().(*mantraev1.PublishProfileRequest); ok {
+			if publishResp, ok := resp.Any().(*mantraev1.PublishProfileResponse); ok {
+				return &publishReq.Id, fmt.Sprintf(
+					"Published revision %d (ID: %d)",
+					publishResp.Revision.Revision,
+					publishReq.Id,
+				)
+			}
+		}
+	case "DiscardDraft":
+		if discardReq, ok := req.Any().(*mantraev1.DiscardDraftRequest); ok {
+			return &discardReq.Id, fmt.Sprintf("Discarded draft (ID: %d)", discardReq.Id)
+		}
+	case "CloneProfile":
+		if cloneReq, ok := req.Any().(*mantraev1.CloneProfileRequest); ok {
+			if cloneResp, ok := resp.Any().(*mantraev1.CloneProfileResponse); ok {
+				return &cloneResp.Profile.Id, fmt.Sprintf(
+					"Cloned profile %d as '%s' (ID: %d)",
+					cloneReq.Id,
+					cloneReq.Name,
+					cloneResp.Profile.Id,
+				)
+			}
+		}
+	case "PromoteResources":
+		if promoteReq, ok := req.Any().(*mantraev1.PromoteResourcesRequest); ok {
+			return &promoteReq.TargetProfileId, fmt.Sprintf(
+				"Promoted %d resources from profile %d (ID: %d)",
+				len(promoteReq.Resources),
+				promoteReq.SourceProfileId,
+				promoteReq.TargetProfileId,
+			)
+		}
 	}
 	return nil, ""
 }
@@ -387,6 +460,178 @@ func extractUserServiceDetails(
 		if deleteReq, ok := req.Any().(*mantraev1.DeleteUserRequest); ok {
 			return nil, fmt.Sprintf("Deleted user (ID: %s)", deleteReq.Id)
 		}
+	case "CreateAPIToken":
+		if createResp, ok := resp.Any().(*mantraev1.CreateAPITokenResponse); ok {
+			return nil, fmt.Sprintf(
+				"Created API token '%s' (ID: %s)",
+				createResp.ApiToken.Name,
+				createResp.ApiToken.Id,
+			)
+		}
+	case "DeleteAPIToken":
+		if deleteReq, ok := req.Any().(*mantraev1.DeleteAPITokenRequest); ok {
+			return nil, fmt.Sprintf("Revoked API token (ID: %s)", deleteReq.Id)
+		}
+	case "RevokeSession":
+		if revokeReq, ok := req.Any().(*mantraev1.RevokeSessionRequest); ok {
+			return nil, fmt.Sprintf("Revoked session (ID: %s)", revokeReq.Id)
+		}
+	case "RevokeAllSessions":
+		if revokeReq, ok := req.Any().(*mantraev1.RevokeAllSessionsRequest); ok {
+			if revokeReq.UserId != nil {
+				return nil, fmt.Sprintf("Revoked all sessions of user (ID: %s)", *revokeReq.UserId)
+			}
+			return nil, "Revoked all own sessions"
+		}
+	case "EnableTwoFactor":
+		return nil, "Enabled two-factor authentication"
+	case "DisableTwoFactor":
+		if disableReq, ok := req.Any().(*mantraev1.DisableTwoFactorRequest); ok {
+			if disableReq.UserId != nil {
+				return nil, fmt.Sprintf(
+					"Disabled two-factor authentication of user (ID: %s)",
+					*disableReq.UserId,
+				)
+			}
+			return nil, "Disabled two-factor authentication"
+		}
+	case "UpdateUserRole":
+		if roleResp, ok := resp.Any().(*mantraev1.UpdateUserRoleResponse); ok {
+			return nil, fmt.Sprintf(
+				"Changed role of user '%s' to %s",
+				roleResp.User.Username,
+				strings.ToLower(strings.TrimPrefix(roleResp.User.Role.String(), "ROLE_")),
+			)
+		}
+	}
+	return nil, ""
+}
+
+func extractRevisionServiceDetails(
+	method string,
+	req connect.AnyRequest,
+	resp connect.AnyResponse,
+) (*int64, string) {
+	switch method {
+	case "RollbackRevision":
+		if rollbackReq, ok := req.Any().(*mantraev1.RollbackRevisionRequest); ok {
+			if rollbackResp, ok := resp.Any().(*mantraev1.RollbackRevisionResponse); ok {
+				return &rollbackReq.ProfileId, fmt.Sprintf(
+					"Rolled back to revision %d as revision %d",
+					rollbackReq.Revision,
+					rollbackResp.Revision.Revision,
+				)
+			}
+		}
+	}
+	return nil, ""
+}
+
+func extractTraefikInstanceServiceDetails(
+	method string,
+	req connect.AnyRequest,
+	resp connect.AnyResponse,
+) (*int64, string) {
+	switch method {
+	case "DeleteTraefikInstance":
+		if deleteReq, ok := req.Any().(*mantraev1.DeleteTraefikInstanceRequest); ok {
+			return nil, fmt.Sprintf("Deleted traefik instance (ID: %d)", deleteReq.Id)
+		}
+	}
+	return nil, ""
+}
+
+func extractTemplateServiceDetails(
+	method string,
+	req connect.AnyRequest,
+	resp connect.AnyResponse,
+) (*int64, string) {
+	switch method {
+	case "CreateTemplate":
+		if createReq, ok := req.Any().(*mantraev1.CreateTemplateRequest); ok {
+			return nil, fmt.Sprintf("Created template '%s'", createReq.Name)
+		}
+	case "UpdateTemplate":
+		if updateReq, ok := req.Any().(*mantraev1.UpdateTemplateRequest); ok {
+			return nil, fmt.Sprintf(
+				"Updated template '%s' (ID: %s)",
+				updateReq.Name,
+				updateReq.Id,
+			)
+		}
+	case "DeleteTemplate":
+		if deleteReq, ok := req.Any().(*mantraev1.DeleteTemplateRequest); ok {
+			return nil, fmt.Sprintf("Deleted template (ID: %s)", deleteReq.Id)
+		}
+	case "InstantiateTemplate":
+		if instReq, ok := req.Any().(*mantraev1.InstantiateTemplateRequest); ok {
+			if instResp, ok := resp.Any().(*mantraev1.InstantiateTemplateResponse); ok {
+				return &instReq.ProfileId, fmt.Sprintf(
+					"Instantiated template '%s' (%d items)",
+					instReq.Name,
+					len(instResp.Items),
+				)
+			}
+		}
+	}
+	return nil, ""
+}
+
+func extractProfileVariableServiceDetails(
+	method string,
+	req connect.AnyRequest,
+	resp connect.AnyResponse,
+) (*int64, string) {
+	switch method {
+	case "CreateProfileVariable":
+		if createReq, ok := req.Any().(*mantraev1.CreateProfileVariableRequest); ok {
+			return &createReq.ProfileId, fmt.Sprintf("Created variable '%s'", createReq.Name)
+		}
+	case "UpdateProfileVariable":
+		if updateResp, ok := resp.Any().(*mantraev1.UpdateProfileVariableResponse); ok {
+			return &updateResp.Variable.ProfileId, fmt.Sprintf(
+				"Updated variable '%s' (ID: %s)",
+				updateResp.Variable.Name,
+				updateResp.Variable.Id,
+			)
+		}
+	case "DeleteProfileVariable":
+		if deleteReq, ok := req.Any().(*mantraev1.DeleteProfileVariableRequest); ok {
+			return nil, fmt.Sprintf("Deleted variable (ID: %s)", deleteReq.Id)
+		}
+	}
+	return nil, ""
+}
+
+func extractProfileMemberServiceDetails(
+	method string,
+	req connect.AnyRequest,
+	resp connect.AnyResponse,
+) (*int64, string) {
+	switch method {
+	case "CreateProfileMember":
+		if createResp, ok := resp.Any().(*mantraev1.CreateProfileMemberResponse); ok {
+			return &createResp.Member.ProfileId, fmt.Sprintf(
+				"Added member (user ID: %s) as %s",
+				createResp.Member.UserId,
+				strings.ToLower(strings.TrimPrefix(createResp.Member.Role.String(), "ROLE_")),
+			)
+		}
+	case "UpdateProfileMember":
+		if updateResp, ok := resp.Any().(*mantraev1.UpdateProfileMemberResponse); ok {
+			return &updateResp.Member.ProfileId, fmt.Sprintf(
+				"Changed role of member (user ID: %s) to %s",
+				updateResp.Member.UserId,
+				strings.ToLower(strings.TrimPrefix(updateResp.Member.Role.String(), "ROLE_")),
+			)
+		}
+	case "DeleteProfileMember":
+		if deleteReq, ok := req.Any().(*mantraev1.DeleteProfileMemberRequest); ok {
+			return &deleteReq.ProfileId, fmt.Sprintf(
+				"Removed member (user ID: %s)",
+				deleteReq.UserId,
+			)
+		}
 	}
 	return nil, ""
 }
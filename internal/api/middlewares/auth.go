@@ -4,20 +4,27 @@ package middlewares
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/mizuchilabs/mantrae/internal/config"
 	"github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1/mantraev1connect"
 	"github.com/mizuchilabs/mantrae/internal/meta"
+	"github.com/mizuchilabs/mantrae/internal/util"
 )
 
 type ctxKey string
 
 const (
-	AuthUserIDKey  ctxKey = "user_id"
-	AuthAgentIDKey ctxKey = "agent_id"
+	AuthUserIDKey         ctxKey = "user_id"
+	AuthAgentIDKey        ctxKey = "agent_id"
+	AuthRoleKey           ctxKey = "role"
+	AuthTokenIDKey        ctxKey = "token_id"
+	AuthSessionIDKey      ctxKey = "session_id"
+	AuthTwoFactorSetupKey ctxKey = "two_factor_setup"
 )
 
 type AuthInterceptor struct {
@@ -40,6 +47,12 @@ func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			if err != nil {
 				return nil, err
 			}
+			if err := authorizeRequest(authedCtx, req.Spec().Procedure); err != nil {
+				return nil, err
+			}
+			if err := i.authorizeProfiles(authedCtx, req.Spec().Procedure, req.Any()); err != nil {
+				return nil, err
+			}
 			return next(authedCtx, req)
 		},
 	)
@@ -65,11 +78,33 @@ func (i *AuthInterceptor) WrapStreamingHandler(
 			if err != nil {
 				return err
 			}
-			return next(authedCtx, conn)
+			if err := authorizeRequest(authedCtx, conn.Spec().Procedure); err != nil {
+				return err
+			}
+			return next(authedCtx, &profileCheckedConn{
+				StreamingHandlerConn: conn,
+				check: func(msg any) error {
+					return i.authorizeProfiles(authedCtx, conn.Spec().Procedure, msg)
+				},
+			})
 		},
 	)
 }
 
+// profileCheckedConn checks the profiles of every message received on a
+// stream, as its messages aren't known when the stream is opened.
+type profileCheckedConn struct {
+	connect.StreamingHandlerConn
+	check func(msg any) error
+}
+
+func (c *profileCheckedConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return c.check(msg)
+}
+
 // HTTP wrappers --------------------------------------------------------------
 
 func (a *AuthInterceptor) WithAuth(next http.Handler) http.Handler {
@@ -80,6 +115,10 @@ func (a *AuthInterceptor) WithAuth(next http.Handler) http.Handler {
 			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
+		if pending, _ := authedCtx.Value(AuthTwoFactorSetupKey).(bool); pending {
+			http.Error(w, "Two-factor authentication must be set up first", http.StatusForbidden)
+			return
+		}
 
 		// Continue with authenticated context
 		next.ServeHTTP(w, r.WithContext(authedCtx))
@@ -113,61 +152,90 @@ func (i *AuthInterceptor) authenticateRequest(
 
 	// User request (Cookie/Bearer) -------------------------------------------
 	if token := getCookieToken(header); token != "" {
-		claims, err := meta.DecodeUserToken(token, i.app.Secret)
-		if err != nil {
-			return nil, connect.NewError(
-				connect.CodeUnauthenticated,
-				errors.New("unauthorized"),
-			)
-		}
-		if claims.IsExpired() {
-			return nil, connect.NewError(
-				connect.CodeUnauthenticated,
-				errors.New("unauthorized"),
-			)
-		}
-		user, err := i.app.Conn.Q.GetUserByID(ctx, claims.UserID)
-		if err != nil {
-			return nil, connect.NewError(
-				connect.CodeUnauthenticated,
-				errors.New("unauthorized"),
-			)
-		}
-		return context.WithValue(ctx, AuthUserIDKey, user.ID), nil
+		return i.authenticateSession(ctx, token)
+	}
+	if token := getBearerToken(header); strings.HasPrefix(token, meta.APITokenPrefix) {
+		return i.authenticateAPIToken(ctx, token)
 	}
 	if token := getBearerToken(header); token != "" {
-		claims, err := meta.DecodeUserToken(token, i.app.Secret)
-		if err != nil {
-			return nil, connect.NewError(
-				connect.CodeUnauthenticated,
-				errors.New("unauthorized"),
-			)
-		}
-		if claims.IsExpired() {
-			return nil, connect.NewError(
-				connect.CodeUnauthenticated,
-				errors.New("unauthorized"),
-			)
-		}
-		user, err := i.app.Conn.Q.GetUserByID(ctx, claims.UserID)
-		if err != nil {
-			return nil, connect.NewError(
-				connect.CodeUnauthenticated,
-				errors.New("unauthorized"),
-			)
-		}
-		return context.WithValue(ctx, AuthUserIDKey, user.ID), nil
+		return i.authenticateSession(ctx, token)
 	}
 
 	// Unauthorized -----------------------------------------------------------
 	return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthorized"))
 }
 
+// authenticateSession authenticates a user token, the session it was issued
+// for must not have been revoked.
+func (i *AuthInterceptor) authenticateSession(
+	ctx context.Context,
+	token string,
+) (context.Context, error) {
+	claims, err := meta.DecodeUserToken(token, i.app.Secret)
+	if err != nil || claims.IsExpired() {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthorized"))
+	}
+	session, err := i.app.Conn.Q.GetSession(ctx, claims.ID)
+	if err != nil || session.UserID != claims.UserID || session.ExpiresAt.Before(time.Now()) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session revoked"))
+	}
+	user, err := i.app.Conn.Q.GetUserByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthorized"))
+	}
+	if session.LastSeenAt == nil || time.Since(*session.LastSeenAt) > time.Minute {
+		if err = i.app.Conn.Q.UpdateSessionLastSeen(ctx, session.ID); err != nil {
+			slog.Error("failed to update session last seen", "error", err)
+		}
+	}
+
+	ctx = withUser(ctx, user.ID, user.Role)
+	if i.requiresTwoFactorSetup(ctx, user) {
+		ctx = context.WithValue(ctx, AuthTwoFactorSetupKey, true)
+	}
+	return context.WithValue(ctx, AuthSessionIDKey, session.ID), nil
+}
+
+// authenticateAPIToken authenticates a personal API token. The request gets
+// the lower of the token and the user role.
+func (i *AuthInterceptor) authenticateAPIToken(
+	ctx context.Context,
+	token string,
+) (context.Context, error) {
+	apiToken, err := i.app.Conn.Q.GetApiTokenByHash(ctx, util.HashToken(token))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthorized"))
+	}
+	if apiToken.ExpiresAt != nil && apiToken.ExpiresAt.Before(time.Now()) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("token expired"))
+	}
+	user, err := i.app.Conn.Q.GetUserByID(ctx, apiToken.UserID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthorized"))
+	}
+	if err = i.app.Conn.Q.UpdateApiTokenLastUsed(ctx, apiToken.ID); err != nil {
+		slog.Error("failed to update API token last use", "error", err)
+	}
+
+	role := apiToken.Role
+	if HasRole(role, user.Role) {
+		role = user.Role
+	}
+	ctx = withUser(ctx, user.ID, role)
+	return context.WithValue(ctx, AuthTokenIDKey, apiToken.ID), nil
+}
+
 // Helper
+func withUser(ctx context.Context, userID, role string) context.Context {
+	ctx = context.WithValue(ctx, AuthUserIDKey, userID)
+	return context.WithValue(ctx, AuthRoleKey, role)
+}
+
 func isPublicEndpoint(procedure string) bool {
 	publicEndpoints := map[string]bool{
-		mantraev1connect.UserServiceLoginUserProcedure:     true,
-		mantraev1connect.UserServiceGetOIDCStatusProcedure: true,
+		mantraev1connect.UserServiceLoginUserProcedure:            true,
+		mantraev1connect.UserServiceGetOIDCStatusProcedure:        true,
+		mantraev1connect.UserServiceVerifyTwoFactorLoginProcedure: true,
 	}
 	return publicEndpoints[procedure]
 }
@@ -209,6 +277,24 @@ func GetUserIDFromContext(ctx context.Context) *string {
 	return nil
 }
 
+func GetTokenIDFromContext(ctx context.Context) *string {
+	if token := ctx.Value(AuthTokenIDKey); token != nil {
+		if tokenID, ok := token.(string); ok && tokenID != "" {
+			return &tokenID
+		}
+	}
+	return nil
+}
+
+func GetSessionIDFromContext(ctx context.Context) *string {
+	if session := ctx.Value(AuthSessionIDKey); session != nil {
+		if sessionID, ok := session.(string); ok && sessionID != "" {
+			return &sessionID
+		}
+	}
+	return nil
+}
+
 func GetAgentIDFromContext(ctx context.Context) *string {
 	if agent := ctx.Value(AuthAgentIDKey); agent != nil {
 		if agentID, ok := agent.(string); ok && agentID != "" {
@@ -0,0 +1,185 @@
+package middlewares
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/mizuchilabs/mantrae/internal/meta"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/util"
+)
+
+func bearer(token string) http.Header {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+token)
+	return header
+}
+
+func TestAuthenticateAPIToken(t *testing.T) {
+	expired := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		userRole  string
+		tokenRole string
+		expiresAt *time.Time
+		revoked   bool
+		want      string       // role of the request
+		code      connect.Code // zero if authenticated
+	}{
+		{name: "same role", userRole: meta.RoleEditor, tokenRole: meta.RoleEditor, want: meta.RoleEditor},
+		{name: "lower token role", userRole: meta.RoleAdmin, tokenRole: meta.RoleViewer, want: meta.RoleViewer},
+		{name: "capped at user role", userRole: meta.RoleViewer, tokenRole: meta.RoleAdmin, want: meta.RoleViewer},
+		{name: "capped at editor", userRole: meta.RoleEditor, tokenRole: meta.RoleAdmin, want: meta.RoleEditor},
+		{
+			name:      "revoked",
+			userRole:  meta.RoleAdmin,
+			tokenRole: meta.RoleAdmin,
+			revoked:   true,
+			code:      connect.CodeUnauthenticated,
+		},
+		{
+			name:      "expired",
+			userRole:  meta.RoleAdmin,
+			tokenRole: meta.RoleAdmin,
+			expiresAt: &expired,
+			code:      connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			i := testInterceptor(t)
+			user, err := i.app.Conn.Q.CreateUser(ctx, &db.CreateUserParams{
+				ID:       "user",
+				Username: "user",
+				Password: "password",
+				Role:     tt.userRole,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			token := meta.APITokenPrefix + util.GenerateToken(16)
+			apiToken, err := i.app.Conn.Q.CreateApiToken(ctx, &db.CreateApiTokenParams{
+				ID:        "token",
+				UserID:    user.ID,
+				Name:      "ci",
+				TokenHash: util.HashToken(token),
+				Role:      tt.tokenRole,
+				ExpiresAt: tt.expiresAt,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.revoked {
+				if err = i.app.Conn.Q.DeleteApiToken(ctx, apiToken.ID); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			authed, err := i.authenticateRequest(ctx, bearer(token))
+			if tt.code != 0 {
+				if connect.CodeOf(err) != tt.code {
+					t.Errorf("err = %v, want code %v", err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if role := GetRoleFromContext(authed); role != tt.want {
+				t.Errorf("role = %q, want %q", role, tt.want)
+			}
+			if id := GetTokenIDFromContext(authed); id == nil || *id != apiToken.ID {
+				t.Errorf("token id = %v, want %q", id, apiToken.ID)
+			}
+		})
+	}
+}
+
+func TestAuthenticateSession(t *testing.T) {
+	tests := []struct {
+		name   string
+		revoke func(t *testing.T, q *db.Queries, session *db.Session)
+		other  bool // the token names another user than the session
+		code   connect.Code
+	}{
+		{name: "active"},
+		{
+			name: "revoked",
+			revoke: func(t *testing.T, q *db.Queries, session *db.Session) {
+				if err := q.DeleteSession(t.Context(), session.ID); err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: connect.CodeUnauthenticated,
+		},
+		{
+			name: "all sessions revoked",
+			revoke: func(t *testing.T, q *db.Queries, session *db.Session) {
+				if err := q.DeleteSessionsByUser(t.Context(), session.UserID); err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: connect.CodeUnauthenticated,
+		},
+		{name: "other user", other: true, code: connect.CodeUnauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			i := testInterceptor(t)
+			for _, id := range []string{"user", "other"} {
+				if _, err := i.app.Conn.Q.CreateUser(ctx, &db.CreateUserParams{
+					ID:       id,
+					Username: id,
+					Password: "password",
+					Role:     meta.RoleEditor,
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expiresAt := time.Now().Add(time.Hour)
+			session, err := i.app.Conn.Q.CreateSession(ctx, &db.CreateSessionParams{
+				ID:        "session",
+				UserID:    "user",
+				ExpiresAt: expiresAt,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			userID := session.UserID
+			if tt.other {
+				userID = "other"
+			}
+			token, err := meta.EncodeUserToken(userID, session.ID, i.app.Secret, expiresAt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.revoke != nil {
+				tt.revoke(t, i.app.Conn.Q, session)
+			}
+
+			authed, err := i.authenticateRequest(ctx, bearer(token))
+			if tt.code != 0 {
+				if connect.CodeOf(err) != tt.code {
+					t.Errorf("err = %v, want code %v", err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id := GetSessionIDFromContext(authed); id == nil || *id != session.ID {
+				t.Errorf("session id = %v, want %q", id, session.ID)
+			}
+			if role := GetRoleFromContext(authed); role != meta.RoleEditor {
+				t.Errorf("role = %q, want %q", role, meta.RoleEditor)
+			}
+		})
+	}
+}
@@ -15,6 +15,12 @@ func NewChain(constructors ...Constructor) Chain {
 	return Chain{append(([]Constructor)(nil), constructors...)}
 }
 
+// Append returns a new chain with the constructors added after the existing
+// ones
+func (c Chain) Append(constructors ...Constructor) Chain {
+	return Chain{append(append(([]Constructor)(nil), c.constructors...), constructors...)}
+}
+
 func (c Chain) Then(h http.Handler) http.Handler {
 	if h == nil {
 		h = http.DefaultServeMux
@@ -0,0 +1,313 @@
+package middlewares
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"connectrpc.com/connect"
+	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1/mantraev1connect"
+	"github.com/mizuchilabs/mantrae/internal/meta"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// profileFields name the request fields that hold a profile ID.
+var profileFields = []protoreflect.Name{"profile_id", "source_profile_id", "target_profile_id"}
+
+// authorizeProfiles checks that a user is a member of every profile a request
+// touches, with a profile role allowing the procedure. Admins are not scoped
+// to profiles, agents are limited to their own profile.
+func (i *AuthInterceptor) authorizeProfiles(
+	ctx context.Context,
+	procedure string,
+	msg any,
+) error {
+	if agentID := GetAgentIDFromContext(ctx); agentID != nil {
+		return i.authorizeAgentProfile(ctx, *agentID, procedure, msg)
+	}
+	userID := GetUserIDFromContext(ctx)
+	if userID == nil || GetRoleFromContext(ctx) == meta.RoleAdmin {
+		return nil
+	}
+
+	profileIDs, err := requestProfiles(ctx, i.app.Conn.Q, procedure, msg)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	for _, profileID := range profileIDs {
+		if err = i.checkProfileRole(ctx, *userID, profileID, requiredRole(procedure)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authorizeAgentProfile fails if a request of an agent touches a profile
+// other than the agent's own.
+func (i *AuthInterceptor) authorizeAgentProfile(
+	ctx context.Context,
+	agentID, procedure string,
+	msg any,
+) error {
+	agent, err := i.app.Conn.Q.GetAgent(ctx, agentID)
+	if err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("unauthorized"))
+	}
+	profileIDs, err := requestProfiles(ctx, i.app.Conn.Q, procedure, msg)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	for _, profileID := range profileIDs {
+		if profileID != agent.ProfileID {
+			return connect.NewError(
+				connect.CodePermissionDenied,
+				fmt.Errorf("agent is not allowed to access profile %d", profileID),
+			)
+		}
+	}
+	return nil
+}
+
+// checkProfileRole fails unless the user is a member of the profile with at
+// least the required role.
+func (i *AuthInterceptor) checkProfileRole(
+	ctx context.Context,
+	userID string,
+	profileID int64,
+	required string,
+) error {
+	member, err := i.app.Conn.Q.GetProfileMember(ctx, &db.GetProfileMemberParams{
+		ProfileID: profileID,
+		UserID:    userID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return connect.NewError(
+				connect.CodePermissionDenied,
+				fmt.Errorf("not a member of profile %d", profileID),
+			)
+		}
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if !HasRole(member.Role, required) {
+		return connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf("role %q in profile %d is not sufficient", member.Role, profileID),
+		)
+	}
+	return nil
+}
+
+// WithProfileRole only lets users through that have at least the given role,
+// globally and in the profile named by the "id" path value. It must be
+// chained after WithAuth.
+func (a *AuthInterceptor) WithProfileRole(required string) Constructor {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role := GetRoleFromContext(r.Context())
+			userID := GetUserIDFromContext(r.Context())
+			if !HasRole(role, required) || userID == nil {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+			if role != meta.RoleAdmin {
+				profileID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+				if err != nil {
+					http.Error(w, "Invalid profile ID", http.StatusBadRequest)
+					return
+				}
+				if err = a.checkProfileRole(r.Context(), *userID, profileID, required); err != nil {
+					http.Error(w, "Forbidden", http.StatusForbidden)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requestProfiles returns the profiles a request touches, either named by its
+// profile fields or resolved from the resource it addresses by ID.
+func requestProfiles(
+	ctx context.Context,
+	q *db.Queries,
+	procedure string,
+	msg any,
+) ([]int64, error) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil, nil
+	}
+
+	var profileIDs []int64
+	refl := m.ProtoReflect()
+	for _, name := range profileFields {
+		field := refl.Descriptor().Fields().ByName(name)
+		if field != nil && field.Kind() == protoreflect.Int64Kind && refl.Has(field) {
+			profileIDs = append(profileIDs, refl.Get(field).Int())
+		}
+	}
+
+	// Unknown resources are reported by the handler
+	profileID, err := resourceProfile(ctx, q, procedure, msg)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if profileID != nil {
+		profileIDs = append(profileIDs, *profileID)
+	}
+	return profileIDs, nil
+}
+
+// resourceProfile resolves the profile of the resource a request addresses
+// by ID, e.g. the router of an UpdateRouterRequest.
+func resourceProfile(
+	ctx context.Context,
+	q *db.Queries,
+	procedure string,
+	msg any,
+) (*int64, error) {
+	service, _ := splitProcedure(procedure)
+	switch r := msg.(type) {
+	case *mantraev1.ListServiceHealthHistoryRequest:
+		return protocolResourceProfile(ctx, q, service, r.Type, r.ServiceId)
+	case interface {
+		GetId() string
+		GetType() mantraev1.ProtocolType
+	}:
+		return protocolResourceProfile(ctx, q, service, r.GetType(), r.GetId())
+	case interface{ GetId() string }:
+		if r.GetId() == "" {
+			return nil, nil
+		}
+		switch service {
+		case mantraev1connect.EntryPointServiceName:
+			entryPoint, err := q.GetEntryPoint(ctx, r.GetId())
+			if err != nil {
+				return nil, err
+			}
+			return &entryPoint.ProfileID, nil
+		case mantraev1connect.ProfileVariableServiceName:
+			variable, err := q.GetProfileVariable(ctx, r.GetId())
+			if err != nil {
+				return nil, err
+			}
+			return &variable.ProfileID, nil
+		case mantraev1connect.AgentServiceName:
+			agent, err := q.GetAgent(ctx, r.GetId())
+			if err != nil {
+				return nil, err
+			}
+			return &agent.ProfileID, nil
+		}
+	case interface{ GetId() int64 }:
+		switch service {
+		case mantraev1connect.ProfileServiceName:
+			profileID := r.GetId()
+			return &profileID, nil
+		case mantraev1connect.TraefikInstanceServiceName:
+			instance, err := q.GetTraefikInstance(ctx, r.GetId())
+			if err != nil {
+				return nil, err
+			}
+			return &instance.ProfileID, nil
+		}
+	}
+	return nil, nil
+}
+
+// protocolResourceProfile resolves the profile of a router, service,
+// middleware or servers transport.
+func protocolResourceProfile(
+	ctx context.Context,
+	q *db.Queries,
+	service string,
+	protocol mantraev1.ProtocolType,
+	id string,
+) (*int64, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	switch service {
+	case mantraev1connect.RouterServiceName:
+		switch protocol {
+		case mantraev1.ProtocolType_PROTOCOL_TYPE_HTTP:
+			router, err := q.GetHttpRouter(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &router.ProfileID, nil
+		case mantraev1.ProtocolType_PROTOCOL_TYPE_TCP:
+			router, err := q.GetTcpRouter(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &router.ProfileID, nil
+		case mantraev1.ProtocolType_PROTOCOL_TYPE_UDP:
+			router, err := q.GetUdpRouter(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &router.ProfileID, nil
+		}
+	case mantraev1connect.ServiceServiceName:
+		switch protocol {
+		case mantraev1.ProtocolType_PROTOCOL_TYPE_HTTP:
+			svc, err := q.GetHttpService(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &svc.ProfileID, nil
+		case mantraev1.ProtocolType_PROTOCOL_TYPE_TCP:
+			svc, err := q.GetTcpService(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &svc.ProfileID, nil
+		case mantraev1.ProtocolType_PROTOCOL_TYPE_UDP:
+			svc, err := q.GetUdpService(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &svc.ProfileID, nil
+		}
+	case mantraev1connect.MiddlewareServiceName:
+		switch protocol {
+		case mantraev1.ProtocolType_PROTOCOL_TYPE_HTTP:
+			middleware, err := q.GetHttpMiddleware(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &middleware.ProfileID, nil
+		case mantraev1.ProtocolType_PROTOCOL_TYPE_TCP:
+			middleware, err := q.GetTcpMiddleware(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &middleware.ProfileID, nil
+		}
+	case mantraev1connect.ServersTransportServiceName:
+		switch protocol {
+		case mantraev1.ProtocolType_PROTOCOL_TYPE_HTTP:
+			transport, err := q.GetHttpServersTransport(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &transport.ProfileID, nil
+		case mantraev1.ProtocolType_PROTOCOL_TYPE_TCP:
+			transport, err := q.GetTcpServersTransport(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &transport.ProfileID, nil
+		}
+	}
+	return nil, nil
+}
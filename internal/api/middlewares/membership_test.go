@@ -0,0 +1,269 @@
+package middlewares
+
+import (
+	"context"
+	"encoding/base64"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/mizuchilabs/mantrae/internal/config"
+	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1/mantraev1connect"
+	"github.com/mizuchilabs/mantrae/internal/meta"
+	"github.com/mizuchilabs/mantrae/internal/settings"
+	"github.com/mizuchilabs/mantrae/internal/store"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// testInterceptor returns an interceptor backed by a scratch database.
+func testInterceptor(t *testing.T) *AuthInterceptor {
+	t.Helper()
+	app := &config.App{
+		Conn: store.NewConnection(t.Context(), "file:"+filepath.Join(t.TempDir(), "mantrae.db")),
+	}
+	app.Secret = base64.StdEncoding.EncodeToString([]byte(strings.Repeat("s", 32)))
+	app.SM = settings.NewManager(app.Conn)
+	return NewAuthInterceptor(app)
+}
+
+// membershipFixture creates three profiles: the user is an editor of the
+// first, a viewer of the second and not a member of the third. Each profile
+// has an HTTP router with the ID "router-<profile name>".
+func membershipFixture(t *testing.T, i *AuthInterceptor) (user string, profiles []int64) {
+	t.Helper()
+	ctx := t.Context()
+	q := i.app.Conn.Q
+	created, err := q.CreateUser(ctx, &db.CreateUserParams{
+		ID:       "user",
+		Username: "user",
+		Password: "password",
+		Role:     meta.RoleEditor,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []struct{ name, role string }{
+		{"dev", meta.RoleEditor},
+		{"prod", meta.RoleViewer},
+		{"other", ""},
+	} {
+		profile, err := q.CreateProfile(ctx, &db.CreateProfileParams{Name: m.name, Token: m.name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = q.CreateHttpRouter(ctx, &db.CreateHttpRouterParams{
+			ID:        "router-" + m.name,
+			ProfileID: profile.ID,
+			Name:      "web",
+			Config:    &db.RouterConfig{Data: &dynamic.Router{Rule: "Host(`example.com`)"}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if m.role != "" {
+			if _, err = q.CreateProfileMember(ctx, &db.CreateProfileMemberParams{
+				ProfileID: profile.ID,
+				UserID:    created.ID,
+				Role:      m.role,
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		profiles = append(profiles, profile.ID)
+	}
+	return created.ID, profiles
+}
+
+func TestRequestProfiles(t *testing.T) {
+	i := testInterceptor(t)
+	_, profiles := membershipFixture(t, i)
+	dev, prod, other := profiles[0], profiles[1], profiles[2]
+	httpType := mantraev1.ProtocolType_PROTOCOL_TYPE_HTTP
+
+	tests := []struct {
+		name      string
+		procedure string
+		msg       any
+		want      []int64
+	}{
+		{
+			name:      "profile id",
+			procedure: mantraev1connect.RouterServiceListRoutersProcedure,
+			msg:       &mantraev1.ListRoutersRequest{ProfileId: prod},
+			want:      []int64{prod},
+		},
+		{
+			name:      "source and target",
+			procedure: mantraev1connect.ProfileServicePromoteResourcesProcedure,
+			msg: &mantraev1.PromoteResourcesRequest{
+				SourceProfileId: dev,
+				TargetProfileId: other,
+			},
+			want: []int64{dev, other},
+		},
+		{
+			name:      "resource id",
+			procedure: mantraev1connect.RouterServiceUpdateRouterProcedure,
+			msg:       &mantraev1.UpdateRouterRequest{Id: "router-other", Type: httpType},
+			want:      []int64{other},
+		},
+		{
+			name:      "profile as resource",
+			procedure: mantraev1connect.ProfileServiceGetProfileProcedure,
+			msg:       &mantraev1.GetProfileRequest{Id: dev},
+			want:      []int64{dev},
+		},
+		{
+			name:      "unknown resource",
+			procedure: mantraev1connect.RouterServiceGetRouterProcedure,
+			msg:       &mantraev1.GetRouterRequest{Id: "missing", Type: httpType},
+		},
+		{
+			name:      "no profile",
+			procedure: mantraev1connect.UserServiceListUsersProcedure,
+			msg:       &mantraev1.ListUsersRequest{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := requestProfiles(t.Context(), i.app.Conn.Q, tt.procedure, tt.msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("profiles = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizeProfiles(t *testing.T) {
+	i := testInterceptor(t)
+	user, profiles := membershipFixture(t, i)
+	dev, prod, other := profiles[0], profiles[1], profiles[2]
+	httpType := mantraev1.ProtocolType_PROTOCOL_TYPE_HTTP
+
+	tests := []struct {
+		name      string
+		role      string // global role of the user
+		procedure string
+		msg       any
+		allowed   bool
+	}{
+		{
+			name:      "member",
+			procedure: mantraev1connect.RouterServiceCreateRouterProcedure,
+			msg:       &mantraev1.CreateRouterRequest{ProfileId: dev},
+			allowed:   true,
+		},
+		{
+			name:      "non-member",
+			procedure: mantraev1connect.RouterServiceListRoutersProcedure,
+			msg:       &mantraev1.ListRoutersRequest{ProfileId: other},
+		},
+		{
+			name:      "profile role too low",
+			procedure: mantraev1connect.RouterServiceCreateRouterProcedure,
+			msg:       &mantraev1.CreateRouterRequest{ProfileId: prod},
+		},
+		{
+			name:      "viewer reads",
+			procedure: mantraev1connect.RouterServiceListRoutersProcedure,
+			msg:       &mantraev1.ListRoutersRequest{ProfileId: prod},
+			allowed:   true,
+		},
+		{
+			name:      "source not a member",
+			procedure: mantraev1connect.ProfileServicePromoteResourcesProcedure,
+			msg: &mantraev1.PromoteResourcesRequest{
+				SourceProfileId: other,
+				TargetProfileId: dev,
+			},
+		},
+		{
+			name:      "target role too low",
+			procedure: mantraev1connect.ProfileServicePromoteResourcesProcedure,
+			msg: &mantraev1.PromoteResourcesRequest{
+				SourceProfileId: dev,
+				TargetProfileId: prod,
+			},
+		},
+		{
+			name:      "resource of a member profile",
+			procedure: mantraev1connect.RouterServiceDeleteRouterProcedure,
+			msg:       &mantraev1.DeleteRouterRequest{Id: "router-dev", Type: httpType},
+			allowed:   true,
+		},
+		{
+			name:      "resource of a read-only profile",
+			procedure: mantraev1connect.RouterServiceDeleteRouterProcedure,
+			msg:       &mantraev1.DeleteRouterRequest{Id: "router-prod", Type: httpType},
+		},
+		{
+			name:      "resource of another profile",
+			procedure: mantraev1connect.RouterServiceGetRouterProcedure,
+			msg:       &mantraev1.GetRouterRequest{Id: "router-other", Type: httpType},
+		},
+		{
+			name:      "admin",
+			role:      meta.RoleAdmin,
+			procedure: mantraev1connect.RouterServiceDeleteRouterProcedure,
+			msg:       &mantraev1.DeleteRouterRequest{Id: "router-other", Type: httpType},
+			allowed:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role := tt.role
+			if role == "" {
+				role = meta.RoleEditor
+			}
+			ctx := withUser(t.Context(), user, role)
+			err := i.authorizeProfiles(ctx, tt.procedure, tt.msg)
+			if (err == nil) != tt.allowed {
+				t.Fatalf("err = %v, want allowed %v", err, tt.allowed)
+			}
+			if err != nil && connect.CodeOf(err) != connect.CodePermissionDenied {
+				t.Errorf("code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+			}
+		})
+	}
+}
+
+func TestAuthorizeProfilesAgent(t *testing.T) {
+	i := testInterceptor(t)
+	_, profiles := membershipFixture(t, i)
+	agent, err := i.app.Conn.Q.CreateAgent(t.Context(), &db.CreateAgentParams{
+		ID:        "agent",
+		ProfileID: profiles[0],
+		Token:     "token",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		profileID int64
+		allowed   bool
+	}{
+		{profileID: profiles[0], allowed: true},
+		{profileID: profiles[1]},
+	}
+
+	for _, tt := range tests {
+		ctx := context.WithValue(t.Context(), AuthAgentIDKey, agent.ID)
+		err := i.authorizeProfiles(
+			ctx,
+			mantraev1connect.RouterServiceListRoutersProcedure,
+			&mantraev1.ListRoutersRequest{ProfileId: tt.profileID},
+		)
+		if (err == nil) != tt.allowed {
+			t.Errorf("profile %d: err = %v, want allowed %v", tt.profileID, err, tt.allowed)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package middlewares
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1/mantraev1connect"
+	"github.com/mizuchilabs/mantrae/internal/meta"
+)
+
+// roleRanks orders the roles, a role may do everything a lower one can.
+var roleRanks = map[string]int{
+	meta.RoleViewer: 1,
+	meta.RoleEditor: 2,
+	meta.RoleAdmin:  3,
+}
+
+// adminServices can only be used by admins, they expose secrets, the whole
+// database or the activity and members of every profile.
+var adminServices = map[string]bool{
+	mantraev1connect.SettingServiceName:       true,
+	mantraev1connect.BackupServiceName:        true,
+	mantraev1connect.AuditLogServiceName:      true,
+	mantraev1connect.ProfileMemberServiceName: true,
+}
+
+// adminProcedures can only be called by admins.
+var adminProcedures = map[string]bool{
+	mantraev1connect.UserServiceCreateUserProcedure:               true,
+	mantraev1connect.UserServiceDeleteUserProcedure:               true,
+	mantraev1connect.UserServiceUpdateUserRoleProcedure:           true,
+	mantraev1connect.ProfileServiceCreateProfileProcedure:         true,
+	mantraev1connect.ProfileServiceDeleteProfileProcedure:         true,
+	mantraev1connect.ProfileServiceCloneProfileProcedure:          true,
+	mantraev1connect.DNSProviderServiceCreateDNSProviderProcedure: true,
+	mantraev1connect.DNSProviderServiceUpdateDNSProviderProcedure: true,
+	mantraev1connect.DNSProviderServiceDeleteDNSProviderProcedure: true,
+	mantraev1connect.TemplateServiceCreateTemplateProcedure:       true,
+	mantraev1connect.TemplateServiceUpdateTemplateProcedure:       true,
+	mantraev1connect.TemplateServiceDeleteTemplateProcedure:       true,
+}
+
+// editorProcedures can be called by editors although their service is in
+// adminServices. They only act on a profile, like the HTTP import endpoints.
+var editorProcedures = map[string]bool{
+	mantraev1connect.BackupServiceImportComposeProcedure: true,
+	mantraev1connect.BackupServicePlanImportProcedure:    true,
+	mantraev1connect.BackupServiceApplyImportProcedure:   true,
+}
+
+// selfServiceProcedures can be called by every role, they only act on the
+// caller's own account unless the caller is an admin. The services check that.
+var selfServiceProcedures = map[string]bool{
+	mantraev1connect.UserServiceUpdateUserProcedure:     true,
+	mantraev1connect.UserServiceCreateAPITokenProcedure: true,
+	mantraev1connect.UserServiceListAPITokensProcedure:  true,
+	mantraev1connect.UserServiceDeleteAPITokenProcedure: true,
+}
+
+// agentProcedures are the only procedures agent credentials can call.
+var agentProcedures = map[string]bool{
+	mantraev1connect.AgentServiceHealthCheckProcedure: true,
+	mantraev1connect.AgentServiceGetAgentProcedure:    true,
+}
+
+// writePrefixes mark the methods that change state, viewers can't call them.
+var writePrefixes = []string{
+	"Create",
+	"Update",
+	"Delete",
+	"Publish",
+	"Discard",
+	"Rollback",
+	"Instantiate",
+	"Clone",
+	"Promote",
+	"Apply",
+	"Restore",
+	"Import",
+}
+
+// ValidRole reports whether role is a known user role.
+func ValidRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// HasRole reports whether role grants at least the permissions of required.
+func HasRole(role, required string) bool {
+	return ValidRole(role) && roleRanks[role] >= roleRanks[required]
+}
+
+// splitProcedure splits a procedure, e.g. "/mantrae.v1.UserService/GetUser",
+// into its service and method names.
+func splitProcedure(procedure string) (service, method string) {
+	service, method, _ = strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	return service, method
+}
+
+// requiredRole returns the lowest role allowed to call a procedure.
+func requiredRole(procedure string) string {
+	service, method := splitProcedure(procedure)
+	if editorProcedures[procedure] {
+		return meta.RoleEditor
+	}
+	if adminServices[service] || adminProcedures[procedure] {
+		return meta.RoleAdmin
+	}
+	if selfServiceProcedures[procedure] {
+		return meta.RoleViewer
+	}
+	for _, prefix := range writePrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return meta.RoleEditor
+		}
+	}
+	return meta.RoleViewer
+}
+
+// authorizeRequest checks the role of an authenticated user against the
+// procedure. Agents may only call agentProcedures.
+func authorizeRequest(ctx context.Context, procedure string) error {
+	if GetAgentIDFromContext(ctx) != nil {
+		if !agentProcedures[procedure] {
+			return connect.NewError(
+				connect.CodePermissionDenied,
+				fmt.Errorf("agents are not allowed to call %s", procedure),
+			)
+		}
+		return nil
+	}
+	if err := authorizeTwoFactor(ctx, procedure); err != nil {
+		return err
+	}
+	role := GetRoleFromContext(ctx)
+	if required := requiredRole(procedure); !HasRole(role, required) {
+		return connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf("role %q is not allowed to call %s", role, procedure),
+		)
+	}
+	return nil
+}
+
+// WithRole only lets users with at least the given role through. It must be
+// chained after WithAuth.
+func WithRole(required string) Constructor {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !HasRole(GetRoleFromContext(r.Context()), required) {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func GetRoleFromContext(ctx context.Context) string {
+	if role, ok := ctx.Value(AuthRoleKey).(string); ok {
+		return role
+	}
+	return ""
+}
@@ -0,0 +1,125 @@
+package middlewares
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1/mantraev1connect"
+	"github.com/mizuchilabs/mantrae/internal/meta"
+)
+
+func TestAuthorizeRequest(t *testing.T) {
+	tests := []struct {
+		procedure string
+		required  string // lowest role allowed to call the procedure
+	}{
+		{mantraev1connect.RouterServiceListRoutersProcedure, meta.RoleViewer},
+		{mantraev1connect.RouterServiceGetRouterProcedure, meta.RoleViewer},
+		{mantraev1connect.RouterServiceSimulateRouteProcedure, meta.RoleViewer},
+		{mantraev1connect.RouterServiceListRouteConflictsProcedure, meta.RoleViewer},
+		{mantraev1connect.ProfileServiceListProfilesProcedure, meta.RoleViewer},
+		{mantraev1connect.ProfileServiceValidateProfileProcedure, meta.RoleViewer},
+		{mantraev1connect.DNSProviderServiceListDNSProvidersProcedure, meta.RoleViewer},
+		{mantraev1connect.UserServiceGetUserProcedure, meta.RoleViewer},
+		{mantraev1connect.UserServiceListUsersProcedure, meta.RoleViewer},
+		{mantraev1connect.UserServiceUpdateUserProcedure, meta.RoleViewer},
+		{mantraev1connect.UserServiceCreateAPITokenProcedure, meta.RoleViewer},
+		{mantraev1connect.UserServiceListAPITokensProcedure, meta.RoleViewer},
+		{mantraev1connect.UserServiceDeleteAPITokenProcedure, meta.RoleViewer},
+		{mantraev1connect.RouterServiceCreateRouterProcedure, meta.RoleEditor},
+		{mantraev1connect.RouterServiceDeleteRouterProcedure, meta.RoleEditor},
+		{mantraev1connect.ProfileServicePublishProfileProcedure, meta.RoleEditor},
+		{mantraev1connect.ProfileServicePromoteResourcesProcedure, meta.RoleEditor},
+		{mantraev1connect.ProfileVariableServiceCreateProfileVariableProcedure, meta.RoleEditor},
+		{mantraev1connect.RevisionServiceRollbackRevisionProcedure, meta.RoleEditor},
+		{mantraev1connect.TemplateServiceInstantiateTemplateProcedure, meta.RoleEditor},
+		{mantraev1connect.BackupServiceImportComposeProcedure, meta.RoleEditor},
+		{mantraev1connect.BackupServicePlanImportProcedure, meta.RoleEditor},
+		{mantraev1connect.BackupServiceApplyImportProcedure, meta.RoleEditor},
+		{mantraev1connect.UserServiceCreateUserProcedure, meta.RoleAdmin},
+		{mantraev1connect.UserServiceDeleteUserProcedure, meta.RoleAdmin},
+		{mantraev1connect.UserServiceUpdateUserRoleProcedure, meta.RoleAdmin},
+		{mantraev1connect.ProfileServiceCreateProfileProcedure, meta.RoleAdmin},
+		{mantraev1connect.ProfileServiceCloneProfileProcedure, meta.RoleAdmin},
+		{mantraev1connect.DNSProviderServiceCreateDNSProviderProcedure, meta.RoleAdmin},
+		{mantraev1connect.TemplateServiceCreateTemplateProcedure, meta.RoleAdmin},
+		{mantraev1connect.TemplateServiceUpdateTemplateProcedure, meta.RoleAdmin},
+		{mantraev1connect.TemplateServiceDeleteTemplateProcedure, meta.RoleAdmin},
+		{mantraev1connect.SettingServiceGetSettingProcedure, meta.RoleAdmin},
+		{mantraev1connect.SettingServiceListSettingsProcedure, meta.RoleAdmin},
+		{mantraev1connect.BackupServiceCreateBackupProcedure, meta.RoleAdmin},
+		{mantraev1connect.BackupServiceRestoreBackupProcedure, meta.RoleAdmin},
+		{mantraev1connect.AuditLogServiceListAuditLogsProcedure, meta.RoleAdmin},
+		{mantraev1connect.ProfileMemberServiceListProfileMembersProcedure, meta.RoleAdmin},
+	}
+	roles := []string{"", "owner", meta.RoleViewer, meta.RoleEditor, meta.RoleAdmin}
+
+	for _, tt := range tests {
+		t.Run(tt.procedure, func(t *testing.T) {
+			if got := requiredRole(tt.procedure); got != tt.required {
+				t.Errorf("required role = %q, want %q", got, tt.required)
+			}
+			for _, role := range roles {
+				ctx := context.WithValue(t.Context(), AuthRoleKey, role)
+				err := authorizeRequest(ctx, tt.procedure)
+				if allowed := HasRole(role, tt.required); allowed != (err == nil) {
+					t.Errorf("role %q: err = %v, want allowed %v", role, err, allowed)
+				}
+				if err != nil && connect.CodeOf(err) != connect.CodePermissionDenied {
+					t.Errorf("role %q: code = %v, want %v",
+						role, connect.CodeOf(err), connect.CodePermissionDenied)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthorizeRequestAgent(t *testing.T) {
+	tests := []struct {
+		procedure string
+		allowed   bool
+	}{
+		{mantraev1connect.AgentServiceHealthCheckProcedure, true},
+		{mantraev1connect.AgentServiceGetAgentProcedure, true},
+		{mantraev1connect.RouterServiceListRoutersProcedure, false},
+		{mantraev1connect.UserServiceUpdateUserProcedure, false},
+		{mantraev1connect.SettingServiceGetSettingProcedure, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.procedure, func(t *testing.T) {
+			// Agents carry no role, the admin role must not widen their access
+			ctx := context.WithValue(t.Context(), AuthAgentIDKey, "agent")
+			ctx = context.WithValue(ctx, AuthRoleKey, meta.RoleAdmin)
+			if err := authorizeRequest(ctx, tt.procedure); (err == nil) != tt.allowed {
+				t.Errorf("err = %v, want allowed %v", err, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestAuthorizeRequestTwoFactorSetup(t *testing.T) {
+	tests := []struct {
+		procedure string
+		code      connect.Code // zero if allowed
+	}{
+		{mantraev1connect.UserServiceGetUserProcedure, 0},
+		{mantraev1connect.UserServiceEnableTwoFactorProcedure, 0},
+		{mantraev1connect.RouterServiceListRoutersProcedure, connect.CodeFailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.procedure, func(t *testing.T) {
+			ctx := context.WithValue(t.Context(), AuthRoleKey, meta.RoleAdmin)
+			ctx = context.WithValue(ctx, AuthTwoFactorSetupKey, true)
+			err := authorizeRequest(ctx, tt.procedure)
+			switch {
+			case tt.code == 0 && err != nil:
+				t.Errorf("err = %v, want nil", err)
+			case tt.code != 0 && connect.CodeOf(err) != tt.code:
+				t.Errorf("err = %v, want code %v", err, tt.code)
+			}
+		})
+	}
+}
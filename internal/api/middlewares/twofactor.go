@@ -0,0 +1,43 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1/mantraev1connect"
+	"github.com/mizuchilabs/mantrae/internal/settings"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+)
+
+// twoFactorSetupProcedures can still be called by users that have to set up
+// two-factor authentication before using the rest of the API.
+var twoFactorSetupProcedures = map[string]bool{
+	mantraev1connect.UserServiceGetUserProcedure:         true,
+	mantraev1connect.UserServiceLogoutUserProcedure:      true,
+	mantraev1connect.UserServiceSetupTwoFactorProcedure:  true,
+	mantraev1connect.UserServiceEnableTwoFactorProcedure: true,
+}
+
+// requiresTwoFactorSetup reports whether a password user still has to set up
+// two-factor authentication because it is required for everyone.
+func (i *AuthInterceptor) requiresTwoFactorSetup(ctx context.Context, user *db.User) bool {
+	if user.Password == "" || user.TotpEnabled {
+		return false
+	}
+	required, _ := i.app.SM.Get(ctx, settings.KeyTwoFactorRequired)
+	return settings.AsBool(required)
+}
+
+// authorizeTwoFactor limits users without a required second factor to setting
+// one up.
+func authorizeTwoFactor(ctx context.Context, procedure string) error {
+	if pending, _ := ctx.Value(AuthTwoFactorSetupKey).(bool); pending &&
+		!twoFactorSetupProcedures[procedure] {
+		return connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("two-factor authentication must be set up first"),
+		)
+	}
+	return nil
+}
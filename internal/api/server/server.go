@@ -4,6 +4,7 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"expvar"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -21,6 +22,7 @@ import (
 	"github.com/mizuchilabs/mantrae/internal/api/service"
 	"github.com/mizuchilabs/mantrae/internal/config"
 	"github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1/mantraev1connect"
+	"github.com/mizuchilabs/mantrae/internal/meta"
 )
 
 type Server struct {
@@ -140,6 +142,11 @@ func (s *Server) registerServices() {
 		mantraev1connect.BackupServiceName,
 		mantraev1connect.UtilServiceName,
 		mantraev1connect.AuditLogServiceName,
+		mantraev1connect.RevisionServiceName,
+		mantraev1connect.TraefikInstanceServiceName,
+		mantraev1connect.TemplateServiceName,
+		mantraev1connect.ProfileVariableServiceName,
+		mantraev1connect.ProfileMemberServiceName,
 	}
 	s.registerHealthAndReflection(serviceNames)
 
@@ -211,17 +218,45 @@ func (s *Server) registerServices() {
 		service.NewAuditLogService(s.app),
 		opts...,
 	))
+	s.mux.Handle(mantraev1connect.NewRevisionServiceHandler(
+		service.NewRevisionService(s.app),
+		opts...,
+	))
+	s.mux.Handle(mantraev1connect.NewTraefikInstanceServiceHandler(
+		service.NewTraefikInstanceService(s.app),
+		opts...,
+	))
+	s.mux.Handle(mantraev1connect.NewTemplateServiceHandler(
+		service.NewTemplateService(s.app),
+		opts...,
+	))
+	s.mux.Handle(mantraev1connect.NewProfileVariableServiceHandler(
+		service.NewProfileVariableService(s.app),
+		opts...,
+	))
+	s.mux.Handle(mantraev1connect.NewProfileMemberServiceHandler(
+		service.NewProfileMemberService(s.app),
+		opts...,
+	))
 
 	// HTTP middlewares -------------------------------------------------------
 	auth := middlewares.NewAuthInterceptor(s.app)
 	authChain := middlewares.NewChain(auth.WithAuth)
+	editorChain := authChain.Append(auth.WithProfileRole(meta.RoleEditor))
+	adminChain := authChain.Append(middlewares.WithRole(meta.RoleAdmin))
 
 	// Traefik endpoint (HTTP) ------------------------------------------------
 	s.mux.Handle("GET /api/{name}", handler.PublishTraefikConfig(s.app))
+	s.mux.Handle("GET /api/{name}/kubernetes", handler.ExportKubernetes(s.app))
+
+	// Runtime metrics (admin only, expvar includes cmdline and memstats) -----
+	s.mux.Handle("GET /debug/vars", adminChain.Then(expvar.Handler()))
 
 	// File handler (HTTP) --------------------------------------------------
-	s.mux.Handle("GET /backups/download", authChain.ThenFunc(handler.DownloadBackup(s.app)))
-	s.mux.Handle("POST /backups/upload/{id}", authChain.ThenFunc(handler.UploadBackup(s.app)))
+	s.mux.Handle("GET /backups/download", adminChain.ThenFunc(handler.DownloadBackup(s.app)))
+	s.mux.Handle("POST /backups/upload/{id}", adminChain.ThenFunc(handler.UploadBackup(s.app)))
+	s.mux.Handle("POST /import/kubernetes/{id}", editorChain.ThenFunc(handler.ImportKubernetes(s.app)))
+	s.mux.Handle("POST /import/compose/{id}", editorChain.ThenFunc(handler.ImportCompose(s.app)))
 
 	// OIDC handlers (HTTP) ---------------------------------------------------
 	s.mux.Handle("GET /oidc/login", handler.OIDCLogin(s.app))
@@ -86,9 +86,15 @@ func (s *AgentService) DeleteAgent(
 	ctx context.Context,
 	req *mantraev1.DeleteAgentRequest,
 ) (*mantraev1.DeleteAgentResponse, error) {
+	agent, err := s.app.Conn.Q.GetAgent(ctx, req.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 	if err := s.app.Conn.Q.DeleteAgent(ctx, req.Id); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	// Removing an agent cascades to all of its routers, services, etc.
+	s.app.Revisions.Bump(agent.ProfileID)
 	return &mantraev1.DeleteAgentResponse{}, nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/mizuchilabs/mantrae/internal/config"
 	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
 	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/traefik"
 )
 
 type BackupService struct {
@@ -76,10 +77,24 @@ func (s *BackupService) RestoreBackup(
 		if err := s.app.BM.Restore(ctx, req.Name); err != nil {
 			return nil, connect.NewError(connect.CodeInternal, err)
 		}
-	case ".yaml", ".yml", ".json":
-		if err := s.app.BM.RestoreViaConfig(ctx, req.ProfileId, req.Name); err != nil {
+		if err := s.app.BumpAllProfiles(ctx); err != nil {
 			return nil, connect.NewError(connect.CodeInternal, err)
 		}
+	case ".yaml", ".yml", ".json":
+		if _, err := s.app.Conn.Q.GetProfile(ctx, req.ProfileId); err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		items, err := s.app.BM.RestoreViaConfig(
+			ctx,
+			req.ProfileId,
+			req.Name,
+			importStrategy(req.Strategy),
+		)
+		if err != nil {
+			return nil, importError(err)
+		}
+		s.app.Revisions.Bump(req.ProfileId)
+		return &mantraev1.RestoreBackupResponse{Items: importItemsToProto(items)}, nil
 	default:
 		return nil, connect.NewError(
 			connect.CodeInvalidArgument,
@@ -88,3 +103,135 @@ func (s *BackupService) RestoreBackup(
 	}
 	return &mantraev1.RestoreBackupResponse{}, nil
 }
+
+func (s *BackupService) ImportCompose(
+	ctx context.Context,
+	req *mantraev1.ImportComposeRequest,
+) (*mantraev1.ImportComposeResponse, error) {
+	if _, err := s.app.Conn.Q.GetProfile(ctx, req.ProfileId); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	result, err := traefik.FromCompose([]byte(req.Content))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var items []traefik.ImportItem
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		items, err = traefik.ApplyImport(ctx, q, req.ProfileId, result.Config, importStrategy(req.Strategy))
+		return err
+	}); err != nil {
+		return nil, importError(err)
+	}
+
+	s.app.Revisions.Bump(req.ProfileId)
+	return &mantraev1.ImportComposeResponse{
+		Items:    importItemsToProto(items),
+		Warnings: result.Warnings,
+	}, nil
+}
+
+func (s *BackupService) PlanImport(
+	ctx context.Context,
+	req *mantraev1.PlanImportRequest,
+) (*mantraev1.PlanImportResponse, error) {
+	if _, err := s.app.Conn.Q.GetProfile(ctx, req.ProfileId); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	cfg, warnings, err := traefik.DecodeImport(importFormat(req.Format), []byte(req.Content))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	items, err := traefik.PlanImport(ctx, s.app.Conn.Q, req.ProfileId, cfg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.PlanImportResponse{
+		Items:    importItemsToProto(items),
+		Warnings: warnings,
+	}, nil
+}
+
+func (s *BackupService) ApplyImport(
+	ctx context.Context,
+	req *mantraev1.ApplyImportRequest,
+) (*mantraev1.ApplyImportResponse, error) {
+	if _, err := s.app.Conn.Q.GetProfile(ctx, req.ProfileId); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	cfg, warnings, err := traefik.DecodeImport(importFormat(req.Format), []byte(req.Content))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var items []traefik.ImportItem
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		items, err = traefik.ApplyImport(ctx, q, req.ProfileId, cfg, importStrategy(req.Strategy))
+		return err
+	}); err != nil {
+		return nil, importError(err)
+	}
+
+	s.app.Revisions.Bump(req.ProfileId)
+	return &mantraev1.ApplyImportResponse{
+		Items:    importItemsToProto(items),
+		Warnings: warnings,
+	}, nil
+}
+
+func importFormat(format mantraev1.ImportFormat) string {
+	switch format {
+	case mantraev1.ImportFormat_IMPORT_FORMAT_KUBERNETES:
+		return traefik.FormatKubernetes
+	case mantraev1.ImportFormat_IMPORT_FORMAT_COMPOSE:
+		return traefik.FormatCompose
+	default:
+		return traefik.FormatDynamic
+	}
+}
+
+func importStrategy(strategy mantraev1.ImportStrategy) traefik.ImportStrategy {
+	switch strategy {
+	case mantraev1.ImportStrategy_IMPORT_STRATEGY_OVERWRITE:
+		return traefik.ImportOverwrite
+	case mantraev1.ImportStrategy_IMPORT_STRATEGY_RENAME:
+		return traefik.ImportRename
+	default:
+		return traefik.ImportSkip
+	}
+}
+
+// importError rejects imports that fail validation as invalid arguments.
+func importError(err error) error {
+	var importErr *traefik.ImportError
+	if errors.As(err, &importErr) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func importItemsToProto(items []traefik.ImportItem) []*mantraev1.ImportItem {
+	protocols := map[string]mantraev1.ProtocolType{
+		"http": mantraev1.ProtocolType_PROTOCOL_TYPE_HTTP,
+		"tcp":  mantraev1.ProtocolType_PROTOCOL_TYPE_TCP,
+		"udp":  mantraev1.ProtocolType_PROTOCOL_TYPE_UDP,
+	}
+	statuses := map[traefik.ImportStatus]mantraev1.ImportStatus{
+		traefik.ImportNew:       mantraev1.ImportStatus_IMPORT_STATUS_NEW,
+		traefik.ImportIdentical: mantraev1.ImportStatus_IMPORT_STATUS_IDENTICAL,
+		traefik.ImportConflict:  mantraev1.ImportStatus_IMPORT_STATUS_CONFLICT,
+	}
+
+	result := make([]*mantraev1.ImportItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &mantraev1.ImportItem{
+			Protocol: protocols[item.Protocol],
+			Type:     item.Type,
+			Name:     item.Name,
+			Status:   statuses[item.Status],
+			NewName:  item.NewName,
+		})
+	}
+	return result
+}
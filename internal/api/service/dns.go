@@ -6,8 +6,10 @@ import (
 	"connectrpc.com/connect"
 
 	"github.com/google/uuid"
+	"github.com/mizuchilabs/mantrae/internal/api/middlewares"
 	"github.com/mizuchilabs/mantrae/internal/config"
 	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/meta"
 	"github.com/mizuchilabs/mantrae/internal/store/db"
 	"github.com/mizuchilabs/mantrae/internal/util"
 )
@@ -28,11 +30,9 @@ func (s *DNSProviderService) GetDNSProvider(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	decryptedAPIKey, err := util.DecryptSecret(result.Config.Data.ApiKey, s.app.Secret)
-	if err != nil {
+	if err = s.revealAPIKey(ctx, result); err != nil {
 		return nil, err
 	}
-	result.Config.Data.ApiKey = decryptedAPIKey
 	return &mantraev1.GetDNSProviderResponse{DnsProvider: result.ToProto()}, nil
 }
 
@@ -54,14 +54,18 @@ func (s *DNSProviderService) CreateDNSProvider(
 		}
 		params.Config.Data.ApiKey = apiKeyHash
 	}
-	if req.IsDefault {
-		if err := s.app.Conn.Q.UnsetDefaultDNSProvider(ctx); err != nil {
-			return nil, connect.NewError(connect.CodeInternal, err)
-		}
-	}
 
-	result, err := s.app.Conn.Q.CreateDnsProvider(ctx, params)
-	if err != nil {
+	var result *db.DnsProvider
+	if err := s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if req.IsDefault {
+			if err := q.UnsetDefaultDNSProvider(ctx); err != nil {
+				return err
+			}
+		}
+		var err error
+		result, err = q.CreateDnsProvider(ctx, params)
+		return err
+	}); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -86,14 +90,18 @@ func (s *DNSProviderService) UpdateDNSProvider(
 		}
 		params.Config.Data.ApiKey = apiKeyHash
 	}
-	if req.IsDefault {
-		if err := s.app.Conn.Q.UnsetDefaultDNSProvider(ctx); err != nil {
-			return nil, connect.NewError(connect.CodeInternal, err)
-		}
-	}
 
-	result, err := s.app.Conn.Q.UpdateDnsProvider(ctx, params)
-	if err != nil {
+	var result *db.DnsProvider
+	if err := s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if req.IsDefault {
+			if err := q.UnsetDefaultDNSProvider(ctx); err != nil {
+				return err
+			}
+		}
+		var err error
+		result, err = q.UpdateDnsProvider(ctx, params)
+		return err
+	}); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -130,11 +138,9 @@ func (s *DNSProviderService) ListDNSProviders(
 
 	dnsProviders := make([]*mantraev1.DNSProvider, 0, len(result))
 	for _, p := range result {
-		decryptedAPIKey, err := util.DecryptSecret(p.Config.Data.ApiKey, s.app.Secret)
-		if err != nil {
+		if err = s.revealAPIKey(ctx, p); err != nil {
 			return nil, err
 		}
-		p.Config.Data.ApiKey = decryptedAPIKey
 		dnsProviders = append(dnsProviders, p.ToProto())
 	}
 	return &mantraev1.ListDNSProvidersResponse{
@@ -142,3 +148,21 @@ func (s *DNSProviderService) ListDNSProviders(
 		TotalCount:   totalCount,
 	}, nil
 }
+
+// revealAPIKey decrypts the API key of a provider for admins. Everyone else
+// may list the providers to link them to routers, but gets a blank key.
+func (s *DNSProviderService) revealAPIKey(ctx context.Context, p *db.DnsProvider) error {
+	if p.Config == nil || p.Config.Data == nil {
+		return nil
+	}
+	if !middlewares.HasRole(middlewares.GetRoleFromContext(ctx), meta.RoleAdmin) {
+		p.Config.Data.ApiKey = ""
+		return nil
+	}
+	decryptedAPIKey, err := util.DecryptSecret(p.Config.Data.ApiKey, s.app.Secret)
+	if err != nil {
+		return err
+	}
+	p.Config.Data.ApiKey = decryptedAPIKey
+	return nil
+}
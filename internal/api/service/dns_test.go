@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mizuchilabs/mantrae/internal/api/middlewares"
+	"github.com/mizuchilabs/mantrae/internal/config"
+	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/meta"
+	"github.com/mizuchilabs/mantrae/internal/store"
+)
+
+// testApp returns an app backed by a scratch database.
+func testApp(t *testing.T) *config.App {
+	t.Helper()
+	app := &config.App{
+		Conn: store.NewConnection(t.Context(), "file:"+filepath.Join(t.TempDir(), "mantrae.db")),
+	}
+	app.Secret = base64.StdEncoding.EncodeToString([]byte(strings.Repeat("s", 32)))
+	return app
+}
+
+func TestDNSProviderAPIKey(t *testing.T) {
+	s := NewDNSProviderService(testApp(t))
+	created, err := s.CreateDNSProvider(t.Context(), &mantraev1.CreateDNSProviderRequest{
+		Name:   "cloudflare",
+		Type:   mantraev1.DNSProviderType_DNS_PROVIDER_TYPE_CLOUDFLARE,
+		Config: &mantraev1.DNSProviderConfig{ApiKey: "secret-key", Ip: "10.0.0.1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		role string
+		want string
+	}{
+		{role: "", want: ""},
+		{role: meta.RoleViewer, want: ""},
+		{role: meta.RoleEditor, want: ""},
+		{role: meta.RoleAdmin, want: "secret-key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			ctx := context.WithValue(t.Context(), middlewares.AuthRoleKey, tt.role)
+
+			got, err := s.GetDNSProvider(ctx, &mantraev1.GetDNSProviderRequest{
+				Id: created.DnsProvider.Id,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if key := got.DnsProvider.Config.ApiKey; key != tt.want {
+				t.Errorf("get: api key = %q, want %q", key, tt.want)
+			}
+			if ip := got.DnsProvider.Config.Ip; ip != "10.0.0.1" {
+				t.Errorf("get: ip = %q, want %q", ip, "10.0.0.1")
+			}
+
+			list, err := s.ListDNSProviders(ctx, &mantraev1.ListDNSProvidersRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(list.DnsProviders) != 1 {
+				t.Fatalf("list: %d providers, want 1", len(list.DnsProviders))
+			}
+			if key := list.DnsProviders[0].Config.ApiKey; key != tt.want {
+				t.Errorf("list: api key = %q, want %q", key, tt.want)
+			}
+		})
+	}
+}
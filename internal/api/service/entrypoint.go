@@ -42,14 +42,18 @@ func (s *EntryPointService) CreateEntryPoint(
 		Address:   req.Address,
 		IsDefault: req.IsDefault,
 	}
-	if req.IsDefault {
-		if err := s.app.Conn.Q.UnsetDefaultEntryPoint(ctx, req.ProfileId); err != nil {
-			return nil, connect.NewError(connect.CodeInternal, err)
-		}
-	}
 
-	result, err := s.app.Conn.Q.CreateEntryPoint(ctx, params)
-	if err != nil {
+	var result *db.EntryPoint
+	if err := s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if req.IsDefault {
+			if err := q.UnsetDefaultEntryPoint(ctx, req.ProfileId); err != nil {
+				return err
+			}
+		}
+		var err error
+		result, err = q.CreateEntryPoint(ctx, params)
+		return err
+	}); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	return &mantraev1.CreateEntryPointResponse{EntryPoint: result.ToProto()}, nil
@@ -65,21 +69,27 @@ func (s *EntryPointService) UpdateEntryPoint(
 		Address:   req.Address,
 		IsDefault: req.IsDefault,
 	}
-	if req.IsDefault {
-		if err := s.app.Conn.Q.UnsetDefaultEntryPoint(ctx, req.ProfileId); err != nil {
-			return nil, connect.NewError(connect.CodeInternal, err)
+
+	var result *db.EntryPoint
+	if err := s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if req.IsDefault {
+			if err := q.UnsetDefaultEntryPoint(ctx, req.ProfileId); err != nil {
+				return err
+			}
 		}
-	}
 
-	// Remove old EntryPoint name and replace with new one (Order is important!)
-	if err := s.updateRouterEntrypoints(ctx, req.Id, req.Name); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
+		// Remove old EntryPoint name and replace with new one (Order is important!)
+		if err := updateRouterEntrypoints(ctx, q, req.Id, req.Name); err != nil {
+			return err
+		}
 
-	result, err := s.app.Conn.Q.UpdateEntryPoint(ctx, params)
-	if err != nil {
+		var err error
+		result, err = q.UpdateEntryPoint(ctx, params)
+		return err
+	}); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.UpdateEntryPointResponse{EntryPoint: result.ToProto()}, nil
 }
 
@@ -87,12 +97,19 @@ func (s *EntryPointService) DeleteEntryPoint(
 	ctx context.Context,
 	req *mantraev1.DeleteEntryPointRequest,
 ) (*mantraev1.DeleteEntryPointResponse, error) {
-	if err := s.updateRouterEntrypoints(ctx, req.Id, ""); err != nil {
+	entrypoint, err := s.app.Conn.Q.GetEntryPoint(ctx, req.Id)
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if err := s.app.Conn.Q.DeleteEntryPointByID(ctx, req.Id); err != nil {
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if err := updateRouterEntrypoints(ctx, q, req.Id, ""); err != nil {
+			return err
+		}
+		return q.DeleteEntryPointByID(ctx, req.Id)
+	}); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	s.app.Revisions.Bump(entrypoint.ProfileID)
 	return &mantraev1.DeleteEntryPointResponse{}, nil
 }
 
@@ -126,22 +143,23 @@ func (s *EntryPointService) ListEntryPoints(
 }
 
 // Helper functions
-func (s *EntryPointService) updateRouterEntrypoints(
+func updateRouterEntrypoints(
 	ctx context.Context,
+	q *db.Queries,
 	id,
 	newEntrypoint string,
 ) error {
-	entrypoint, err := s.app.Conn.Q.GetEntryPoint(ctx, id)
+	entrypoint, err := q.GetEntryPoint(ctx, id)
 	if err != nil {
 		return err
 	}
-	httpRouters, err := s.app.Conn.Q.
+	httpRouters, err := q.
 		GetHttpRoutersUsingEntryPoint(ctx, &db.GetHttpRoutersUsingEntryPointParams{
 			ProfileID: entrypoint.ProfileID,
 			ID:        entrypoint.ID,
 		})
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, err)
+		return err
 	}
 	for _, r := range httpRouters {
 		if idx := slices.Index(r.Config.Data.EntryPoints, entrypoint.Name); idx != -1 {
@@ -150,22 +168,22 @@ func (s *EntryPointService) updateRouterEntrypoints(
 		if newEntrypoint != "" {
 			r.Config.Data.EntryPoints = append(r.Config.Data.EntryPoints, newEntrypoint)
 		}
-		if _, err = s.app.Conn.Q.UpdateHttpRouter(ctx, &db.UpdateHttpRouterParams{
+		if _, err = q.UpdateHttpRouter(ctx, &db.UpdateHttpRouterParams{
 			ID:      r.ID,
 			Enabled: r.Enabled,
 			Config:  r.Config,
 			Name:    r.Name,
 		}); err != nil {
-			return connect.NewError(connect.CodeInternal, err)
+			return err
 		}
 	}
-	tcpRouters, err := s.app.Conn.Q.
+	tcpRouters, err := q.
 		GetTcpRoutersUsingEntryPoint(ctx, &db.GetTcpRoutersUsingEntryPointParams{
 			ProfileID: entrypoint.ProfileID,
 			ID:        entrypoint.ID,
 		})
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, err)
+		return err
 	}
 	for _, r := range tcpRouters {
 		if idx := slices.Index(r.Config.Data.EntryPoints, entrypoint.Name); idx != -1 {
@@ -174,22 +192,22 @@ func (s *EntryPointService) updateRouterEntrypoints(
 		if newEntrypoint != "" {
 			r.Config.Data.EntryPoints = append(r.Config.Data.EntryPoints, newEntrypoint)
 		}
-		if _, err = s.app.Conn.Q.UpdateTcpRouter(ctx, &db.UpdateTcpRouterParams{
+		if _, err = q.UpdateTcpRouter(ctx, &db.UpdateTcpRouterParams{
 			ID:      r.ID,
 			Enabled: r.Enabled,
 			Config:  r.Config,
 			Name:    r.Name,
 		}); err != nil {
-			return connect.NewError(connect.CodeInternal, err)
+			return err
 		}
 	}
-	udpRouters, err := s.app.Conn.Q.
+	udpRouters, err := q.
 		GetUdpRoutersUsingEntryPoint(ctx, &db.GetUdpRoutersUsingEntryPointParams{
 			ProfileID: entrypoint.ProfileID,
 			ID:        entrypoint.ID,
 		})
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, err)
+		return err
 	}
 	for _, r := range udpRouters {
 		if idx := slices.Index(r.Config.Data.EntryPoints, entrypoint.Name); idx != -1 {
@@ -198,15 +216,14 @@ func (s *EntryPointService) updateRouterEntrypoints(
 		if newEntrypoint != "" {
 			r.Config.Data.EntryPoints = append(r.Config.Data.EntryPoints, newEntrypoint)
 		}
-		if _, err = s.app.Conn.Q.UpdateUdpRouter(ctx, &db.UpdateUdpRouterParams{
+		if _, err = q.UpdateUdpRouter(ctx, &db.UpdateUdpRouterParams{
 			ID:      r.ID,
 			Enabled: r.Enabled,
 			Config:  r.Config,
 			Name:    r.Name,
 		}); err != nil {
-			return connect.NewError(connect.CodeInternal, err)
+			return err
 		}
 	}
-
 	return nil
 }
@@ -65,6 +65,14 @@ func (s *MiddlewareService) CreateMiddleware(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	result.Issues = itemIssues(
+		ctx,
+		s.app,
+		result.Middleware.ProfileId,
+		req.Type,
+		"middlewares",
+		result.Middleware.Name,
+	)
 	return result, nil
 }
 
@@ -84,6 +92,14 @@ func (s *MiddlewareService) UpdateMiddleware(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	result.Issues = itemIssues(
+		ctx,
+		s.app,
+		result.Middleware.ProfileId,
+		req.Type,
+		"middlewares",
+		result.Middleware.Name,
+	)
 	return result, nil
 }
 
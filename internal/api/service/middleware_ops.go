@@ -87,16 +87,19 @@ func (s *HTTPMiddlewareOps) Create(
 		return nil, err
 	}
 
-	if req.IsDefault {
-		if err = s.app.Conn.Q.UnsetDefaultHttpMiddleware(ctx, req.ProfileId); err != nil {
-			return nil, err
+	var result *db.HttpMiddleware
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if req.IsDefault {
+			if err := q.UnsetDefaultHttpMiddleware(ctx, req.ProfileId); err != nil {
+				return err
+			}
 		}
-	}
-
-	result, err := s.app.Conn.Q.CreateHttpMiddleware(ctx, params)
-	if err != nil {
+		result, err = q.CreateHttpMiddleware(ctx, params)
+		return err
+	}); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.CreateMiddlewareResponse{
 		Middleware: result.ToProto(),
 	}, nil
@@ -122,46 +125,50 @@ func (s *HTTPMiddlewareOps) Update(
 		return nil, err
 	}
 
-	if req.IsDefault {
-		if err = s.app.Conn.Q.UnsetDefaultHttpMiddleware(ctx, req.ProfileId); err != nil {
-			return nil, err
+	var result *db.HttpMiddleware
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if req.IsDefault {
+			if err := q.UnsetDefaultHttpMiddleware(ctx, req.ProfileId); err != nil {
+				return err
+			}
 		}
-	}
 
-	// Get old middleware for router update
-	middleware, err := s.app.Conn.Q.GetHttpMiddleware(ctx, req.Id)
-	if err != nil {
-		return nil, err
-	}
+		// Get old middleware for router update
+		middleware, err := q.GetHttpMiddleware(ctx, req.Id)
+		if err != nil {
+			return err
+		}
 
-	// Make sure routers using this middleware use the new name
-	routers, err := s.app.Conn.Q.
-		GetHttpRoutersUsingMiddleware(ctx, &db.GetHttpRoutersUsingMiddlewareParams{
-			ProfileID: middleware.ProfileID,
-			ID:        middleware.ID,
-		})
-	if err != nil {
-		return nil, err
-	}
-	for _, r := range routers {
-		if idx := slices.Index(r.Config.Data.Middlewares, middleware.Name); idx != -1 {
-			r.Config.Data.Middlewares = slices.Delete(r.Config.Data.Middlewares, idx, idx+1)
+		// Make sure routers using this middleware use the new name
+		routers, err := q.
+			GetHttpRoutersUsingMiddleware(ctx, &db.GetHttpRoutersUsingMiddlewareParams{
+				ProfileID: middleware.ProfileID,
+				ID:        middleware.ID,
+			})
+		if err != nil {
+			return err
 		}
-		r.Config.Data.Middlewares = append(r.Config.Data.Middlewares, req.Name)
-		if _, err = s.app.Conn.Q.UpdateHttpRouter(ctx, &db.UpdateHttpRouterParams{
-			ID:      r.ID,
-			Enabled: r.Enabled,
-			Config:  r.Config,
-			Name:    r.Name,
-		}); err != nil {
-			return nil, err
+		for _, r := range routers {
+			if idx := slices.Index(r.Config.Data.Middlewares, middleware.Name); idx != -1 {
+				r.Config.Data.Middlewares = slices.Delete(r.Config.Data.Middlewares, idx, idx+1)
+			}
+			r.Config.Data.Middlewares = append(r.Config.Data.Middlewares, req.Name)
+			if _, err = q.UpdateHttpRouter(ctx, &db.UpdateHttpRouterParams{
+				ID:      r.ID,
+				Enabled: r.Enabled,
+				Config:  r.Config,
+				Name:    r.Name,
+			}); err != nil {
+				return err
+			}
 		}
-	}
 
-	result, err := s.app.Conn.Q.UpdateHttpMiddleware(ctx, params)
-	if err != nil {
+		result, err = q.UpdateHttpMiddleware(ctx, params)
+		return err
+	}); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.UpdateMiddlewareResponse{
 		Middleware: result.ToProto(),
 	}, nil
@@ -176,32 +183,34 @@ func (s *HTTPMiddlewareOps) Delete(
 		return nil, err
 	}
 
-	// Make sure to delete the middleware from related routers
-	routers, err := s.app.Conn.Q.
-		GetHttpRoutersUsingMiddleware(ctx, &db.GetHttpRoutersUsingMiddlewareParams{
-			ProfileID: middleware.ProfileID,
-			ID:        middleware.ID,
-		})
-	if err != nil {
-		return nil, err
-	}
-	for _, r := range routers {
-		if idx := slices.Index(r.Config.Data.Middlewares, middleware.Name); idx != -1 {
-			r.Config.Data.Middlewares = slices.Delete(r.Config.Data.Middlewares, idx, idx+1)
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		// Make sure to delete the middleware from related routers
+		routers, err := q.
+			GetHttpRoutersUsingMiddleware(ctx, &db.GetHttpRoutersUsingMiddlewareParams{
+				ProfileID: middleware.ProfileID,
+				ID:        middleware.ID,
+			})
+		if err != nil {
+			return err
 		}
-		if _, err := s.app.Conn.Q.UpdateHttpRouter(ctx, &db.UpdateHttpRouterParams{
-			ID:      r.ID,
-			Enabled: r.Enabled,
-			Config:  r.Config,
-			Name:    r.Name,
-		}); err != nil {
-			return nil, err
+		for _, r := range routers {
+			if idx := slices.Index(r.Config.Data.Middlewares, middleware.Name); idx != -1 {
+				r.Config.Data.Middlewares = slices.Delete(r.Config.Data.Middlewares, idx, idx+1)
+			}
+			if _, err = q.UpdateHttpRouter(ctx, &db.UpdateHttpRouterParams{
+				ID:      r.ID,
+				Enabled: r.Enabled,
+				Config:  r.Config,
+				Name:    r.Name,
+			}); err != nil {
+				return err
+			}
 		}
-	}
-
-	if err := s.app.Conn.Q.DeleteHttpMiddleware(ctx, req.Id); err != nil {
+		return q.DeleteHttpMiddleware(ctx, req.Id)
+	}); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(middleware.ProfileID)
 	return &mantraev1.DeleteMiddlewareResponse{}, nil
 }
 
@@ -271,16 +280,19 @@ func (s *TCPMiddlewareOps) Create(
 		return nil, err
 	}
 
-	if req.IsDefault {
-		if err = s.app.Conn.Q.UnsetDefaultTcpMiddleware(ctx, req.ProfileId); err != nil {
-			return nil, err
+	var result *db.TcpMiddleware
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if req.IsDefault {
+			if err := q.UnsetDefaultTcpMiddleware(ctx, req.ProfileId); err != nil {
+				return err
+			}
 		}
-	}
-
-	result, err := s.app.Conn.Q.CreateTcpMiddleware(ctx, params)
-	if err != nil {
+		result, err = q.CreateTcpMiddleware(ctx, params)
+		return err
+	}); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.CreateMiddlewareResponse{
 		Middleware: result.ToProto(),
 	}, nil
@@ -303,46 +315,50 @@ func (s *TCPMiddlewareOps) Update(
 		return nil, err
 	}
 
-	if req.IsDefault {
-		if err = s.app.Conn.Q.UnsetDefaultTcpMiddleware(ctx, req.ProfileId); err != nil {
-			return nil, err
+	var result *db.TcpMiddleware
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if req.IsDefault {
+			if err := q.UnsetDefaultTcpMiddleware(ctx, req.ProfileId); err != nil {
+				return err
+			}
 		}
-	}
 
-	// Get old middleware for router update
-	middleware, err := s.app.Conn.Q.GetTcpMiddleware(ctx, req.Id)
-	if err != nil {
-		return nil, err
-	}
+		// Get old middleware for router update
+		middleware, err := q.GetTcpMiddleware(ctx, req.Id)
+		if err != nil {
+			return err
+		}
 
-	// Make sure routers using this middleware use the new name
-	routers, err := s.app.Conn.Q.
-		GetTcpRoutersUsingMiddleware(ctx, &db.GetTcpRoutersUsingMiddlewareParams{
-			ProfileID: middleware.ProfileID,
-			ID:        middleware.ID,
-		})
-	if err != nil {
-		return nil, err
-	}
-	for _, r := range routers {
-		if idx := slices.Index(r.Config.Data.Middlewares, middleware.Name); idx != -1 {
-			r.Config.Data.Middlewares = slices.Delete(r.Config.Data.Middlewares, idx, idx+1)
+		// Make sure routers using this middleware use the new name
+		routers, err := q.
+			GetTcpRoutersUsingMiddleware(ctx, &db.GetTcpRoutersUsingMiddlewareParams{
+				ProfileID: middleware.ProfileID,
+				ID:        middleware.ID,
+			})
+		if err != nil {
+			return err
 		}
-		r.Config.Data.Middlewares = append(r.Config.Data.Middlewares, req.Name)
-		if _, err = s.app.Conn.Q.UpdateTcpRouter(ctx, &db.UpdateTcpRouterParams{
-			ID:      r.ID,
-			Enabled: r.Enabled,
-			Config:  r.Config,
-			Name:    r.Name,
-		}); err != nil {
-			return nil, err
+		for _, r := range routers {
+			if idx := slices.Index(r.Config.Data.Middlewares, middleware.Name); idx != -1 {
+				r.Config.Data.Middlewares = slices.Delete(r.Config.Data.Middlewares, idx, idx+1)
+			}
+			r.Config.Data.Middlewares = append(r.Config.Data.Middlewares, req.Name)
+			if _, err = q.UpdateTcpRouter(ctx, &db.UpdateTcpRouterParams{
+				ID:      r.ID,
+				Enabled: r.Enabled,
+				Config:  r.Config,
+				Name:    r.Name,
+			}); err != nil {
+				return err
+			}
 		}
-	}
 
-	result, err := s.app.Conn.Q.UpdateTcpMiddleware(ctx, params)
-	if err != nil {
+		result, err = q.UpdateTcpMiddleware(ctx, params)
+		return err
+	}); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.UpdateMiddlewareResponse{
 		Middleware: result.ToProto(),
 	}, nil
@@ -357,32 +373,34 @@ func (s *TCPMiddlewareOps) Delete(
 		return nil, err
 	}
 
-	// Make sure to delete the middleware from related routers
-	routers, err := s.app.Conn.Q.
-		GetTcpRoutersUsingMiddleware(ctx, &db.GetTcpRoutersUsingMiddlewareParams{
-			ProfileID: middleware.ProfileID,
-			ID:        middleware.ID,
-		})
-	if err != nil {
-		return nil, err
-	}
-	for _, r := range routers {
-		if idx := slices.Index(r.Config.Data.Middlewares, middleware.Name); idx != -1 {
-			r.Config.Data.Middlewares = slices.Delete(r.Config.Data.Middlewares, idx, idx+1)
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		// Make sure to delete the middleware from related routers
+		routers, err := q.
+			GetTcpRoutersUsingMiddleware(ctx, &db.GetTcpRoutersUsingMiddlewareParams{
+				ProfileID: middleware.ProfileID,
+				ID:        middleware.ID,
+			})
+		if err != nil {
+			return err
 		}
-		if _, err := s.app.Conn.Q.UpdateTcpRouter(ctx, &db.UpdateTcpRouterParams{
-			ID:      r.ID,
-			Enabled: r.Enabled,
-			Config:  r.Config,
-			Name:    r.Name,
-		}); err != nil {
-			return nil, err
+		for _, r := range routers {
+			if idx := slices.Index(r.Config.Data.Middlewares, middleware.Name); idx != -1 {
+				r.Config.Data.Middlewares = slices.Delete(r.Config.Data.Middlewares, idx, idx+1)
+			}
+			if _, err = q.UpdateTcpRouter(ctx, &db.UpdateTcpRouterParams{
+				ID:      r.ID,
+				Enabled: r.Enabled,
+				Config:  r.Config,
+				Name:    r.Name,
+			}); err != nil {
+				return err
+			}
 		}
-	}
-
-	if err := s.app.Conn.Q.DeleteTcpMiddleware(ctx, req.Id); err != nil {
+		return q.DeleteTcpMiddleware(ctx, req.Id)
+	}); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(middleware.ProfileID)
 	return &mantraev1.DeleteMiddlewareResponse{}, nil
 }
 
@@ -2,13 +2,19 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 
 	"connectrpc.com/connect"
 
 	"github.com/gosimple/slug"
+	"github.com/mizuchilabs/mantrae/internal/api/middlewares"
 	"github.com/mizuchilabs/mantrae/internal/config"
 	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/meta"
 	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/traefik"
 	"github.com/mizuchilabs/mantrae/internal/util"
 )
 
@@ -71,6 +77,35 @@ func (s *ProfileService) UpdateProfile(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+
+	switch {
+	case req.Staged == nil:
+	case *req.Staged && result.PublishedRevision == nil:
+		// Staging starts from the current configuration
+		if result, _, err = s.app.History.Publish(ctx, s.app.Conn.Q, result.ID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		s.app.Revisions.Bump(result.ID)
+	case !*req.Staged && result.PublishedRevision != nil:
+		// Pending draft changes go live
+		published, err := s.getPublished(ctx, result.ID)
+		if err != nil {
+			return nil, err
+		}
+		draft, err := traefik.TakeSnapshot(ctx, s.app.Conn.Q, result.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		result, err = s.app.Conn.Q.UpdateProfilePublishedRevision(
+			ctx,
+			&db.UpdateProfilePublishedRevisionParams{ID: result.ID},
+		)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		publishDNS(s.app, result.ID, published.Snapshot.Data, draft)
+		s.app.Revisions.Bump(result.ID)
+	}
 	return &mantraev1.UpdateProfileResponse{Profile: result.ToProto()}, nil
 }
 
@@ -81,6 +116,9 @@ func (s *ProfileService) DeleteProfile(
 	if err := s.app.Conn.Q.DeleteProfile(ctx, req.Id); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	s.app.Revisions.Bump(req.Id)
+	s.app.Configs.Evict(req.Id)
+	s.app.History.Evict(req.Id)
 	return &mantraev1.DeleteProfileResponse{}, nil
 }
 
@@ -88,17 +126,32 @@ func (s *ProfileService) ListProfiles(
 	ctx context.Context,
 	req *mantraev1.ListProfilesRequest,
 ) (*mantraev1.ListProfilesResponse, error) {
-	params := &db.ListProfilesParams{
-		Limit:  req.Limit,
-		Offset: req.Offset,
-	}
+	var result []*db.Profile
+	var totalCount int64
+	var err error
 
-	result, err := s.app.Conn.Q.ListProfiles(ctx, params)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	// Users other than admins only see the profiles they are members of
+	userID := middlewares.GetUserIDFromContext(ctx)
+	if userID != nil && middlewares.GetRoleFromContext(ctx) != meta.RoleAdmin {
+		result, err = s.app.Conn.Q.ListProfilesByMember(ctx, &db.ListProfilesByMemberParams{
+			UserID: *userID,
+			Limit:  req.Limit,
+			Offset: req.Offset,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		totalCount, err = s.app.Conn.Q.CountProfilesByMember(ctx, *userID)
+	} else {
+		result, err = s.app.Conn.Q.ListProfiles(ctx, &db.ListProfilesParams{
+			Limit:  req.Limit,
+			Offset: req.Offset,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		totalCount, err = s.app.Conn.Q.CountProfiles(ctx)
 	}
-
-	totalCount, err := s.app.Conn.Q.CountProfiles(ctx)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -112,3 +165,288 @@ func (s *ProfileService) ListProfiles(
 		TotalCount: totalCount,
 	}, nil
 }
+
+func (s *ProfileService) PublishProfile(
+	ctx context.Context,
+	req *mantraev1.PublishProfileRequest,
+) (*mantraev1.PublishProfileResponse, error) {
+	issues, err := validateProfile(ctx, s.app, req.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, issue := range issues {
+		if issue.Severity == traefik.SeverityError {
+			return nil, connect.NewError(
+				connect.CodeFailedPrecondition,
+				fmt.Errorf("invalid configuration: %s: %s", issue.Path, issue.Message),
+			)
+		}
+	}
+
+	published, err := s.getPublished(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	profile, revision, err := s.app.History.Publish(ctx, s.app.Conn.Q, req.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	publishDNS(s.app, req.Id, published.Snapshot.Data, revision.Snapshot.Data)
+	s.app.Revisions.Bump(req.Id)
+	return &mantraev1.PublishProfileResponse{
+		Profile:  profile.ToProto(),
+		Revision: revision.ToProto(),
+	}, nil
+}
+
+func (s *ProfileService) DiscardDraft(
+	ctx context.Context,
+	req *mantraev1.DiscardDraftRequest,
+) (*mantraev1.DiscardDraftResponse, error) {
+	published, err := s.getPublished(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		return traefik.RestoreSnapshot(ctx, q, req.Id, published.Snapshot.Data)
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	go s.app.DNS.UpdateDNS()
+	s.app.Revisions.Bump(req.Id)
+	return &mantraev1.DiscardDraftResponse{}, nil
+}
+
+func (s *ProfileService) GetDraftDiff(
+	ctx context.Context,
+	req *mantraev1.GetDraftDiffRequest,
+) (*mantraev1.GetDraftDiffResponse, error) {
+	published, err := s.getPublished(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.app.Conn.Q.GetProfile(ctx, req.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	draft, err := s.app.Configs.Get(ctx, s.app.Conn.Q, *profile)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	changes, err := traefik.DiffConfigs(published.Config.Data, draft.Config)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.GetDraftDiffResponse{Changes: configChangesToProto(changes)}, nil
+}
+
+func (s *ProfileService) ValidateProfile(
+	ctx context.Context,
+	req *mantraev1.ValidateProfileRequest,
+) (*mantraev1.ValidateProfileResponse, error) {
+	issues, err := validateProfile(ctx, s.app, req.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.ValidateProfileResponse{Issues: issuesToProto(issues)}, nil
+}
+
+func (s *ProfileService) CloneProfile(
+	ctx context.Context,
+	req *mantraev1.CloneProfileRequest,
+) (*mantraev1.CloneProfileResponse, error) {
+	if _, err := s.app.Conn.Q.GetProfile(ctx, req.Id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var result *db.Profile
+	if err := s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		var err error
+		result, err = q.CreateProfile(ctx, &db.CreateProfileParams{
+			Name:        slug.Make(req.Name),
+			Description: req.Description,
+			Token:       util.GenerateToken(6),
+		})
+		if err != nil {
+			return err
+		}
+		return traefik.CloneProfile(ctx, q, req.Id, result.ID)
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	go s.app.DNS.UpdateDNS()
+	s.app.Revisions.Bump(result.ID)
+	return &mantraev1.CloneProfileResponse{Profile: result.ToProto()}, nil
+}
+
+func (s *ProfileService) PlanPromotion(
+	ctx context.Context,
+	req *mantraev1.PlanPromotionRequest,
+) (*mantraev1.PlanPromotionResponse, error) {
+	if err := s.checkPromotion(ctx, req.SourceProfileId, req.TargetProfileId); err != nil {
+		return nil, err
+	}
+
+	items, changes, err := traefik.PlanPromotion(
+		ctx,
+		s.app.Conn.Q,
+		req.SourceProfileId,
+		req.TargetProfileId,
+		resourcesFromProto(req.Resources),
+	)
+	if err != nil {
+		if errors.Is(err, traefik.ErrUnknownResource) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.PlanPromotionResponse{
+		Items:   importItemsToProto(items),
+		Changes: configChangesToProto(changes),
+	}, nil
+}
+
+func (s *ProfileService) PromoteResources(
+	ctx context.Context,
+	req *mantraev1.PromoteResourcesRequest,
+) (*mantraev1.PromoteResourcesResponse, error) {
+	if err := s.checkPromotion(ctx, req.SourceProfileId, req.TargetProfileId); err != nil {
+		return nil, err
+	}
+
+	var items []traefik.ImportItem
+	if err := s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		var err error
+		items, err = traefik.Promote(
+			ctx,
+			q,
+			req.SourceProfileId,
+			req.TargetProfileId,
+			resourcesFromProto(req.Resources),
+			importStrategy(req.Strategy),
+		)
+		return err
+	}); err != nil {
+		if errors.Is(err, traefik.ErrUnknownResource) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, importError(err)
+	}
+
+	updateDNS(ctx, s.app, req.TargetProfileId)
+	s.app.Revisions.Bump(req.TargetProfileId)
+	return &mantraev1.PromoteResourcesResponse{Items: importItemsToProto(items)}, nil
+}
+
+// checkPromotion makes sure resources are promoted between two existing
+// profiles.
+func (s *ProfileService) checkPromotion(ctx context.Context, sourceID, targetID int64) error {
+	if sourceID == targetID {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("source and target profile must differ"),
+		)
+	}
+	for _, id := range []int64{sourceID, targetID} {
+		if _, err := s.app.Conn.Q.GetProfile(ctx, id); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return connect.NewError(connect.CodeNotFound, err)
+			}
+			return connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return nil
+}
+
+// getPublished returns the published revision of a staged profile.
+func (s *ProfileService) getPublished(
+	ctx context.Context,
+	profileID int64,
+) (*db.ConfigRevision, error) {
+	profile, err := s.app.Conn.Q.GetProfile(ctx, profileID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if profile.PublishedRevision == nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("profile %q is not staged", profile.Name),
+		)
+	}
+
+	revision, err := s.app.Conn.Q.GetConfigRevision(ctx, &db.GetConfigRevisionParams{
+		ProfileID: profileID,
+		Revision:  *profile.PublishedRevision,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return revision, nil
+}
+
+// updateDNS refreshes the DNS records after the profile changed. Edits to a
+// staged profile are drafts, so its records are only refreshed once the draft
+// is published or discarded.
+func updateDNS(ctx context.Context, app *config.App, profileID int64) {
+	if isStaged(ctx, app, profileID) {
+		return
+	}
+	go app.DNS.UpdateDNS()
+}
+
+// deleteDNS removes the records of a router rule from a DNS provider. Records
+// of a staged profile stay live until the draft is published, see publishDNS.
+func deleteDNS(ctx context.Context, app *config.App, profileID int64, providerID, rule string) {
+	if isStaged(ctx, app, profileID) {
+		return
+	}
+	go app.DNS.DeleteDNS(profileID, providerID, rule)
+}
+
+// publishDNS brings the DNS records in line with a draft that went live: the
+// records only the previously published snapshot needed are deleted before the
+// others are refreshed.
+func publishDNS(app *config.App, profileID int64, published, draft *db.Snapshot) {
+	stale := traefik.StaleDNSLinks(published, draft)
+	go func() {
+		for _, link := range stale {
+			app.DNS.DeleteDNS(profileID, link.ProviderID, link.Rule)
+		}
+		app.DNS.UpdateDNS()
+	}()
+}
+
+func isStaged(ctx context.Context, app *config.App, profileID int64) bool {
+	profile, err := app.Conn.Q.GetProfile(ctx, profileID)
+	return err == nil && profile.PublishedRevision != nil
+}
+
+func resourcesFromProto(resources []*mantraev1.ProfileResource) []traefik.Resource {
+	result := make([]traefik.Resource, 0, len(resources))
+	for _, r := range resources {
+		result = append(result, traefik.Resource{
+			Protocol: protocolName(r.Protocol),
+			Type:     r.Type,
+			Name:     r.Name,
+		})
+	}
+	return result
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"github.com/mizuchilabs/mantrae/internal/config"
+	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/meta"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+)
+
+type ProfileMemberService struct {
+	app *config.App
+}
+
+func NewProfileMemberService(app *config.App) *ProfileMemberService {
+	return &ProfileMemberService{app: app}
+}
+
+func (s *ProfileMemberService) CreateProfileMember(
+	ctx context.Context,
+	req *mantraev1.CreateProfileMemberRequest,
+) (*mantraev1.CreateProfileMemberResponse, error) {
+	role, err := memberRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.app.Conn.Q.GetProfile(ctx, req.ProfileId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("profile not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err = s.app.Conn.Q.GetUserByID(ctx, req.UserId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result, err := s.app.Conn.Q.CreateProfileMember(ctx, &db.CreateProfileMemberParams{
+		ProfileID: req.ProfileId,
+		UserID:    req.UserId,
+		Role:      role,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.CreateProfileMemberResponse{Member: result.ToProto()}, nil
+}
+
+func (s *ProfileMemberService) UpdateProfileMember(
+	ctx context.Context,
+	req *mantraev1.UpdateProfileMemberRequest,
+) (*mantraev1.UpdateProfileMemberResponse, error) {
+	role, err := memberRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.app.Conn.Q.UpdateProfileMember(ctx, &db.UpdateProfileMemberParams{
+		Role:      role,
+		ProfileID: req.ProfileId,
+		UserID:    req.UserId,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.UpdateProfileMemberResponse{Member: result.ToProto()}, nil
+}
+
+func (s *ProfileMemberService) DeleteProfileMember(
+	ctx context.Context,
+	req *mantraev1.DeleteProfileMemberRequest,
+) (*mantraev1.DeleteProfileMemberResponse, error) {
+	if err := s.app.Conn.Q.DeleteProfileMember(ctx, &db.DeleteProfileMemberParams{
+		ProfileID: req.ProfileId,
+		UserID:    req.UserId,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.DeleteProfileMemberResponse{}, nil
+}
+
+func (s *ProfileMemberService) ListProfileMembers(
+	ctx context.Context,
+	req *mantraev1.ListProfileMembersRequest,
+) (*mantraev1.ListProfileMembersResponse, error) {
+	result, err := s.app.Conn.Q.ListProfileMembers(ctx, req.ProfileId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	members := make([]*mantraev1.ProfileMember, 0, len(result))
+	for _, m := range result {
+		members = append(members, m.ToProto())
+	}
+	return &mantraev1.ListProfileMembersResponse{Members: members}, nil
+}
+
+// memberRole returns the stored name of a profile role. Admins already see
+// every profile, so members are either editors or viewers.
+func memberRole(role mantraev1.Role) (string, error) {
+	switch name := roleName(role); name {
+	case meta.RoleEditor, meta.RoleViewer:
+		return name, nil
+	default:
+		return "", connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("profile role must be editor or viewer"),
+		)
+	}
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"github.com/google/uuid"
+	"github.com/mizuchilabs/mantrae/internal/config"
+	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/util"
+)
+
+type ProfileVariableService struct {
+	app *config.App
+}
+
+func NewProfileVariableService(app *config.App) *ProfileVariableService {
+	return &ProfileVariableService{app: app}
+}
+
+func (s *ProfileVariableService) GetProfileVariable(
+	ctx context.Context,
+	req *mantraev1.GetProfileVariableRequest,
+) (*mantraev1.GetProfileVariableResponse, error) {
+	result, err := s.app.Conn.Q.GetProfileVariable(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.GetProfileVariableResponse{Variable: result.ToProto()}, nil
+}
+
+func (s *ProfileVariableService) CreateProfileVariable(
+	ctx context.Context,
+	req *mantraev1.CreateProfileVariableRequest,
+) (*mantraev1.CreateProfileVariableResponse, error) {
+	if err := checkVariableValue(req.Value); err != nil {
+		return nil, err
+	}
+	result, err := s.app.Conn.Q.CreateProfileVariable(ctx, &db.CreateProfileVariableParams{
+		ID:          uuid.New().String(),
+		ProfileID:   req.ProfileId,
+		Name:        req.Name,
+		Value:       req.Value,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.bump(ctx, result.ProfileID)
+	return &mantraev1.CreateProfileVariableResponse{Variable: result.ToProto()}, nil
+}
+
+func (s *ProfileVariableService) UpdateProfileVariable(
+	ctx context.Context,
+	req *mantraev1.UpdateProfileVariableRequest,
+) (*mantraev1.UpdateProfileVariableResponse, error) {
+	if err := checkVariableValue(req.Value); err != nil {
+		return nil, err
+	}
+	result, err := s.app.Conn.Q.UpdateProfileVariable(ctx, &db.UpdateProfileVariableParams{
+		ID:          req.Id,
+		Name:        req.Name,
+		Value:       req.Value,
+		Description: req.Description,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.bump(ctx, result.ProfileID)
+	return &mantraev1.UpdateProfileVariableResponse{Variable: result.ToProto()}, nil
+}
+
+func (s *ProfileVariableService) DeleteProfileVariable(
+	ctx context.Context,
+	req *mantraev1.DeleteProfileVariableRequest,
+) (*mantraev1.DeleteProfileVariableResponse, error) {
+	variable, err := s.app.Conn.Q.GetProfileVariable(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err = s.app.Conn.Q.DeleteProfileVariable(ctx, req.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.bump(ctx, variable.ProfileID)
+	return &mantraev1.DeleteProfileVariableResponse{}, nil
+}
+
+func (s *ProfileVariableService) ListProfileVariables(
+	ctx context.Context,
+	req *mantraev1.ListProfileVariablesRequest,
+) (*mantraev1.ListProfileVariablesResponse, error) {
+	result, err := s.app.Conn.Q.ListProfileVariables(ctx, req.ProfileId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	variables := make([]*mantraev1.ProfileVariable, 0, len(result))
+	for _, v := range result {
+		variables = append(variables, v.ToProto())
+	}
+	return &mantraev1.ListProfileVariablesResponse{Variables: variables}, nil
+}
+
+// bump rebuilds the configuration of the profile and the DNS records of its
+// routers, which may refer to the variables.
+func (s *ProfileVariableService) bump(ctx context.Context, profileID int64) {
+	updateDNS(ctx, s.app, profileID)
+	s.app.Revisions.Bump(profileID)
+}
+
+// checkVariableValue rejects values referring to other variables, as
+// references are only expanded once.
+func checkVariableValue(value string) error {
+	if refs := util.VariableRefs(value); len(refs) > 0 {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("value must not refer to variable %q", refs[0]),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"github.com/mizuchilabs/mantrae/internal/config"
+	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/traefik"
+)
+
+type RevisionService struct {
+	app *config.App
+}
+
+func NewRevisionService(app *config.App) *RevisionService {
+	return &RevisionService{app: app}
+}
+
+func (s *RevisionService) ListRevisions(
+	ctx context.Context,
+	req *mantraev1.ListRevisionsRequest,
+) (*mantraev1.ListRevisionsResponse, error) {
+	result, err := s.app.Conn.Q.ListConfigRevisions(ctx, &db.ListConfigRevisionsParams{
+		ProfileID: req.ProfileId,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	totalCount, err := s.app.Conn.Q.CountConfigRevisions(ctx, req.ProfileId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	revisions := make([]*mantraev1.Revision, 0, len(result))
+	for _, r := range result {
+		revisions = append(revisions, &mantraev1.Revision{
+			ProfileId: req.ProfileId,
+			Revision:  r.Revision,
+			CreatedAt: db.SafeTimestamp(r.CreatedAt),
+		})
+	}
+	return &mantraev1.ListRevisionsResponse{
+		Revisions:  revisions,
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (s *RevisionService) GetRevision(
+	ctx context.Context,
+	req *mantraev1.GetRevisionRequest,
+) (*mantraev1.GetRevisionResponse, error) {
+	revision, err := s.getRevision(ctx, req.ProfileId, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := traefik.EncodeConfig("", revision.Config.Data)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.GetRevisionResponse{
+		Revision: revision.ToProto(),
+		Config:   string(encoded.Encoded(req.Format)),
+	}, nil
+}
+
+func (s *RevisionService) DiffRevisions(
+	ctx context.Context,
+	req *mantraev1.DiffRevisionsRequest,
+) (*mantraev1.DiffRevisionsResponse, error) {
+	from, err := s.getRevision(ctx, req.ProfileId, req.FromRevision)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getRevision(ctx, req.ProfileId, req.ToRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := traefik.DiffConfigs(from.Config.Data, to.Config.Data)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.DiffRevisionsResponse{Changes: configChangesToProto(changes)}, nil
+}
+
+func (s *RevisionService) RollbackRevision(
+	ctx context.Context,
+	req *mantraev1.RollbackRevisionRequest,
+) (*mantraev1.RollbackRevisionResponse, error) {
+	revision, err := s.getRevision(ctx, req.ProfileId, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		return traefik.RestoreSnapshot(ctx, q, req.ProfileId, revision.Snapshot.Data)
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.app.Revisions.Bump(req.ProfileId)
+	updateDNS(ctx, s.app, req.ProfileId)
+
+	// Bump records the restored configuration as a new revision
+	latest, err := s.app.Conn.Q.GetLatestConfigRevision(ctx, req.ProfileId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.RollbackRevisionResponse{Revision: latest.ToProto()}, nil
+}
+
+func (s *RevisionService) getRevision(
+	ctx context.Context,
+	profileID, revision int64,
+) (*db.ConfigRevision, error) {
+	result, err := s.app.Conn.Q.GetConfigRevision(ctx, &db.GetConfigRevisionParams{
+		ProfileID: profileID,
+		Revision:  revision,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("revision %d not found", revision),
+		)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return result, nil
+}
+
+func configChangesToProto(changes []traefik.ConfigChange) []*mantraev1.ConfigChange {
+	result := make([]*mantraev1.ConfigChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, &mantraev1.ConfigChange{
+			Path:   c.Path,
+			Kind:   changeKindToProto(c.Kind),
+			Before: string(c.Before),
+			After:  string(c.After),
+		})
+	}
+	return result
+}
+
+func changeKindToProto(kind traefik.ChangeKind) mantraev1.ChangeKind {
+	switch kind {
+	case traefik.ChangeAdded:
+		return mantraev1.ChangeKind_CHANGE_KIND_ADDED
+	case traefik.ChangeRemoved:
+		return mantraev1.ChangeKind_CHANGE_KIND_REMOVED
+	case traefik.ChangeModified:
+		return mantraev1.ChangeKind_CHANGE_KIND_MODIFIED
+	default:
+		return mantraev1.ChangeKind_CHANGE_KIND_UNSPECIFIED
+	}
+}
@@ -3,11 +3,16 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"connectrpc.com/connect"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/mizuchilabs/mantrae/internal/config"
 	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/traefik"
+	"github.com/mizuchilabs/mantrae/internal/util"
 )
 
 type RouterService struct {
@@ -57,10 +62,22 @@ func (s *RouterService) CreateRouter(
 		)
 	}
 
+	if err := s.checkRule(ctx, req.ProfileId, req.Type, req.Config); err != nil {
+		return nil, err
+	}
+
 	result, err := ops.Create(ctx, req)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	result.Issues = itemIssues(
+		ctx,
+		s.app,
+		result.Router.ProfileId,
+		req.Type,
+		"routers",
+		result.Router.Name,
+	)
 	return result, nil
 }
 
@@ -76,10 +93,26 @@ func (s *RouterService) UpdateRouter(
 		)
 	}
 
+	current, err := ops.Get(ctx, &mantraev1.GetRouterRequest{Id: req.Id, Type: req.Type})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err = s.checkRule(ctx, current.Router.ProfileId, req.Type, req.Config); err != nil {
+		return nil, err
+	}
+
 	result, err := ops.Update(ctx, req)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	result.Issues = itemIssues(
+		ctx,
+		s.app,
+		result.Router.ProfileId,
+		req.Type,
+		"routers",
+		result.Router.Name,
+	)
 	return result, nil
 }
 
@@ -153,3 +186,150 @@ func (s *RouterService) ListRouters(
 		TotalCount: totalCount,
 	}, nil
 }
+
+func (s *RouterService) ListRouteConflicts(
+	ctx context.Context,
+	req *mantraev1.ListRouteConflictsRequest,
+) (*mantraev1.ListRouteConflictsResponse, error) {
+	cfg, err := s.storedConfig(ctx, req.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := traefik.FindConflicts(cfg)
+	result := make([]*mantraev1.RouteConflict, 0, len(conflicts))
+	for _, c := range conflicts {
+		kind := mantraev1.ConflictKind_CONFLICT_KIND_AMBIGUOUS
+		if c.Kind == traefik.ConflictShadowed {
+			kind = mantraev1.ConflictKind_CONFLICT_KIND_SHADOWED
+		}
+		protocol := mantraev1.ProtocolType_PROTOCOL_TYPE_HTTP
+		if c.Protocol == "tcp" {
+			protocol = mantraev1.ProtocolType_PROTOCOL_TYPE_TCP
+		}
+		result = append(result, &mantraev1.RouteConflict{
+			Kind:          kind,
+			Type:          protocol,
+			Router:        c.Router,
+			Other:         c.Other,
+			Priority:      int32(c.Priority),
+			OtherPriority: int32(c.OtherPriority),
+			EntryPoints:   c.EntryPoints,
+		})
+	}
+	return &mantraev1.ListRouteConflictsResponse{Conflicts: result}, nil
+}
+
+func (s *RouterService) SimulateRoute(
+	ctx context.Context,
+	req *mantraev1.SimulateRouteRequest,
+) (*mantraev1.SimulateRouteResponse, error) {
+	cfg, err := s.storedConfig(ctx, req.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+
+	sim, err := traefik.SimulateRequest(ctx, cfg, traefik.SimulatedRequest{
+		EntryPoint: req.EntryPoint,
+		TLS:        req.Tls,
+		Method:     req.Method,
+		Host:       req.Host,
+		Path:       req.Path,
+		Headers:    req.Headers,
+		ClientIP:   req.ClientIp,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result := &mantraev1.SimulateRouteResponse{Router: sim.Router}
+	for _, c := range sim.Candidates {
+		result.Candidates = append(result.Candidates, &mantraev1.RouteCandidate{
+			Router:   c.Router,
+			Rule:     c.Rule,
+			Priority: int32(c.Priority),
+			Matches:  c.Matches,
+			Error:    c.Error,
+		})
+	}
+	for _, m := range sim.Middlewares {
+		result.Middlewares = append(result.Middlewares, &mantraev1.SimulatedMiddleware{
+			Name:    m.Name,
+			Type:    m.Type,
+			Service: m.Service,
+		})
+	}
+	for _, svc := range sim.Services {
+		result.Services = append(result.Services, &mantraev1.SimulatedService{
+			Name:    svc.Name,
+			Type:    svc.Type,
+			Servers: svc.Servers,
+		})
+	}
+	return result, nil
+}
+
+// storedConfig builds the profile's configuration from the database, including
+// changes not published yet.
+func (s *RouterService) storedConfig(
+	ctx context.Context,
+	profileID int64,
+) (*dynamic.Configuration, error) {
+	profile, err := s.app.Conn.Q.GetProfile(ctx, profileID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	cached, err := s.app.Configs.Get(ctx, s.app.Conn.Q, *profile)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return cached.Config, nil
+}
+
+// checkRule rejects HTTP and TCP router rules Traefik would fail to parse,
+// with the position of the error attached as a RuleError detail. The rule is
+// checked with the profile variables expanded, as it is served. Rules still
+// referring to unknown variables are left to CheckVariables.
+func (s *RouterService) checkRule(
+	ctx context.Context,
+	profileID int64,
+	protocol mantraev1.ProtocolType,
+	cfg *structpb.Struct,
+) error {
+	if protocol == mantraev1.ProtocolType_PROTOCOL_TYPE_UDP {
+		return nil
+	}
+	rule := cfg.GetFields()["rule"].GetStringValue()
+	if rule == "" {
+		return nil
+	}
+	vars, err := traefik.ProfileVariables(ctx, s.app.Conn.Q, profileID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if rule = util.ExpandVariables(rule, vars); len(util.VariableRefs(rule)) > 0 {
+		return nil
+	}
+	syntax := cfg.GetFields()["ruleSyntax"].GetStringValue()
+
+	_, err = traefik.ParseRule(protocolName(protocol), rule, syntax)
+	var ruleErr *traefik.RuleError
+	if !errors.As(err, &ruleErr) {
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	}
+
+	connectErr := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid rule: %w", err))
+	detail, err := connect.NewErrorDetail(&mantraev1.RuleError{
+		Rule:    rule,
+		Line:    int32(ruleErr.Line),
+		Column:  int32(ruleErr.Column),
+		Message: ruleErr.Message,
+	})
+	if err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
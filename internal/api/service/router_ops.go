@@ -103,26 +103,32 @@ func (s *HTTPRouterOps) Create(
 		params.Config.Data.Service = params.Name
 	}
 
-	// Add default DNS provider
-
-	result, err := s.app.Conn.Q.CreateHttpRouter(ctx, params)
-	if err != nil {
-		return nil, err
-	}
-	router := result.ToProto()
-
-	dnsProvider, err := s.app.Conn.Q.GetDefaultDNSProvider(ctx)
-	if err == nil {
-		if err := s.app.Conn.Q.CreateHttpRouterDNSProvider(ctx, &db.CreateHttpRouterDNSProviderParams{
-			HttpRouterID:  router.Id,
-			DnsProviderID: dnsProvider.ID,
-		}); err != nil {
-			return nil, err
+	var router *mantraev1.Router
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		result, err := q.CreateHttpRouter(ctx, params)
+		if err != nil {
+			return err
+		}
+		router = result.ToProto()
+
+		// Add default DNS provider
+		dnsProvider, err := q.GetDefaultDNSProvider(ctx)
+		if err == nil {
+			if err = q.CreateHttpRouterDNSProvider(ctx, &db.CreateHttpRouterDNSProviderParams{
+				HttpRouterID:  router.Id,
+				DnsProviderID: dnsProvider.ID,
+			}); err != nil {
+				return err
+			}
+			router.DnsProviders = append(router.DnsProviders, dnsProvider.ToProto())
 		}
-		router.DnsProviders = append(router.DnsProviders, dnsProvider.ToProto())
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	go s.app.DNS.UpdateDNS()
+	updateDNS(ctx, s.app, router.ProfileId)
+	s.app.Revisions.Bump(router.ProfileId)
 	return &mantraev1.CreateRouterResponse{
 		Router: router,
 	}, nil
@@ -150,75 +156,94 @@ func (s *HTTPRouterOps) Update(
 	params.Config.Data.EntryPoints = util.CleanSliceStr(params.Config.Data.EntryPoints)
 	params.Config.Data.Middlewares = util.CleanSliceStr(params.Config.Data.Middlewares)
 
-	// Update DNS Providers
-	existing, err := s.app.Conn.Q.GetDnsProvidersByHttpRouter(ctx, params.ID)
-	if err != nil {
-		return nil, err
-	}
-	existingMap := make(map[string]bool)
-	for _, provider := range existing {
-		existingMap[provider.ID] = true
-	}
+	var (
+		result  *db.HttpRouter
+		added   bool
+		removed []string
+	)
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		// Update DNS Providers
+		existing, err := q.GetDnsProvidersByHttpRouter(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+		existingMap := make(map[string]bool)
+		for _, provider := range existing {
+			existingMap[provider.ID] = true
+		}
 
-	desiredMap := make(map[string]bool)
-	var desiredIDs []string
-	for _, protoProvider := range req.DnsProviders {
-		desiredMap[protoProvider.Id] = true
-		desiredIDs = append(desiredIDs, protoProvider.Id)
-	}
+		desiredMap := make(map[string]bool)
+		var desiredIDs []string
+		for _, protoProvider := range req.DnsProviders {
+			desiredMap[protoProvider.Id] = true
+			desiredIDs = append(desiredIDs, protoProvider.Id)
+		}
 
-	// Identify inserts
-	for _, id := range desiredIDs {
-		if !existingMap[id] {
-			if err = s.app.Conn.Q.
-				CreateHttpRouterDNSProvider(ctx, &db.CreateHttpRouterDNSProviderParams{
-					HttpRouterID:  params.ID,
-					DnsProviderID: id,
-				}); err != nil {
-				return nil, err
+		// Identify inserts
+		for _, id := range desiredIDs {
+			if !existingMap[id] {
+				if err = q.
+					CreateHttpRouterDNSProvider(ctx, &db.CreateHttpRouterDNSProviderParams{
+						HttpRouterID:  params.ID,
+						DnsProviderID: id,
+					}); err != nil {
+					return err
+				}
+				added = true
 			}
-			go s.app.DNS.UpdateDNS()
 		}
-	}
 
-	// Identify deletes
-	for id := range existingMap {
-		if !desiredMap[id] {
-			if err = s.app.Conn.Q.
-				DeleteHttpRouterDNSProvider(ctx, &db.DeleteHttpRouterDNSProviderParams{
-					HttpRouterID:  params.ID,
-					DnsProviderID: id,
-				}); err != nil {
-				return nil, err
+		// Identify deletes
+		for id := range existingMap {
+			if !desiredMap[id] {
+				if err = q.
+					DeleteHttpRouterDNSProvider(ctx, &db.DeleteHttpRouterDNSProviderParams{
+						HttpRouterID:  params.ID,
+						DnsProviderID: id,
+					}); err != nil {
+					return err
+				}
+				removed = append(removed, id)
 			}
-			go s.app.DNS.DeleteDNS(id, params.Config.Data.Rule)
 		}
-	}
 
-	result, err := s.app.Conn.Q.UpdateHttpRouter(ctx, params)
-	if err != nil {
-		return nil, err
-	}
-	// Disable service if router is disabled
-	if result.Config.Data.Service != "" {
-		service, err := s.app.Conn.Q.GetHttpServiceByName(ctx, &db.GetHttpServiceByNameParams{
-			ProfileID: result.ProfileID,
-			Name:      result.Config.Data.Service,
-		})
+		result, err = q.UpdateHttpRouter(ctx, params)
 		if err != nil {
-			slog.Error("failed to get http service for disabling", "err", err)
-		} else {
-			if _, err := s.app.Conn.Q.UpdateHttpService(ctx, &db.UpdateHttpServiceParams{
+			return err
+		}
+		// Disable service if router is disabled
+		if result.Config.Data.Service != "" {
+			service, err := q.GetHttpServiceByName(ctx, &db.GetHttpServiceByNameParams{
+				ProfileID: result.ProfileID,
+				Name:      result.Config.Data.Service,
+			})
+			if err != nil {
+				slog.Error("failed to get http service for disabling", "err", err)
+				return nil
+			}
+			if _, err = q.UpdateHttpService(ctx, &db.UpdateHttpServiceParams{
 				ID:      service.ID,
 				Name:    service.Name,
 				Config:  service.Config,
 				Enabled: result.Enabled,
 			}); err != nil {
-				slog.Error("failed to disable http service", "err", err)
+				return err
 			}
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
+	// Only touch DNS records once the changes are committed
+	if added {
+		updateDNS(ctx, s.app, result.ProfileID)
+	}
+	for _, id := range removed {
+		deleteDNS(ctx, s.app, result.ProfileID, id, params.Config.Data.Rule)
+	}
+	s.app.Revisions.Bump(result.ProfileID)
+
 	router := result.ToProto()
 
 	dnsProviders, err := s.app.Conn.Q.GetDnsProvidersByHttpRouter(ctx, result.ID)
@@ -243,32 +268,34 @@ func (s *HTTPRouterOps) Delete(
 		return nil, err
 	}
 
-	if router.Config.Data.Service != "" {
-		service, err := s.app.Conn.Q.
-			GetHttpServiceByName(ctx, &db.GetHttpServiceByNameParams{
-				ProfileID: router.ProfileID,
-				Name:      router.Config.Data.Service,
-			})
-		if err != nil {
-			slog.Error("failed to get http service", "err", err)
-		}
-		if err := s.app.Conn.Q.DeleteHttpService(ctx, service.ID); err != nil {
-			slog.Error("failed to delete http service", "err", err)
-		}
-	}
-
-	// Delete DNS entries
 	dnsProviders, err := s.app.Conn.Q.GetDnsProvidersByHttpRouter(ctx, router.ID)
 	if err != nil {
 		return nil, err
 	}
-	for _, p := range dnsProviders {
-		go s.app.DNS.DeleteDNS(p.ID, router.Config.Data.Rule)
-	}
 
-	if err := s.app.Conn.Q.DeleteHttpRouter(ctx, req.Id); err != nil {
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if router.Config.Data.Service != "" {
+			service, err := q.
+				GetHttpServiceByName(ctx, &db.GetHttpServiceByNameParams{
+					ProfileID: router.ProfileID,
+					Name:      router.Config.Data.Service,
+				})
+			if err != nil {
+				slog.Error("failed to get http service", "err", err)
+			} else if err = q.DeleteHttpService(ctx, service.ID); err != nil {
+				return err
+			}
+		}
+		return q.DeleteHttpRouter(ctx, req.Id)
+	}); err != nil {
 		return nil, err
 	}
+
+	// Delete DNS entries
+	for _, p := range dnsProviders {
+		deleteDNS(ctx, s.app, router.ProfileID, p.ID, router.Config.Data.Rule)
+	}
+	s.app.Revisions.Bump(router.ProfileID)
 	return &mantraev1.DeleteRouterResponse{}, nil
 }
 
@@ -360,6 +387,7 @@ func (s *TCPRouterOps) Create(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.CreateRouterResponse{
 		Router: result.ToProto(),
 	}, nil
@@ -387,73 +415,93 @@ func (s *TCPRouterOps) Update(
 	params.Config.Data.EntryPoints = util.CleanSliceStr(params.Config.Data.EntryPoints)
 	params.Config.Data.Middlewares = util.CleanSliceStr(params.Config.Data.Middlewares)
 
-	// Update DNS Providers
-	existing, err := s.app.Conn.Q.GetDnsProvidersByTcpRouter(ctx, params.ID)
-	if err != nil {
-		return nil, err
-	}
-	existingMap := make(map[string]bool)
-	for _, provider := range existing {
-		existingMap[provider.ID] = true
-	}
+	var (
+		result  *db.TcpRouter
+		added   bool
+		removed []string
+	)
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		// Update DNS Providers
+		existing, err := q.GetDnsProvidersByTcpRouter(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+		existingMap := make(map[string]bool)
+		for _, provider := range existing {
+			existingMap[provider.ID] = true
+		}
 
-	desiredMap := make(map[string]bool)
-	var desiredIDs []string
-	for _, protoProvider := range req.DnsProviders {
-		desiredMap[protoProvider.Id] = true
-		desiredIDs = append(desiredIDs, protoProvider.Id)
-	}
-	// Identify inserts
-	for _, id := range desiredIDs {
-		if !existingMap[id] {
-			if err = s.app.Conn.Q.
-				CreateTcpRouterDNSProvider(ctx, &db.CreateTcpRouterDNSProviderParams{
-					TcpRouterID:   params.ID,
-					DnsProviderID: id,
-				}); err != nil {
-				return nil, err
+		desiredMap := make(map[string]bool)
+		var desiredIDs []string
+		for _, protoProvider := range req.DnsProviders {
+			desiredMap[protoProvider.Id] = true
+			desiredIDs = append(desiredIDs, protoProvider.Id)
+		}
+
+		// Identify inserts
+		for _, id := range desiredIDs {
+			if !existingMap[id] {
+				if err = q.
+					CreateTcpRouterDNSProvider(ctx, &db.CreateTcpRouterDNSProviderParams{
+						TcpRouterID:   params.ID,
+						DnsProviderID: id,
+					}); err != nil {
+					return err
+				}
+				added = true
 			}
-			go s.app.DNS.UpdateDNS()
 		}
-	}
 
-	// Identify deletes
-	for id := range existingMap {
-		if !desiredMap[id] {
-			if err = s.app.Conn.Q.
-				DeleteTcpRouterDNSProvider(ctx, &db.DeleteTcpRouterDNSProviderParams{
-					TcpRouterID:   params.ID,
-					DnsProviderID: id,
-				}); err != nil {
-				return nil, err
+		// Identify deletes
+		for id := range existingMap {
+			if !desiredMap[id] {
+				if err = q.
+					DeleteTcpRouterDNSProvider(ctx, &db.DeleteTcpRouterDNSProviderParams{
+						TcpRouterID:   params.ID,
+						DnsProviderID: id,
+					}); err != nil {
+					return err
+				}
+				removed = append(removed, id)
 			}
-			go s.app.DNS.DeleteDNS(id, params.Config.Data.Rule)
 		}
-	}
 
-	result, err := s.app.Conn.Q.UpdateTcpRouter(ctx, params)
-	if err != nil {
-		return nil, err
-	}
-	// Disable service if router is disabled
-	if result.Config.Data.Service != "" {
-		service, err := s.app.Conn.Q.GetTcpServiceByName(ctx, &db.GetTcpServiceByNameParams{
-			ProfileID: result.ProfileID,
-			Name:      result.Config.Data.Service,
-		})
+		result, err = q.UpdateTcpRouter(ctx, params)
 		if err != nil {
-			slog.Error("failed to get tcp service for disabling", "err", err)
-		} else {
-			if _, err := s.app.Conn.Q.UpdateTcpService(ctx, &db.UpdateTcpServiceParams{
+			return err
+		}
+		// Disable service if router is disabled
+		if result.Config.Data.Service != "" {
+			service, err := q.GetTcpServiceByName(ctx, &db.GetTcpServiceByNameParams{
+				ProfileID: result.ProfileID,
+				Name:      result.Config.Data.Service,
+			})
+			if err != nil {
+				slog.Error("failed to get tcp service for disabling", "err", err)
+				return nil
+			}
+			if _, err = q.UpdateTcpService(ctx, &db.UpdateTcpServiceParams{
 				ID:      service.ID,
 				Name:    service.Name,
 				Config:  service.Config,
 				Enabled: result.Enabled,
 			}); err != nil {
-				slog.Error("failed to disable tcp service", "err", err)
+				return err
 			}
 		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Only touch DNS records once the changes are committed
+	if added {
+		updateDNS(ctx, s.app, result.ProfileID)
+	}
+	for _, id := range removed {
+		deleteDNS(ctx, s.app, result.ProfileID, id, params.Config.Data.Rule)
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 
 	router := result.ToProto()
 
@@ -480,32 +528,34 @@ func (s *TCPRouterOps) Delete(
 		return nil, err
 	}
 
-	if router.Config.Data.Service != "" {
-		service, err := s.app.Conn.Q.
-			GetTcpServiceByName(ctx, &db.GetTcpServiceByNameParams{
-				ProfileID: router.ProfileID,
-				Name:      router.Config.Data.Service,
-			})
-		if err != nil {
-			slog.Error("failed to get tcp service", "err", err)
-		}
-		if err := s.app.Conn.Q.DeleteTcpService(ctx, service.ID); err != nil {
-			slog.Error("failed to delete tcp service", "err", err)
-		}
-	}
-
-	// Delete DNS entries
 	dnsProviders, err := s.app.Conn.Q.GetDnsProvidersByTcpRouter(ctx, router.ID)
 	if err != nil {
 		return nil, err
 	}
-	for _, p := range dnsProviders {
-		go s.app.DNS.DeleteDNS(p.ID, router.Config.Data.Rule)
-	}
 
-	if err := s.app.Conn.Q.DeleteTcpRouter(ctx, req.Id); err != nil {
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if router.Config.Data.Service != "" {
+			service, err := q.
+				GetTcpServiceByName(ctx, &db.GetTcpServiceByNameParams{
+					ProfileID: router.ProfileID,
+					Name:      router.Config.Data.Service,
+				})
+			if err != nil {
+				slog.Error("failed to get tcp service", "err", err)
+			} else if err = q.DeleteTcpService(ctx, service.ID); err != nil {
+				return err
+			}
+		}
+		return q.DeleteTcpRouter(ctx, req.Id)
+	}); err != nil {
 		return nil, err
 	}
+
+	// Delete DNS entries
+	for _, p := range dnsProviders {
+		deleteDNS(ctx, s.app, router.ProfileID, p.ID, router.Config.Data.Rule)
+	}
+	s.app.Revisions.Bump(router.ProfileID)
 	return &mantraev1.DeleteRouterResponse{}, nil
 }
 
@@ -589,6 +639,7 @@ func (s *UDPRouterOps) Create(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.CreateRouterResponse{
 		Router: result.ToProto(),
 	}, nil
@@ -615,31 +666,38 @@ func (s *UDPRouterOps) Update(
 	}
 	params.Config.Data.EntryPoints = util.CleanSliceStr(params.Config.Data.EntryPoints)
 
-	result, err := s.app.Conn.Q.UpdateUdpRouter(ctx, params)
-	if err != nil {
-		return nil, err
-	}
-
-	// Change service status
-	if result.Config.Data.Service != "" {
-		service, err := s.app.Conn.Q.GetUdpServiceByName(ctx, &db.GetUdpServiceByNameParams{
-			ProfileID: result.ProfileID,
-			Name:      result.Config.Data.Service,
-		})
+	var result *db.UdpRouter
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		result, err = q.UpdateUdpRouter(ctx, params)
 		if err != nil {
-			slog.Error("failed to get udp service for disabling", "err", err)
-		} else {
-			if _, err := s.app.Conn.Q.UpdateUdpService(ctx, &db.UpdateUdpServiceParams{
+			return err
+		}
+
+		// Change service status
+		if result.Config.Data.Service != "" {
+			service, err := q.GetUdpServiceByName(ctx, &db.GetUdpServiceByNameParams{
+				ProfileID: result.ProfileID,
+				Name:      result.Config.Data.Service,
+			})
+			if err != nil {
+				slog.Error("failed to get udp service for disabling", "err", err)
+				return nil
+			}
+			if _, err = q.UpdateUdpService(ctx, &db.UpdateUdpServiceParams{
 				ID:      service.ID,
 				Name:    service.Name,
 				Config:  service.Config,
 				Enabled: result.Enabled,
 			}); err != nil {
-				slog.Error("failed to disable udp service", "err", err)
+				return err
 			}
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.UpdateRouterResponse{
 		Router: result.ToProto(),
 	}, nil
@@ -653,23 +711,24 @@ func (s *UDPRouterOps) Delete(
 	if err != nil {
 		return nil, err
 	}
-	if router.Config.Data.Service != "" {
-		service, err := s.app.Conn.Q.
-			GetUdpServiceByName(ctx, &db.GetUdpServiceByNameParams{
-				ProfileID: router.ProfileID,
-				Name:      router.Config.Data.Service,
-			})
-		if err != nil {
-			slog.Error("failed to get udp service", "err", err)
-		}
-		if err := s.app.Conn.Q.DeleteUdpService(ctx, service.ID); err != nil {
-			slog.Error("failed to delete udp service", "err", err)
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if router.Config.Data.Service != "" {
+			service, err := q.
+				GetUdpServiceByName(ctx, &db.GetUdpServiceByNameParams{
+					ProfileID: router.ProfileID,
+					Name:      router.Config.Data.Service,
+				})
+			if err != nil {
+				slog.Error("failed to get udp service", "err", err)
+			} else if err = q.DeleteUdpService(ctx, service.ID); err != nil {
+				return err
+			}
 		}
-	}
-
-	if err := s.app.Conn.Q.DeleteUdpRouter(ctx, req.Id); err != nil {
+		return q.DeleteUdpRouter(ctx, req.Id)
+	}); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(router.ProfileID)
 	return &mantraev1.DeleteRouterResponse{}, nil
 }
 
@@ -59,6 +59,14 @@ func (s *ServersTransportService) CreateServersTransport(
 	if err != nil {
 		return nil, err
 	}
+	result.Issues = itemIssues(
+		ctx,
+		s.app,
+		result.ServersTransport.ProfileId,
+		req.Type,
+		"serversTransports",
+		result.ServersTransport.Name,
+	)
 	return result, nil
 }
 
@@ -78,6 +86,14 @@ func (s *ServersTransportService) UpdateServersTransport(
 	if err != nil {
 		return nil, err
 	}
+	result.Issues = itemIssues(
+		ctx,
+		s.app,
+		result.ServersTransport.ProfileId,
+		req.Type,
+		"serversTransports",
+		result.ServersTransport.Name,
+	)
 	return result, nil
 }
 
@@ -85,6 +85,7 @@ func (s *HTTPServersTransportOps) Create(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.CreateServersTransportResponse{
 		ServersTransport: result.ToProto(),
 	}, nil
@@ -110,6 +111,7 @@ func (s *HTTPServersTransportOps) Update(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.UpdateServersTransportResponse{
 		ServersTransport: result.ToProto(),
 	}, nil
@@ -119,9 +121,14 @@ func (s *HTTPServersTransportOps) Delete(
 	ctx context.Context,
 	req *mantraev1.DeleteServersTransportRequest,
 ) (*mantraev1.DeleteServersTransportResponse, error) {
+	transport, err := s.app.Conn.Q.GetHttpServersTransport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.app.Conn.Q.DeleteHttpServersTransport(ctx, req.Id); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(transport.ProfileID)
 	return &mantraev1.DeleteServersTransportResponse{}, nil
 }
 
@@ -194,6 +201,7 @@ func (s *TCPServersTransportOps) Create(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.CreateServersTransportResponse{
 		ServersTransport: result.ToProto(),
 	}, nil
@@ -219,6 +227,7 @@ func (s *TCPServersTransportOps) Update(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.UpdateServersTransportResponse{
 		ServersTransport: result.ToProto(),
 	}, nil
@@ -228,9 +237,14 @@ func (s *TCPServersTransportOps) Delete(
 	ctx context.Context,
 	req *mantraev1.DeleteServersTransportRequest,
 ) (*mantraev1.DeleteServersTransportResponse, error) {
+	transport, err := s.app.Conn.Q.GetTcpServersTransport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.app.Conn.Q.DeleteTcpServersTransport(ctx, req.Id); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(transport.ProfileID)
 	return &mantraev1.DeleteServersTransportResponse{}, nil
 }
 
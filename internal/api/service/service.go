@@ -9,6 +9,7 @@ import (
 
 	"github.com/mizuchilabs/mantrae/internal/config"
 	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
 )
 
 type Service struct {
@@ -62,6 +63,14 @@ func (s *Service) CreateService(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	result.Issues = itemIssues(
+		ctx,
+		s.app,
+		result.Service.ProfileId,
+		req.Type,
+		"services",
+		result.Service.Name,
+	)
 	return result, nil
 }
 
@@ -81,6 +90,14 @@ func (s *Service) UpdateService(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	result.Issues = itemIssues(
+		ctx,
+		s.app,
+		result.Service.ProfileId,
+		req.Type,
+		"services",
+		result.Service.Name,
+	)
 	return result, nil
 }
 
@@ -154,3 +171,48 @@ func (s *Service) ListServices(
 		TotalCount: totalCount,
 	}, nil
 }
+
+func (s *Service) ListServiceHealth(
+	ctx context.Context,
+	req *mantraev1.ListServiceHealthRequest,
+) (*mantraev1.ListServiceHealthResponse, error) {
+	result, err := s.app.Conn.Q.ListLatestServiceHealthChecks(ctx, req.ProfileId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	servers := make([]*mantraev1.ServerHealth, 0, len(result))
+	for _, h := range result {
+		servers = append(servers, h.ToProto())
+	}
+	return &mantraev1.ListServiceHealthResponse{Servers: servers}, nil
+}
+
+func (s *Service) ListServiceHealthHistory(
+	ctx context.Context,
+	req *mantraev1.ListServiceHealthHistoryRequest,
+) (*mantraev1.ListServiceHealthHistoryResponse, error) {
+	if req.Type != mantraev1.ProtocolType_PROTOCOL_TYPE_HTTP &&
+		req.Type != mantraev1.ProtocolType_PROTOCOL_TYPE_TCP {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("health is only probed for HTTP and TCP services"),
+		)
+	}
+
+	result, err := s.app.Conn.Q.ListServiceHealthChecks(ctx, &db.ListServiceHealthChecksParams{
+		ServiceID: req.ServiceId,
+		Protocol:  protocolName(req.Type),
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	checks := make([]*mantraev1.ServerHealth, 0, len(result))
+	for _, h := range result {
+		checks = append(checks, h.ToProto())
+	}
+	return &mantraev1.ListServiceHealthHistoryResponse{Checks: checks}, nil
+}
@@ -111,6 +111,7 @@ func (s *HTTPServiceOps) Create(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.CreateServiceResponse{
 		Service: result.ToProto(),
 	}, nil
@@ -136,6 +137,7 @@ func (s *HTTPServiceOps) Update(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.UpdateServiceResponse{
 		Service: result.ToProto(),
 	}, nil
@@ -145,9 +147,14 @@ func (s *HTTPServiceOps) Delete(
 	ctx context.Context,
 	req *mantraev1.DeleteServiceRequest,
 ) (*mantraev1.DeleteServiceResponse, error) {
+	service, err := s.app.Conn.Q.GetHttpService(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.app.Conn.Q.DeleteHttpService(ctx, req.Id); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(service.ProfileID)
 	return &mantraev1.DeleteServiceResponse{}, nil
 }
 
@@ -236,6 +243,7 @@ func (s *TCPServiceOps) Create(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.CreateServiceResponse{
 		Service: result.ToProto(),
 	}, nil
@@ -261,6 +269,7 @@ func (s *TCPServiceOps) Update(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.UpdateServiceResponse{
 		Service: result.ToProto(),
 	}, nil
@@ -270,9 +279,14 @@ func (s *TCPServiceOps) Delete(
 	ctx context.Context,
 	req *mantraev1.DeleteServiceRequest,
 ) (*mantraev1.DeleteServiceResponse, error) {
+	service, err := s.app.Conn.Q.GetTcpService(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.app.Conn.Q.DeleteTcpService(ctx, req.Id); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(service.ProfileID)
 	return &mantraev1.DeleteServiceResponse{}, nil
 }
 
@@ -361,6 +375,7 @@ func (s *UDPServiceOps) Create(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.CreateServiceResponse{
 		Service: result.ToProto(),
 	}, nil
@@ -386,6 +401,7 @@ func (s *UDPServiceOps) Update(
 	if err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(result.ProfileID)
 	return &mantraev1.UpdateServiceResponse{
 		Service: result.ToProto(),
 	}, nil
@@ -395,9 +411,14 @@ func (s *UDPServiceOps) Delete(
 	ctx context.Context,
 	req *mantraev1.DeleteServiceRequest,
 ) (*mantraev1.DeleteServiceResponse, error) {
+	service, err := s.app.Conn.Q.GetUdpService(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.app.Conn.Q.DeleteUdpService(ctx, req.Id); err != nil {
 		return nil, err
 	}
+	s.app.Revisions.Bump(service.ProfileID)
 	return &mantraev1.DeleteServiceResponse{}, nil
 }
 
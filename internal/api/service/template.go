@@ -0,0 +1,338 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/mizuchilabs/mantrae/internal/config"
+	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/traefik"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+type TemplateService struct {
+	app *config.App
+}
+
+func NewTemplateService(app *config.App) *TemplateService {
+	return &TemplateService{app: app}
+}
+
+func (s *TemplateService) ListTemplates(
+	ctx context.Context,
+	req *mantraev1.ListTemplatesRequest,
+) (*mantraev1.ListTemplatesResponse, error) {
+	builtins, err := traefik.BuiltinTemplates()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	result, err := s.app.Conn.Q.ListConfigTemplates(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	templates := make([]*mantraev1.ConfigTemplate, 0, len(builtins)+len(result))
+	for _, t := range builtins {
+		templates = append(templates, templateToProto(&t, nil))
+	}
+	for _, t := range result {
+		templates = append(templates, templateToProto(templateFromDB(t), t))
+	}
+	return &mantraev1.ListTemplatesResponse{Templates: templates}, nil
+}
+
+func (s *TemplateService) GetTemplate(
+	ctx context.Context,
+	req *mantraev1.GetTemplateRequest,
+) (*mantraev1.GetTemplateResponse, error) {
+	if t, ok := traefik.BuiltinTemplate(req.Name); ok {
+		return &mantraev1.GetTemplateResponse{Template: templateToProto(t, nil)}, nil
+	}
+	result, err := s.app.Conn.Q.GetConfigTemplateByName(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.GetTemplateResponse{
+		Template: templateToProto(templateFromDB(result), result),
+	}, nil
+}
+
+func (s *TemplateService) CreateTemplate(
+	ctx context.Context,
+	req *mantraev1.CreateTemplateRequest,
+) (*mantraev1.CreateTemplateResponse, error) {
+	variables, err := checkTemplate(req.Name, req.Variables, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &db.CreateConfigTemplateParams{
+		ID:        uuid.New().String(),
+		Name:      req.Name,
+		Variables: &db.TemplateVariables{Data: &variables},
+		Content:   req.Content,
+	}
+	if req.Description != "" {
+		params.Description = &req.Description
+	}
+	result, err := s.app.Conn.Q.CreateConfigTemplate(ctx, params)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.CreateTemplateResponse{
+		Template: templateToProto(templateFromDB(result), result),
+	}, nil
+}
+
+func (s *TemplateService) UpdateTemplate(
+	ctx context.Context,
+	req *mantraev1.UpdateTemplateRequest,
+) (*mantraev1.UpdateTemplateResponse, error) {
+	variables, err := checkTemplate(req.Name, req.Variables, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &db.UpdateConfigTemplateParams{
+		ID:        req.Id,
+		Name:      req.Name,
+		Variables: &db.TemplateVariables{Data: &variables},
+		Content:   req.Content,
+	}
+	if req.Description != "" {
+		params.Description = &req.Description
+	}
+	result, err := s.app.Conn.Q.UpdateConfigTemplate(ctx, params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.UpdateTemplateResponse{
+		Template: templateToProto(templateFromDB(result), result),
+	}, nil
+}
+
+func (s *TemplateService) DeleteTemplate(
+	ctx context.Context,
+	req *mantraev1.DeleteTemplateRequest,
+) (*mantraev1.DeleteTemplateResponse, error) {
+	if err := s.app.Conn.Q.DeleteConfigTemplate(ctx, req.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.DeleteTemplateResponse{}, nil
+}
+
+func (s *TemplateService) PreviewTemplate(
+	ctx context.Context,
+	req *mantraev1.PreviewTemplateRequest,
+) (*mantraev1.PreviewTemplateResponse, error) {
+	content, cfg, err := s.render(ctx, req.ProfileId, req.Name, req.Variables)
+	if err != nil {
+		return nil, err
+	}
+	items, err := traefik.PlanImport(ctx, s.app.Conn.Q, req.ProfileId, cfg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.PreviewTemplateResponse{
+		Content: content,
+		Items:   importItemsToProto(items),
+	}, nil
+}
+
+func (s *TemplateService) InstantiateTemplate(
+	ctx context.Context,
+	req *mantraev1.InstantiateTemplateRequest,
+) (*mantraev1.InstantiateTemplateResponse, error) {
+	_, cfg, err := s.render(ctx, req.ProfileId, req.Name, req.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := importStrategy(req.Strategy)
+	var items []traefik.ImportItem
+	if err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		items, err = traefik.ApplyImport(ctx, q, req.ProfileId, cfg, strategy)
+		if err != nil {
+			return err
+		}
+		return linkDNSProviders(ctx, q, req.ProfileId, items, strategy, req.DnsProviderIds)
+	}); err != nil {
+		return nil, importError(err)
+	}
+
+	updateDNS(ctx, s.app, req.ProfileId)
+	s.app.Revisions.Bump(req.ProfileId)
+	return &mantraev1.InstantiateTemplateResponse{Items: importItemsToProto(items)}, nil
+}
+
+// render looks up a built-in or stored template by name and renders it.
+func (s *TemplateService) render(
+	ctx context.Context,
+	profileID int64,
+	name string,
+	values map[string]string,
+) (string, *dynamic.Configuration, error) {
+	if _, err := s.app.Conn.Q.GetProfile(ctx, profileID); err != nil {
+		return "", nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	t, ok := traefik.BuiltinTemplate(name)
+	if !ok {
+		result, err := s.app.Conn.Q.GetConfigTemplateByName(ctx, name)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return "", nil, connect.NewError(connect.CodeNotFound, err)
+			}
+			return "", nil, connect.NewError(connect.CodeInternal, err)
+		}
+		t = templateFromDB(result)
+	}
+
+	content, cfg, err := t.Render(values)
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return content, cfg, nil
+}
+
+// linkDNSProviders links the routers created or overwritten by a template to
+// the given DNS providers, or to the default one like newly created routers.
+func linkDNSProviders(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	items []traefik.ImportItem,
+	strategy traefik.ImportStrategy,
+	providerIDs []string,
+) error {
+	if len(providerIDs) == 0 {
+		provider, err := q.GetDefaultDNSProvider(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		providerIDs = []string{provider.ID}
+	}
+
+	for _, item := range items {
+		if item.Type != "router" || item.Status == traefik.ImportIdentical ||
+			item.Status == traefik.ImportConflict && strategy == traefik.ImportSkip {
+			continue
+		}
+		name := cmp.Or(item.NewName, item.Name)
+		for _, providerID := range providerIDs {
+			switch item.Protocol {
+			case "http":
+				router, err := q.GetHttpRouterByName(ctx, &db.GetHttpRouterByNameParams{
+					ProfileID: profileID,
+					Name:      name,
+				})
+				if err != nil {
+					return err
+				}
+				if err = q.CreateHttpRouterDNSProvider(ctx, &db.CreateHttpRouterDNSProviderParams{
+					HttpRouterID:  router.ID,
+					DnsProviderID: providerID,
+				}); err != nil {
+					return err
+				}
+			case "tcp":
+				router, err := q.GetTcpRouterByName(ctx, &db.GetTcpRouterByNameParams{
+					ProfileID: profileID,
+					Name:      name,
+				})
+				if err != nil {
+					return err
+				}
+				if err = q.CreateTcpRouterDNSProvider(ctx, &db.CreateTcpRouterDNSProviderParams{
+					TcpRouterID:   router.ID,
+					DnsProviderID: providerID,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkTemplate validates a stored template, whose name must not shadow a
+// built-in one.
+func checkTemplate(
+	name string,
+	variables []*mantraev1.TemplateVariable,
+	content string,
+) ([]db.TemplateVariable, error) {
+	if _, ok := traefik.BuiltinTemplate(name); ok {
+		return nil, connect.NewError(
+			connect.CodeAlreadyExists,
+			fmt.Errorf("template %q is built in", name),
+		)
+	}
+	t := &traefik.Template{Name: name, Content: content}
+	for _, v := range variables {
+		t.Variables = append(t.Variables, db.TemplateVariable{
+			Name:        v.Name,
+			Description: v.Description,
+			Default:     v.DefaultValue,
+			Required:    v.Required,
+		})
+	}
+	if err := t.Check(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if t.Variables == nil {
+		t.Variables = []db.TemplateVariable{}
+	}
+	return t.Variables, nil
+}
+
+func templateFromDB(t *db.ConfigTemplate) *traefik.Template {
+	result := &traefik.Template{Name: t.Name, Content: t.Content}
+	if t.Description != nil {
+		result.Description = *t.Description
+	}
+	if t.Variables != nil && t.Variables.Data != nil {
+		result.Variables = *t.Variables.Data
+	}
+	return result
+}
+
+// templateToProto converts a template, with the row it is stored in unless it
+// is built in.
+func templateToProto(t *traefik.Template, row *db.ConfigTemplate) *mantraev1.ConfigTemplate {
+	result := &mantraev1.ConfigTemplate{
+		Name:        t.Name,
+		Description: t.Description,
+		Content:     t.Content,
+		Builtin:     t.Builtin,
+	}
+	for _, v := range t.Variables {
+		result.Variables = append(result.Variables, &mantraev1.TemplateVariable{
+			Name:         v.Name,
+			Description:  v.Description,
+			DefaultValue: v.Default,
+			Required:     v.Required,
+		})
+	}
+	if row != nil {
+		result.Id = row.ID
+		result.CreatedAt = db.SafeTimestamp(row.CreatedAt)
+		result.UpdatedAt = db.SafeTimestamp(row.UpdatedAt)
+	}
+	return result
+}
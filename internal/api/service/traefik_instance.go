@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"github.com/mizuchilabs/mantrae/internal/config"
+	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+)
+
+type TraefikInstanceService struct {
+	app *config.App
+}
+
+func NewTraefikInstanceService(app *config.App) *TraefikInstanceService {
+	return &TraefikInstanceService{app: app}
+}
+
+func (s *TraefikInstanceService) ListTraefikInstances(
+	ctx context.Context,
+	req *mantraev1.ListTraefikInstancesRequest,
+) (*mantraev1.ListTraefikInstancesResponse, error) {
+	result, err := s.app.Conn.Q.ListTraefikInstances(ctx, req.ProfileId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	instances := make([]*mantraev1.TraefikInstance, 0, len(result))
+	for _, i := range result {
+		instances = append(instances, i.ToProto())
+	}
+	return &mantraev1.ListTraefikInstancesResponse{Instances: instances}, nil
+}
+
+func (s *TraefikInstanceService) DeleteTraefikInstance(
+	ctx context.Context,
+	req *mantraev1.DeleteTraefikInstanceRequest,
+) (*mantraev1.DeleteTraefikInstanceResponse, error) {
+	if _, err := s.app.Conn.Q.GetTraefikInstance(ctx, req.Id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := s.app.Conn.Q.DeleteTraefikInstance(ctx, req.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.DeleteTraefikInstanceResponse{}, nil
+}
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"crypto/subtle"
+	"database/sql"
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -16,6 +19,8 @@ import (
 	"github.com/mizuchilabs/mantrae/internal/settings"
 	"github.com/mizuchilabs/mantrae/internal/store/db"
 	"github.com/mizuchilabs/mantrae/internal/util"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
 )
 
 type UserService struct {
@@ -53,27 +58,68 @@ func (s *UserService) LoginUser(
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid password"))
 	}
 
-	expirationTime := time.Now().Add(24 * time.Hour)
-	token, err := meta.EncodeUserToken(user.ID, s.app.Secret, expirationTime)
+	// Users with two-factor authentication get a challenge instead of a session
+	if user.TotpEnabled {
+		challenge, err := meta.EncodeChallengeToken(
+			user.ID,
+			s.app.Secret,
+			time.Now().Add(challengeLifetime),
+		)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return &mantraev1.LoginUserResponse{
+			TwoFactorRequired: true,
+			ChallengeToken:    challenge,
+		}, nil
+	}
+
+	token, err := s.startSession(ctx, ci, user.ID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
+	required, _ := s.app.SM.Get(ctx, settings.KeyTwoFactorRequired)
+	return &mantraev1.LoginUserResponse{
+		Token:                  token,
+		TwoFactorSetupRequired: settings.AsBool(required),
+	}, nil
+}
 
-	if err := s.app.Conn.Q.UpdateUserLastLogin(ctx, user.ID); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+func (s *UserService) VerifyTwoFactorLogin(
+	ctx context.Context,
+	req *mantraev1.VerifyTwoFactorLoginRequest,
+) (*mantraev1.VerifyTwoFactorLoginResponse, error) {
+	ci, ok := connect.CallInfoForHandlerContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to get call info"))
 	}
 
-	cookie := http.Cookie{
-		Name:     meta.CookieName,
-		Value:    token,
-		Path:     "/",
-		HttpOnly: true,
-		MaxAge:   int(expirationTime.Unix() - time.Now().Unix()),
-		Secure:   ci.RequestHeader().Get("X-Forwarded-Proto") == "https",
-		SameSite: http.SameSiteLaxMode,
+	userID, err := meta.DecodeChallengeToken(req.ChallengeToken, s.app.Secret)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("invalid or expired challenge"),
+		)
 	}
-	ci.ResponseHeader().Set("Set-Cookie", cookie.String())
-	return &mantraev1.LoginUserResponse{Token: token}, nil
+	user, err := s.app.Conn.Q.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthorized"))
+	}
+	if !user.TotpEnabled {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("two-factor authentication is not enabled"),
+		)
+	}
+	if err = s.verifyTwoFactorCode(ctx, user, req.Code); err != nil {
+		return nil, err
+	}
+
+	token, err := s.startSession(ctx, ci, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &mantraev1.VerifyTwoFactorLoginResponse{Token: token}, nil
 }
 
 func (s *UserService) LogoutUser(
@@ -84,6 +130,11 @@ func (s *UserService) LogoutUser(
 	if !ok {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to get call info"))
 	}
+	if sessionID := middlewares.GetSessionIDFromContext(ctx); sessionID != nil {
+		if err := s.app.Conn.Q.DeleteSession(ctx, *sessionID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
 
 	cookie := http.Cookie{
 		Name:     meta.CookieName,
@@ -133,6 +184,10 @@ func (s *UserService) CreateUser(
 		ID:       uuid.NewString(),
 		Username: req.Username,
 		Email:    req.Email,
+		Role:     meta.RoleViewer,
+	}
+	if req.Role != mantraev1.Role_ROLE_UNSPECIFIED {
+		params.Role = roleName(req.Role)
 	}
 
 	var err error
@@ -152,6 +207,16 @@ func (s *UserService) UpdateUser(
 	ctx context.Context,
 	req *mantraev1.UpdateUserRequest,
 ) (*mantraev1.UpdateUserResponse, error) {
+	// Only admins may edit other users
+	userID := middlewares.GetUserIDFromContext(ctx)
+	if !middlewares.HasRole(middlewares.GetRoleFromContext(ctx), meta.RoleAdmin) &&
+		(userID == nil || *userID != req.Id) {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("only admins can update other users"),
+		)
+	}
+
 	params := &db.UpdateUserParams{
 		ID:       req.Id,
 		Username: req.Username,
@@ -169,10 +234,24 @@ func (s *UserService) UpdateUser(
 			return nil, connect.NewError(connect.CodeInternal, err)
 		}
 
-		if err := s.app.Conn.Q.UpdateUserPassword(ctx, &db.UpdateUserPasswordParams{
-			ID:       result.ID,
-			Password: hash,
-		}); err != nil {
+		// Sign out everywhere else, users keep the session they changed it from
+		err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+			if err := q.UpdateUserPassword(ctx, &db.UpdateUserPasswordParams{
+				ID:       result.ID,
+				Password: hash,
+			}); err != nil {
+				return err
+			}
+			sessionID := middlewares.GetSessionIDFromContext(ctx)
+			if sessionID == nil || userID == nil || *userID != result.ID {
+				return q.DeleteSessionsByUser(ctx, result.ID)
+			}
+			return q.DeleteOtherSessionsByUser(ctx, &db.DeleteOtherSessionsByUserParams{
+				UserID: result.ID,
+				ID:     *sessionID,
+			})
+		})
+		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, err)
 		}
 	}
@@ -183,12 +262,42 @@ func (s *UserService) DeleteUser(
 	ctx context.Context,
 	req *mantraev1.DeleteUserRequest,
 ) (*mantraev1.DeleteUserResponse, error) {
-	if err := s.app.Conn.Q.DeleteUser(ctx, req.Id); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	err := s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if err := checkLastAdmin(ctx, q, req.Id, ""); err != nil {
+			return err
+		}
+		return q.DeleteUser(ctx, req.Id)
+	})
+	if err != nil {
+		return nil, userError(err)
 	}
 	return &mantraev1.DeleteUserResponse{}, nil
 }
 
+func (s *UserService) UpdateUserRole(
+	ctx context.Context,
+	req *mantraev1.UpdateUserRoleRequest,
+) (*mantraev1.UpdateUserRoleResponse, error) {
+	if req.Role == mantraev1.Role_ROLE_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("role must be set"))
+	}
+
+	var result *db.User
+	err := s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		role := roleName(req.Role)
+		if err := checkLastAdmin(ctx, q, req.Id, role); err != nil {
+			return err
+		}
+		var err error
+		result, err = q.UpdateUserRole(ctx, &db.UpdateUserRoleParams{ID: req.Id, Role: role})
+		return err
+	})
+	if err != nil {
+		return nil, userError(err)
+	}
+	return &mantraev1.UpdateUserRoleResponse{User: result.ToProto()}, nil
+}
+
 func (s *UserService) ListUsers(
 	ctx context.Context,
 	req *mantraev1.ListUsersRequest,
@@ -217,6 +326,332 @@ func (s *UserService) ListUsers(
 	}, nil
 }
 
+func (s *UserService) CreateAPIToken(
+	ctx context.Context,
+	req *mantraev1.CreateAPITokenRequest,
+) (*mantraev1.CreateAPITokenResponse, error) {
+	userID := middlewares.GetUserIDFromContext(ctx)
+	if userID == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	// Tokens can't grant more than the caller has
+	callerRole := middlewares.GetRoleFromContext(ctx)
+	role := callerRole
+	if req.Role != mantraev1.Role_ROLE_UNSPECIFIED {
+		role = roleName(req.Role)
+	}
+	if !middlewares.HasRole(callerRole, role) {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("token role exceeds your own role"),
+		)
+	}
+
+	params := &db.CreateApiTokenParams{
+		ID:     uuid.NewString(),
+		UserID: *userID,
+		Name:   req.Name,
+		Role:   role,
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		if expiresAt.Before(time.Now()) {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("expiry must be in the future"),
+			)
+		}
+		params.ExpiresAt = &expiresAt
+	}
+
+	token := meta.APITokenPrefix + util.GenerateToken(32)
+	params.TokenHash = util.HashToken(token)
+	result, err := s.app.Conn.Q.CreateApiToken(ctx, params)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.CreateAPITokenResponse{ApiToken: result.ToProto(), Token: token}, nil
+}
+
+func (s *UserService) ListAPITokens(
+	ctx context.Context,
+	req *mantraev1.ListAPITokensRequest,
+) (*mantraev1.ListAPITokensResponse, error) {
+	userID := middlewares.GetUserIDFromContext(ctx)
+	if userID == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	result, err := s.app.Conn.Q.ListApiTokensByUser(ctx, *userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	tokens := make([]*mantraev1.APIToken, 0, len(result))
+	for _, t := range result {
+		tokens = append(tokens, t.ToProto())
+	}
+	return &mantraev1.ListAPITokensResponse{ApiTokens: tokens}, nil
+}
+
+func (s *UserService) DeleteAPIToken(
+	ctx context.Context,
+	req *mantraev1.DeleteAPITokenRequest,
+) (*mantraev1.DeleteAPITokenResponse, error) {
+	apiToken, err := s.app.Conn.Q.GetApiToken(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Only admins may revoke the tokens of other users
+	userID := middlewares.GetUserIDFromContext(ctx)
+	if !middlewares.HasRole(middlewares.GetRoleFromContext(ctx), meta.RoleAdmin) &&
+		(userID == nil || *userID != apiToken.UserID) {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("only admins can revoke tokens of other users"),
+		)
+	}
+
+	if err = s.app.Conn.Q.DeleteApiToken(ctx, req.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.DeleteAPITokenResponse{}, nil
+}
+
+func (s *UserService) ListSessions(
+	ctx context.Context,
+	req *mantraev1.ListSessionsRequest,
+) (*mantraev1.ListSessionsResponse, error) {
+	userID, err := sessionOwner(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.app.Conn.Q.ListSessionsByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	currentID := middlewares.GetSessionIDFromContext(ctx)
+	sessions := make([]*mantraev1.Session, 0, len(result))
+	for _, session := range result {
+		if session.ExpiresAt.Before(time.Now()) {
+			continue
+		}
+		sessionProto := session.ToProto()
+		sessionProto.Current = currentID != nil && *currentID == session.ID
+		sessions = append(sessions, sessionProto)
+	}
+	return &mantraev1.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+func (s *UserService) RevokeSession(
+	ctx context.Context,
+	req *mantraev1.RevokeSessionRequest,
+) (*mantraev1.RevokeSessionResponse, error) {
+	session, err := s.app.Conn.Q.GetSession(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err = sessionOwner(ctx, &session.UserID); err != nil {
+		return nil, err
+	}
+
+	if err = s.app.Conn.Q.DeleteSession(ctx, req.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.RevokeSessionResponse{}, nil
+}
+
+func (s *UserService) RevokeAllSessions(
+	ctx context.Context,
+	req *mantraev1.RevokeAllSessionsRequest,
+) (*mantraev1.RevokeAllSessionsResponse, error) {
+	userID, err := sessionOwner(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.app.Conn.Q.DeleteSessionsByUser(ctx, userID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.RevokeAllSessionsResponse{}, nil
+}
+
+func (s *UserService) SetupTwoFactor(
+	ctx context.Context,
+	req *mantraev1.SetupTwoFactorRequest,
+) (*mantraev1.SetupTwoFactorResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.TotpEnabled {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("two-factor authentication is already enabled"),
+		)
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      totpIssuer,
+		AccountName: user.Username,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	encrypted, err := util.EncryptSecret(key.Secret(), s.app.Secret)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// The secret stays inactive until a code from it has been confirmed
+	if err = s.app.Conn.Q.UpdateUserTotp(ctx, &db.UpdateUserTotpParams{
+		TotpSecret:  &encrypted,
+		TotpEnabled: false,
+		ID:          user.ID,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.SetupTwoFactorResponse{
+		Secret:     key.Secret(),
+		OtpauthUri: key.URL(),
+	}, nil
+}
+
+func (s *UserService) EnableTwoFactor(
+	ctx context.Context,
+	req *mantraev1.EnableTwoFactorRequest,
+) (*mantraev1.EnableTwoFactorResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.TotpEnabled {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("two-factor authentication is already enabled"),
+		)
+	}
+	if user.TotpSecret == nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("two-factor authentication has not been set up"),
+		)
+	}
+
+	secret, err := util.DecryptSecret(*user.TotpSecret, s.app.Secret)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	step, ok := totpStep(strings.TrimSpace(req.Code), secret, time.Now())
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errInvalidTwoFactorCode)
+	}
+
+	var codes []string
+	err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.UpdateUserTotp(ctx, &db.UpdateUserTotpParams{
+			TotpSecret:  user.TotpSecret,
+			TotpEnabled: true,
+			ID:          user.ID,
+		}); err != nil {
+			return err
+		}
+		// The confirmation code can't be used to log in again
+		if _, err := q.UpdateUserTotpStep(ctx, &db.UpdateUserTotpStepParams{
+			Step: step,
+			ID:   user.ID,
+		}); err != nil {
+			return err
+		}
+		var err error
+		codes, err = createRecoveryCodes(ctx, q, user.ID)
+		return err
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.EnableTwoFactorResponse{RecoveryCodes: codes}, nil
+}
+
+func (s *UserService) DisableTwoFactor(
+	ctx context.Context,
+	req *mantraev1.DisableTwoFactorRequest,
+) (*mantraev1.DisableTwoFactorResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users confirm with a code, admins may reset users that lost their device
+	if req.UserId != nil && *req.UserId != user.ID {
+		if !middlewares.HasRole(middlewares.GetRoleFromContext(ctx), meta.RoleAdmin) {
+			return nil, connect.NewError(
+				connect.CodePermissionDenied,
+				errors.New("only admins can disable two-factor authentication of other users"),
+			)
+		}
+		user, err = s.app.Conn.Q.GetUserByID(ctx, *req.UserId)
+		if err != nil {
+			return nil, userError(err)
+		}
+	} else if user.TotpEnabled {
+		if err = s.verifyTwoFactorCode(ctx, user, req.GetCode()); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.UpdateUserTotp(ctx, &db.UpdateUserTotpParams{ID: user.ID}); err != nil {
+			return err
+		}
+		return q.DeleteRecoveryCodesByUser(ctx, user.ID)
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.DisableTwoFactorResponse{}, nil
+}
+
+func (s *UserService) RegenerateRecoveryCodes(
+	ctx context.Context,
+	req *mantraev1.RegenerateRecoveryCodesRequest,
+) (*mantraev1.RegenerateRecoveryCodesResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !user.TotpEnabled {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("two-factor authentication is not enabled"),
+		)
+	}
+	if err = s.verifyTwoFactorCode(ctx, user, req.Code); err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err = s.app.Conn.WithTx(ctx, func(q *db.Queries) error {
+		var err error
+		codes, err = createRecoveryCodes(ctx, q, user.ID)
+		return err
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &mantraev1.RegenerateRecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
 func (s *UserService) GetOIDCStatus(
 	ctx context.Context,
 	req *mantraev1.GetOIDCStatusRequest,
@@ -235,3 +670,239 @@ func (s *UserService) GetOIDCStatus(
 		Provider:     sets[settings.KeyOIDCProviderName],
 	}, nil
 }
+
+// errLastAdmin is returned when a change would leave no admin behind.
+var errLastAdmin = errors.New("at least one admin must remain")
+
+// checkLastAdmin fails if the user is the last admin and would lose the role,
+// either by being deleted (empty role) or by getting a lower one.
+func checkLastAdmin(ctx context.Context, q *db.Queries, userID, role string) error {
+	user, err := q.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Role != meta.RoleAdmin || role == meta.RoleAdmin {
+		return nil
+	}
+	admins, err := q.CountUsersByRole(ctx, meta.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if admins <= 1 {
+		return errLastAdmin
+	}
+	return nil
+}
+
+func userError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, errLastAdmin):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// roleName returns the stored name of a role, e.g. "editor".
+func roleName(role mantraev1.Role) string {
+	return strings.ToLower(strings.TrimPrefix(role.String(), "ROLE_"))
+}
+
+// sessionOwner returns the user whose sessions a request manages, the caller
+// unless another user is given. Only admins may manage other users' sessions.
+func sessionOwner(ctx context.Context, requested *string) (string, error) {
+	userID := middlewares.GetUserIDFromContext(ctx)
+	if userID == nil {
+		return "", connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+	if requested == nil || *requested == *userID {
+		return *userID, nil
+	}
+	if !middlewares.HasRole(middlewares.GetRoleFromContext(ctx), meta.RoleAdmin) {
+		return "", connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("only admins can manage sessions of other users"),
+		)
+	}
+	return *requested, nil
+}
+
+// startSession creates a session for a user that passed the login and sets
+// its cookie.
+func (s *UserService) startSession(
+	ctx context.Context,
+	ci connect.CallInfo,
+	userID string,
+) (string, error) {
+	token, expirationTime, err := s.app.CreateSession(
+		ctx,
+		userID,
+		ci.RequestHeader().Get("User-Agent"),
+		util.ClientIP(ci.RequestHeader(), ci.Peer().Addr),
+	)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.app.Conn.Q.UpdateUserLastLogin(ctx, userID); err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+
+	cookie := http.Cookie{
+		Name:     meta.CookieName,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		MaxAge:   int(expirationTime.Unix() - time.Now().Unix()),
+		Secure:   ci.RequestHeader().Get("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	}
+	ci.ResponseHeader().Set("Set-Cookie", cookie.String())
+	return token, nil
+}
+
+// currentUser returns the authenticated user of a request.
+func (s *UserService) currentUser(ctx context.Context) (*db.User, error) {
+	userID := middlewares.GetUserIDFromContext(ctx)
+	if userID == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+	user, err := s.app.Conn.Q.GetUserByID(ctx, *userID)
+	if err != nil {
+		return nil, userError(err)
+	}
+	return user, nil
+}
+
+const (
+	totpIssuer = "Mantrae"
+
+	// challengeLifetime limits how long the second login step may take
+	challengeLifetime = 5 * time.Minute
+	// maxTwoFactorAttempts wrong codes in a row lock the second factor of a
+	// user for twoFactorLockout
+	maxTwoFactorAttempts = 5
+	twoFactorLockout     = 15 * time.Minute
+	// totpPeriod is the time step of the codes from authenticator apps
+	totpPeriod = 30
+
+	recoveryCodeCount = 10
+)
+
+var errInvalidTwoFactorCode = errors.New("invalid two-factor code")
+
+// verifyTwoFactorCode checks a code from the authenticator app of a user or
+// one of the user's recovery codes, which is used up. Each authenticator code
+// is only accepted once, and too many wrong codes lock the second factor for
+// a while.
+func (s *UserService) verifyTwoFactorCode(ctx context.Context, user *db.User, code string) error {
+	if user.TotpSecret == nil {
+		return connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("two-factor authentication is not enabled"),
+		)
+	}
+	if user.TotpLockedUntil != nil && user.TotpLockedUntil.After(time.Now()) {
+		return connect.NewError(
+			connect.CodeResourceExhausted,
+			errors.New("too many invalid two-factor codes, try again later"),
+		)
+	}
+	secret, err := util.DecryptSecret(*user.TotpSecret, s.app.Secret)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	if step, ok := totpStep(strings.TrimSpace(code), secret, time.Now()); ok {
+		accepted, err := s.app.Conn.Q.UpdateUserTotpStep(ctx, &db.UpdateUserTotpStepParams{
+			Step: step,
+			ID:   user.ID,
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if accepted == 0 {
+			return s.failTwoFactor(ctx, user.ID)
+		}
+		return nil
+	}
+
+	used, err := s.app.Conn.Q.DeleteRecoveryCode(ctx, &db.DeleteRecoveryCodeParams{
+		UserID:   user.ID,
+		CodeHash: util.HashToken(normalizeRecoveryCode(code)),
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if used == 0 {
+		return s.failTwoFactor(ctx, user.ID)
+	}
+	if err = s.app.Conn.Q.ResetUserTotpFailures(ctx, user.ID); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// failTwoFactor counts a wrong or reused code and locks the second factor of
+// the user after maxTwoFactorAttempts in a row.
+func (s *UserService) failTwoFactor(ctx context.Context, userID string) error {
+	attempts, err := s.app.Conn.Q.IncrementUserTotpFailures(ctx, userID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if attempts >= maxTwoFactorAttempts {
+		lockedUntil := time.Now().UTC().Add(twoFactorLockout)
+		if err = s.app.Conn.Q.LockUserTotp(ctx, &db.LockUserTotpParams{
+			TotpLockedUntil: &lockedUntil,
+			ID:              userID,
+		}); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return connect.NewError(connect.CodeUnauthenticated, errInvalidTwoFactorCode)
+}
+
+// totpStep returns the time step of a code from an authenticator app,
+// allowing one step of clock skew either way like totp.Validate.
+func totpStep(code, secret string, now time.Time) (int64, bool) {
+	current := now.Unix() / totpPeriod
+	for _, step := range []int64{current - 1, current, current + 1} {
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix(step*totpPeriod, 0), totp.ValidateOpts{
+			Period:    totpPeriod,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err == nil && subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// createRecoveryCodes replaces the recovery codes of a user, only their
+// hashes are stored.
+func createRecoveryCodes(ctx context.Context, q *db.Queries, userID string) ([]string, error) {
+	if err := q.DeleteRecoveryCodesByUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	codes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		code := util.GenerateToken(5)
+		if err := q.CreateRecoveryCode(ctx, &db.CreateRecoveryCodeParams{
+			UserID:   userID,
+			CodeHash: util.HashToken(code),
+		}); err != nil {
+			return nil, err
+		}
+		codes = append(codes, code[:4]+"-"+code[4:])
+	}
+	return codes, nil
+}
+
+// normalizeRecoveryCode strips the formatting of a recovery code.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.ReplaceAll(code, "-", "")
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/mizuchilabs/mantrae/internal/meta"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/util"
+	"github.com/pquerna/otp/totp"
+)
+
+func TestVerifyTwoFactorCode(t *testing.T) {
+	const secret = "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+
+	// Codes are built from the authenticator code and the recovery codes
+	// when the test runs
+	const (
+		current = "current"
+		wrong   = "wrong"
+	)
+	tests := []struct {
+		name  string
+		codes []string // authenticator code, wrong code or recovery code index
+		want  []connect.Code
+	}{
+		{
+			name:  "authenticator code",
+			codes: []string{current},
+			want:  []connect.Code{0},
+		},
+		{
+			name:  "reused step",
+			codes: []string{current, current},
+			want:  []connect.Code{0, connect.CodeUnauthenticated},
+		},
+		{
+			name:  "lockout",
+			codes: []string{wrong, wrong, wrong, wrong, wrong, current},
+			want: []connect.Code{
+				connect.CodeUnauthenticated,
+				connect.CodeUnauthenticated,
+				connect.CodeUnauthenticated,
+				connect.CodeUnauthenticated,
+				connect.CodeUnauthenticated,
+				connect.CodeResourceExhausted,
+			},
+		},
+		{
+			name:  "recovery code used once",
+			codes: []string{"0", "0", "1"},
+			want:  []connect.Code{0, connect.CodeUnauthenticated, 0},
+		},
+		{
+			name:  "recovery code resets failures",
+			codes: []string{wrong, wrong, wrong, wrong, "0", wrong, current},
+			want: []connect.Code{
+				connect.CodeUnauthenticated,
+				connect.CodeUnauthenticated,
+				connect.CodeUnauthenticated,
+				connect.CodeUnauthenticated,
+				0,
+				connect.CodeUnauthenticated,
+				0,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			app := testApp(t)
+			s := NewUserService(app)
+
+			user, err := app.Conn.Q.CreateUser(ctx, &db.CreateUserParams{
+				ID:       "user",
+				Username: "user",
+				Password: "password",
+				Role:     meta.RoleViewer,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			encrypted, err := util.EncryptSecret(secret, app.Secret)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = app.Conn.Q.UpdateUserTotp(ctx, &db.UpdateUserTotpParams{
+				TotpSecret:  &encrypted,
+				TotpEnabled: true,
+				ID:          user.ID,
+			}); err != nil {
+				t.Fatal(err)
+			}
+			recovery, err := createRecoveryCodes(ctx, app.Conn.Q, user.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for n, code := range tt.codes {
+				switch code {
+				case current:
+					if code, err = totp.GenerateCode(secret, time.Now()); err != nil {
+						t.Fatal(err)
+					}
+				case wrong:
+					code = "abcdef"
+				default:
+					code = recovery[code[0]-'0']
+				}
+
+				// The lockout is read from the user
+				if user, err = app.Conn.Q.GetUserByID(ctx, user.ID); err != nil {
+					t.Fatal(err)
+				}
+				err = s.verifyTwoFactorCode(ctx, user, code)
+				switch {
+				case tt.want[n] == 0 && err != nil:
+					t.Errorf("code %d: err = %v, want nil", n, err)
+				case tt.want[n] != 0 && connect.CodeOf(err) != tt.want[n]:
+					t.Errorf("code %d: err = %v, want code %v", n, err, tt.want[n])
+				}
+			}
+		})
+	}
+}
@@ -3,12 +3,12 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"connectrpc.com/connect"
 
 	"github.com/mizuchilabs/mantrae/internal/config"
 	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
-	"github.com/mizuchilabs/mantrae/internal/traefik"
 	"github.com/mizuchilabs/mantrae/internal/util"
 )
 
@@ -36,16 +36,28 @@ func (s *UtilService) GetDynamicConfig(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	cfg, err := traefik.BuildDynamicConfig(ctx, s.app.Conn.Q, *profile)
+	cached, err := s.app.Configs.Get(ctx, s.app.Conn.Q, *profile)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	jsonBytes, err := json.Marshal(cfg)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	switch req.Format {
+	case "yaml":
+		return &mantraev1.GetDynamicConfigResponse{Config: string(cached.YAML)}, nil
+	case "toml":
+		return &mantraev1.GetDynamicConfigResponse{Config: string(cached.TOML)}, nil
+	case "", "json":
+		jsonBytes, err := json.Marshal(cached.Config)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return &mantraev1.GetDynamicConfigResponse{Config: string(jsonBytes)}, nil
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("unsupported format %q", req.Format),
+		)
 	}
-	return &mantraev1.GetDynamicConfigResponse{Config: string(jsonBytes)}, nil
 }
 
 func (s *UtilService) GetPublicIP(
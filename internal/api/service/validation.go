@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/mizuchilabs/mantrae/internal/config"
+	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/traefik"
+)
+
+// validateProfile checks the references of the profile's current (draft)
+// configuration.
+func validateProfile(
+	ctx context.Context,
+	app *config.App,
+	profileID int64,
+) ([]traefik.Issue, error) {
+	profile, err := app.Conn.Q.GetProfile(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	cached, err := app.Configs.Get(ctx, app.Conn.Q, *profile)
+	if err != nil {
+		return nil, err
+	}
+	return traefik.ValidateProfile(ctx, app.Conn.Q, profileID, cached.Config)
+}
+
+// itemIssues returns the validation issues of an item and of the items
+// referring to it. The write that triggered the validation already succeeded,
+// so failures are only logged.
+func itemIssues(
+	ctx context.Context,
+	app *config.App,
+	profileID int64,
+	protocol mantraev1.ProtocolType,
+	kind, name string,
+) []*mantraev1.ValidationIssue {
+	issues, err := validateProfile(ctx, app, profileID)
+	if err != nil {
+		slog.Error("failed to validate profile", "profile", profileID, "error", err)
+		return nil
+	}
+
+	path := protocolName(protocol) + "." + kind + "." + name
+	var result []traefik.Issue
+	for _, issue := range issues {
+		if issue.Path == path || issue.Reference == path {
+			result = append(result, issue)
+		}
+	}
+	return issuesToProto(result)
+}
+
+// protocolName returns the configuration key of a protocol, e.g. "http".
+func protocolName(protocol mantraev1.ProtocolType) string {
+	return strings.ToLower(strings.TrimPrefix(protocol.String(), "PROTOCOL_TYPE_"))
+}
+
+func issuesToProto(issues []traefik.Issue) []*mantraev1.ValidationIssue {
+	result := make([]*mantraev1.ValidationIssue, 0, len(issues))
+	for _, issue := range issues {
+		severity := mantraev1.Severity_SEVERITY_ERROR
+		if issue.Severity == traefik.SeverityWarning {
+			severity = mantraev1.Severity_SEVERITY_WARNING
+		}
+		result = append(result, &mantraev1.ValidationIssue{
+			Severity:  severity,
+			Path:      issue.Path,
+			Reference: issue.Reference,
+			Message:   issue.Message,
+		})
+	}
+	return result
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/mizuchilabs/mantrae/internal/settings"
 	"github.com/mizuchilabs/mantrae/internal/storage"
 	"github.com/mizuchilabs/mantrae/internal/store"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
 	"github.com/mizuchilabs/mantrae/internal/traefik"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	"gopkg.in/yaml.v3"
@@ -216,29 +217,33 @@ func (m *BackupManager) Restore(ctx context.Context, backupName string) error {
 	return nil
 }
 
+// RestoreViaConfig imports a dynamic config backup into a profile in a single
+// transaction, resolving conflicts with the given strategy, and returns the
+// applied plan.
 func (m *BackupManager) RestoreViaConfig(
 	ctx context.Context,
 	profileID int64,
 	backupName string,
-) error {
+	strategy traefik.ImportStrategy,
+) ([]traefik.ImportItem, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	// Set storage
 	if err := m.SetStorage(ctx); err != nil {
-		return fmt.Errorf("failed to set storage: %w", err)
+		return nil, fmt.Errorf("failed to set storage: %w", err)
 	}
 
 	// Validate backup name
 	ext := strings.ToLower(filepath.Ext(backupName))
 	if !slices.Contains([]string{".yaml", ".yml", ".json"}, ext) {
-		return fmt.Errorf("invalid backup file name")
+		return nil, fmt.Errorf("invalid backup file name")
 	}
 
 	// Get the backup from storage
 	reader, err := m.Storage.Retrieve(ctx, backupName)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve backup: %w", err)
+		return nil, fmt.Errorf("failed to retrieve backup: %w", err)
 	}
 	defer func() {
 		if err = reader.Close(); err != nil {
@@ -249,25 +254,29 @@ func (m *BackupManager) RestoreViaConfig(
 	dynamic := &dynamic.Configuration{}
 	content, err := io.ReadAll(reader)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	switch ext {
 	case ".yaml", ".yml":
 		if err = yaml.Unmarshal(content, dynamic); err != nil {
-			return err
+			return nil, err
 		}
 	case ".json":
 		if err = json.Unmarshal(content, dynamic); err != nil {
-			return err
+			return nil, err
 		}
 	default:
-		return errors.New("invalid backup file type")
+		return nil, errors.New("invalid backup file type")
 	}
 
 	// Write to database
-	traefik.DynamicToDB(ctx, m.Conn.Q, profileID, dynamic)
-	return nil
+	var items []traefik.ImportItem
+	err = m.Conn.WithTx(ctx, func(q *db.Queries) error {
+		items, err = traefik.ApplyImport(ctx, q, profileID, dynamic, strategy)
+		return err
+	})
+	return items, err
 }
 
 func (m *BackupManager) List(ctx context.Context) ([]storage.StoredFile, error) {
@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log/slog"
 	"os"
 
 	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/traefik"
 	"github.com/mizuchilabs/mantrae/internal/util"
 	"github.com/urfave/cli/v3"
 )
@@ -38,7 +40,40 @@ func (a *App) ResetPassword(ctx context.Context, cmd *cli.Command) {
 		slog.Error("failed to update password for user", "user", cmd.String("user"), "error", err)
 		os.Exit(1)
 	}
+	if err = a.Conn.Q.DeleteSessionsByUser(ctx, user.ID); err != nil {
+		slog.Error("failed to revoke sessions for user", "user", cmd.String("user"), "error", err)
+		os.Exit(1)
+	}
 
 	slog.Info("Reset successful!", "user", cmd.String("user"), "password", cmd.String("password"))
 	os.Exit(1)
 }
+
+// ExportKubernetes writes the dynamic config of a profile as Traefik
+// Kubernetes CRD manifests.
+func (a *App) ExportKubernetes(ctx context.Context, cmd *cli.Command) error {
+	profile, err := a.Conn.Q.GetProfileByName(ctx, cmd.String("profile"))
+	if err != nil {
+		return fmt.Errorf("failed to get profile %q: %w", cmd.String("profile"), err)
+	}
+
+	cached, err := a.History.Live(ctx, a.Conn.Q, *profile)
+	if err != nil {
+		return fmt.Errorf("failed to build dynamic config: %w", err)
+	}
+
+	export := traefik.ToKubernetes(cached.Config, cmd.String("namespace"))
+	for _, w := range export.Warnings {
+		slog.Warn(w)
+	}
+	out, err := export.YAML()
+	if err != nil {
+		return fmt.Errorf("failed to encode manifests: %w", err)
+	}
+
+	if path := cmd.String("output"); path != "" && path != "-" {
+		return os.WriteFile(path, out, 0o644)
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
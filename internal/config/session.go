@@ -0,0 +1,49 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mizuchilabs/mantrae/internal/meta"
+	"github.com/mizuchilabs/mantrae/internal/settings"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+)
+
+// CreateSession starts a session for a user and returns its signed token.
+// Sessions last as long as the session lifetime setting.
+func (a *App) CreateSession(
+	ctx context.Context,
+	userID, userAgent, ipAddress string,
+) (string, time.Time, error) {
+	lifetime, _ := a.SM.Get(ctx, settings.KeySessionLifetime)
+	duration := settings.AsDuration(lifetime)
+	if duration <= 0 {
+		duration = 24 * time.Hour
+	}
+	expiresAt := time.Now().UTC().Add(duration)
+
+	session, err := a.Conn.Q.CreateSession(ctx, &db.CreateSessionParams{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		UserAgent: optional(userAgent),
+		IpAddress: optional(ipAddress),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	token, err := meta.EncodeUserToken(userID, session.ID, a.Secret, expiresAt)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
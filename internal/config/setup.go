@@ -3,6 +3,8 @@ package config
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/url"
@@ -12,9 +14,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/mizuchilabs/mantrae/internal/backup"
 	"github.com/mizuchilabs/mantrae/internal/dns"
+	"github.com/mizuchilabs/mantrae/internal/meta"
 	"github.com/mizuchilabs/mantrae/internal/settings"
 	"github.com/mizuchilabs/mantrae/internal/store"
 	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/traefik"
 	"github.com/mizuchilabs/mantrae/internal/util"
 	"github.com/urfave/cli/v3"
 )
@@ -38,6 +42,11 @@ type App struct {
 	BM   *backup.BackupManager
 	SM   *settings.SettingsManager
 	DNS  *dns.DNSManager
+
+	// Revisions tracks changes to the published dynamic config per profile
+	Revisions *traefik.Revisions
+	Configs   *traefik.ConfigCache
+	History   *traefik.History
 }
 
 func New(ctx context.Context, cmd *cli.Command) (*App, error) {
@@ -63,10 +72,34 @@ func New(ctx context.Context, cmd *cli.Command) (*App, error) {
 	app.BM.Start(ctx)
 
 	app.DNS = dns.NewManager(app.Conn, app.Secret)
+	app.Revisions = traefik.NewRevisions()
+	app.Configs = traefik.NewConfigCache(app.Revisions)
+	app.History = traefik.NewHistory(app.Configs)
+	app.Revisions.OnBump(func(profileID int64) {
+		if _, err := app.History.Record(ctx, app.Conn.Q, profileID); err != nil &&
+			!errors.Is(err, sql.ErrNoRows) {
+			slog.Error("failed to record config revision", "profile", profileID, "error", err)
+		}
+	})
 
 	return &app, app.setupDefaultData(ctx)
 }
 
+// BumpAllProfiles marks every profile as changed after the database was
+// replaced, so each of them gets a new revision recorded.
+func (a *App) BumpAllProfiles(ctx context.Context) error {
+	profiles, err := a.Conn.Q.ListProfiles(ctx, &db.ListProfilesParams{})
+	if err != nil {
+		return fmt.Errorf("failed to list profiles: %w", err)
+	}
+	ids := make([]int64, 0, len(profiles))
+	for _, profile := range profiles {
+		ids = append(ids, profile.ID)
+	}
+	a.Revisions.BumpAll(ids...)
+	return nil
+}
+
 func (a *App) setupDefaultData(ctx context.Context) error {
 	q := a.Conn.Q
 
@@ -104,6 +137,7 @@ func (a *App) setupDefaultData(ctx context.Context) error {
 			Username: "admin",
 			Password: hash,
 			Email:    &email,
+			Role:     meta.RoleAdmin,
 		}); err != nil {
 			return fmt.Errorf("failed to create admin user: %w", err)
 		}
@@ -126,6 +160,17 @@ func (a *App) setupDefaultData(ctx context.Context) error {
 		}
 	}
 
+	// Record a baseline revision for changes made before history existed
+	profiles, err = q.ListProfiles(ctx, &db.ListProfilesParams{})
+	if err != nil {
+		return fmt.Errorf("failed to list profiles: %w", err)
+	}
+	for _, p := range profiles {
+		if _, err = a.History.Record(ctx, q, p.ID); err != nil {
+			return fmt.Errorf("failed to record config revision: %w", err)
+		}
+	}
+
 	// Check default server url
 	ip, err := util.GetHostIPv4()
 	if err != nil {
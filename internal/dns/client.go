@@ -61,10 +61,15 @@ func (d *DNSManager) UpdateDNS() {
 }
 
 // DeleteDNS deletes the DNS record for a router if it's managed by us
-func (d *DNSManager) DeleteDNS(providerID, rule string) {
+func (d *DNSManager) DeleteDNS(profileID int64, providerID, rule string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	rule = util.ExpandVariables(rule, d.profileVariables(ctx, profileID))
+	if refs := util.VariableRefs(rule); len(refs) > 0 {
+		slog.Error("Failed to resolve variables", "variables", refs)
+		return
+	}
 	domains, err := util.ExtractDomainFromRule(rule)
 	if err != nil {
 		slog.Error("Failed to extract domains", "error", err)
@@ -143,13 +148,24 @@ func (d *DNSManager) getSubdomains() map[string][]DNSRouterInfo {
 	defer cancel()
 
 	domainMap := make(map[string][]DNSRouterInfo)
-	process := func(routerName, profileName, rule, providerID string) error {
+	variables := make(map[int64]map[string]string)
+	process := func(routerName string, profileID int64, profileName, rule, providerID string) error {
 		provider, err := d.getProvider(providerID)
 		if err != nil {
 			slog.Warn("Unable to load provider", "id", providerID, "err", err)
 			return nil // soft fail
 		}
 
+		vars, ok := variables[profileID]
+		if !ok {
+			vars = d.profileVariables(ctx, profileID)
+			variables[profileID] = vars
+		}
+		rule = util.ExpandVariables(rule, vars)
+		if refs := util.VariableRefs(rule); len(refs) > 0 {
+			slog.Warn("Skipping router with undefined variables", "router", routerName, "variables", refs)
+			return nil
+		}
 		domains, err := util.ExtractDomainFromRule(rule)
 		if err != nil {
 			return fmt.Errorf("failed to extract domain from rule '%s': %w", rule, err)
@@ -175,7 +191,13 @@ func (d *DNSManager) getSubdomains() map[string][]DNSRouterInfo {
 		if r.DnsProviderID == nil {
 			continue
 		}
-		if err := process(r.RouterName, r.ProfileName, r.ConfigJson.Data.Rule, *r.DnsProviderID); err != nil {
+		if err := process(
+			r.RouterName,
+			r.ProfileID,
+			r.ProfileName,
+			r.ConfigJson.Data.Rule,
+			*r.DnsProviderID,
+		); err != nil {
 			slog.Error("Failed to process HTTP router", "router", r.RouterName, "error", err)
 			return nil
 		}
@@ -191,7 +213,13 @@ func (d *DNSManager) getSubdomains() map[string][]DNSRouterInfo {
 		if r.DnsProviderID == nil {
 			continue
 		}
-		if err := process(r.RouterName, r.ProfileName, r.ConfigJson.Data.Rule, *r.DnsProviderID); err != nil {
+		if err := process(
+			r.RouterName,
+			r.ProfileID,
+			r.ProfileName,
+			r.ConfigJson.Data.Rule,
+			*r.DnsProviderID,
+		); err != nil {
 			slog.Error("Failed to process TCP router", "router", r.RouterName, "error", err)
 			return nil
 		}
@@ -199,3 +227,18 @@ func (d *DNSManager) getSubdomains() map[string][]DNSRouterInfo {
 
 	return domainMap
 }
+
+// profileVariables returns the variables of a profile by name. Failures are
+// only logged, routers referring to variables are skipped then.
+func (d *DNSManager) profileVariables(ctx context.Context, profileID int64) map[string]string {
+	rows, err := d.conn.Q.ListProfileVariables(ctx, profileID)
+	if err != nil {
+		slog.Warn("Unable to load profile variables", "profile", profileID, "err", err)
+		return nil
+	}
+	vars := make(map[string]string, len(rows))
+	for _, row := range rows {
+		vars[row.Name] = row.Value
+	}
+	return vars
+}
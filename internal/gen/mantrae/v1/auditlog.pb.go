@@ -35,6 +35,8 @@ type AuditLog struct {
 	Event         string                 `protobuf:"bytes,8,opt,name=event,proto3" json:"event,omitempty"`
 	Details       string                 `protobuf:"bytes,9,opt,name=details,proto3" json:"details,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TokenId       string                 `protobuf:"bytes,11,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	TokenName     string                 `protobuf:"bytes,12,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -139,6 +141,20 @@ func (x *AuditLog) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AuditLog) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTokenName() string {
+	if x != nil {
+		return x.TokenName
+	}
+	return ""
+}
+
 type ListAuditLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Limit         *int64                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
@@ -248,7 +264,7 @@ var File_mantrae_v1_auditlog_proto protoreflect.FileDescriptor
 const file_mantrae_v1_auditlog_proto_rawDesc = "" +
 	"\n" +
 	"\x19mantrae/v1/auditlog.proto\x12\n" +
-	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb7\x02\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf1\x02\n" +
 	"\bAuditLog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -263,7 +279,10 @@ const file_mantrae_v1_auditlog_proto_rawDesc = "" +
 	"\adetails\x18\t \x01(\tR\adetails\x129\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc4\x01\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x19\n" +
+	"\btoken_id\x18\v \x01(\tR\atokenId\x12\x1d\n" +
+	"\n" +
+	"token_name\x18\f \x01(\tR\ttokenName\"\xc4\x01\n" +
 	"\x14ListAuditLogsRequest\x12q\n" +
 	"\x05limit\x18\x01 \x01(\x03BV\xbaHS\xba\x01P\n" +
 	"\vlimit.valid\x12)limit must be either -1 or greater than 0\x1a\x16this == -1 || this > 0H\x00R\x05limit\x88\x01\x01\x12$\n" +
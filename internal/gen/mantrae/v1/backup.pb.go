@@ -23,6 +23,162 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	ImportFormat_IMPORT_FORMAT_DYNAMIC     ImportFormat = 1
+	ImportFormat_IMPORT_FORMAT_KUBERNETES  ImportFormat = 2
+	ImportFormat_IMPORT_FORMAT_COMPOSE     ImportFormat = 3
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_DYNAMIC",
+		2: "IMPORT_FORMAT_KUBERNETES",
+		3: "IMPORT_FORMAT_COMPOSE",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_DYNAMIC":     1,
+		"IMPORT_FORMAT_KUBERNETES":  2,
+		"IMPORT_FORMAT_COMPOSE":     3,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_mantrae_v1_backup_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_mantrae_v1_backup_proto_enumTypes[0]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportStrategy int32
+
+const (
+	ImportStrategy_IMPORT_STRATEGY_UNSPECIFIED ImportStrategy = 0
+	ImportStrategy_IMPORT_STRATEGY_SKIP        ImportStrategy = 1
+	ImportStrategy_IMPORT_STRATEGY_OVERWRITE   ImportStrategy = 2
+	ImportStrategy_IMPORT_STRATEGY_RENAME      ImportStrategy = 3
+)
+
+// Enum value maps for ImportStrategy.
+var (
+	ImportStrategy_name = map[int32]string{
+		0: "IMPORT_STRATEGY_UNSPECIFIED",
+		1: "IMPORT_STRATEGY_SKIP",
+		2: "IMPORT_STRATEGY_OVERWRITE",
+		3: "IMPORT_STRATEGY_RENAME",
+	}
+	ImportStrategy_value = map[string]int32{
+		"IMPORT_STRATEGY_UNSPECIFIED": 0,
+		"IMPORT_STRATEGY_SKIP":        1,
+		"IMPORT_STRATEGY_OVERWRITE":   2,
+		"IMPORT_STRATEGY_RENAME":      3,
+	}
+)
+
+func (x ImportStrategy) Enum() *ImportStrategy {
+	p := new(ImportStrategy)
+	*p = x
+	return p
+}
+
+func (x ImportStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_mantrae_v1_backup_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportStrategy) Type() protoreflect.EnumType {
+	return &file_mantrae_v1_backup_proto_enumTypes[1]
+}
+
+func (x ImportStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportStrategy.Descriptor instead.
+func (ImportStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{1}
+}
+
+type ImportStatus int32
+
+const (
+	ImportStatus_IMPORT_STATUS_UNSPECIFIED ImportStatus = 0
+	ImportStatus_IMPORT_STATUS_NEW         ImportStatus = 1
+	ImportStatus_IMPORT_STATUS_IDENTICAL   ImportStatus = 2
+	ImportStatus_IMPORT_STATUS_CONFLICT    ImportStatus = 3
+)
+
+// Enum value maps for ImportStatus.
+var (
+	ImportStatus_name = map[int32]string{
+		0: "IMPORT_STATUS_UNSPECIFIED",
+		1: "IMPORT_STATUS_NEW",
+		2: "IMPORT_STATUS_IDENTICAL",
+		3: "IMPORT_STATUS_CONFLICT",
+	}
+	ImportStatus_value = map[string]int32{
+		"IMPORT_STATUS_UNSPECIFIED": 0,
+		"IMPORT_STATUS_NEW":         1,
+		"IMPORT_STATUS_IDENTICAL":   2,
+		"IMPORT_STATUS_CONFLICT":    3,
+	}
+)
+
+func (x ImportStatus) Enum() *ImportStatus {
+	p := new(ImportStatus)
+	*p = x
+	return p
+}
+
+func (x ImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mantrae_v1_backup_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportStatus) Type() protoreflect.EnumType {
+	return &file_mantrae_v1_backup_proto_enumTypes[2]
+}
+
+func (x ImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportStatus.Descriptor instead.
+func (ImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{2}
+}
+
 type Backup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -159,6 +315,7 @@ type RestoreBackupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	ProfileId     int64                  `protobuf:"varint,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Strategy      ImportStrategy         `protobuf:"varint,3,opt,name=strategy,proto3,enum=mantrae.v1.ImportStrategy" json:"strategy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -207,8 +364,16 @@ func (x *RestoreBackupRequest) GetProfileId() int64 {
 	return 0
 }
 
+func (x *RestoreBackupRequest) GetStrategy() ImportStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ImportStrategy_IMPORT_STRATEGY_UNSPECIFIED
+}
+
 type RestoreBackupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ImportItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -243,6 +408,13 @@ func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
 	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *RestoreBackupResponse) GetItems() []*ImportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 type ListBackupsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -403,35 +575,508 @@ func (*DeleteBackupResponse) Descriptor() ([]byte, []int) {
 	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{8}
 }
 
+type ImportComposeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Strategy      ImportStrategy         `protobuf:"varint,3,opt,name=strategy,proto3,enum=mantrae.v1.ImportStrategy" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportComposeRequest) Reset() {
+	*x = ImportComposeRequest{}
+	mi := &file_mantrae_v1_backup_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportComposeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportComposeRequest) ProtoMessage() {}
+
+func (x *ImportComposeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_backup_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportComposeRequest.ProtoReflect.Descriptor instead.
+func (*ImportComposeRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImportComposeRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *ImportComposeRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ImportComposeRequest) GetStrategy() ImportStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ImportStrategy_IMPORT_STRATEGY_UNSPECIFIED
+}
+
+type ImportComposeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warnings      []string               `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Items         []*ImportItem          `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportComposeResponse) Reset() {
+	*x = ImportComposeResponse{}
+	mi := &file_mantrae_v1_backup_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportComposeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportComposeResponse) ProtoMessage() {}
+
+func (x *ImportComposeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_backup_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportComposeResponse.ProtoReflect.Descriptor instead.
+func (*ImportComposeResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportComposeResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ImportComposeResponse) GetItems() []*ImportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ImportItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Protocol      ProtocolType           `protobuf:"varint,1,opt,name=protocol,proto3,enum=mantrae.v1.ProtocolType" json:"protocol,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status        ImportStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=mantrae.v1.ImportStatus" json:"status,omitempty"`
+	NewName       string                 `protobuf:"bytes,5,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportItem) Reset() {
+	*x = ImportItem{}
+	mi := &file_mantrae_v1_backup_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportItem) ProtoMessage() {}
+
+func (x *ImportItem) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_backup_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportItem.ProtoReflect.Descriptor instead.
+func (*ImportItem) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportItem) GetProtocol() ProtocolType {
+	if x != nil {
+		return x.Protocol
+	}
+	return ProtocolType_PROTOCOL_TYPE_UNSPECIFIED
+}
+
+func (x *ImportItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ImportItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportItem) GetStatus() ImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportStatus_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ImportItem) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type PlanImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Format        ImportFormat           `protobuf:"varint,3,opt,name=format,proto3,enum=mantrae.v1.ImportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanImportRequest) Reset() {
+	*x = PlanImportRequest{}
+	mi := &file_mantrae_v1_backup_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanImportRequest) ProtoMessage() {}
+
+func (x *PlanImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_backup_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanImportRequest.ProtoReflect.Descriptor instead.
+func (*PlanImportRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlanImportRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *PlanImportRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PlanImportRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+type PlanImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ImportItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanImportResponse) Reset() {
+	*x = PlanImportResponse{}
+	mi := &file_mantrae_v1_backup_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanImportResponse) ProtoMessage() {}
+
+func (x *PlanImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_backup_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanImportResponse.ProtoReflect.Descriptor instead.
+func (*PlanImportResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlanImportResponse) GetItems() []*ImportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PlanImportResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ApplyImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Format        ImportFormat           `protobuf:"varint,3,opt,name=format,proto3,enum=mantrae.v1.ImportFormat" json:"format,omitempty"`
+	Strategy      ImportStrategy         `protobuf:"varint,4,opt,name=strategy,proto3,enum=mantrae.v1.ImportStrategy" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyImportRequest) Reset() {
+	*x = ApplyImportRequest{}
+	mi := &file_mantrae_v1_backup_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyImportRequest) ProtoMessage() {}
+
+func (x *ApplyImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_backup_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyImportRequest.ProtoReflect.Descriptor instead.
+func (*ApplyImportRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApplyImportRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *ApplyImportRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ApplyImportRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ApplyImportRequest) GetStrategy() ImportStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ImportStrategy_IMPORT_STRATEGY_UNSPECIFIED
+}
+
+type ApplyImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ImportItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyImportResponse) Reset() {
+	*x = ApplyImportResponse{}
+	mi := &file_mantrae_v1_backup_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyImportResponse) ProtoMessage() {}
+
+func (x *ApplyImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_backup_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyImportResponse.ProtoReflect.Descriptor instead.
+func (*ApplyImportResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_backup_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ApplyImportResponse) GetItems() []*ImportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ApplyImportResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 var File_mantrae_v1_backup_proto protoreflect.FileDescriptor
 
 const file_mantrae_v1_backup_proto_rawDesc = "" +
 	"\n" +
 	"\x17mantrae/v1/backup.proto\x12\n" +
-	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"k\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19mantrae/v1/protocol.proto\"k\n" +
 	"\x06Backup\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x129\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x15\n" +
 	"\x13CreateBackupRequest\"\x16\n" +
-	"\x14CreateBackupResponse\"R\n" +
+	"\x14CreateBackupResponse\"\x8a\x01\n" +
 	"\x14RestoreBackupRequest\x12\x1b\n" +
 	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12\x1d\n" +
 	"\n" +
-	"profile_id\x18\x02 \x01(\x03R\tprofileId\"\x17\n" +
-	"\x15RestoreBackupResponse\"\x14\n" +
+	"profile_id\x18\x02 \x01(\x03R\tprofileId\x126\n" +
+	"\bstrategy\x18\x03 \x01(\x0e2\x1a.mantrae.v1.ImportStrategyR\bstrategy\"E\n" +
+	"\x15RestoreBackupResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.mantrae.v1.ImportItemR\x05items\"\x14\n" +
 	"\x12ListBackupsRequest\"C\n" +
 	"\x13ListBackupsResponse\x12,\n" +
 	"\abackups\x18\x01 \x03(\v2\x12.mantrae.v1.BackupR\abackups\"2\n" +
 	"\x13DeleteBackupRequest\x12\x1b\n" +
 	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\"\x16\n" +
-	"\x14DeleteBackupResponse2\xe0\x02\n" +
+	"\x14DeleteBackupResponse\"\x99\x01\n" +
+	"\x14ImportComposeRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\x12!\n" +
+	"\acontent\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acontent\x126\n" +
+	"\bstrategy\x18\x03 \x01(\x0e2\x1a.mantrae.v1.ImportStrategyR\bstrategy\"a\n" +
+	"\x15ImportComposeResponse\x12\x1a\n" +
+	"\bwarnings\x18\x01 \x03(\tR\bwarnings\x12,\n" +
+	"\x05items\x18\x02 \x03(\v2\x16.mantrae.v1.ImportItemR\x05items\"\xb7\x01\n" +
+	"\n" +
+	"ImportItem\x124\n" +
+	"\bprotocol\x18\x01 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeR\bprotocol\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x120\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.mantrae.v1.ImportStatusR\x06status\x12\x19\n" +
+	"\bnew_name\x18\x05 \x01(\tR\anewName\"\x90\x01\n" +
+	"\x11PlanImportRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\x12!\n" +
+	"\acontent\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acontent\x120\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x18.mantrae.v1.ImportFormatR\x06format\"^\n" +
+	"\x12PlanImportResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.mantrae.v1.ImportItemR\x05items\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\xc9\x01\n" +
+	"\x12ApplyImportRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\x12!\n" +
+	"\acontent\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acontent\x120\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x18.mantrae.v1.ImportFormatR\x06format\x126\n" +
+	"\bstrategy\x18\x04 \x01(\x0e2\x1a.mantrae.v1.ImportStrategyR\bstrategy\"_\n" +
+	"\x13ApplyImportResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.mantrae.v1.ImportItemR\x05items\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings*\x81\x01\n" +
+	"\fImportFormat\x12\x1d\n" +
+	"\x19IMPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15IMPORT_FORMAT_DYNAMIC\x10\x01\x12\x1c\n" +
+	"\x18IMPORT_FORMAT_KUBERNETES\x10\x02\x12\x19\n" +
+	"\x15IMPORT_FORMAT_COMPOSE\x10\x03*\x86\x01\n" +
+	"\x0eImportStrategy\x12\x1f\n" +
+	"\x1bIMPORT_STRATEGY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14IMPORT_STRATEGY_SKIP\x10\x01\x12\x1d\n" +
+	"\x19IMPORT_STRATEGY_OVERWRITE\x10\x02\x12\x1a\n" +
+	"\x16IMPORT_STRATEGY_RENAME\x10\x03*}\n" +
+	"\fImportStatus\x12\x1d\n" +
+	"\x19IMPORT_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11IMPORT_STATUS_NEW\x10\x01\x12\x1b\n" +
+	"\x17IMPORT_STATUS_IDENTICAL\x10\x02\x12\x1a\n" +
+	"\x16IMPORT_STATUS_CONFLICT\x10\x032\xd3\x04\n" +
 	"\rBackupService\x12Q\n" +
 	"\fCreateBackup\x12\x1f.mantrae.v1.CreateBackupRequest\x1a .mantrae.v1.CreateBackupResponse\x12T\n" +
 	"\rRestoreBackup\x12 .mantrae.v1.RestoreBackupRequest\x1a!.mantrae.v1.RestoreBackupResponse\x12S\n" +
 	"\vListBackups\x12\x1e.mantrae.v1.ListBackupsRequest\x1a\x1f.mantrae.v1.ListBackupsResponse\"\x03\x90\x02\x01\x12Q\n" +
-	"\fDeleteBackup\x12\x1f.mantrae.v1.DeleteBackupRequest\x1a .mantrae.v1.DeleteBackupResponseB\xa8\x01\n" +
+	"\fDeleteBackup\x12\x1f.mantrae.v1.DeleteBackupRequest\x1a .mantrae.v1.DeleteBackupResponse\x12T\n" +
+	"\rImportCompose\x12 .mantrae.v1.ImportComposeRequest\x1a!.mantrae.v1.ImportComposeResponse\x12K\n" +
+	"\n" +
+	"PlanImport\x12\x1d.mantrae.v1.PlanImportRequest\x1a\x1e.mantrae.v1.PlanImportResponse\x12N\n" +
+	"\vApplyImport\x12\x1e.mantrae.v1.ApplyImportRequest\x1a\x1f.mantrae.v1.ApplyImportResponseB\xa8\x01\n" +
 	"\x0ecom.mantrae.v1B\vBackupProtoP\x01Z@github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1;mantraev1\xa2\x02\x03MXX\xaa\x02\n" +
 	"Mantrae.V1\xca\x02\n" +
 	"Mantrae\\V1\xe2\x02\x16Mantrae\\V1\\GPBMetadata\xea\x02\vMantrae::V1b\x06proto3"
@@ -448,35 +1093,64 @@ func file_mantrae_v1_backup_proto_rawDescGZIP() []byte {
 	return file_mantrae_v1_backup_proto_rawDescData
 }
 
-var file_mantrae_v1_backup_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_mantrae_v1_backup_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_mantrae_v1_backup_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_mantrae_v1_backup_proto_goTypes = []any{
-	(*Backup)(nil),                // 0: mantrae.v1.Backup
-	(*CreateBackupRequest)(nil),   // 1: mantrae.v1.CreateBackupRequest
-	(*CreateBackupResponse)(nil),  // 2: mantrae.v1.CreateBackupResponse
-	(*RestoreBackupRequest)(nil),  // 3: mantrae.v1.RestoreBackupRequest
-	(*RestoreBackupResponse)(nil), // 4: mantrae.v1.RestoreBackupResponse
-	(*ListBackupsRequest)(nil),    // 5: mantrae.v1.ListBackupsRequest
-	(*ListBackupsResponse)(nil),   // 6: mantrae.v1.ListBackupsResponse
-	(*DeleteBackupRequest)(nil),   // 7: mantrae.v1.DeleteBackupRequest
-	(*DeleteBackupResponse)(nil),  // 8: mantrae.v1.DeleteBackupResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(ImportFormat)(0),             // 0: mantrae.v1.ImportFormat
+	(ImportStrategy)(0),           // 1: mantrae.v1.ImportStrategy
+	(ImportStatus)(0),             // 2: mantrae.v1.ImportStatus
+	(*Backup)(nil),                // 3: mantrae.v1.Backup
+	(*CreateBackupRequest)(nil),   // 4: mantrae.v1.CreateBackupRequest
+	(*CreateBackupResponse)(nil),  // 5: mantrae.v1.CreateBackupResponse
+	(*RestoreBackupRequest)(nil),  // 6: mantrae.v1.RestoreBackupRequest
+	(*RestoreBackupResponse)(nil), // 7: mantrae.v1.RestoreBackupResponse
+	(*ListBackupsRequest)(nil),    // 8: mantrae.v1.ListBackupsRequest
+	(*ListBackupsResponse)(nil),   // 9: mantrae.v1.ListBackupsResponse
+	(*DeleteBackupRequest)(nil),   // 10: mantrae.v1.DeleteBackupRequest
+	(*DeleteBackupResponse)(nil),  // 11: mantrae.v1.DeleteBackupResponse
+	(*ImportComposeRequest)(nil),  // 12: mantrae.v1.ImportComposeRequest
+	(*ImportComposeResponse)(nil), // 13: mantrae.v1.ImportComposeResponse
+	(*ImportItem)(nil),            // 14: mantrae.v1.ImportItem
+	(*PlanImportRequest)(nil),     // 15: mantrae.v1.PlanImportRequest
+	(*PlanImportResponse)(nil),    // 16: mantrae.v1.PlanImportResponse
+	(*ApplyImportRequest)(nil),    // 17: mantrae.v1.ApplyImportRequest
+	(*ApplyImportResponse)(nil),   // 18: mantrae.v1.ApplyImportResponse
+	(*timestamppb.Timestamp)(nil), // 19: google.protobuf.Timestamp
+	(ProtocolType)(0),             // 20: mantrae.v1.ProtocolType
 }
 var file_mantrae_v1_backup_proto_depIdxs = []int32{
-	9, // 0: mantrae.v1.Backup.created_at:type_name -> google.protobuf.Timestamp
-	0, // 1: mantrae.v1.ListBackupsResponse.backups:type_name -> mantrae.v1.Backup
-	1, // 2: mantrae.v1.BackupService.CreateBackup:input_type -> mantrae.v1.CreateBackupRequest
-	3, // 3: mantrae.v1.BackupService.RestoreBackup:input_type -> mantrae.v1.RestoreBackupRequest
-	5, // 4: mantrae.v1.BackupService.ListBackups:input_type -> mantrae.v1.ListBackupsRequest
-	7, // 5: mantrae.v1.BackupService.DeleteBackup:input_type -> mantrae.v1.DeleteBackupRequest
-	2, // 6: mantrae.v1.BackupService.CreateBackup:output_type -> mantrae.v1.CreateBackupResponse
-	4, // 7: mantrae.v1.BackupService.RestoreBackup:output_type -> mantrae.v1.RestoreBackupResponse
-	6, // 8: mantrae.v1.BackupService.ListBackups:output_type -> mantrae.v1.ListBackupsResponse
-	8, // 9: mantrae.v1.BackupService.DeleteBackup:output_type -> mantrae.v1.DeleteBackupResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	19, // 0: mantrae.v1.Backup.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: mantrae.v1.RestoreBackupRequest.strategy:type_name -> mantrae.v1.ImportStrategy
+	14, // 2: mantrae.v1.RestoreBackupResponse.items:type_name -> mantrae.v1.ImportItem
+	3,  // 3: mantrae.v1.ListBackupsResponse.backups:type_name -> mantrae.v1.Backup
+	1,  // 4: mantrae.v1.ImportComposeRequest.strategy:type_name -> mantrae.v1.ImportStrategy
+	14, // 5: mantrae.v1.ImportComposeResponse.items:type_name -> mantrae.v1.ImportItem
+	20, // 6: mantrae.v1.ImportItem.protocol:type_name -> mantrae.v1.ProtocolType
+	2,  // 7: mantrae.v1.ImportItem.status:type_name -> mantrae.v1.ImportStatus
+	0,  // 8: mantrae.v1.PlanImportRequest.format:type_name -> mantrae.v1.ImportFormat
+	14, // 9: mantrae.v1.PlanImportResponse.items:type_name -> mantrae.v1.ImportItem
+	0,  // 10: mantrae.v1.ApplyImportRequest.format:type_name -> mantrae.v1.ImportFormat
+	1,  // 11: mantrae.v1.ApplyImportRequest.strategy:type_name -> mantrae.v1.ImportStrategy
+	14, // 12: mantrae.v1.ApplyImportResponse.items:type_name -> mantrae.v1.ImportItem
+	4,  // 13: mantrae.v1.BackupService.CreateBackup:input_type -> mantrae.v1.CreateBackupRequest
+	6,  // 14: mantrae.v1.BackupService.RestoreBackup:input_type -> mantrae.v1.RestoreBackupRequest
+	8,  // 15: mantrae.v1.BackupService.ListBackups:input_type -> mantrae.v1.ListBackupsRequest
+	10, // 16: mantrae.v1.BackupService.DeleteBackup:input_type -> mantrae.v1.DeleteBackupRequest
+	12, // 17: mantrae.v1.BackupService.ImportCompose:input_type -> mantrae.v1.ImportComposeRequest
+	15, // 18: mantrae.v1.BackupService.PlanImport:input_type -> mantrae.v1.PlanImportRequest
+	17, // 19: mantrae.v1.BackupService.ApplyImport:input_type -> mantrae.v1.ApplyImportRequest
+	5,  // 20: mantrae.v1.BackupService.CreateBackup:output_type -> mantrae.v1.CreateBackupResponse
+	7,  // 21: mantrae.v1.BackupService.RestoreBackup:output_type -> mantrae.v1.RestoreBackupResponse
+	9,  // 22: mantrae.v1.BackupService.ListBackups:output_type -> mantrae.v1.ListBackupsResponse
+	11, // 23: mantrae.v1.BackupService.DeleteBackup:output_type -> mantrae.v1.DeleteBackupResponse
+	13, // 24: mantrae.v1.BackupService.ImportCompose:output_type -> mantrae.v1.ImportComposeResponse
+	16, // 25: mantrae.v1.BackupService.PlanImport:output_type -> mantrae.v1.PlanImportResponse
+	18, // 26: mantrae.v1.BackupService.ApplyImport:output_type -> mantrae.v1.ApplyImportResponse
+	20, // [20:27] is the sub-list for method output_type
+	13, // [13:20] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_mantrae_v1_backup_proto_init() }
@@ -484,18 +1158,20 @@ func file_mantrae_v1_backup_proto_init() {
 	if File_mantrae_v1_backup_proto != nil {
 		return
 	}
+	file_mantrae_v1_protocol_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mantrae_v1_backup_proto_rawDesc), len(file_mantrae_v1_backup_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_mantrae_v1_backup_proto_goTypes,
 		DependencyIndexes: file_mantrae_v1_backup_proto_depIdxs,
+		EnumInfos:         file_mantrae_v1_backup_proto_enumTypes,
 		MessageInfos:      file_mantrae_v1_backup_proto_msgTypes,
 	}.Build()
 	File_mantrae_v1_backup_proto = out.File
@@ -45,6 +45,15 @@ const (
 	// BackupServiceDeleteBackupProcedure is the fully-qualified name of the BackupService's
 	// DeleteBackup RPC.
 	BackupServiceDeleteBackupProcedure = "/mantrae.v1.BackupService/DeleteBackup"
+	// BackupServiceImportComposeProcedure is the fully-qualified name of the BackupService's
+	// ImportCompose RPC.
+	BackupServiceImportComposeProcedure = "/mantrae.v1.BackupService/ImportCompose"
+	// BackupServicePlanImportProcedure is the fully-qualified name of the BackupService's PlanImport
+	// RPC.
+	BackupServicePlanImportProcedure = "/mantrae.v1.BackupService/PlanImport"
+	// BackupServiceApplyImportProcedure is the fully-qualified name of the BackupService's ApplyImport
+	// RPC.
+	BackupServiceApplyImportProcedure = "/mantrae.v1.BackupService/ApplyImport"
 )
 
 // BackupServiceClient is a client for the mantrae.v1.BackupService service.
@@ -53,6 +62,9 @@ type BackupServiceClient interface {
 	RestoreBackup(context.Context, *v1.RestoreBackupRequest) (*v1.RestoreBackupResponse, error)
 	ListBackups(context.Context, *v1.ListBackupsRequest) (*v1.ListBackupsResponse, error)
 	DeleteBackup(context.Context, *v1.DeleteBackupRequest) (*v1.DeleteBackupResponse, error)
+	ImportCompose(context.Context, *v1.ImportComposeRequest) (*v1.ImportComposeResponse, error)
+	PlanImport(context.Context, *v1.PlanImportRequest) (*v1.PlanImportResponse, error)
+	ApplyImport(context.Context, *v1.ApplyImportRequest) (*v1.ApplyImportResponse, error)
 }
 
 // NewBackupServiceClient constructs a client for the mantrae.v1.BackupService service. By default,
@@ -91,6 +103,24 @@ func NewBackupServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(backupServiceMethods.ByName("DeleteBackup")),
 			connect.WithClientOptions(opts...),
 		),
+		importCompose: connect.NewClient[v1.ImportComposeRequest, v1.ImportComposeResponse](
+			httpClient,
+			baseURL+BackupServiceImportComposeProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("ImportCompose")),
+			connect.WithClientOptions(opts...),
+		),
+		planImport: connect.NewClient[v1.PlanImportRequest, v1.PlanImportResponse](
+			httpClient,
+			baseURL+BackupServicePlanImportProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("PlanImport")),
+			connect.WithClientOptions(opts...),
+		),
+		applyImport: connect.NewClient[v1.ApplyImportRequest, v1.ApplyImportResponse](
+			httpClient,
+			baseURL+BackupServiceApplyImportProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("ApplyImport")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -100,6 +130,9 @@ type backupServiceClient struct {
 	restoreBackup *connect.Client[v1.RestoreBackupRequest, v1.RestoreBackupResponse]
 	listBackups   *connect.Client[v1.ListBackupsRequest, v1.ListBackupsResponse]
 	deleteBackup  *connect.Client[v1.DeleteBackupRequest, v1.DeleteBackupResponse]
+	importCompose *connect.Client[v1.ImportComposeRequest, v1.ImportComposeResponse]
+	planImport    *connect.Client[v1.PlanImportRequest, v1.PlanImportResponse]
+	applyImport   *connect.Client[v1.ApplyImportRequest, v1.ApplyImportResponse]
 }
 
 // CreateBackup calls mantrae.v1.BackupService.CreateBackup.
@@ -138,12 +171,42 @@ func (c *backupServiceClient) DeleteBackup(ctx context.Context, req *v1.DeleteBa
 	return nil, err
 }
 
+// ImportCompose calls mantrae.v1.BackupService.ImportCompose.
+func (c *backupServiceClient) ImportCompose(ctx context.Context, req *v1.ImportComposeRequest) (*v1.ImportComposeResponse, error) {
+	response, err := c.importCompose.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PlanImport calls mantrae.v1.BackupService.PlanImport.
+func (c *backupServiceClient) PlanImport(ctx context.Context, req *v1.PlanImportRequest) (*v1.PlanImportResponse, error) {
+	response, err := c.planImport.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ApplyImport calls mantrae.v1.BackupService.ApplyImport.
+func (c *backupServiceClient) ApplyImport(ctx context.Context, req *v1.ApplyImportRequest) (*v1.ApplyImportResponse, error) {
+	response, err := c.applyImport.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // BackupServiceHandler is an implementation of the mantrae.v1.BackupService service.
 type BackupServiceHandler interface {
 	CreateBackup(context.Context, *v1.CreateBackupRequest) (*v1.CreateBackupResponse, error)
 	RestoreBackup(context.Context, *v1.RestoreBackupRequest) (*v1.RestoreBackupResponse, error)
 	ListBackups(context.Context, *v1.ListBackupsRequest) (*v1.ListBackupsResponse, error)
 	DeleteBackup(context.Context, *v1.DeleteBackupRequest) (*v1.DeleteBackupResponse, error)
+	ImportCompose(context.Context, *v1.ImportComposeRequest) (*v1.ImportComposeResponse, error)
+	PlanImport(context.Context, *v1.PlanImportRequest) (*v1.PlanImportResponse, error)
+	ApplyImport(context.Context, *v1.ApplyImportRequest) (*v1.ApplyImportResponse, error)
 }
 
 // NewBackupServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -178,6 +241,24 @@ func NewBackupServiceHandler(svc BackupServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(backupServiceMethods.ByName("DeleteBackup")),
 		connect.WithHandlerOptions(opts...),
 	)
+	backupServiceImportComposeHandler := connect.NewUnaryHandlerSimple(
+		BackupServiceImportComposeProcedure,
+		svc.ImportCompose,
+		connect.WithSchema(backupServiceMethods.ByName("ImportCompose")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServicePlanImportHandler := connect.NewUnaryHandlerSimple(
+		BackupServicePlanImportProcedure,
+		svc.PlanImport,
+		connect.WithSchema(backupServiceMethods.ByName("PlanImport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceApplyImportHandler := connect.NewUnaryHandlerSimple(
+		BackupServiceApplyImportProcedure,
+		svc.ApplyImport,
+		connect.WithSchema(backupServiceMethods.ByName("ApplyImport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mantrae.v1.BackupService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BackupServiceCreateBackupProcedure:
@@ -188,6 +269,12 @@ func NewBackupServiceHandler(svc BackupServiceHandler, opts ...connect.HandlerOp
 			backupServiceListBackupsHandler.ServeHTTP(w, r)
 		case BackupServiceDeleteBackupProcedure:
 			backupServiceDeleteBackupHandler.ServeHTTP(w, r)
+		case BackupServiceImportComposeProcedure:
+			backupServiceImportComposeHandler.ServeHTTP(w, r)
+		case BackupServicePlanImportProcedure:
+			backupServicePlanImportHandler.ServeHTTP(w, r)
+		case BackupServiceApplyImportProcedure:
+			backupServiceApplyImportHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -212,3 +299,15 @@ func (UnimplementedBackupServiceHandler) ListBackups(context.Context, *v1.ListBa
 func (UnimplementedBackupServiceHandler) DeleteBackup(context.Context, *v1.DeleteBackupRequest) (*v1.DeleteBackupResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.BackupService.DeleteBackup is not implemented"))
 }
+
+func (UnimplementedBackupServiceHandler) ImportCompose(context.Context, *v1.ImportComposeRequest) (*v1.ImportComposeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.BackupService.ImportCompose is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) PlanImport(context.Context, *v1.PlanImportRequest) (*v1.PlanImportResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.BackupService.PlanImport is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) ApplyImport(context.Context, *v1.ApplyImportRequest) (*v1.ApplyImportResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.BackupService.ApplyImport is not implemented"))
+}
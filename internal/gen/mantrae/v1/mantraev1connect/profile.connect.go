@@ -48,6 +48,27 @@ const (
 	// ProfileServiceListProfilesProcedure is the fully-qualified name of the ProfileService's
 	// ListProfiles RPC.
 	ProfileServiceListProfilesProcedure = "/mantrae.v1.ProfileService/ListProfiles"
+	// ProfileServicePublishProfileProcedure is the fully-qualified name of the ProfileService's
+	// PublishProfile RPC.
+	ProfileServicePublishProfileProcedure = "/mantrae.v1.ProfileService/PublishProfile"
+	// ProfileServiceDiscardDraftProcedure is the fully-qualified name of the ProfileService's
+	// DiscardDraft RPC.
+	ProfileServiceDiscardDraftProcedure = "/mantrae.v1.ProfileService/DiscardDraft"
+	// ProfileServiceGetDraftDiffProcedure is the fully-qualified name of the ProfileService's
+	// GetDraftDiff RPC.
+	ProfileServiceGetDraftDiffProcedure = "/mantrae.v1.ProfileService/GetDraftDiff"
+	// ProfileServiceValidateProfileProcedure is the fully-qualified name of the ProfileService's
+	// ValidateProfile RPC.
+	ProfileServiceValidateProfileProcedure = "/mantrae.v1.ProfileService/ValidateProfile"
+	// ProfileServiceCloneProfileProcedure is the fully-qualified name of the ProfileService's
+	// CloneProfile RPC.
+	ProfileServiceCloneProfileProcedure = "/mantrae.v1.ProfileService/CloneProfile"
+	// ProfileServicePlanPromotionProcedure is the fully-qualified name of the ProfileService's
+	// PlanPromotion RPC.
+	ProfileServicePlanPromotionProcedure = "/mantrae.v1.ProfileService/PlanPromotion"
+	// ProfileServicePromoteResourcesProcedure is the fully-qualified name of the ProfileService's
+	// PromoteResources RPC.
+	ProfileServicePromoteResourcesProcedure = "/mantrae.v1.ProfileService/PromoteResources"
 )
 
 // ProfileServiceClient is a client for the mantrae.v1.ProfileService service.
@@ -57,6 +78,13 @@ type ProfileServiceClient interface {
 	UpdateProfile(context.Context, *v1.UpdateProfileRequest) (*v1.UpdateProfileResponse, error)
 	DeleteProfile(context.Context, *v1.DeleteProfileRequest) (*v1.DeleteProfileResponse, error)
 	ListProfiles(context.Context, *v1.ListProfilesRequest) (*v1.ListProfilesResponse, error)
+	PublishProfile(context.Context, *v1.PublishProfileRequest) (*v1.PublishProfileResponse, error)
+	DiscardDraft(context.Context, *v1.DiscardDraftRequest) (*v1.DiscardDraftResponse, error)
+	GetDraftDiff(context.Context, *v1.GetDraftDiffRequest) (*v1.GetDraftDiffResponse, error)
+	ValidateProfile(context.Context, *v1.ValidateProfileRequest) (*v1.ValidateProfileResponse, error)
+	CloneProfile(context.Context, *v1.CloneProfileRequest) (*v1.CloneProfileResponse, error)
+	PlanPromotion(context.Context, *v1.PlanPromotionRequest) (*v1.PlanPromotionResponse, error)
+	PromoteResources(context.Context, *v1.PromoteResourcesRequest) (*v1.PromoteResourcesResponse, error)
 }
 
 // NewProfileServiceClient constructs a client for the mantrae.v1.ProfileService service. By
@@ -102,16 +130,67 @@ func NewProfileServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		publishProfile: connect.NewClient[v1.PublishProfileRequest, v1.PublishProfileResponse](
+			httpClient,
+			baseURL+ProfileServicePublishProfileProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("PublishProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		discardDraft: connect.NewClient[v1.DiscardDraftRequest, v1.DiscardDraftResponse](
+			httpClient,
+			baseURL+ProfileServiceDiscardDraftProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("DiscardDraft")),
+			connect.WithClientOptions(opts...),
+		),
+		getDraftDiff: connect.NewClient[v1.GetDraftDiffRequest, v1.GetDraftDiffResponse](
+			httpClient,
+			baseURL+ProfileServiceGetDraftDiffProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("GetDraftDiff")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		validateProfile: connect.NewClient[v1.ValidateProfileRequest, v1.ValidateProfileResponse](
+			httpClient,
+			baseURL+ProfileServiceValidateProfileProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("ValidateProfile")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		cloneProfile: connect.NewClient[v1.CloneProfileRequest, v1.CloneProfileResponse](
+			httpClient,
+			baseURL+ProfileServiceCloneProfileProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("CloneProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		planPromotion: connect.NewClient[v1.PlanPromotionRequest, v1.PlanPromotionResponse](
+			httpClient,
+			baseURL+ProfileServicePlanPromotionProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("PlanPromotion")),
+			connect.WithClientOptions(opts...),
+		),
+		promoteResources: connect.NewClient[v1.PromoteResourcesRequest, v1.PromoteResourcesResponse](
+			httpClient,
+			baseURL+ProfileServicePromoteResourcesProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("PromoteResources")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // profileServiceClient implements ProfileServiceClient.
 type profileServiceClient struct {
-	getProfile    *connect.Client[v1.GetProfileRequest, v1.GetProfileResponse]
-	createProfile *connect.Client[v1.CreateProfileRequest, v1.CreateProfileResponse]
-	updateProfile *connect.Client[v1.UpdateProfileRequest, v1.UpdateProfileResponse]
-	deleteProfile *connect.Client[v1.DeleteProfileRequest, v1.DeleteProfileResponse]
-	listProfiles  *connect.Client[v1.ListProfilesRequest, v1.ListProfilesResponse]
+	getProfile       *connect.Client[v1.GetProfileRequest, v1.GetProfileResponse]
+	createProfile    *connect.Client[v1.CreateProfileRequest, v1.CreateProfileResponse]
+	updateProfile    *connect.Client[v1.UpdateProfileRequest, v1.UpdateProfileResponse]
+	deleteProfile    *connect.Client[v1.DeleteProfileRequest, v1.DeleteProfileResponse]
+	listProfiles     *connect.Client[v1.ListProfilesRequest, v1.ListProfilesResponse]
+	publishProfile   *connect.Client[v1.PublishProfileRequest, v1.PublishProfileResponse]
+	discardDraft     *connect.Client[v1.DiscardDraftRequest, v1.DiscardDraftResponse]
+	getDraftDiff     *connect.Client[v1.GetDraftDiffRequest, v1.GetDraftDiffResponse]
+	validateProfile  *connect.Client[v1.ValidateProfileRequest, v1.ValidateProfileResponse]
+	cloneProfile     *connect.Client[v1.CloneProfileRequest, v1.CloneProfileResponse]
+	planPromotion    *connect.Client[v1.PlanPromotionRequest, v1.PlanPromotionResponse]
+	promoteResources *connect.Client[v1.PromoteResourcesRequest, v1.PromoteResourcesResponse]
 }
 
 // GetProfile calls mantrae.v1.ProfileService.GetProfile.
@@ -159,6 +238,69 @@ func (c *profileServiceClient) ListProfiles(ctx context.Context, req *v1.ListPro
 	return nil, err
 }
 
+// PublishProfile calls mantrae.v1.ProfileService.PublishProfile.
+func (c *profileServiceClient) PublishProfile(ctx context.Context, req *v1.PublishProfileRequest) (*v1.PublishProfileResponse, error) {
+	response, err := c.publishProfile.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DiscardDraft calls mantrae.v1.ProfileService.DiscardDraft.
+func (c *profileServiceClient) DiscardDraft(ctx context.Context, req *v1.DiscardDraftRequest) (*v1.DiscardDraftResponse, error) {
+	response, err := c.discardDraft.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetDraftDiff calls mantrae.v1.ProfileService.GetDraftDiff.
+func (c *profileServiceClient) GetDraftDiff(ctx context.Context, req *v1.GetDraftDiffRequest) (*v1.GetDraftDiffResponse, error) {
+	response, err := c.getDraftDiff.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ValidateProfile calls mantrae.v1.ProfileService.ValidateProfile.
+func (c *profileServiceClient) ValidateProfile(ctx context.Context, req *v1.ValidateProfileRequest) (*v1.ValidateProfileResponse, error) {
+	response, err := c.validateProfile.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CloneProfile calls mantrae.v1.ProfileService.CloneProfile.
+func (c *profileServiceClient) CloneProfile(ctx context.Context, req *v1.CloneProfileRequest) (*v1.CloneProfileResponse, error) {
+	response, err := c.cloneProfile.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PlanPromotion calls mantrae.v1.ProfileService.PlanPromotion.
+func (c *profileServiceClient) PlanPromotion(ctx context.Context, req *v1.PlanPromotionRequest) (*v1.PlanPromotionResponse, error) {
+	response, err := c.planPromotion.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PromoteResources calls mantrae.v1.ProfileService.PromoteResources.
+func (c *profileServiceClient) PromoteResources(ctx context.Context, req *v1.PromoteResourcesRequest) (*v1.PromoteResourcesResponse, error) {
+	response, err := c.promoteResources.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ProfileServiceHandler is an implementation of the mantrae.v1.ProfileService service.
 type ProfileServiceHandler interface {
 	GetProfile(context.Context, *v1.GetProfileRequest) (*v1.GetProfileResponse, error)
@@ -166,6 +308,13 @@ type ProfileServiceHandler interface {
 	UpdateProfile(context.Context, *v1.UpdateProfileRequest) (*v1.UpdateProfileResponse, error)
 	DeleteProfile(context.Context, *v1.DeleteProfileRequest) (*v1.DeleteProfileResponse, error)
 	ListProfiles(context.Context, *v1.ListProfilesRequest) (*v1.ListProfilesResponse, error)
+	PublishProfile(context.Context, *v1.PublishProfileRequest) (*v1.PublishProfileResponse, error)
+	DiscardDraft(context.Context, *v1.DiscardDraftRequest) (*v1.DiscardDraftResponse, error)
+	GetDraftDiff(context.Context, *v1.GetDraftDiffRequest) (*v1.GetDraftDiffResponse, error)
+	ValidateProfile(context.Context, *v1.ValidateProfileRequest) (*v1.ValidateProfileResponse, error)
+	CloneProfile(context.Context, *v1.CloneProfileRequest) (*v1.CloneProfileResponse, error)
+	PlanPromotion(context.Context, *v1.PlanPromotionRequest) (*v1.PlanPromotionResponse, error)
+	PromoteResources(context.Context, *v1.PromoteResourcesRequest) (*v1.PromoteResourcesResponse, error)
 }
 
 // NewProfileServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -207,6 +356,50 @@ func NewProfileServiceHandler(svc ProfileServiceHandler, opts ...connect.Handler
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	profileServicePublishProfileHandler := connect.NewUnaryHandlerSimple(
+		ProfileServicePublishProfileProcedure,
+		svc.PublishProfile,
+		connect.WithSchema(profileServiceMethods.ByName("PublishProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileServiceDiscardDraftHandler := connect.NewUnaryHandlerSimple(
+		ProfileServiceDiscardDraftProcedure,
+		svc.DiscardDraft,
+		connect.WithSchema(profileServiceMethods.ByName("DiscardDraft")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileServiceGetDraftDiffHandler := connect.NewUnaryHandlerSimple(
+		ProfileServiceGetDraftDiffProcedure,
+		svc.GetDraftDiff,
+		connect.WithSchema(profileServiceMethods.ByName("GetDraftDiff")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileServiceValidateProfileHandler := connect.NewUnaryHandlerSimple(
+		ProfileServiceValidateProfileProcedure,
+		svc.ValidateProfile,
+		connect.WithSchema(profileServiceMethods.ByName("ValidateProfile")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileServiceCloneProfileHandler := connect.NewUnaryHandlerSimple(
+		ProfileServiceCloneProfileProcedure,
+		svc.CloneProfile,
+		connect.WithSchema(profileServiceMethods.ByName("CloneProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileServicePlanPromotionHandler := connect.NewUnaryHandlerSimple(
+		ProfileServicePlanPromotionProcedure,
+		svc.PlanPromotion,
+		connect.WithSchema(profileServiceMethods.ByName("PlanPromotion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileServicePromoteResourcesHandler := connect.NewUnaryHandlerSimple(
+		ProfileServicePromoteResourcesProcedure,
+		svc.PromoteResources,
+		connect.WithSchema(profileServiceMethods.ByName("PromoteResources")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mantrae.v1.ProfileService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProfileServiceGetProfileProcedure:
@@ -219,6 +412,20 @@ func NewProfileServiceHandler(svc ProfileServiceHandler, opts ...connect.Handler
 			profileServiceDeleteProfileHandler.ServeHTTP(w, r)
 		case ProfileServiceListProfilesProcedure:
 			profileServiceListProfilesHandler.ServeHTTP(w, r)
+		case ProfileServicePublishProfileProcedure:
+			profileServicePublishProfileHandler.ServeHTTP(w, r)
+		case ProfileServiceDiscardDraftProcedure:
+			profileServiceDiscardDraftHandler.ServeHTTP(w, r)
+		case ProfileServiceGetDraftDiffProcedure:
+			profileServiceGetDraftDiffHandler.ServeHTTP(w, r)
+		case ProfileServiceValidateProfileProcedure:
+			profileServiceValidateProfileHandler.ServeHTTP(w, r)
+		case ProfileServiceCloneProfileProcedure:
+			profileServiceCloneProfileHandler.ServeHTTP(w, r)
+		case ProfileServicePlanPromotionProcedure:
+			profileServicePlanPromotionHandler.ServeHTTP(w, r)
+		case ProfileServicePromoteResourcesProcedure:
+			profileServicePromoteResourcesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -247,3 +454,31 @@ func (UnimplementedProfileServiceHandler) DeleteProfile(context.Context, *v1.Del
 func (UnimplementedProfileServiceHandler) ListProfiles(context.Context, *v1.ListProfilesRequest) (*v1.ListProfilesResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileService.ListProfiles is not implemented"))
 }
+
+func (UnimplementedProfileServiceHandler) PublishProfile(context.Context, *v1.PublishProfileRequest) (*v1.PublishProfileResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileService.PublishProfile is not implemented"))
+}
+
+func (UnimplementedProfileServiceHandler) DiscardDraft(context.Context, *v1.DiscardDraftRequest) (*v1.DiscardDraftResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileService.DiscardDraft is not implemented"))
+}
+
+func (UnimplementedProfileServiceHandler) GetDraftDiff(context.Context, *v1.GetDraftDiffRequest) (*v1.GetDraftDiffResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileService.GetDraftDiff is not implemented"))
+}
+
+func (UnimplementedProfileServiceHandler) ValidateProfile(context.Context, *v1.ValidateProfileRequest) (*v1.ValidateProfileResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileService.ValidateProfile is not implemented"))
+}
+
+func (UnimplementedProfileServiceHandler) CloneProfile(context.Context, *v1.CloneProfileRequest) (*v1.CloneProfileResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileService.CloneProfile is not implemented"))
+}
+
+func (UnimplementedProfileServiceHandler) PlanPromotion(context.Context, *v1.PlanPromotionRequest) (*v1.PlanPromotionResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileService.PlanPromotion is not implemented"))
+}
+
+func (UnimplementedProfileServiceHandler) PromoteResources(context.Context, *v1.PromoteResourcesRequest) (*v1.PromoteResourcesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileService.PromoteResources is not implemented"))
+}
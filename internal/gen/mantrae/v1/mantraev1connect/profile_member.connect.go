@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mantrae/v1/profile_member.proto
+
+package mantraev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProfileMemberServiceName is the fully-qualified name of the ProfileMemberService service.
+	ProfileMemberServiceName = "mantrae.v1.ProfileMemberService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProfileMemberServiceCreateProfileMemberProcedure is the fully-qualified name of the
+	// ProfileMemberService's CreateProfileMember RPC.
+	ProfileMemberServiceCreateProfileMemberProcedure = "/mantrae.v1.ProfileMemberService/CreateProfileMember"
+	// ProfileMemberServiceUpdateProfileMemberProcedure is the fully-qualified name of the
+	// ProfileMemberService's UpdateProfileMember RPC.
+	ProfileMemberServiceUpdateProfileMemberProcedure = "/mantrae.v1.ProfileMemberService/UpdateProfileMember"
+	// ProfileMemberServiceDeleteProfileMemberProcedure is the fully-qualified name of the
+	// ProfileMemberService's DeleteProfileMember RPC.
+	ProfileMemberServiceDeleteProfileMemberProcedure = "/mantrae.v1.ProfileMemberService/DeleteProfileMember"
+	// ProfileMemberServiceListProfileMembersProcedure is the fully-qualified name of the
+	// ProfileMemberService's ListProfileMembers RPC.
+	ProfileMemberServiceListProfileMembersProcedure = "/mantrae.v1.ProfileMemberService/ListProfileMembers"
+)
+
+// ProfileMemberServiceClient is a client for the mantrae.v1.ProfileMemberService service.
+type ProfileMemberServiceClient interface {
+	CreateProfileMember(context.Context, *v1.CreateProfileMemberRequest) (*v1.CreateProfileMemberResponse, error)
+	UpdateProfileMember(context.Context, *v1.UpdateProfileMemberRequest) (*v1.UpdateProfileMemberResponse, error)
+	DeleteProfileMember(context.Context, *v1.DeleteProfileMemberRequest) (*v1.DeleteProfileMemberResponse, error)
+	ListProfileMembers(context.Context, *v1.ListProfileMembersRequest) (*v1.ListProfileMembersResponse, error)
+}
+
+// NewProfileMemberServiceClient constructs a client for the mantrae.v1.ProfileMemberService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProfileMemberServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProfileMemberServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	profileMemberServiceMethods := v1.File_mantrae_v1_profile_member_proto.Services().ByName("ProfileMemberService").Methods()
+	return &profileMemberServiceClient{
+		createProfileMember: connect.NewClient[v1.CreateProfileMemberRequest, v1.CreateProfileMemberResponse](
+			httpClient,
+			baseURL+ProfileMemberServiceCreateProfileMemberProcedure,
+			connect.WithSchema(profileMemberServiceMethods.ByName("CreateProfileMember")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProfileMember: connect.NewClient[v1.UpdateProfileMemberRequest, v1.UpdateProfileMemberResponse](
+			httpClient,
+			baseURL+ProfileMemberServiceUpdateProfileMemberProcedure,
+			connect.WithSchema(profileMemberServiceMethods.ByName("UpdateProfileMember")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteProfileMember: connect.NewClient[v1.DeleteProfileMemberRequest, v1.DeleteProfileMemberResponse](
+			httpClient,
+			baseURL+ProfileMemberServiceDeleteProfileMemberProcedure,
+			connect.WithSchema(profileMemberServiceMethods.ByName("DeleteProfileMember")),
+			connect.WithClientOptions(opts...),
+		),
+		listProfileMembers: connect.NewClient[v1.ListProfileMembersRequest, v1.ListProfileMembersResponse](
+			httpClient,
+			baseURL+ProfileMemberServiceListProfileMembersProcedure,
+			connect.WithSchema(profileMemberServiceMethods.ByName("ListProfileMembers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// profileMemberServiceClient implements ProfileMemberServiceClient.
+type profileMemberServiceClient struct {
+	createProfileMember *connect.Client[v1.CreateProfileMemberRequest, v1.CreateProfileMemberResponse]
+	updateProfileMember *connect.Client[v1.UpdateProfileMemberRequest, v1.UpdateProfileMemberResponse]
+	deleteProfileMember *connect.Client[v1.DeleteProfileMemberRequest, v1.DeleteProfileMemberResponse]
+	listProfileMembers  *connect.Client[v1.ListProfileMembersRequest, v1.ListProfileMembersResponse]
+}
+
+// CreateProfileMember calls mantrae.v1.ProfileMemberService.CreateProfileMember.
+func (c *profileMemberServiceClient) CreateProfileMember(ctx context.Context, req *v1.CreateProfileMemberRequest) (*v1.CreateProfileMemberResponse, error) {
+	response, err := c.createProfileMember.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateProfileMember calls mantrae.v1.ProfileMemberService.UpdateProfileMember.
+func (c *profileMemberServiceClient) UpdateProfileMember(ctx context.Context, req *v1.UpdateProfileMemberRequest) (*v1.UpdateProfileMemberResponse, error) {
+	response, err := c.updateProfileMember.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteProfileMember calls mantrae.v1.ProfileMemberService.DeleteProfileMember.
+func (c *profileMemberServiceClient) DeleteProfileMember(ctx context.Context, req *v1.DeleteProfileMemberRequest) (*v1.DeleteProfileMemberResponse, error) {
+	response, err := c.deleteProfileMember.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListProfileMembers calls mantrae.v1.ProfileMemberService.ListProfileMembers.
+func (c *profileMemberServiceClient) ListProfileMembers(ctx context.Context, req *v1.ListProfileMembersRequest) (*v1.ListProfileMembersResponse, error) {
+	response, err := c.listProfileMembers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ProfileMemberServiceHandler is an implementation of the mantrae.v1.ProfileMemberService service.
+type ProfileMemberServiceHandler interface {
+	CreateProfileMember(context.Context, *v1.CreateProfileMemberRequest) (*v1.CreateProfileMemberResponse, error)
+	UpdateProfileMember(context.Context, *v1.UpdateProfileMemberRequest) (*v1.UpdateProfileMemberResponse, error)
+	DeleteProfileMember(context.Context, *v1.DeleteProfileMemberRequest) (*v1.DeleteProfileMemberResponse, error)
+	ListProfileMembers(context.Context, *v1.ListProfileMembersRequest) (*v1.ListProfileMembersResponse, error)
+}
+
+// NewProfileMemberServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProfileMemberServiceHandler(svc ProfileMemberServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	profileMemberServiceMethods := v1.File_mantrae_v1_profile_member_proto.Services().ByName("ProfileMemberService").Methods()
+	profileMemberServiceCreateProfileMemberHandler := connect.NewUnaryHandlerSimple(
+		ProfileMemberServiceCreateProfileMemberProcedure,
+		svc.CreateProfileMember,
+		connect.WithSchema(profileMemberServiceMethods.ByName("CreateProfileMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileMemberServiceUpdateProfileMemberHandler := connect.NewUnaryHandlerSimple(
+		ProfileMemberServiceUpdateProfileMemberProcedure,
+		svc.UpdateProfileMember,
+		connect.WithSchema(profileMemberServiceMethods.ByName("UpdateProfileMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileMemberServiceDeleteProfileMemberHandler := connect.NewUnaryHandlerSimple(
+		ProfileMemberServiceDeleteProfileMemberProcedure,
+		svc.DeleteProfileMember,
+		connect.WithSchema(profileMemberServiceMethods.ByName("DeleteProfileMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileMemberServiceListProfileMembersHandler := connect.NewUnaryHandlerSimple(
+		ProfileMemberServiceListProfileMembersProcedure,
+		svc.ListProfileMembers,
+		connect.WithSchema(profileMemberServiceMethods.ByName("ListProfileMembers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mantrae.v1.ProfileMemberService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProfileMemberServiceCreateProfileMemberProcedure:
+			profileMemberServiceCreateProfileMemberHandler.ServeHTTP(w, r)
+		case ProfileMemberServiceUpdateProfileMemberProcedure:
+			profileMemberServiceUpdateProfileMemberHandler.ServeHTTP(w, r)
+		case ProfileMemberServiceDeleteProfileMemberProcedure:
+			profileMemberServiceDeleteProfileMemberHandler.ServeHTTP(w, r)
+		case ProfileMemberServiceListProfileMembersProcedure:
+			profileMemberServiceListProfileMembersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProfileMemberServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProfileMemberServiceHandler struct{}
+
+func (UnimplementedProfileMemberServiceHandler) CreateProfileMember(context.Context, *v1.CreateProfileMemberRequest) (*v1.CreateProfileMemberResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileMemberService.CreateProfileMember is not implemented"))
+}
+
+func (UnimplementedProfileMemberServiceHandler) UpdateProfileMember(context.Context, *v1.UpdateProfileMemberRequest) (*v1.UpdateProfileMemberResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileMemberService.UpdateProfileMember is not implemented"))
+}
+
+func (UnimplementedProfileMemberServiceHandler) DeleteProfileMember(context.Context, *v1.DeleteProfileMemberRequest) (*v1.DeleteProfileMemberResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileMemberService.DeleteProfileMember is not implemented"))
+}
+
+func (UnimplementedProfileMemberServiceHandler) ListProfileMembers(context.Context, *v1.ListProfileMembersRequest) (*v1.ListProfileMembersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileMemberService.ListProfileMembers is not implemented"))
+}
@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mantrae/v1/profile_variable.proto
+
+package mantraev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProfileVariableServiceName is the fully-qualified name of the ProfileVariableService service.
+	ProfileVariableServiceName = "mantrae.v1.ProfileVariableService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProfileVariableServiceGetProfileVariableProcedure is the fully-qualified name of the
+	// ProfileVariableService's GetProfileVariable RPC.
+	ProfileVariableServiceGetProfileVariableProcedure = "/mantrae.v1.ProfileVariableService/GetProfileVariable"
+	// ProfileVariableServiceCreateProfileVariableProcedure is the fully-qualified name of the
+	// ProfileVariableService's CreateProfileVariable RPC.
+	ProfileVariableServiceCreateProfileVariableProcedure = "/mantrae.v1.ProfileVariableService/CreateProfileVariable"
+	// ProfileVariableServiceUpdateProfileVariableProcedure is the fully-qualified name of the
+	// ProfileVariableService's UpdateProfileVariable RPC.
+	ProfileVariableServiceUpdateProfileVariableProcedure = "/mantrae.v1.ProfileVariableService/UpdateProfileVariable"
+	// ProfileVariableServiceDeleteProfileVariableProcedure is the fully-qualified name of the
+	// ProfileVariableService's DeleteProfileVariable RPC.
+	ProfileVariableServiceDeleteProfileVariableProcedure = "/mantrae.v1.ProfileVariableService/DeleteProfileVariable"
+	// ProfileVariableServiceListProfileVariablesProcedure is the fully-qualified name of the
+	// ProfileVariableService's ListProfileVariables RPC.
+	ProfileVariableServiceListProfileVariablesProcedure = "/mantrae.v1.ProfileVariableService/ListProfileVariables"
+)
+
+// ProfileVariableServiceClient is a client for the mantrae.v1.ProfileVariableService service.
+type ProfileVariableServiceClient interface {
+	GetProfileVariable(context.Context, *v1.GetProfileVariableRequest) (*v1.GetProfileVariableResponse, error)
+	CreateProfileVariable(context.Context, *v1.CreateProfileVariableRequest) (*v1.CreateProfileVariableResponse, error)
+	UpdateProfileVariable(context.Context, *v1.UpdateProfileVariableRequest) (*v1.UpdateProfileVariableResponse, error)
+	DeleteProfileVariable(context.Context, *v1.DeleteProfileVariableRequest) (*v1.DeleteProfileVariableResponse, error)
+	ListProfileVariables(context.Context, *v1.ListProfileVariablesRequest) (*v1.ListProfileVariablesResponse, error)
+}
+
+// NewProfileVariableServiceClient constructs a client for the mantrae.v1.ProfileVariableService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProfileVariableServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProfileVariableServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	profileVariableServiceMethods := v1.File_mantrae_v1_profile_variable_proto.Services().ByName("ProfileVariableService").Methods()
+	return &profileVariableServiceClient{
+		getProfileVariable: connect.NewClient[v1.GetProfileVariableRequest, v1.GetProfileVariableResponse](
+			httpClient,
+			baseURL+ProfileVariableServiceGetProfileVariableProcedure,
+			connect.WithSchema(profileVariableServiceMethods.ByName("GetProfileVariable")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createProfileVariable: connect.NewClient[v1.CreateProfileVariableRequest, v1.CreateProfileVariableResponse](
+			httpClient,
+			baseURL+ProfileVariableServiceCreateProfileVariableProcedure,
+			connect.WithSchema(profileVariableServiceMethods.ByName("CreateProfileVariable")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProfileVariable: connect.NewClient[v1.UpdateProfileVariableRequest, v1.UpdateProfileVariableResponse](
+			httpClient,
+			baseURL+ProfileVariableServiceUpdateProfileVariableProcedure,
+			connect.WithSchema(profileVariableServiceMethods.ByName("UpdateProfileVariable")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteProfileVariable: connect.NewClient[v1.DeleteProfileVariableRequest, v1.DeleteProfileVariableResponse](
+			httpClient,
+			baseURL+ProfileVariableServiceDeleteProfileVariableProcedure,
+			connect.WithSchema(profileVariableServiceMethods.ByName("DeleteProfileVariable")),
+			connect.WithClientOptions(opts...),
+		),
+		listProfileVariables: connect.NewClient[v1.ListProfileVariablesRequest, v1.ListProfileVariablesResponse](
+			httpClient,
+			baseURL+ProfileVariableServiceListProfileVariablesProcedure,
+			connect.WithSchema(profileVariableServiceMethods.ByName("ListProfileVariables")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// profileVariableServiceClient implements ProfileVariableServiceClient.
+type profileVariableServiceClient struct {
+	getProfileVariable    *connect.Client[v1.GetProfileVariableRequest, v1.GetProfileVariableResponse]
+	createProfileVariable *connect.Client[v1.CreateProfileVariableRequest, v1.CreateProfileVariableResponse]
+	updateProfileVariable *connect.Client[v1.UpdateProfileVariableRequest, v1.UpdateProfileVariableResponse]
+	deleteProfileVariable *connect.Client[v1.DeleteProfileVariableRequest, v1.DeleteProfileVariableResponse]
+	listProfileVariables  *connect.Client[v1.ListProfileVariablesRequest, v1.ListProfileVariablesResponse]
+}
+
+// GetProfileVariable calls mantrae.v1.ProfileVariableService.GetProfileVariable.
+func (c *profileVariableServiceClient) GetProfileVariable(ctx context.Context, req *v1.GetProfileVariableRequest) (*v1.GetProfileVariableResponse, error) {
+	response, err := c.getProfileVariable.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateProfileVariable calls mantrae.v1.ProfileVariableService.CreateProfileVariable.
+func (c *profileVariableServiceClient) CreateProfileVariable(ctx context.Context, req *v1.CreateProfileVariableRequest) (*v1.CreateProfileVariableResponse, error) {
+	response, err := c.createProfileVariable.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateProfileVariable calls mantrae.v1.ProfileVariableService.UpdateProfileVariable.
+func (c *profileVariableServiceClient) UpdateProfileVariable(ctx context.Context, req *v1.UpdateProfileVariableRequest) (*v1.UpdateProfileVariableResponse, error) {
+	response, err := c.updateProfileVariable.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteProfileVariable calls mantrae.v1.ProfileVariableService.DeleteProfileVariable.
+func (c *profileVariableServiceClient) DeleteProfileVariable(ctx context.Context, req *v1.DeleteProfileVariableRequest) (*v1.DeleteProfileVariableResponse, error) {
+	response, err := c.deleteProfileVariable.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListProfileVariables calls mantrae.v1.ProfileVariableService.ListProfileVariables.
+func (c *profileVariableServiceClient) ListProfileVariables(ctx context.Context, req *v1.ListProfileVariablesRequest) (*v1.ListProfileVariablesResponse, error) {
+	response, err := c.listProfileVariables.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ProfileVariableServiceHandler is an implementation of the mantrae.v1.ProfileVariableService
+// service.
+type ProfileVariableServiceHandler interface {
+	GetProfileVariable(context.Context, *v1.GetProfileVariableRequest) (*v1.GetProfileVariableResponse, error)
+	CreateProfileVariable(context.Context, *v1.CreateProfileVariableRequest) (*v1.CreateProfileVariableResponse, error)
+	UpdateProfileVariable(context.Context, *v1.UpdateProfileVariableRequest) (*v1.UpdateProfileVariableResponse, error)
+	DeleteProfileVariable(context.Context, *v1.DeleteProfileVariableRequest) (*v1.DeleteProfileVariableResponse, error)
+	ListProfileVariables(context.Context, *v1.ListProfileVariablesRequest) (*v1.ListProfileVariablesResponse, error)
+}
+
+// NewProfileVariableServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProfileVariableServiceHandler(svc ProfileVariableServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	profileVariableServiceMethods := v1.File_mantrae_v1_profile_variable_proto.Services().ByName("ProfileVariableService").Methods()
+	profileVariableServiceGetProfileVariableHandler := connect.NewUnaryHandlerSimple(
+		ProfileVariableServiceGetProfileVariableProcedure,
+		svc.GetProfileVariable,
+		connect.WithSchema(profileVariableServiceMethods.ByName("GetProfileVariable")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileVariableServiceCreateProfileVariableHandler := connect.NewUnaryHandlerSimple(
+		ProfileVariableServiceCreateProfileVariableProcedure,
+		svc.CreateProfileVariable,
+		connect.WithSchema(profileVariableServiceMethods.ByName("CreateProfileVariable")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileVariableServiceUpdateProfileVariableHandler := connect.NewUnaryHandlerSimple(
+		ProfileVariableServiceUpdateProfileVariableProcedure,
+		svc.UpdateProfileVariable,
+		connect.WithSchema(profileVariableServiceMethods.ByName("UpdateProfileVariable")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileVariableServiceDeleteProfileVariableHandler := connect.NewUnaryHandlerSimple(
+		ProfileVariableServiceDeleteProfileVariableProcedure,
+		svc.DeleteProfileVariable,
+		connect.WithSchema(profileVariableServiceMethods.ByName("DeleteProfileVariable")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileVariableServiceListProfileVariablesHandler := connect.NewUnaryHandlerSimple(
+		ProfileVariableServiceListProfileVariablesProcedure,
+		svc.ListProfileVariables,
+		connect.WithSchema(profileVariableServiceMethods.ByName("ListProfileVariables")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mantrae.v1.ProfileVariableService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProfileVariableServiceGetProfileVariableProcedure:
+			profileVariableServiceGetProfileVariableHandler.ServeHTTP(w, r)
+		case ProfileVariableServiceCreateProfileVariableProcedure:
+			profileVariableServiceCreateProfileVariableHandler.ServeHTTP(w, r)
+		case ProfileVariableServiceUpdateProfileVariableProcedure:
+			profileVariableServiceUpdateProfileVariableHandler.ServeHTTP(w, r)
+		case ProfileVariableServiceDeleteProfileVariableProcedure:
+			profileVariableServiceDeleteProfileVariableHandler.ServeHTTP(w, r)
+		case ProfileVariableServiceListProfileVariablesProcedure:
+			profileVariableServiceListProfileVariablesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProfileVariableServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProfileVariableServiceHandler struct{}
+
+func (UnimplementedProfileVariableServiceHandler) GetProfileVariable(context.Context, *v1.GetProfileVariableRequest) (*v1.GetProfileVariableResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileVariableService.GetProfileVariable is not implemented"))
+}
+
+func (UnimplementedProfileVariableServiceHandler) CreateProfileVariable(context.Context, *v1.CreateProfileVariableRequest) (*v1.CreateProfileVariableResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileVariableService.CreateProfileVariable is not implemented"))
+}
+
+func (UnimplementedProfileVariableServiceHandler) UpdateProfileVariable(context.Context, *v1.UpdateProfileVariableRequest) (*v1.UpdateProfileVariableResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileVariableService.UpdateProfileVariable is not implemented"))
+}
+
+func (UnimplementedProfileVariableServiceHandler) DeleteProfileVariable(context.Context, *v1.DeleteProfileVariableRequest) (*v1.DeleteProfileVariableResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileVariableService.DeleteProfileVariable is not implemented"))
+}
+
+func (UnimplementedProfileVariableServiceHandler) ListProfileVariables(context.Context, *v1.ListProfileVariablesRequest) (*v1.ListProfileVariablesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ProfileVariableService.ListProfileVariables is not implemented"))
+}
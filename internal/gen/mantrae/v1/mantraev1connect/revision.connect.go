@@ -0,0 +1,218 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mantrae/v1/revision.proto
+
+package mantraev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RevisionServiceName is the fully-qualified name of the RevisionService service.
+	RevisionServiceName = "mantrae.v1.RevisionService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RevisionServiceListRevisionsProcedure is the fully-qualified name of the RevisionService's
+	// ListRevisions RPC.
+	RevisionServiceListRevisionsProcedure = "/mantrae.v1.RevisionService/ListRevisions"
+	// RevisionServiceGetRevisionProcedure is the fully-qualified name of the RevisionService's
+	// GetRevision RPC.
+	RevisionServiceGetRevisionProcedure = "/mantrae.v1.RevisionService/GetRevision"
+	// RevisionServiceDiffRevisionsProcedure is the fully-qualified name of the RevisionService's
+	// DiffRevisions RPC.
+	RevisionServiceDiffRevisionsProcedure = "/mantrae.v1.RevisionService/DiffRevisions"
+	// RevisionServiceRollbackRevisionProcedure is the fully-qualified name of the RevisionService's
+	// RollbackRevision RPC.
+	RevisionServiceRollbackRevisionProcedure = "/mantrae.v1.RevisionService/RollbackRevision"
+)
+
+// RevisionServiceClient is a client for the mantrae.v1.RevisionService service.
+type RevisionServiceClient interface {
+	ListRevisions(context.Context, *v1.ListRevisionsRequest) (*v1.ListRevisionsResponse, error)
+	GetRevision(context.Context, *v1.GetRevisionRequest) (*v1.GetRevisionResponse, error)
+	DiffRevisions(context.Context, *v1.DiffRevisionsRequest) (*v1.DiffRevisionsResponse, error)
+	RollbackRevision(context.Context, *v1.RollbackRevisionRequest) (*v1.RollbackRevisionResponse, error)
+}
+
+// NewRevisionServiceClient constructs a client for the mantrae.v1.RevisionService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRevisionServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RevisionServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	revisionServiceMethods := v1.File_mantrae_v1_revision_proto.Services().ByName("RevisionService").Methods()
+	return &revisionServiceClient{
+		listRevisions: connect.NewClient[v1.ListRevisionsRequest, v1.ListRevisionsResponse](
+			httpClient,
+			baseURL+RevisionServiceListRevisionsProcedure,
+			connect.WithSchema(revisionServiceMethods.ByName("ListRevisions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getRevision: connect.NewClient[v1.GetRevisionRequest, v1.GetRevisionResponse](
+			httpClient,
+			baseURL+RevisionServiceGetRevisionProcedure,
+			connect.WithSchema(revisionServiceMethods.ByName("GetRevision")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		diffRevisions: connect.NewClient[v1.DiffRevisionsRequest, v1.DiffRevisionsResponse](
+			httpClient,
+			baseURL+RevisionServiceDiffRevisionsProcedure,
+			connect.WithSchema(revisionServiceMethods.ByName("DiffRevisions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackRevision: connect.NewClient[v1.RollbackRevisionRequest, v1.RollbackRevisionResponse](
+			httpClient,
+			baseURL+RevisionServiceRollbackRevisionProcedure,
+			connect.WithSchema(revisionServiceMethods.ByName("RollbackRevision")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// revisionServiceClient implements RevisionServiceClient.
+type revisionServiceClient struct {
+	listRevisions    *connect.Client[v1.ListRevisionsRequest, v1.ListRevisionsResponse]
+	getRevision      *connect.Client[v1.GetRevisionRequest, v1.GetRevisionResponse]
+	diffRevisions    *connect.Client[v1.DiffRevisionsRequest, v1.DiffRevisionsResponse]
+	rollbackRevision *connect.Client[v1.RollbackRevisionRequest, v1.RollbackRevisionResponse]
+}
+
+// ListRevisions calls mantrae.v1.RevisionService.ListRevisions.
+func (c *revisionServiceClient) ListRevisions(ctx context.Context, req *v1.ListRevisionsRequest) (*v1.ListRevisionsResponse, error) {
+	response, err := c.listRevisions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetRevision calls mantrae.v1.RevisionService.GetRevision.
+func (c *revisionServiceClient) GetRevision(ctx context.Context, req *v1.GetRevisionRequest) (*v1.GetRevisionResponse, error) {
+	response, err := c.getRevision.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DiffRevisions calls mantrae.v1.RevisionService.DiffRevisions.
+func (c *revisionServiceClient) DiffRevisions(ctx context.Context, req *v1.DiffRevisionsRequest) (*v1.DiffRevisionsResponse, error) {
+	response, err := c.diffRevisions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RollbackRevision calls mantrae.v1.RevisionService.RollbackRevision.
+func (c *revisionServiceClient) RollbackRevision(ctx context.Context, req *v1.RollbackRevisionRequest) (*v1.RollbackRevisionResponse, error) {
+	response, err := c.rollbackRevision.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevisionServiceHandler is an implementation of the mantrae.v1.RevisionService service.
+type RevisionServiceHandler interface {
+	ListRevisions(context.Context, *v1.ListRevisionsRequest) (*v1.ListRevisionsResponse, error)
+	GetRevision(context.Context, *v1.GetRevisionRequest) (*v1.GetRevisionResponse, error)
+	DiffRevisions(context.Context, *v1.DiffRevisionsRequest) (*v1.DiffRevisionsResponse, error)
+	RollbackRevision(context.Context, *v1.RollbackRevisionRequest) (*v1.RollbackRevisionResponse, error)
+}
+
+// NewRevisionServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRevisionServiceHandler(svc RevisionServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	revisionServiceMethods := v1.File_mantrae_v1_revision_proto.Services().ByName("RevisionService").Methods()
+	revisionServiceListRevisionsHandler := connect.NewUnaryHandlerSimple(
+		RevisionServiceListRevisionsProcedure,
+		svc.ListRevisions,
+		connect.WithSchema(revisionServiceMethods.ByName("ListRevisions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	revisionServiceGetRevisionHandler := connect.NewUnaryHandlerSimple(
+		RevisionServiceGetRevisionProcedure,
+		svc.GetRevision,
+		connect.WithSchema(revisionServiceMethods.ByName("GetRevision")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	revisionServiceDiffRevisionsHandler := connect.NewUnaryHandlerSimple(
+		RevisionServiceDiffRevisionsProcedure,
+		svc.DiffRevisions,
+		connect.WithSchema(revisionServiceMethods.ByName("DiffRevisions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	revisionServiceRollbackRevisionHandler := connect.NewUnaryHandlerSimple(
+		RevisionServiceRollbackRevisionProcedure,
+		svc.RollbackRevision,
+		connect.WithSchema(revisionServiceMethods.ByName("RollbackRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mantrae.v1.RevisionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RevisionServiceListRevisionsProcedure:
+			revisionServiceListRevisionsHandler.ServeHTTP(w, r)
+		case RevisionServiceGetRevisionProcedure:
+			revisionServiceGetRevisionHandler.ServeHTTP(w, r)
+		case RevisionServiceDiffRevisionsProcedure:
+			revisionServiceDiffRevisionsHandler.ServeHTTP(w, r)
+		case RevisionServiceRollbackRevisionProcedure:
+			revisionServiceRollbackRevisionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRevisionServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRevisionServiceHandler struct{}
+
+func (UnimplementedRevisionServiceHandler) ListRevisions(context.Context, *v1.ListRevisionsRequest) (*v1.ListRevisionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.RevisionService.ListRevisions is not implemented"))
+}
+
+func (UnimplementedRevisionServiceHandler) GetRevision(context.Context, *v1.GetRevisionRequest) (*v1.GetRevisionResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.RevisionService.GetRevision is not implemented"))
+}
+
+func (UnimplementedRevisionServiceHandler) DiffRevisions(context.Context, *v1.DiffRevisionsRequest) (*v1.DiffRevisionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.RevisionService.DiffRevisions is not implemented"))
+}
+
+func (UnimplementedRevisionServiceHandler) RollbackRevision(context.Context, *v1.RollbackRevisionRequest) (*v1.RollbackRevisionResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.RevisionService.RollbackRevision is not implemented"))
+}
@@ -47,6 +47,12 @@ const (
 	// RouterServiceListRoutersProcedure is the fully-qualified name of the RouterService's ListRouters
 	// RPC.
 	RouterServiceListRoutersProcedure = "/mantrae.v1.RouterService/ListRouters"
+	// RouterServiceListRouteConflictsProcedure is the fully-qualified name of the RouterService's
+	// ListRouteConflicts RPC.
+	RouterServiceListRouteConflictsProcedure = "/mantrae.v1.RouterService/ListRouteConflicts"
+	// RouterServiceSimulateRouteProcedure is the fully-qualified name of the RouterService's
+	// SimulateRoute RPC.
+	RouterServiceSimulateRouteProcedure = "/mantrae.v1.RouterService/SimulateRoute"
 )
 
 // RouterServiceClient is a client for the mantrae.v1.RouterService service.
@@ -56,6 +62,8 @@ type RouterServiceClient interface {
 	UpdateRouter(context.Context, *v1.UpdateRouterRequest) (*v1.UpdateRouterResponse, error)
 	DeleteRouter(context.Context, *v1.DeleteRouterRequest) (*v1.DeleteRouterResponse, error)
 	ListRouters(context.Context, *v1.ListRoutersRequest) (*v1.ListRoutersResponse, error)
+	ListRouteConflicts(context.Context, *v1.ListRouteConflictsRequest) (*v1.ListRouteConflictsResponse, error)
+	SimulateRoute(context.Context, *v1.SimulateRouteRequest) (*v1.SimulateRouteResponse, error)
 }
 
 // NewRouterServiceClient constructs a client for the mantrae.v1.RouterService service. By default,
@@ -101,16 +109,32 @@ func NewRouterServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		listRouteConflicts: connect.NewClient[v1.ListRouteConflictsRequest, v1.ListRouteConflictsResponse](
+			httpClient,
+			baseURL+RouterServiceListRouteConflictsProcedure,
+			connect.WithSchema(routerServiceMethods.ByName("ListRouteConflicts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		simulateRoute: connect.NewClient[v1.SimulateRouteRequest, v1.SimulateRouteResponse](
+			httpClient,
+			baseURL+RouterServiceSimulateRouteProcedure,
+			connect.WithSchema(routerServiceMethods.ByName("SimulateRoute")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // routerServiceClient implements RouterServiceClient.
 type routerServiceClient struct {
-	getRouter    *connect.Client[v1.GetRouterRequest, v1.GetRouterResponse]
-	createRouter *connect.Client[v1.CreateRouterRequest, v1.CreateRouterResponse]
-	updateRouter *connect.Client[v1.UpdateRouterRequest, v1.UpdateRouterResponse]
-	deleteRouter *connect.Client[v1.DeleteRouterRequest, v1.DeleteRouterResponse]
-	listRouters  *connect.Client[v1.ListRoutersRequest, v1.ListRoutersResponse]
+	getRouter          *connect.Client[v1.GetRouterRequest, v1.GetRouterResponse]
+	createRouter       *connect.Client[v1.CreateRouterRequest, v1.CreateRouterResponse]
+	updateRouter       *connect.Client[v1.UpdateRouterRequest, v1.UpdateRouterResponse]
+	deleteRouter       *connect.Client[v1.DeleteRouterRequest, v1.DeleteRouterResponse]
+	listRouters        *connect.Client[v1.ListRoutersRequest, v1.ListRoutersResponse]
+	listRouteConflicts *connect.Client[v1.ListRouteConflictsRequest, v1.ListRouteConflictsResponse]
+	simulateRoute      *connect.Client[v1.SimulateRouteRequest, v1.SimulateRouteResponse]
 }
 
 // GetRouter calls mantrae.v1.RouterService.GetRouter.
@@ -158,6 +182,24 @@ func (c *routerServiceClient) ListRouters(ctx context.Context, req *v1.ListRoute
 	return nil, err
 }
 
+// ListRouteConflicts calls mantrae.v1.RouterService.ListRouteConflicts.
+func (c *routerServiceClient) ListRouteConflicts(ctx context.Context, req *v1.ListRouteConflictsRequest) (*v1.ListRouteConflictsResponse, error) {
+	response, err := c.listRouteConflicts.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SimulateRoute calls mantrae.v1.RouterService.SimulateRoute.
+func (c *routerServiceClient) SimulateRoute(ctx context.Context, req *v1.SimulateRouteRequest) (*v1.SimulateRouteResponse, error) {
+	response, err := c.simulateRoute.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // RouterServiceHandler is an implementation of the mantrae.v1.RouterService service.
 type RouterServiceHandler interface {
 	GetRouter(context.Context, *v1.GetRouterRequest) (*v1.GetRouterResponse, error)
@@ -165,6 +207,8 @@ type RouterServiceHandler interface {
 	UpdateRouter(context.Context, *v1.UpdateRouterRequest) (*v1.UpdateRouterResponse, error)
 	DeleteRouter(context.Context, *v1.DeleteRouterRequest) (*v1.DeleteRouterResponse, error)
 	ListRouters(context.Context, *v1.ListRoutersRequest) (*v1.ListRoutersResponse, error)
+	ListRouteConflicts(context.Context, *v1.ListRouteConflictsRequest) (*v1.ListRouteConflictsResponse, error)
+	SimulateRoute(context.Context, *v1.SimulateRouteRequest) (*v1.SimulateRouteResponse, error)
 }
 
 // NewRouterServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -206,6 +250,20 @@ func NewRouterServiceHandler(svc RouterServiceHandler, opts ...connect.HandlerOp
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	routerServiceListRouteConflictsHandler := connect.NewUnaryHandlerSimple(
+		RouterServiceListRouteConflictsProcedure,
+		svc.ListRouteConflicts,
+		connect.WithSchema(routerServiceMethods.ByName("ListRouteConflicts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	routerServiceSimulateRouteHandler := connect.NewUnaryHandlerSimple(
+		RouterServiceSimulateRouteProcedure,
+		svc.SimulateRoute,
+		connect.WithSchema(routerServiceMethods.ByName("SimulateRoute")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mantrae.v1.RouterService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RouterServiceGetRouterProcedure:
@@ -218,6 +276,10 @@ func NewRouterServiceHandler(svc RouterServiceHandler, opts ...connect.HandlerOp
 			routerServiceDeleteRouterHandler.ServeHTTP(w, r)
 		case RouterServiceListRoutersProcedure:
 			routerServiceListRoutersHandler.ServeHTTP(w, r)
+		case RouterServiceListRouteConflictsProcedure:
+			routerServiceListRouteConflictsHandler.ServeHTTP(w, r)
+		case RouterServiceSimulateRouteProcedure:
+			routerServiceSimulateRouteHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -246,3 +308,11 @@ func (UnimplementedRouterServiceHandler) DeleteRouter(context.Context, *v1.Delet
 func (UnimplementedRouterServiceHandler) ListRouters(context.Context, *v1.ListRoutersRequest) (*v1.ListRoutersResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.RouterService.ListRouters is not implemented"))
 }
+
+func (UnimplementedRouterServiceHandler) ListRouteConflicts(context.Context, *v1.ListRouteConflictsRequest) (*v1.ListRouteConflictsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.RouterService.ListRouteConflicts is not implemented"))
+}
+
+func (UnimplementedRouterServiceHandler) SimulateRoute(context.Context, *v1.SimulateRouteRequest) (*v1.SimulateRouteResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.RouterService.SimulateRoute is not implemented"))
+}
@@ -48,6 +48,12 @@ const (
 	// ServiceServiceListServicesProcedure is the fully-qualified name of the ServiceService's
 	// ListServices RPC.
 	ServiceServiceListServicesProcedure = "/mantrae.v1.ServiceService/ListServices"
+	// ServiceServiceListServiceHealthProcedure is the fully-qualified name of the ServiceService's
+	// ListServiceHealth RPC.
+	ServiceServiceListServiceHealthProcedure = "/mantrae.v1.ServiceService/ListServiceHealth"
+	// ServiceServiceListServiceHealthHistoryProcedure is the fully-qualified name of the
+	// ServiceService's ListServiceHealthHistory RPC.
+	ServiceServiceListServiceHealthHistoryProcedure = "/mantrae.v1.ServiceService/ListServiceHealthHistory"
 )
 
 // ServiceServiceClient is a client for the mantrae.v1.ServiceService service.
@@ -57,6 +63,8 @@ type ServiceServiceClient interface {
 	UpdateService(context.Context, *v1.UpdateServiceRequest) (*v1.UpdateServiceResponse, error)
 	DeleteService(context.Context, *v1.DeleteServiceRequest) (*v1.DeleteServiceResponse, error)
 	ListServices(context.Context, *v1.ListServicesRequest) (*v1.ListServicesResponse, error)
+	ListServiceHealth(context.Context, *v1.ListServiceHealthRequest) (*v1.ListServiceHealthResponse, error)
+	ListServiceHealthHistory(context.Context, *v1.ListServiceHealthHistoryRequest) (*v1.ListServiceHealthHistoryResponse, error)
 }
 
 // NewServiceServiceClient constructs a client for the mantrae.v1.ServiceService service. By
@@ -102,16 +110,32 @@ func NewServiceServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		listServiceHealth: connect.NewClient[v1.ListServiceHealthRequest, v1.ListServiceHealthResponse](
+			httpClient,
+			baseURL+ServiceServiceListServiceHealthProcedure,
+			connect.WithSchema(serviceServiceMethods.ByName("ListServiceHealth")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listServiceHealthHistory: connect.NewClient[v1.ListServiceHealthHistoryRequest, v1.ListServiceHealthHistoryResponse](
+			httpClient,
+			baseURL+ServiceServiceListServiceHealthHistoryProcedure,
+			connect.WithSchema(serviceServiceMethods.ByName("ListServiceHealthHistory")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // serviceServiceClient implements ServiceServiceClient.
 type serviceServiceClient struct {
-	getService    *connect.Client[v1.GetServiceRequest, v1.GetServiceResponse]
-	createService *connect.Client[v1.CreateServiceRequest, v1.CreateServiceResponse]
-	updateService *connect.Client[v1.UpdateServiceRequest, v1.UpdateServiceResponse]
-	deleteService *connect.Client[v1.DeleteServiceRequest, v1.DeleteServiceResponse]
-	listServices  *connect.Client[v1.ListServicesRequest, v1.ListServicesResponse]
+	getService               *connect.Client[v1.GetServiceRequest, v1.GetServiceResponse]
+	createService            *connect.Client[v1.CreateServiceRequest, v1.CreateServiceResponse]
+	updateService            *connect.Client[v1.UpdateServiceRequest, v1.UpdateServiceResponse]
+	deleteService            *connect.Client[v1.DeleteServiceRequest, v1.DeleteServiceResponse]
+	listServices             *connect.Client[v1.ListServicesRequest, v1.ListServicesResponse]
+	listServiceHealth        *connect.Client[v1.ListServiceHealthRequest, v1.ListServiceHealthResponse]
+	listServiceHealthHistory *connect.Client[v1.ListServiceHealthHistoryRequest, v1.ListServiceHealthHistoryResponse]
 }
 
 // GetService calls mantrae.v1.ServiceService.GetService.
@@ -159,6 +183,24 @@ func (c *serviceServiceClient) ListServices(ctx context.Context, req *v1.ListSer
 	return nil, err
 }
 
+// ListServiceHealth calls mantrae.v1.ServiceService.ListServiceHealth.
+func (c *serviceServiceClient) ListServiceHealth(ctx context.Context, req *v1.ListServiceHealthRequest) (*v1.ListServiceHealthResponse, error) {
+	response, err := c.listServiceHealth.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListServiceHealthHistory calls mantrae.v1.ServiceService.ListServiceHealthHistory.
+func (c *serviceServiceClient) ListServiceHealthHistory(ctx context.Context, req *v1.ListServiceHealthHistoryRequest) (*v1.ListServiceHealthHistoryResponse, error) {
+	response, err := c.listServiceHealthHistory.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ServiceServiceHandler is an implementation of the mantrae.v1.ServiceService service.
 type ServiceServiceHandler interface {
 	GetService(context.Context, *v1.GetServiceRequest) (*v1.GetServiceResponse, error)
@@ -166,6 +208,8 @@ type ServiceServiceHandler interface {
 	UpdateService(context.Context, *v1.UpdateServiceRequest) (*v1.UpdateServiceResponse, error)
 	DeleteService(context.Context, *v1.DeleteServiceRequest) (*v1.DeleteServiceResponse, error)
 	ListServices(context.Context, *v1.ListServicesRequest) (*v1.ListServicesResponse, error)
+	ListServiceHealth(context.Context, *v1.ListServiceHealthRequest) (*v1.ListServiceHealthResponse, error)
+	ListServiceHealthHistory(context.Context, *v1.ListServiceHealthHistoryRequest) (*v1.ListServiceHealthHistoryResponse, error)
 }
 
 // NewServiceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -207,6 +251,20 @@ func NewServiceServiceHandler(svc ServiceServiceHandler, opts ...connect.Handler
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	serviceServiceListServiceHealthHandler := connect.NewUnaryHandlerSimple(
+		ServiceServiceListServiceHealthProcedure,
+		svc.ListServiceHealth,
+		connect.WithSchema(serviceServiceMethods.ByName("ListServiceHealth")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceServiceListServiceHealthHistoryHandler := connect.NewUnaryHandlerSimple(
+		ServiceServiceListServiceHealthHistoryProcedure,
+		svc.ListServiceHealthHistory,
+		connect.WithSchema(serviceServiceMethods.ByName("ListServiceHealthHistory")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mantrae.v1.ServiceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ServiceServiceGetServiceProcedure:
@@ -219,6 +277,10 @@ func NewServiceServiceHandler(svc ServiceServiceHandler, opts ...connect.Handler
 			serviceServiceDeleteServiceHandler.ServeHTTP(w, r)
 		case ServiceServiceListServicesProcedure:
 			serviceServiceListServicesHandler.ServeHTTP(w, r)
+		case ServiceServiceListServiceHealthProcedure:
+			serviceServiceListServiceHealthHandler.ServeHTTP(w, r)
+		case ServiceServiceListServiceHealthHistoryProcedure:
+			serviceServiceListServiceHealthHistoryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -247,3 +309,11 @@ func (UnimplementedServiceServiceHandler) DeleteService(context.Context, *v1.Del
 func (UnimplementedServiceServiceHandler) ListServices(context.Context, *v1.ListServicesRequest) (*v1.ListServicesResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ServiceService.ListServices is not implemented"))
 }
+
+func (UnimplementedServiceServiceHandler) ListServiceHealth(context.Context, *v1.ListServiceHealthRequest) (*v1.ListServiceHealthResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ServiceService.ListServiceHealth is not implemented"))
+}
+
+func (UnimplementedServiceServiceHandler) ListServiceHealthHistory(context.Context, *v1.ListServiceHealthHistoryRequest) (*v1.ListServiceHealthHistoryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.ServiceService.ListServiceHealthHistory is not implemented"))
+}
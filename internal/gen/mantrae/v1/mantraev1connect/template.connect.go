@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mantrae/v1/template.proto
+
+package mantraev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TemplateServiceName is the fully-qualified name of the TemplateService service.
+	TemplateServiceName = "mantrae.v1.TemplateService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TemplateServiceListTemplatesProcedure is the fully-qualified name of the TemplateService's
+	// ListTemplates RPC.
+	TemplateServiceListTemplatesProcedure = "/mantrae.v1.TemplateService/ListTemplates"
+	// TemplateServiceGetTemplateProcedure is the fully-qualified name of the TemplateService's
+	// GetTemplate RPC.
+	TemplateServiceGetTemplateProcedure = "/mantrae.v1.TemplateService/GetTemplate"
+	// TemplateServiceCreateTemplateProcedure is the fully-qualified name of the TemplateService's
+	// CreateTemplate RPC.
+	TemplateServiceCreateTemplateProcedure = "/mantrae.v1.TemplateService/CreateTemplate"
+	// TemplateServiceUpdateTemplateProcedure is the fully-qualified name of the TemplateService's
+	// UpdateTemplate RPC.
+	TemplateServiceUpdateTemplateProcedure = "/mantrae.v1.TemplateService/UpdateTemplate"
+	// TemplateServiceDeleteTemplateProcedure is the fully-qualified name of the TemplateService's
+	// DeleteTemplate RPC.
+	TemplateServiceDeleteTemplateProcedure = "/mantrae.v1.TemplateService/DeleteTemplate"
+	// TemplateServicePreviewTemplateProcedure is the fully-qualified name of the TemplateService's
+	// PreviewTemplate RPC.
+	TemplateServicePreviewTemplateProcedure = "/mantrae.v1.TemplateService/PreviewTemplate"
+	// TemplateServiceInstantiateTemplateProcedure is the fully-qualified name of the TemplateService's
+	// InstantiateTemplate RPC.
+	TemplateServiceInstantiateTemplateProcedure = "/mantrae.v1.TemplateService/InstantiateTemplate"
+)
+
+// TemplateServiceClient is a client for the mantrae.v1.TemplateService service.
+type TemplateServiceClient interface {
+	ListTemplates(context.Context, *v1.ListTemplatesRequest) (*v1.ListTemplatesResponse, error)
+	GetTemplate(context.Context, *v1.GetTemplateRequest) (*v1.GetTemplateResponse, error)
+	CreateTemplate(context.Context, *v1.CreateTemplateRequest) (*v1.CreateTemplateResponse, error)
+	UpdateTemplate(context.Context, *v1.UpdateTemplateRequest) (*v1.UpdateTemplateResponse, error)
+	DeleteTemplate(context.Context, *v1.DeleteTemplateRequest) (*v1.DeleteTemplateResponse, error)
+	PreviewTemplate(context.Context, *v1.PreviewTemplateRequest) (*v1.PreviewTemplateResponse, error)
+	InstantiateTemplate(context.Context, *v1.InstantiateTemplateRequest) (*v1.InstantiateTemplateResponse, error)
+}
+
+// NewTemplateServiceClient constructs a client for the mantrae.v1.TemplateService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTemplateServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TemplateServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	templateServiceMethods := v1.File_mantrae_v1_template_proto.Services().ByName("TemplateService").Methods()
+	return &templateServiceClient{
+		listTemplates: connect.NewClient[v1.ListTemplatesRequest, v1.ListTemplatesResponse](
+			httpClient,
+			baseURL+TemplateServiceListTemplatesProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("ListTemplates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getTemplate: connect.NewClient[v1.GetTemplateRequest, v1.GetTemplateResponse](
+			httpClient,
+			baseURL+TemplateServiceGetTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("GetTemplate")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createTemplate: connect.NewClient[v1.CreateTemplateRequest, v1.CreateTemplateResponse](
+			httpClient,
+			baseURL+TemplateServiceCreateTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("CreateTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTemplate: connect.NewClient[v1.UpdateTemplateRequest, v1.UpdateTemplateResponse](
+			httpClient,
+			baseURL+TemplateServiceUpdateTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("UpdateTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTemplate: connect.NewClient[v1.DeleteTemplateRequest, v1.DeleteTemplateResponse](
+			httpClient,
+			baseURL+TemplateServiceDeleteTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("DeleteTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		previewTemplate: connect.NewClient[v1.PreviewTemplateRequest, v1.PreviewTemplateResponse](
+			httpClient,
+			baseURL+TemplateServicePreviewTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("PreviewTemplate")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		instantiateTemplate: connect.NewClient[v1.InstantiateTemplateRequest, v1.InstantiateTemplateResponse](
+			httpClient,
+			baseURL+TemplateServiceInstantiateTemplateProcedure,
+			connect.WithSchema(templateServiceMethods.ByName("InstantiateTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// templateServiceClient implements TemplateServiceClient.
+type templateServiceClient struct {
+	listTemplates       *connect.Client[v1.ListTemplatesRequest, v1.ListTemplatesResponse]
+	getTemplate         *connect.Client[v1.GetTemplateRequest, v1.GetTemplateResponse]
+	createTemplate      *connect.Client[v1.CreateTemplateRequest, v1.CreateTemplateResponse]
+	updateTemplate      *connect.Client[v1.UpdateTemplateRequest, v1.UpdateTemplateResponse]
+	deleteTemplate      *connect.Client[v1.DeleteTemplateRequest, v1.DeleteTemplateResponse]
+	previewTemplate     *connect.Client[v1.PreviewTemplateRequest, v1.PreviewTemplateResponse]
+	instantiateTemplate *connect.Client[v1.InstantiateTemplateRequest, v1.InstantiateTemplateResponse]
+}
+
+// ListTemplates calls mantrae.v1.TemplateService.ListTemplates.
+func (c *templateServiceClient) ListTemplates(ctx context.Context, req *v1.ListTemplatesRequest) (*v1.ListTemplatesResponse, error) {
+	response, err := c.listTemplates.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetTemplate calls mantrae.v1.TemplateService.GetTemplate.
+func (c *templateServiceClient) GetTemplate(ctx context.Context, req *v1.GetTemplateRequest) (*v1.GetTemplateResponse, error) {
+	response, err := c.getTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateTemplate calls mantrae.v1.TemplateService.CreateTemplate.
+func (c *templateServiceClient) CreateTemplate(ctx context.Context, req *v1.CreateTemplateRequest) (*v1.CreateTemplateResponse, error) {
+	response, err := c.createTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateTemplate calls mantrae.v1.TemplateService.UpdateTemplate.
+func (c *templateServiceClient) UpdateTemplate(ctx context.Context, req *v1.UpdateTemplateRequest) (*v1.UpdateTemplateResponse, error) {
+	response, err := c.updateTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTemplate calls mantrae.v1.TemplateService.DeleteTemplate.
+func (c *templateServiceClient) DeleteTemplate(ctx context.Context, req *v1.DeleteTemplateRequest) (*v1.DeleteTemplateResponse, error) {
+	response, err := c.deleteTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PreviewTemplate calls mantrae.v1.TemplateService.PreviewTemplate.
+func (c *templateServiceClient) PreviewTemplate(ctx context.Context, req *v1.PreviewTemplateRequest) (*v1.PreviewTemplateResponse, error) {
+	response, err := c.previewTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// InstantiateTemplate calls mantrae.v1.TemplateService.InstantiateTemplate.
+func (c *templateServiceClient) InstantiateTemplate(ctx context.Context, req *v1.InstantiateTemplateRequest) (*v1.InstantiateTemplateResponse, error) {
+	response, err := c.instantiateTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// TemplateServiceHandler is an implementation of the mantrae.v1.TemplateService service.
+type TemplateServiceHandler interface {
+	ListTemplates(context.Context, *v1.ListTemplatesRequest) (*v1.ListTemplatesResponse, error)
+	GetTemplate(context.Context, *v1.GetTemplateRequest) (*v1.GetTemplateResponse, error)
+	CreateTemplate(context.Context, *v1.CreateTemplateRequest) (*v1.CreateTemplateResponse, error)
+	UpdateTemplate(context.Context, *v1.UpdateTemplateRequest) (*v1.UpdateTemplateResponse, error)
+	DeleteTemplate(context.Context, *v1.DeleteTemplateRequest) (*v1.DeleteTemplateResponse, error)
+	PreviewTemplate(context.Context, *v1.PreviewTemplateRequest) (*v1.PreviewTemplateResponse, error)
+	InstantiateTemplate(context.Context, *v1.InstantiateTemplateRequest) (*v1.InstantiateTemplateResponse, error)
+}
+
+// NewTemplateServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTemplateServiceHandler(svc TemplateServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	templateServiceMethods := v1.File_mantrae_v1_template_proto.Services().ByName("TemplateService").Methods()
+	templateServiceListTemplatesHandler := connect.NewUnaryHandlerSimple(
+		TemplateServiceListTemplatesProcedure,
+		svc.ListTemplates,
+		connect.WithSchema(templateServiceMethods.ByName("ListTemplates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceGetTemplateHandler := connect.NewUnaryHandlerSimple(
+		TemplateServiceGetTemplateProcedure,
+		svc.GetTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("GetTemplate")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceCreateTemplateHandler := connect.NewUnaryHandlerSimple(
+		TemplateServiceCreateTemplateProcedure,
+		svc.CreateTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("CreateTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceUpdateTemplateHandler := connect.NewUnaryHandlerSimple(
+		TemplateServiceUpdateTemplateProcedure,
+		svc.UpdateTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("UpdateTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceDeleteTemplateHandler := connect.NewUnaryHandlerSimple(
+		TemplateServiceDeleteTemplateProcedure,
+		svc.DeleteTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("DeleteTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServicePreviewTemplateHandler := connect.NewUnaryHandlerSimple(
+		TemplateServicePreviewTemplateProcedure,
+		svc.PreviewTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("PreviewTemplate")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	templateServiceInstantiateTemplateHandler := connect.NewUnaryHandlerSimple(
+		TemplateServiceInstantiateTemplateProcedure,
+		svc.InstantiateTemplate,
+		connect.WithSchema(templateServiceMethods.ByName("InstantiateTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mantrae.v1.TemplateService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TemplateServiceListTemplatesProcedure:
+			templateServiceListTemplatesHandler.ServeHTTP(w, r)
+		case TemplateServiceGetTemplateProcedure:
+			templateServiceGetTemplateHandler.ServeHTTP(w, r)
+		case TemplateServiceCreateTemplateProcedure:
+			templateServiceCreateTemplateHandler.ServeHTTP(w, r)
+		case TemplateServiceUpdateTemplateProcedure:
+			templateServiceUpdateTemplateHandler.ServeHTTP(w, r)
+		case TemplateServiceDeleteTemplateProcedure:
+			templateServiceDeleteTemplateHandler.ServeHTTP(w, r)
+		case TemplateServicePreviewTemplateProcedure:
+			templateServicePreviewTemplateHandler.ServeHTTP(w, r)
+		case TemplateServiceInstantiateTemplateProcedure:
+			templateServiceInstantiateTemplateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTemplateServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTemplateServiceHandler struct{}
+
+func (UnimplementedTemplateServiceHandler) ListTemplates(context.Context, *v1.ListTemplatesRequest) (*v1.ListTemplatesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.TemplateService.ListTemplates is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) GetTemplate(context.Context, *v1.GetTemplateRequest) (*v1.GetTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.TemplateService.GetTemplate is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) CreateTemplate(context.Context, *v1.CreateTemplateRequest) (*v1.CreateTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.TemplateService.CreateTemplate is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) UpdateTemplate(context.Context, *v1.UpdateTemplateRequest) (*v1.UpdateTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.TemplateService.UpdateTemplate is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) DeleteTemplate(context.Context, *v1.DeleteTemplateRequest) (*v1.DeleteTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.TemplateService.DeleteTemplate is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) PreviewTemplate(context.Context, *v1.PreviewTemplateRequest) (*v1.PreviewTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.TemplateService.PreviewTemplate is not implemented"))
+}
+
+func (UnimplementedTemplateServiceHandler) InstantiateTemplate(context.Context, *v1.InstantiateTemplateRequest) (*v1.InstantiateTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.TemplateService.InstantiateTemplate is not implemented"))
+}
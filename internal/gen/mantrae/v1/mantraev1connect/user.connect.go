@@ -50,6 +50,42 @@ const (
 	// UserServiceGetOIDCStatusProcedure is the fully-qualified name of the UserService's GetOIDCStatus
 	// RPC.
 	UserServiceGetOIDCStatusProcedure = "/mantrae.v1.UserService/GetOIDCStatus"
+	// UserServiceUpdateUserRoleProcedure is the fully-qualified name of the UserService's
+	// UpdateUserRole RPC.
+	UserServiceUpdateUserRoleProcedure = "/mantrae.v1.UserService/UpdateUserRole"
+	// UserServiceCreateAPITokenProcedure is the fully-qualified name of the UserService's
+	// CreateAPIToken RPC.
+	UserServiceCreateAPITokenProcedure = "/mantrae.v1.UserService/CreateAPIToken"
+	// UserServiceListAPITokensProcedure is the fully-qualified name of the UserService's ListAPITokens
+	// RPC.
+	UserServiceListAPITokensProcedure = "/mantrae.v1.UserService/ListAPITokens"
+	// UserServiceDeleteAPITokenProcedure is the fully-qualified name of the UserService's
+	// DeleteAPIToken RPC.
+	UserServiceDeleteAPITokenProcedure = "/mantrae.v1.UserService/DeleteAPIToken"
+	// UserServiceListSessionsProcedure is the fully-qualified name of the UserService's ListSessions
+	// RPC.
+	UserServiceListSessionsProcedure = "/mantrae.v1.UserService/ListSessions"
+	// UserServiceRevokeSessionProcedure is the fully-qualified name of the UserService's RevokeSession
+	// RPC.
+	UserServiceRevokeSessionProcedure = "/mantrae.v1.UserService/RevokeSession"
+	// UserServiceRevokeAllSessionsProcedure is the fully-qualified name of the UserService's
+	// RevokeAllSessions RPC.
+	UserServiceRevokeAllSessionsProcedure = "/mantrae.v1.UserService/RevokeAllSessions"
+	// UserServiceVerifyTwoFactorLoginProcedure is the fully-qualified name of the UserService's
+	// VerifyTwoFactorLogin RPC.
+	UserServiceVerifyTwoFactorLoginProcedure = "/mantrae.v1.UserService/VerifyTwoFactorLogin"
+	// UserServiceSetupTwoFactorProcedure is the fully-qualified name of the UserService's
+	// SetupTwoFactor RPC.
+	UserServiceSetupTwoFactorProcedure = "/mantrae.v1.UserService/SetupTwoFactor"
+	// UserServiceEnableTwoFactorProcedure is the fully-qualified name of the UserService's
+	// EnableTwoFactor RPC.
+	UserServiceEnableTwoFactorProcedure = "/mantrae.v1.UserService/EnableTwoFactor"
+	// UserServiceDisableTwoFactorProcedure is the fully-qualified name of the UserService's
+	// DisableTwoFactor RPC.
+	UserServiceDisableTwoFactorProcedure = "/mantrae.v1.UserService/DisableTwoFactor"
+	// UserServiceRegenerateRecoveryCodesProcedure is the fully-qualified name of the UserService's
+	// RegenerateRecoveryCodes RPC.
+	UserServiceRegenerateRecoveryCodesProcedure = "/mantrae.v1.UserService/RegenerateRecoveryCodes"
 )
 
 // UserServiceClient is a client for the mantrae.v1.UserService service.
@@ -62,6 +98,18 @@ type UserServiceClient interface {
 	DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error)
 	ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error)
 	GetOIDCStatus(context.Context, *v1.GetOIDCStatusRequest) (*v1.GetOIDCStatusResponse, error)
+	UpdateUserRole(context.Context, *v1.UpdateUserRoleRequest) (*v1.UpdateUserRoleResponse, error)
+	CreateAPIToken(context.Context, *v1.CreateAPITokenRequest) (*v1.CreateAPITokenResponse, error)
+	ListAPITokens(context.Context, *v1.ListAPITokensRequest) (*v1.ListAPITokensResponse, error)
+	DeleteAPIToken(context.Context, *v1.DeleteAPITokenRequest) (*v1.DeleteAPITokenResponse, error)
+	ListSessions(context.Context, *v1.ListSessionsRequest) (*v1.ListSessionsResponse, error)
+	RevokeSession(context.Context, *v1.RevokeSessionRequest) (*v1.RevokeSessionResponse, error)
+	RevokeAllSessions(context.Context, *v1.RevokeAllSessionsRequest) (*v1.RevokeAllSessionsResponse, error)
+	VerifyTwoFactorLogin(context.Context, *v1.VerifyTwoFactorLoginRequest) (*v1.VerifyTwoFactorLoginResponse, error)
+	SetupTwoFactor(context.Context, *v1.SetupTwoFactorRequest) (*v1.SetupTwoFactorResponse, error)
+	EnableTwoFactor(context.Context, *v1.EnableTwoFactorRequest) (*v1.EnableTwoFactorResponse, error)
+	DisableTwoFactor(context.Context, *v1.DisableTwoFactorRequest) (*v1.DisableTwoFactorResponse, error)
+	RegenerateRecoveryCodes(context.Context, *v1.RegenerateRecoveryCodesRequest) (*v1.RegenerateRecoveryCodesResponse, error)
 }
 
 // NewUserServiceClient constructs a client for the mantrae.v1.UserService service. By default, it
@@ -125,19 +173,105 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("GetOIDCStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		updateUserRole: connect.NewClient[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse](
+			httpClient,
+			baseURL+UserServiceUpdateUserRoleProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateUserRole")),
+			connect.WithClientOptions(opts...),
+		),
+		createAPIToken: connect.NewClient[v1.CreateAPITokenRequest, v1.CreateAPITokenResponse](
+			httpClient,
+			baseURL+UserServiceCreateAPITokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateAPIToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listAPITokens: connect.NewClient[v1.ListAPITokensRequest, v1.ListAPITokensResponse](
+			httpClient,
+			baseURL+UserServiceListAPITokensProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListAPITokens")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAPIToken: connect.NewClient[v1.DeleteAPITokenRequest, v1.DeleteAPITokenResponse](
+			httpClient,
+			baseURL+UserServiceDeleteAPITokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteAPIToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+UserServiceListSessionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListSessions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+UserServiceRevokeSessionProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAllSessions: connect.NewClient[v1.RevokeAllSessionsRequest, v1.RevokeAllSessionsResponse](
+			httpClient,
+			baseURL+UserServiceRevokeAllSessionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeAllSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyTwoFactorLogin: connect.NewClient[v1.VerifyTwoFactorLoginRequest, v1.VerifyTwoFactorLoginResponse](
+			httpClient,
+			baseURL+UserServiceVerifyTwoFactorLoginProcedure,
+			connect.WithSchema(userServiceMethods.ByName("VerifyTwoFactorLogin")),
+			connect.WithClientOptions(opts...),
+		),
+		setupTwoFactor: connect.NewClient[v1.SetupTwoFactorRequest, v1.SetupTwoFactorResponse](
+			httpClient,
+			baseURL+UserServiceSetupTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SetupTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		enableTwoFactor: connect.NewClient[v1.EnableTwoFactorRequest, v1.EnableTwoFactorResponse](
+			httpClient,
+			baseURL+UserServiceEnableTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("EnableTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTwoFactor: connect.NewClient[v1.DisableTwoFactorRequest, v1.DisableTwoFactorResponse](
+			httpClient,
+			baseURL+UserServiceDisableTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DisableTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateRecoveryCodes: connect.NewClient[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse](
+			httpClient,
+			baseURL+UserServiceRegenerateRecoveryCodesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RegenerateRecoveryCodes")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	loginUser     *connect.Client[v1.LoginUserRequest, v1.LoginUserResponse]
-	logoutUser    *connect.Client[v1.LogoutUserRequest, v1.LogoutUserResponse]
-	getUser       *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
-	createUser    *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
-	updateUser    *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
-	deleteUser    *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
-	listUsers     *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	getOIDCStatus *connect.Client[v1.GetOIDCStatusRequest, v1.GetOIDCStatusResponse]
+	loginUser               *connect.Client[v1.LoginUserRequest, v1.LoginUserResponse]
+	logoutUser              *connect.Client[v1.LogoutUserRequest, v1.LogoutUserResponse]
+	getUser                 *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	createUser              *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	updateUser              *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	deleteUser              *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	listUsers               *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	getOIDCStatus           *connect.Client[v1.GetOIDCStatusRequest, v1.GetOIDCStatusResponse]
+	updateUserRole          *connect.Client[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse]
+	createAPIToken          *connect.Client[v1.CreateAPITokenRequest, v1.CreateAPITokenResponse]
+	listAPITokens           *connect.Client[v1.ListAPITokensRequest, v1.ListAPITokensResponse]
+	deleteAPIToken          *connect.Client[v1.DeleteAPITokenRequest, v1.DeleteAPITokenResponse]
+	listSessions            *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession           *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	revokeAllSessions       *connect.Client[v1.RevokeAllSessionsRequest, v1.RevokeAllSessionsResponse]
+	verifyTwoFactorLogin    *connect.Client[v1.VerifyTwoFactorLoginRequest, v1.VerifyTwoFactorLoginResponse]
+	setupTwoFactor          *connect.Client[v1.SetupTwoFactorRequest, v1.SetupTwoFactorResponse]
+	enableTwoFactor         *connect.Client[v1.EnableTwoFactorRequest, v1.EnableTwoFactorResponse]
+	disableTwoFactor        *connect.Client[v1.DisableTwoFactorRequest, v1.DisableTwoFactorResponse]
+	regenerateRecoveryCodes *connect.Client[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse]
 }
 
 // LoginUser calls mantrae.v1.UserService.LoginUser.
@@ -212,6 +346,114 @@ func (c *userServiceClient) GetOIDCStatus(ctx context.Context, req *v1.GetOIDCSt
 	return nil, err
 }
 
+// UpdateUserRole calls mantrae.v1.UserService.UpdateUserRole.
+func (c *userServiceClient) UpdateUserRole(ctx context.Context, req *v1.UpdateUserRoleRequest) (*v1.UpdateUserRoleResponse, error) {
+	response, err := c.updateUserRole.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateAPIToken calls mantrae.v1.UserService.CreateAPIToken.
+func (c *userServiceClient) CreateAPIToken(ctx context.Context, req *v1.CreateAPITokenRequest) (*v1.CreateAPITokenResponse, error) {
+	response, err := c.createAPIToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAPITokens calls mantrae.v1.UserService.ListAPITokens.
+func (c *userServiceClient) ListAPITokens(ctx context.Context, req *v1.ListAPITokensRequest) (*v1.ListAPITokensResponse, error) {
+	response, err := c.listAPITokens.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteAPIToken calls mantrae.v1.UserService.DeleteAPIToken.
+func (c *userServiceClient) DeleteAPIToken(ctx context.Context, req *v1.DeleteAPITokenRequest) (*v1.DeleteAPITokenResponse, error) {
+	response, err := c.deleteAPIToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListSessions calls mantrae.v1.UserService.ListSessions.
+func (c *userServiceClient) ListSessions(ctx context.Context, req *v1.ListSessionsRequest) (*v1.ListSessionsResponse, error) {
+	response, err := c.listSessions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevokeSession calls mantrae.v1.UserService.RevokeSession.
+func (c *userServiceClient) RevokeSession(ctx context.Context, req *v1.RevokeSessionRequest) (*v1.RevokeSessionResponse, error) {
+	response, err := c.revokeSession.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevokeAllSessions calls mantrae.v1.UserService.RevokeAllSessions.
+func (c *userServiceClient) RevokeAllSessions(ctx context.Context, req *v1.RevokeAllSessionsRequest) (*v1.RevokeAllSessionsResponse, error) {
+	response, err := c.revokeAllSessions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// VerifyTwoFactorLogin calls mantrae.v1.UserService.VerifyTwoFactorLogin.
+func (c *userServiceClient) VerifyTwoFactorLogin(ctx context.Context, req *v1.VerifyTwoFactorLoginRequest) (*v1.VerifyTwoFactorLoginResponse, error) {
+	response, err := c.verifyTwoFactorLogin.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetupTwoFactor calls mantrae.v1.UserService.SetupTwoFactor.
+func (c *userServiceClient) SetupTwoFactor(ctx context.Context, req *v1.SetupTwoFactorRequest) (*v1.SetupTwoFactorResponse, error) {
+	response, err := c.setupTwoFactor.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EnableTwoFactor calls mantrae.v1.UserService.EnableTwoFactor.
+func (c *userServiceClient) EnableTwoFactor(ctx context.Context, req *v1.EnableTwoFactorRequest) (*v1.EnableTwoFactorResponse, error) {
+	response, err := c.enableTwoFactor.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DisableTwoFactor calls mantrae.v1.UserService.DisableTwoFactor.
+func (c *userServiceClient) DisableTwoFactor(ctx context.Context, req *v1.DisableTwoFactorRequest) (*v1.DisableTwoFactorResponse, error) {
+	response, err := c.disableTwoFactor.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RegenerateRecoveryCodes calls mantrae.v1.UserService.RegenerateRecoveryCodes.
+func (c *userServiceClient) RegenerateRecoveryCodes(ctx context.Context, req *v1.RegenerateRecoveryCodesRequest) (*v1.RegenerateRecoveryCodesResponse, error) {
+	response, err := c.regenerateRecoveryCodes.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // UserServiceHandler is an implementation of the mantrae.v1.UserService service.
 type UserServiceHandler interface {
 	LoginUser(context.Context, *v1.LoginUserRequest) (*v1.LoginUserResponse, error)
@@ -222,6 +464,18 @@ type UserServiceHandler interface {
 	DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error)
 	ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error)
 	GetOIDCStatus(context.Context, *v1.GetOIDCStatusRequest) (*v1.GetOIDCStatusResponse, error)
+	UpdateUserRole(context.Context, *v1.UpdateUserRoleRequest) (*v1.UpdateUserRoleResponse, error)
+	CreateAPIToken(context.Context, *v1.CreateAPITokenRequest) (*v1.CreateAPITokenResponse, error)
+	ListAPITokens(context.Context, *v1.ListAPITokensRequest) (*v1.ListAPITokensResponse, error)
+	DeleteAPIToken(context.Context, *v1.DeleteAPITokenRequest) (*v1.DeleteAPITokenResponse, error)
+	ListSessions(context.Context, *v1.ListSessionsRequest) (*v1.ListSessionsResponse, error)
+	RevokeSession(context.Context, *v1.RevokeSessionRequest) (*v1.RevokeSessionResponse, error)
+	RevokeAllSessions(context.Context, *v1.RevokeAllSessionsRequest) (*v1.RevokeAllSessionsResponse, error)
+	VerifyTwoFactorLogin(context.Context, *v1.VerifyTwoFactorLoginRequest) (*v1.VerifyTwoFactorLoginResponse, error)
+	SetupTwoFactor(context.Context, *v1.SetupTwoFactorRequest) (*v1.SetupTwoFactorResponse, error)
+	EnableTwoFactor(context.Context, *v1.EnableTwoFactorRequest) (*v1.EnableTwoFactorResponse, error)
+	DisableTwoFactor(context.Context, *v1.DisableTwoFactorRequest) (*v1.DisableTwoFactorResponse, error)
+	RegenerateRecoveryCodes(context.Context, *v1.RegenerateRecoveryCodesRequest) (*v1.RegenerateRecoveryCodesResponse, error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -281,6 +535,80 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("GetOIDCStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceUpdateUserRoleHandler := connect.NewUnaryHandlerSimple(
+		UserServiceUpdateUserRoleProcedure,
+		svc.UpdateUserRole,
+		connect.WithSchema(userServiceMethods.ByName("UpdateUserRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateAPITokenHandler := connect.NewUnaryHandlerSimple(
+		UserServiceCreateAPITokenProcedure,
+		svc.CreateAPIToken,
+		connect.WithSchema(userServiceMethods.ByName("CreateAPIToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListAPITokensHandler := connect.NewUnaryHandlerSimple(
+		UserServiceListAPITokensProcedure,
+		svc.ListAPITokens,
+		connect.WithSchema(userServiceMethods.ByName("ListAPITokens")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteAPITokenHandler := connect.NewUnaryHandlerSimple(
+		UserServiceDeleteAPITokenProcedure,
+		svc.DeleteAPIToken,
+		connect.WithSchema(userServiceMethods.ByName("DeleteAPIToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListSessionsHandler := connect.NewUnaryHandlerSimple(
+		UserServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(userServiceMethods.ByName("ListSessions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeSessionHandler := connect.NewUnaryHandlerSimple(
+		UserServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(userServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeAllSessionsHandler := connect.NewUnaryHandlerSimple(
+		UserServiceRevokeAllSessionsProcedure,
+		svc.RevokeAllSessions,
+		connect.WithSchema(userServiceMethods.ByName("RevokeAllSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceVerifyTwoFactorLoginHandler := connect.NewUnaryHandlerSimple(
+		UserServiceVerifyTwoFactorLoginProcedure,
+		svc.VerifyTwoFactorLogin,
+		connect.WithSchema(userServiceMethods.ByName("VerifyTwoFactorLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSetupTwoFactorHandler := connect.NewUnaryHandlerSimple(
+		UserServiceSetupTwoFactorProcedure,
+		svc.SetupTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("SetupTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceEnableTwoFactorHandler := connect.NewUnaryHandlerSimple(
+		UserServiceEnableTwoFactorProcedure,
+		svc.EnableTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("EnableTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDisableTwoFactorHandler := connect.NewUnaryHandlerSimple(
+		UserServiceDisableTwoFactorProcedure,
+		svc.DisableTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("DisableTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRegenerateRecoveryCodesHandler := connect.NewUnaryHandlerSimple(
+		UserServiceRegenerateRecoveryCodesProcedure,
+		svc.RegenerateRecoveryCodes,
+		connect.WithSchema(userServiceMethods.ByName("RegenerateRecoveryCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mantrae.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceLoginUserProcedure:
@@ -299,6 +627,30 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceListUsersHandler.ServeHTTP(w, r)
 		case UserServiceGetOIDCStatusProcedure:
 			userServiceGetOIDCStatusHandler.ServeHTTP(w, r)
+		case UserServiceUpdateUserRoleProcedure:
+			userServiceUpdateUserRoleHandler.ServeHTTP(w, r)
+		case UserServiceCreateAPITokenProcedure:
+			userServiceCreateAPITokenHandler.ServeHTTP(w, r)
+		case UserServiceListAPITokensProcedure:
+			userServiceListAPITokensHandler.ServeHTTP(w, r)
+		case UserServiceDeleteAPITokenProcedure:
+			userServiceDeleteAPITokenHandler.ServeHTTP(w, r)
+		case UserServiceListSessionsProcedure:
+			userServiceListSessionsHandler.ServeHTTP(w, r)
+		case UserServiceRevokeSessionProcedure:
+			userServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case UserServiceRevokeAllSessionsProcedure:
+			userServiceRevokeAllSessionsHandler.ServeHTTP(w, r)
+		case UserServiceVerifyTwoFactorLoginProcedure:
+			userServiceVerifyTwoFactorLoginHandler.ServeHTTP(w, r)
+		case UserServiceSetupTwoFactorProcedure:
+			userServiceSetupTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceEnableTwoFactorProcedure:
+			userServiceEnableTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceDisableTwoFactorProcedure:
+			userServiceDisableTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceRegenerateRecoveryCodesProcedure:
+			userServiceRegenerateRecoveryCodesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -339,3 +691,51 @@ func (UnimplementedUserServiceHandler) ListUsers(context.Context, *v1.ListUsersR
 func (UnimplementedUserServiceHandler) GetOIDCStatus(context.Context, *v1.GetOIDCStatusRequest) (*v1.GetOIDCStatusResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.GetOIDCStatus is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) UpdateUserRole(context.Context, *v1.UpdateUserRoleRequest) (*v1.UpdateUserRoleResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.UpdateUserRole is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateAPIToken(context.Context, *v1.CreateAPITokenRequest) (*v1.CreateAPITokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.CreateAPIToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListAPITokens(context.Context, *v1.ListAPITokensRequest) (*v1.ListAPITokensResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.ListAPITokens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteAPIToken(context.Context, *v1.DeleteAPITokenRequest) (*v1.DeleteAPITokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.DeleteAPIToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListSessions(context.Context, *v1.ListSessionsRequest) (*v1.ListSessionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.ListSessions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeSession(context.Context, *v1.RevokeSessionRequest) (*v1.RevokeSessionResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeAllSessions(context.Context, *v1.RevokeAllSessionsRequest) (*v1.RevokeAllSessionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.RevokeAllSessions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) VerifyTwoFactorLogin(context.Context, *v1.VerifyTwoFactorLoginRequest) (*v1.VerifyTwoFactorLoginResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.VerifyTwoFactorLogin is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetupTwoFactor(context.Context, *v1.SetupTwoFactorRequest) (*v1.SetupTwoFactorResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.SetupTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) EnableTwoFactor(context.Context, *v1.EnableTwoFactorRequest) (*v1.EnableTwoFactorResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.EnableTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DisableTwoFactor(context.Context, *v1.DisableTwoFactorRequest) (*v1.DisableTwoFactorResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.DisableTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RegenerateRecoveryCodes(context.Context, *v1.RegenerateRecoveryCodesRequest) (*v1.RegenerateRecoveryCodesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mantrae.v1.UserService.RegenerateRecoveryCodes is not implemented"))
+}
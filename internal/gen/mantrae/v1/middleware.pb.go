@@ -539,6 +539,7 @@ func (x *CreateMiddlewareRequest) GetConfig() *structpb.Struct {
 type CreateMiddlewareResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Middleware    *Middleware            `protobuf:"bytes,1,opt,name=middleware,proto3" json:"middleware,omitempty"`
+	Issues        []*ValidationIssue     `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -580,6 +581,13 @@ func (x *CreateMiddlewareResponse) GetMiddleware() *Middleware {
 	return nil
 }
 
+func (x *CreateMiddlewareResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 type UpdateMiddlewareRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -675,6 +683,7 @@ func (x *UpdateMiddlewareRequest) GetIsDefault() bool {
 type UpdateMiddlewareResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Middleware    *Middleware            `protobuf:"bytes,1,opt,name=middleware,proto3" json:"middleware,omitempty"`
+	Issues        []*ValidationIssue     `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -716,6 +725,13 @@ func (x *UpdateMiddlewareResponse) GetMiddleware() *Middleware {
 	return nil
 }
 
+func (x *UpdateMiddlewareResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 type DeleteMiddlewareRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1017,7 +1033,7 @@ var File_mantrae_v1_middleware_proto protoreflect.FileDescriptor
 const file_mantrae_v1_middleware_proto_rawDesc = "" +
 	"\n" +
 	"\x1bmantrae/v1/middleware.proto\x12\n" +
-	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19mantrae/v1/protocol.proto\"\xf8\x02\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19mantrae/v1/protocol.proto\x1a\x1bmantrae/v1/validation.proto\"\xf8\x02\n" +
 	"\n" +
 	"Middleware\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
@@ -1074,11 +1090,12 @@ const file_mantrae_v1_middleware_proto_rawDesc = "" +
 	"\n" +
 	"is_default\x18\x05 \x01(\bR\tisDefault\x12/\n" +
 	"\x06config\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x06configB\v\n" +
-	"\t_agent_id\"R\n" +
+	"\t_agent_id\"\x87\x01\n" +
 	"\x18CreateMiddlewareResponse\x126\n" +
 	"\n" +
 	"middleware\x18\x01 \x01(\v2\x16.mantrae.v1.MiddlewareR\n" +
-	"middleware\"\x99\x02\n" +
+	"middleware\x123\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1b.mantrae.v1.ValidationIssueR\x06issues\"\x99\x02\n" +
 	"\x17UpdateMiddlewareRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12&\n" +
 	"\n" +
@@ -1088,11 +1105,12 @@ const file_mantrae_v1_middleware_proto_rawDesc = "" +
 	"\x06config\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x18\n" +
 	"\aenabled\x18\x06 \x01(\bR\aenabled\x12\x1d\n" +
 	"\n" +
-	"is_default\x18\a \x01(\bR\tisDefault\"R\n" +
+	"is_default\x18\a \x01(\bR\tisDefault\"\x87\x01\n" +
 	"\x18UpdateMiddlewareResponse\x126\n" +
 	"\n" +
 	"middleware\x18\x01 \x01(\v2\x16.mantrae.v1.MiddlewareR\n" +
-	"middleware\"j\n" +
+	"middleware\x123\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1b.mantrae.v1.ValidationIssueR\x06issues\"j\n" +
 	"\x17DeleteMiddlewareRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x126\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\"\x1a\n" +
@@ -1159,6 +1177,7 @@ var file_mantrae_v1_middleware_proto_goTypes = []any{
 	(*structpb.Struct)(nil),              // 15: google.protobuf.Struct
 	(ProtocolType)(0),                    // 16: mantrae.v1.ProtocolType
 	(*timestamppb.Timestamp)(nil),        // 17: google.protobuf.Timestamp
+	(*ValidationIssue)(nil),              // 18: mantrae.v1.ValidationIssue
 }
 var file_mantrae_v1_middleware_proto_depIdxs = []int32{
 	15, // 0: mantrae.v1.Middleware.config:type_name -> google.protobuf.Struct
@@ -1171,30 +1190,32 @@ var file_mantrae_v1_middleware_proto_depIdxs = []int32{
 	16, // 7: mantrae.v1.CreateMiddlewareRequest.type:type_name -> mantrae.v1.ProtocolType
 	15, // 8: mantrae.v1.CreateMiddlewareRequest.config:type_name -> google.protobuf.Struct
 	0,  // 9: mantrae.v1.CreateMiddlewareResponse.middleware:type_name -> mantrae.v1.Middleware
-	16, // 10: mantrae.v1.UpdateMiddlewareRequest.type:type_name -> mantrae.v1.ProtocolType
-	15, // 11: mantrae.v1.UpdateMiddlewareRequest.config:type_name -> google.protobuf.Struct
-	0,  // 12: mantrae.v1.UpdateMiddlewareResponse.middleware:type_name -> mantrae.v1.Middleware
-	16, // 13: mantrae.v1.DeleteMiddlewareRequest.type:type_name -> mantrae.v1.ProtocolType
-	16, // 14: mantrae.v1.ListMiddlewaresRequest.type:type_name -> mantrae.v1.ProtocolType
-	0,  // 15: mantrae.v1.ListMiddlewaresResponse.middlewares:type_name -> mantrae.v1.Middleware
-	1,  // 16: mantrae.v1.GetMiddlewarePluginsResponse.plugins:type_name -> mantrae.v1.Plugin
-	3,  // 17: mantrae.v1.MiddlewareService.GetMiddleware:input_type -> mantrae.v1.GetMiddlewareRequest
-	5,  // 18: mantrae.v1.MiddlewareService.CreateMiddleware:input_type -> mantrae.v1.CreateMiddlewareRequest
-	7,  // 19: mantrae.v1.MiddlewareService.UpdateMiddleware:input_type -> mantrae.v1.UpdateMiddlewareRequest
-	9,  // 20: mantrae.v1.MiddlewareService.DeleteMiddleware:input_type -> mantrae.v1.DeleteMiddlewareRequest
-	11, // 21: mantrae.v1.MiddlewareService.ListMiddlewares:input_type -> mantrae.v1.ListMiddlewaresRequest
-	13, // 22: mantrae.v1.MiddlewareService.GetMiddlewarePlugins:input_type -> mantrae.v1.GetMiddlewarePluginsRequest
-	4,  // 23: mantrae.v1.MiddlewareService.GetMiddleware:output_type -> mantrae.v1.GetMiddlewareResponse
-	6,  // 24: mantrae.v1.MiddlewareService.CreateMiddleware:output_type -> mantrae.v1.CreateMiddlewareResponse
-	8,  // 25: mantrae.v1.MiddlewareService.UpdateMiddleware:output_type -> mantrae.v1.UpdateMiddlewareResponse
-	10, // 26: mantrae.v1.MiddlewareService.DeleteMiddleware:output_type -> mantrae.v1.DeleteMiddlewareResponse
-	12, // 27: mantrae.v1.MiddlewareService.ListMiddlewares:output_type -> mantrae.v1.ListMiddlewaresResponse
-	14, // 28: mantrae.v1.MiddlewareService.GetMiddlewarePlugins:output_type -> mantrae.v1.GetMiddlewarePluginsResponse
-	23, // [23:29] is the sub-list for method output_type
-	17, // [17:23] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	18, // 10: mantrae.v1.CreateMiddlewareResponse.issues:type_name -> mantrae.v1.ValidationIssue
+	16, // 11: mantrae.v1.UpdateMiddlewareRequest.type:type_name -> mantrae.v1.ProtocolType
+	15, // 12: mantrae.v1.UpdateMiddlewareRequest.config:type_name -> google.protobuf.Struct
+	0,  // 13: mantrae.v1.UpdateMiddlewareResponse.middleware:type_name -> mantrae.v1.Middleware
+	18, // 14: mantrae.v1.UpdateMiddlewareResponse.issues:type_name -> mantrae.v1.ValidationIssue
+	16, // 15: mantrae.v1.DeleteMiddlewareRequest.type:type_name -> mantrae.v1.ProtocolType
+	16, // 16: mantrae.v1.ListMiddlewaresRequest.type:type_name -> mantrae.v1.ProtocolType
+	0,  // 17: mantrae.v1.ListMiddlewaresResponse.middlewares:type_name -> mantrae.v1.Middleware
+	1,  // 18: mantrae.v1.GetMiddlewarePluginsResponse.plugins:type_name -> mantrae.v1.Plugin
+	3,  // 19: mantrae.v1.MiddlewareService.GetMiddleware:input_type -> mantrae.v1.GetMiddlewareRequest
+	5,  // 20: mantrae.v1.MiddlewareService.CreateMiddleware:input_type -> mantrae.v1.CreateMiddlewareRequest
+	7,  // 21: mantrae.v1.MiddlewareService.UpdateMiddleware:input_type -> mantrae.v1.UpdateMiddlewareRequest
+	9,  // 22: mantrae.v1.MiddlewareService.DeleteMiddleware:input_type -> mantrae.v1.DeleteMiddlewareRequest
+	11, // 23: mantrae.v1.MiddlewareService.ListMiddlewares:input_type -> mantrae.v1.ListMiddlewaresRequest
+	13, // 24: mantrae.v1.MiddlewareService.GetMiddlewarePlugins:input_type -> mantrae.v1.GetMiddlewarePluginsRequest
+	4,  // 25: mantrae.v1.MiddlewareService.GetMiddleware:output_type -> mantrae.v1.GetMiddlewareResponse
+	6,  // 26: mantrae.v1.MiddlewareService.CreateMiddleware:output_type -> mantrae.v1.CreateMiddlewareResponse
+	8,  // 27: mantrae.v1.MiddlewareService.UpdateMiddleware:output_type -> mantrae.v1.UpdateMiddlewareResponse
+	10, // 28: mantrae.v1.MiddlewareService.DeleteMiddleware:output_type -> mantrae.v1.DeleteMiddlewareResponse
+	12, // 29: mantrae.v1.MiddlewareService.ListMiddlewares:output_type -> mantrae.v1.ListMiddlewaresResponse
+	14, // 30: mantrae.v1.MiddlewareService.GetMiddlewarePlugins:output_type -> mantrae.v1.GetMiddlewarePluginsResponse
+	25, // [25:31] is the sub-list for method output_type
+	19, // [19:25] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_mantrae_v1_middleware_proto_init() }
@@ -1203,6 +1224,7 @@ func file_mantrae_v1_middleware_proto_init() {
 		return
 	}
 	file_mantrae_v1_protocol_proto_init()
+	file_mantrae_v1_validation_proto_init()
 	file_mantrae_v1_middleware_proto_msgTypes[5].OneofWrappers = []any{}
 	file_mantrae_v1_middleware_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
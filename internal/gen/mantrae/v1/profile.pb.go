@@ -24,15 +24,16 @@ const (
 )
 
 type Profile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Token             string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PublishedRevision *int64                 `protobuf:"varint,7,opt,name=published_revision,json=publishedRevision,proto3,oneof" json:"published_revision,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Profile) Reset() {
@@ -107,6 +108,13 @@ func (x *Profile) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Profile) GetPublishedRevision() int64 {
+	if x != nil && x.PublishedRevision != nil {
+		return *x.PublishedRevision
+	}
+	return 0
+}
+
 type GetProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -297,6 +305,7 @@ type UpdateProfileRequest struct {
 	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	RegenerateToken *bool                  `protobuf:"varint,4,opt,name=regenerate_token,json=regenerateToken,proto3,oneof" json:"regenerate_token,omitempty"`
+	Staged          *bool                  `protobuf:"varint,5,opt,name=staged,proto3,oneof" json:"staged,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -359,6 +368,13 @@ func (x *UpdateProfileRequest) GetRegenerateToken() bool {
 	return false
 }
 
+func (x *UpdateProfileRequest) GetStaged() bool {
+	if x != nil && x.Staged != nil {
+		return *x.Staged
+	}
+	return false
+}
+
 type UpdateProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
@@ -587,60 +603,852 @@ func (x *ListProfilesResponse) GetTotalCount() int64 {
 	return 0
 }
 
-var File_mantrae_v1_profile_proto protoreflect.FileDescriptor
+type PublishProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_mantrae_v1_profile_proto_rawDesc = "" +
-	"\n" +
-	"\x18mantrae/v1/profile.proto\x12\n" +
-	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x01\n" +
-	"\aProfile\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05token\x18\x04 \x01(\tR\x05token\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\",\n" +
-	"\x11GetProfileRequest\x12\x17\n" +
-	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"C\n" +
-	"\x12GetProfileResponse\x12-\n" +
-	"\aprofile\x18\x01 \x01(\v2\x13.mantrae.v1.ProfileR\aprofile\"j\n" +
-	"\x14CreateProfileRequest\x12\x1b\n" +
-	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12%\n" +
-	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
-	"\f_description\"F\n" +
-	"\x15CreateProfileResponse\x12-\n" +
-	"\aprofile\x18\x01 \x01(\v2\x13.mantrae.v1.ProfileR\aprofile\"\xc8\x01\n" +
-	"\x14UpdateProfileRequest\x12\x17\n" +
-	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12\x1b\n" +
-	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12.\n" +
-	"\x10regenerate_token\x18\x04 \x01(\bH\x01R\x0fregenerateToken\x88\x01\x01B\x0e\n" +
-	"\f_descriptionB\x13\n" +
-	"\x11_regenerate_token\"F\n" +
-	"\x15UpdateProfileResponse\x12-\n" +
-	"\aprofile\x18\x01 \x01(\v2\x13.mantrae.v1.ProfileR\aprofile\"/\n" +
-	"\x14DeleteProfileRequest\x12\x17\n" +
-	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x17\n" +
-	"\x15DeleteProfileResponse\"\xc3\x01\n" +
-	"\x13ListProfilesRequest\x12q\n" +
-	"\x05limit\x18\x01 \x01(\x03BV\xbaHS\xba\x01P\n" +
-	"\vlimit.valid\x12)limit must be either -1 or greater than 0\x1a\x16this == -1 || this > 0H\x00R\x05limit\x88\x01\x01\x12$\n" +
-	"\x06offset\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x01R\x06offset\x88\x01\x01B\b\n" +
-	"\x06_limitB\t\n" +
-	"\a_offset\"h\n" +
-	"\x14ListProfilesResponse\x12/\n" +
-	"\bprofiles\x18\x01 \x03(\v2\x13.mantrae.v1.ProfileR\bprofiles\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x03R\n" +
-	"totalCount2\xbc\x03\n" +
+func (x *PublishProfileRequest) Reset() {
+	*x = PublishProfileRequest{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishProfileRequest) ProtoMessage() {}
+
+func (x *PublishProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishProfileRequest.ProtoReflect.Descriptor instead.
+func (*PublishProfileRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PublishProfileRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PublishProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Revision      *Revision              `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishProfileResponse) Reset() {
+	*x = PublishProfileResponse{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishProfileResponse) ProtoMessage() {}
+
+func (x *PublishProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishProfileResponse.ProtoReflect.Descriptor instead.
+func (*PublishProfileResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PublishProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *PublishProfileResponse) GetRevision() *Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type DiscardDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDraftRequest) Reset() {
+	*x = DiscardDraftRequest{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDraftRequest) ProtoMessage() {}
+
+func (x *DiscardDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDraftRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDraftRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DiscardDraftRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DiscardDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDraftResponse) Reset() {
+	*x = DiscardDraftResponse{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDraftResponse) ProtoMessage() {}
+
+func (x *DiscardDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDraftResponse.ProtoReflect.Descriptor instead.
+func (*DiscardDraftResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{14}
+}
+
+type GetDraftDiffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDraftDiffRequest) Reset() {
+	*x = GetDraftDiffRequest{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDraftDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDraftDiffRequest) ProtoMessage() {}
+
+func (x *GetDraftDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDraftDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetDraftDiffRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetDraftDiffRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetDraftDiffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*ConfigChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDraftDiffResponse) Reset() {
+	*x = GetDraftDiffResponse{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDraftDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDraftDiffResponse) ProtoMessage() {}
+
+func (x *GetDraftDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDraftDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetDraftDiffResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetDraftDiffResponse) GetChanges() []*ConfigChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ValidateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateProfileRequest) Reset() {
+	*x = ValidateProfileRequest{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateProfileRequest) ProtoMessage() {}
+
+func (x *ValidateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateProfileRequest.ProtoReflect.Descriptor instead.
+func (*ValidateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValidateProfileRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ValidateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*ValidationIssue     `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateProfileResponse) Reset() {
+	*x = ValidateProfileResponse{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateProfileResponse) ProtoMessage() {}
+
+func (x *ValidateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateProfileResponse.ProtoReflect.Descriptor instead.
+func (*ValidateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ValidateProfileResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type CloneProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneProfileRequest) Reset() {
+	*x = CloneProfileRequest{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProfileRequest) ProtoMessage() {}
+
+func (x *CloneProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProfileRequest.ProtoReflect.Descriptor instead.
+func (*CloneProfileRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CloneProfileRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CloneProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneProfileRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type CloneProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneProfileResponse) Reset() {
+	*x = CloneProfileResponse{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProfileResponse) ProtoMessage() {}
+
+func (x *CloneProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProfileResponse.ProtoReflect.Descriptor instead.
+func (*CloneProfileResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CloneProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ProfileResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Protocol      ProtocolType           `protobuf:"varint,1,opt,name=protocol,proto3,enum=mantrae.v1.ProtocolType" json:"protocol,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileResource) Reset() {
+	*x = ProfileResource{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileResource) ProtoMessage() {}
+
+func (x *ProfileResource) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileResource.ProtoReflect.Descriptor instead.
+func (*ProfileResource) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ProfileResource) GetProtocol() ProtocolType {
+	if x != nil {
+		return x.Protocol
+	}
+	return ProtocolType_PROTOCOL_TYPE_UNSPECIFIED
+}
+
+func (x *ProfileResource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProfileResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PlanPromotionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceProfileId int64                  `protobuf:"varint,1,opt,name=source_profile_id,json=sourceProfileId,proto3" json:"source_profile_id,omitempty"`
+	TargetProfileId int64                  `protobuf:"varint,2,opt,name=target_profile_id,json=targetProfileId,proto3" json:"target_profile_id,omitempty"`
+	Resources       []*ProfileResource     `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlanPromotionRequest) Reset() {
+	*x = PlanPromotionRequest{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPromotionRequest) ProtoMessage() {}
+
+func (x *PlanPromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPromotionRequest.ProtoReflect.Descriptor instead.
+func (*PlanPromotionRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PlanPromotionRequest) GetSourceProfileId() int64 {
+	if x != nil {
+		return x.SourceProfileId
+	}
+	return 0
+}
+
+func (x *PlanPromotionRequest) GetTargetProfileId() int64 {
+	if x != nil {
+		return x.TargetProfileId
+	}
+	return 0
+}
+
+func (x *PlanPromotionRequest) GetResources() []*ProfileResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type PlanPromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ImportItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Changes       []*ConfigChange        `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPromotionResponse) Reset() {
+	*x = PlanPromotionResponse{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPromotionResponse) ProtoMessage() {}
+
+func (x *PlanPromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPromotionResponse.ProtoReflect.Descriptor instead.
+func (*PlanPromotionResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PlanPromotionResponse) GetItems() []*ImportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PlanPromotionResponse) GetChanges() []*ConfigChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type PromoteResourcesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceProfileId int64                  `protobuf:"varint,1,opt,name=source_profile_id,json=sourceProfileId,proto3" json:"source_profile_id,omitempty"`
+	TargetProfileId int64                  `protobuf:"varint,2,opt,name=target_profile_id,json=targetProfileId,proto3" json:"target_profile_id,omitempty"`
+	Resources       []*ProfileResource     `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	Strategy        ImportStrategy         `protobuf:"varint,4,opt,name=strategy,proto3,enum=mantrae.v1.ImportStrategy" json:"strategy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PromoteResourcesRequest) Reset() {
+	*x = PromoteResourcesRequest{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteResourcesRequest) ProtoMessage() {}
+
+func (x *PromoteResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteResourcesRequest.ProtoReflect.Descriptor instead.
+func (*PromoteResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PromoteResourcesRequest) GetSourceProfileId() int64 {
+	if x != nil {
+		return x.SourceProfileId
+	}
+	return 0
+}
+
+func (x *PromoteResourcesRequest) GetTargetProfileId() int64 {
+	if x != nil {
+		return x.TargetProfileId
+	}
+	return 0
+}
+
+func (x *PromoteResourcesRequest) GetResources() []*ProfileResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *PromoteResourcesRequest) GetStrategy() ImportStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ImportStrategy_IMPORT_STRATEGY_UNSPECIFIED
+}
+
+type PromoteResourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ImportItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteResourcesResponse) Reset() {
+	*x = PromoteResourcesResponse{}
+	mi := &file_mantrae_v1_profile_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteResourcesResponse) ProtoMessage() {}
+
+func (x *PromoteResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteResourcesResponse.ProtoReflect.Descriptor instead.
+func (*PromoteResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PromoteResourcesResponse) GetItems() []*ImportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_mantrae_v1_profile_proto protoreflect.FileDescriptor
+
+const file_mantrae_v1_profile_proto_rawDesc = "" +
+	"\n" +
+	"\x18mantrae/v1/profile.proto\x12\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17mantrae/v1/backup.proto\x1a\x19mantrae/v1/protocol.proto\x1a\x19mantrae/v1/revision.proto\x1a\x1bmantrae/v1/validation.proto\"\xa6\x02\n" +
+	"\aProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x122\n" +
+	"\x12published_revision\x18\a \x01(\x03H\x00R\x11publishedRevision\x88\x01\x01B\x15\n" +
+	"\x13_published_revision\",\n" +
+	"\x11GetProfileRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"C\n" +
+	"\x12GetProfileResponse\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.mantrae.v1.ProfileR\aprofile\"j\n" +
+	"\x14CreateProfileRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"F\n" +
+	"\x15CreateProfileResponse\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.mantrae.v1.ProfileR\aprofile\"\xf0\x01\n" +
+	"\x14UpdateProfileRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12.\n" +
+	"\x10regenerate_token\x18\x04 \x01(\bH\x01R\x0fregenerateToken\x88\x01\x01\x12\x1b\n" +
+	"\x06staged\x18\x05 \x01(\bH\x02R\x06staged\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\x13\n" +
+	"\x11_regenerate_tokenB\t\n" +
+	"\a_staged\"F\n" +
+	"\x15UpdateProfileResponse\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.mantrae.v1.ProfileR\aprofile\"/\n" +
+	"\x14DeleteProfileRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x17\n" +
+	"\x15DeleteProfileResponse\"\xc3\x01\n" +
+	"\x13ListProfilesRequest\x12q\n" +
+	"\x05limit\x18\x01 \x01(\x03BV\xbaHS\xba\x01P\n" +
+	"\vlimit.valid\x12)limit must be either -1 or greater than 0\x1a\x16this == -1 || this > 0H\x00R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x01R\x06offset\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"h\n" +
+	"\x14ListProfilesResponse\x12/\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x13.mantrae.v1.ProfileR\bprofiles\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\"0\n" +
+	"\x15PublishProfileRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"y\n" +
+	"\x16PublishProfileResponse\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.mantrae.v1.ProfileR\aprofile\x120\n" +
+	"\brevision\x18\x02 \x01(\v2\x14.mantrae.v1.RevisionR\brevision\".\n" +
+	"\x13DiscardDraftRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x16\n" +
+	"\x14DiscardDraftResponse\".\n" +
+	"\x13GetDraftDiffRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"J\n" +
+	"\x14GetDraftDiffResponse\x122\n" +
+	"\achanges\x18\x01 \x03(\v2\x18.mantrae.v1.ConfigChangeR\achanges\"1\n" +
+	"\x16ValidateProfileRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"N\n" +
+	"\x17ValidateProfileResponse\x123\n" +
+	"\x06issues\x18\x01 \x03(\v2\x1b.mantrae.v1.ValidationIssueR\x06issues\"\x82\x01\n" +
+	"\x13CloneProfileRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"E\n" +
+	"\x14CloneProfileResponse\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.mantrae.v1.ProfileR\aprofile\"\x8b\x01\n" +
+	"\x0fProfileResource\x12>\n" +
+	"\bprotocol\x18\x01 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\bprotocol\x12\x1b\n" +
+	"\x04type\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04type\x12\x1b\n" +
+	"\x04name\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\"\xc5\x01\n" +
+	"\x14PlanPromotionRequest\x123\n" +
+	"\x11source_profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0fsourceProfileId\x123\n" +
+	"\x11target_profile_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0ftargetProfileId\x12C\n" +
+	"\tresources\x18\x03 \x03(\v2\x1b.mantrae.v1.ProfileResourceB\b\xbaH\x05\x92\x01\x02\b\x01R\tresources\"y\n" +
+	"\x15PlanPromotionResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.mantrae.v1.ImportItemR\x05items\x122\n" +
+	"\achanges\x18\x02 \x03(\v2\x18.mantrae.v1.ConfigChangeR\achanges\"\x8a\x02\n" +
+	"\x17PromoteResourcesRequest\x123\n" +
+	"\x11source_profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0fsourceProfileId\x123\n" +
+	"\x11target_profile_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0ftargetProfileId\x12C\n" +
+	"\tresources\x18\x03 \x03(\v2\x1b.mantrae.v1.ProfileResourceB\b\xbaH\x05\x92\x01\x02\b\x01R\tresources\x12@\n" +
+	"\bstrategy\x18\x04 \x01(\x0e2\x1a.mantrae.v1.ImportStrategyB\b\xbaH\x05\x82\x01\x02\x10\x01R\bstrategy\"H\n" +
+	"\x18PromoteResourcesResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.mantrae.v1.ImportItemR\x05items2\xa9\b\n" +
 	"\x0eProfileService\x12P\n" +
 	"\n" +
 	"GetProfile\x12\x1d.mantrae.v1.GetProfileRequest\x1a\x1e.mantrae.v1.GetProfileResponse\"\x03\x90\x02\x01\x12T\n" +
 	"\rCreateProfile\x12 .mantrae.v1.CreateProfileRequest\x1a!.mantrae.v1.CreateProfileResponse\x12T\n" +
 	"\rUpdateProfile\x12 .mantrae.v1.UpdateProfileRequest\x1a!.mantrae.v1.UpdateProfileResponse\x12T\n" +
 	"\rDeleteProfile\x12 .mantrae.v1.DeleteProfileRequest\x1a!.mantrae.v1.DeleteProfileResponse\x12V\n" +
-	"\fListProfiles\x12\x1f.mantrae.v1.ListProfilesRequest\x1a .mantrae.v1.ListProfilesResponse\"\x03\x90\x02\x01B\xa9\x01\n" +
+	"\fListProfiles\x12\x1f.mantrae.v1.ListProfilesRequest\x1a .mantrae.v1.ListProfilesResponse\"\x03\x90\x02\x01\x12W\n" +
+	"\x0ePublishProfile\x12!.mantrae.v1.PublishProfileRequest\x1a\".mantrae.v1.PublishProfileResponse\x12Q\n" +
+	"\fDiscardDraft\x12\x1f.mantrae.v1.DiscardDraftRequest\x1a .mantrae.v1.DiscardDraftResponse\x12V\n" +
+	"\fGetDraftDiff\x12\x1f.mantrae.v1.GetDraftDiffRequest\x1a .mantrae.v1.GetDraftDiffResponse\"\x03\x90\x02\x01\x12_\n" +
+	"\x0fValidateProfile\x12\".mantrae.v1.ValidateProfileRequest\x1a#.mantrae.v1.ValidateProfileResponse\"\x03\x90\x02\x01\x12Q\n" +
+	"\fCloneProfile\x12\x1f.mantrae.v1.CloneProfileRequest\x1a .mantrae.v1.CloneProfileResponse\x12T\n" +
+	"\rPlanPromotion\x12 .mantrae.v1.PlanPromotionRequest\x1a!.mantrae.v1.PlanPromotionResponse\x12]\n" +
+	"\x10PromoteResources\x12#.mantrae.v1.PromoteResourcesRequest\x1a$.mantrae.v1.PromoteResourcesResponseB\xa9\x01\n" +
 	"\x0ecom.mantrae.v1B\fProfileProtoP\x01Z@github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1;mantraev1\xa2\x02\x03MXX\xaa\x02\n" +
 	"Mantrae.V1\xca\x02\n" +
 	"Mantrae\\V1\xe2\x02\x16Mantrae\\V1\\GPBMetadata\xea\x02\vMantrae::V1b\x06proto3"
@@ -657,43 +1465,90 @@ func file_mantrae_v1_profile_proto_rawDescGZIP() []byte {
 	return file_mantrae_v1_profile_proto_rawDescData
 }
 
-var file_mantrae_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_mantrae_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_mantrae_v1_profile_proto_goTypes = []any{
-	(*Profile)(nil),               // 0: mantrae.v1.Profile
-	(*GetProfileRequest)(nil),     // 1: mantrae.v1.GetProfileRequest
-	(*GetProfileResponse)(nil),    // 2: mantrae.v1.GetProfileResponse
-	(*CreateProfileRequest)(nil),  // 3: mantrae.v1.CreateProfileRequest
-	(*CreateProfileResponse)(nil), // 4: mantrae.v1.CreateProfileResponse
-	(*UpdateProfileRequest)(nil),  // 5: mantrae.v1.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil), // 6: mantrae.v1.UpdateProfileResponse
-	(*DeleteProfileRequest)(nil),  // 7: mantrae.v1.DeleteProfileRequest
-	(*DeleteProfileResponse)(nil), // 8: mantrae.v1.DeleteProfileResponse
-	(*ListProfilesRequest)(nil),   // 9: mantrae.v1.ListProfilesRequest
-	(*ListProfilesResponse)(nil),  // 10: mantrae.v1.ListProfilesResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*Profile)(nil),                  // 0: mantrae.v1.Profile
+	(*GetProfileRequest)(nil),        // 1: mantrae.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),       // 2: mantrae.v1.GetProfileResponse
+	(*CreateProfileRequest)(nil),     // 3: mantrae.v1.CreateProfileRequest
+	(*CreateProfileResponse)(nil),    // 4: mantrae.v1.CreateProfileResponse
+	(*UpdateProfileRequest)(nil),     // 5: mantrae.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),    // 6: mantrae.v1.UpdateProfileResponse
+	(*DeleteProfileRequest)(nil),     // 7: mantrae.v1.DeleteProfileRequest
+	(*DeleteProfileResponse)(nil),    // 8: mantrae.v1.DeleteProfileResponse
+	(*ListProfilesRequest)(nil),      // 9: mantrae.v1.ListProfilesRequest
+	(*ListProfilesResponse)(nil),     // 10: mantrae.v1.ListProfilesResponse
+	(*PublishProfileRequest)(nil),    // 11: mantrae.v1.PublishProfileRequest
+	(*PublishProfileResponse)(nil),   // 12: mantrae.v1.PublishProfileResponse
+	(*DiscardDraftRequest)(nil),      // 13: mantrae.v1.DiscardDraftRequest
+	(*DiscardDraftResponse)(nil),     // 14: mantrae.v1.DiscardDraftResponse
+	(*GetDraftDiffRequest)(nil),      // 15: mantrae.v1.GetDraftDiffRequest
+	(*GetDraftDiffResponse)(nil),     // 16: mantrae.v1.GetDraftDiffResponse
+	(*ValidateProfileRequest)(nil),   // 17: mantrae.v1.ValidateProfileRequest
+	(*ValidateProfileResponse)(nil),  // 18: mantrae.v1.ValidateProfileResponse
+	(*CloneProfileRequest)(nil),      // 19: mantrae.v1.CloneProfileRequest
+	(*CloneProfileResponse)(nil),     // 20: mantrae.v1.CloneProfileResponse
+	(*ProfileResource)(nil),          // 21: mantrae.v1.ProfileResource
+	(*PlanPromotionRequest)(nil),     // 22: mantrae.v1.PlanPromotionRequest
+	(*PlanPromotionResponse)(nil),    // 23: mantrae.v1.PlanPromotionResponse
+	(*PromoteResourcesRequest)(nil),  // 24: mantrae.v1.PromoteResourcesRequest
+	(*PromoteResourcesResponse)(nil), // 25: mantrae.v1.PromoteResourcesResponse
+	(*timestamppb.Timestamp)(nil),    // 26: google.protobuf.Timestamp
+	(*Revision)(nil),                 // 27: mantrae.v1.Revision
+	(*ConfigChange)(nil),             // 28: mantrae.v1.ConfigChange
+	(*ValidationIssue)(nil),          // 29: mantrae.v1.ValidationIssue
+	(ProtocolType)(0),                // 30: mantrae.v1.ProtocolType
+	(*ImportItem)(nil),               // 31: mantrae.v1.ImportItem
+	(ImportStrategy)(0),              // 32: mantrae.v1.ImportStrategy
 }
 var file_mantrae_v1_profile_proto_depIdxs = []int32{
-	11, // 0: mantrae.v1.Profile.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: mantrae.v1.Profile.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 0: mantrae.v1.Profile.created_at:type_name -> google.protobuf.Timestamp
+	26, // 1: mantrae.v1.Profile.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: mantrae.v1.GetProfileResponse.profile:type_name -> mantrae.v1.Profile
 	0,  // 3: mantrae.v1.CreateProfileResponse.profile:type_name -> mantrae.v1.Profile
 	0,  // 4: mantrae.v1.UpdateProfileResponse.profile:type_name -> mantrae.v1.Profile
 	0,  // 5: mantrae.v1.ListProfilesResponse.profiles:type_name -> mantrae.v1.Profile
-	1,  // 6: mantrae.v1.ProfileService.GetProfile:input_type -> mantrae.v1.GetProfileRequest
-	3,  // 7: mantrae.v1.ProfileService.CreateProfile:input_type -> mantrae.v1.CreateProfileRequest
-	5,  // 8: mantrae.v1.ProfileService.UpdateProfile:input_type -> mantrae.v1.UpdateProfileRequest
-	7,  // 9: mantrae.v1.ProfileService.DeleteProfile:input_type -> mantrae.v1.DeleteProfileRequest
-	9,  // 10: mantrae.v1.ProfileService.ListProfiles:input_type -> mantrae.v1.ListProfilesRequest
-	2,  // 11: mantrae.v1.ProfileService.GetProfile:output_type -> mantrae.v1.GetProfileResponse
-	4,  // 12: mantrae.v1.ProfileService.CreateProfile:output_type -> mantrae.v1.CreateProfileResponse
-	6,  // 13: mantrae.v1.ProfileService.UpdateProfile:output_type -> mantrae.v1.UpdateProfileResponse
-	8,  // 14: mantrae.v1.ProfileService.DeleteProfile:output_type -> mantrae.v1.DeleteProfileResponse
-	10, // 15: mantrae.v1.ProfileService.ListProfiles:output_type -> mantrae.v1.ListProfilesResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	0,  // 6: mantrae.v1.PublishProfileResponse.profile:type_name -> mantrae.v1.Profile
+	27, // 7: mantrae.v1.PublishProfileResponse.revision:type_name -> mantrae.v1.Revision
+	28, // 8: mantrae.v1.GetDraftDiffResponse.changes:type_name -> mantrae.v1.ConfigChange
+	29, // 9: mantrae.v1.ValidateProfileResponse.issues:type_name -> mantrae.v1.ValidationIssue
+	0,  // 10: mantrae.v1.CloneProfileResponse.profile:type_name -> mantrae.v1.Profile
+	30, // 11: mantrae.v1.ProfileResource.protocol:type_name -> mantrae.v1.ProtocolType
+	21, // 12: mantrae.v1.PlanPromotionRequest.resources:type_name -> mantrae.v1.ProfileResource
+	31, // 13: mantrae.v1.PlanPromotionResponse.items:type_name -> mantrae.v1.ImportItem
+	28, // 14: mantrae.v1.PlanPromotionResponse.changes:type_name -> mantrae.v1.ConfigChange
+	21, // 15: mantrae.v1.PromoteResourcesRequest.resources:type_name -> mantrae.v1.ProfileResource
+	32, // 16: mantrae.v1.PromoteResourcesRequest.strategy:type_name -> mantrae.v1.ImportStrategy
+	31, // 17: mantrae.v1.PromoteResourcesResponse.items:type_name -> mantrae.v1.ImportItem
+	1,  // 18: mantrae.v1.ProfileService.GetProfile:input_type -> mantrae.v1.GetProfileRequest
+	3,  // 19: mantrae.v1.ProfileService.CreateProfile:input_type -> mantrae.v1.CreateProfileRequest
+	5,  // 20: mantrae.v1.ProfileService.UpdateProfile:input_type -> mantrae.v1.UpdateProfileRequest
+	7,  // 21: mantrae.v1.ProfileService.DeleteProfile:input_type -> mantrae.v1.DeleteProfileRequest
+	9,  // 22: mantrae.v1.ProfileService.ListProfiles:input_type -> mantrae.v1.ListProfilesRequest
+	11, // 23: mantrae.v1.ProfileService.PublishProfile:input_type -> mantrae.v1.PublishProfileRequest
+	13, // 24: mantrae.v1.ProfileService.DiscardDraft:input_type -> mantrae.v1.DiscardDraftRequest
+	15, // 25: mantrae.v1.ProfileService.GetDraftDiff:input_type -> mantrae.v1.GetDraftDiffRequest
+	17, // 26: mantrae.v1.ProfileService.ValidateProfile:input_type -> mantrae.v1.ValidateProfileRequest
+	19, // 27: mantrae.v1.ProfileService.CloneProfile:input_type -> mantrae.v1.CloneProfileRequest
+	22, // 28: mantrae.v1.ProfileService.PlanPromotion:input_type -> mantrae.v1.PlanPromotionRequest
+	24, // 29: mantrae.v1.ProfileService.PromoteResources:input_type -> mantrae.v1.PromoteResourcesRequest
+	2,  // 30: mantrae.v1.ProfileService.GetProfile:output_type -> mantrae.v1.GetProfileResponse
+	4,  // 31: mantrae.v1.ProfileService.CreateProfile:output_type -> mantrae.v1.CreateProfileResponse
+	6,  // 32: mantrae.v1.ProfileService.UpdateProfile:output_type -> mantrae.v1.UpdateProfileResponse
+	8,  // 33: mantrae.v1.ProfileService.DeleteProfile:output_type -> mantrae.v1.DeleteProfileResponse
+	10, // 34: mantrae.v1.ProfileService.ListProfiles:output_type -> mantrae.v1.ListProfilesResponse
+	12, // 35: mantrae.v1.ProfileService.PublishProfile:output_type -> mantrae.v1.PublishProfileResponse
+	14, // 36: mantrae.v1.ProfileService.DiscardDraft:output_type -> mantrae.v1.DiscardDraftResponse
+	16, // 37: mantrae.v1.ProfileService.GetDraftDiff:output_type -> mantrae.v1.GetDraftDiffResponse
+	18, // 38: mantrae.v1.ProfileService.ValidateProfile:output_type -> mantrae.v1.ValidateProfileResponse
+	20, // 39: mantrae.v1.ProfileService.CloneProfile:output_type -> mantrae.v1.CloneProfileResponse
+	23, // 40: mantrae.v1.ProfileService.PlanPromotion:output_type -> mantrae.v1.PlanPromotionResponse
+	25, // 41: mantrae.v1.ProfileService.PromoteResources:output_type -> mantrae.v1.PromoteResourcesResponse
+	30, // [30:42] is the sub-list for method output_type
+	18, // [18:30] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_mantrae_v1_profile_proto_init() }
@@ -701,16 +1556,22 @@ func file_mantrae_v1_profile_proto_init() {
 	if File_mantrae_v1_profile_proto != nil {
 		return
 	}
+	file_mantrae_v1_backup_proto_init()
+	file_mantrae_v1_protocol_proto_init()
+	file_mantrae_v1_revision_proto_init()
+	file_mantrae_v1_validation_proto_init()
+	file_mantrae_v1_profile_proto_msgTypes[0].OneofWrappers = []any{}
 	file_mantrae_v1_profile_proto_msgTypes[3].OneofWrappers = []any{}
 	file_mantrae_v1_profile_proto_msgTypes[5].OneofWrappers = []any{}
 	file_mantrae_v1_profile_proto_msgTypes[9].OneofWrappers = []any{}
+	file_mantrae_v1_profile_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mantrae_v1_profile_proto_rawDesc), len(file_mantrae_v1_profile_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mantrae/v1/profile_member.proto
+
+package mantraev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProfileMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=mantrae.v1.Role" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileMember) Reset() {
+	*x = ProfileMember{}
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileMember) ProtoMessage() {}
+
+func (x *ProfileMember) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileMember.ProtoReflect.Descriptor instead.
+func (*ProfileMember) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_member_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProfileMember) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *ProfileMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProfileMember) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *ProfileMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProfileMember) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateProfileMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=mantrae.v1.Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProfileMemberRequest) Reset() {
+	*x = CreateProfileMemberRequest{}
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProfileMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProfileMemberRequest) ProtoMessage() {}
+
+func (x *CreateProfileMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProfileMemberRequest.ProtoReflect.Descriptor instead.
+func (*CreateProfileMemberRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_member_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateProfileMemberRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *CreateProfileMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateProfileMemberRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+type CreateProfileMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *ProfileMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProfileMemberResponse) Reset() {
+	*x = CreateProfileMemberResponse{}
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProfileMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProfileMemberResponse) ProtoMessage() {}
+
+func (x *CreateProfileMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProfileMemberResponse.ProtoReflect.Descriptor instead.
+func (*CreateProfileMemberResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_member_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateProfileMemberResponse) GetMember() *ProfileMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateProfileMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=mantrae.v1.Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileMemberRequest) Reset() {
+	*x = UpdateProfileMemberRequest{}
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileMemberRequest) ProtoMessage() {}
+
+func (x *UpdateProfileMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileMemberRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_member_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateProfileMemberRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *UpdateProfileMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateProfileMemberRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+type UpdateProfileMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *ProfileMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileMemberResponse) Reset() {
+	*x = UpdateProfileMemberResponse{}
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileMemberResponse) ProtoMessage() {}
+
+func (x *UpdateProfileMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileMemberResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_member_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateProfileMemberResponse) GetMember() *ProfileMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type DeleteProfileMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProfileMemberRequest) Reset() {
+	*x = DeleteProfileMemberRequest{}
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProfileMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProfileMemberRequest) ProtoMessage() {}
+
+func (x *DeleteProfileMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProfileMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProfileMemberRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_member_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteProfileMemberRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *DeleteProfileMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteProfileMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProfileMemberResponse) Reset() {
+	*x = DeleteProfileMemberResponse{}
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProfileMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProfileMemberResponse) ProtoMessage() {}
+
+func (x *DeleteProfileMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProfileMemberResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProfileMemberResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_member_proto_rawDescGZIP(), []int{6}
+}
+
+type ListProfileMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfileMembersRequest) Reset() {
+	*x = ListProfileMembersRequest{}
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfileMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfileMembersRequest) ProtoMessage() {}
+
+func (x *ListProfileMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfileMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListProfileMembersRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_member_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListProfileMembersRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+type ListProfileMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ProfileMember       `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfileMembersResponse) Reset() {
+	*x = ListProfileMembersResponse{}
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfileMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfileMembersResponse) ProtoMessage() {}
+
+func (x *ListProfileMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_member_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfileMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListProfileMembersResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_member_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListProfileMembersResponse) GetMembers() []*ProfileMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+var File_mantrae_v1_profile_member_proto protoreflect.FileDescriptor
+
+const file_mantrae_v1_profile_member_proto_rawDesc = "" +
+	"\n" +
+	"\x1fmantrae/v1/profile_member.proto\x12\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15mantrae/v1/user.proto\"\xe3\x01\n" +
+	"\rProfileMember\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03R\tprofileId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.mantrae.v1.RoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x96\x01\n" +
+	"\x1aCreateProfileMemberRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12.\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.mantrae.v1.RoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\"P\n" +
+	"\x1bCreateProfileMemberResponse\x121\n" +
+	"\x06member\x18\x01 \x01(\v2\x19.mantrae.v1.ProfileMemberR\x06member\"\x96\x01\n" +
+	"\x1aUpdateProfileMemberRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12.\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.mantrae.v1.RoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\"P\n" +
+	"\x1bUpdateProfileMemberResponse\x121\n" +
+	"\x06member\x18\x01 \x01(\v2\x19.mantrae.v1.ProfileMemberR\x06member\"f\n" +
+	"\x1aDeleteProfileMemberRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\"\x1d\n" +
+	"\x1bDeleteProfileMemberResponse\"C\n" +
+	"\x19ListProfileMembersRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\"Q\n" +
+	"\x1aListProfileMembersResponse\x123\n" +
+	"\amembers\x18\x01 \x03(\v2\x19.mantrae.v1.ProfileMemberR\amembers2\xb8\x03\n" +
+	"\x14ProfileMemberService\x12f\n" +
+	"\x13CreateProfileMember\x12&.mantrae.v1.CreateProfileMemberRequest\x1a'.mantrae.v1.CreateProfileMemberResponse\x12f\n" +
+	"\x13UpdateProfileMember\x12&.mantrae.v1.UpdateProfileMemberRequest\x1a'.mantrae.v1.UpdateProfileMemberResponse\x12f\n" +
+	"\x13DeleteProfileMember\x12&.mantrae.v1.DeleteProfileMemberRequest\x1a'.mantrae.v1.DeleteProfileMemberResponse\x12h\n" +
+	"\x12ListProfileMembers\x12%.mantrae.v1.ListProfileMembersRequest\x1a&.mantrae.v1.ListProfileMembersResponse\"\x03\x90\x02\x01B\xaf\x01\n" +
+	"\x0ecom.mantrae.v1B\x12ProfileMemberProtoP\x01Z@github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1;mantraev1\xa2\x02\x03MXX\xaa\x02\n" +
+	"Mantrae.V1\xca\x02\n" +
+	"Mantrae\\V1\xe2\x02\x16Mantrae\\V1\\GPBMetadata\xea\x02\vMantrae::V1b\x06proto3"
+
+var (
+	file_mantrae_v1_profile_member_proto_rawDescOnce sync.Once
+	file_mantrae_v1_profile_member_proto_rawDescData []byte
+)
+
+func file_mantrae_v1_profile_member_proto_rawDescGZIP() []byte {
+	file_mantrae_v1_profile_member_proto_rawDescOnce.Do(func() {
+		file_mantrae_v1_profile_member_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mantrae_v1_profile_member_proto_rawDesc), len(file_mantrae_v1_profile_member_proto_rawDesc)))
+	})
+	return file_mantrae_v1_profile_member_proto_rawDescData
+}
+
+var file_mantrae_v1_profile_member_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_mantrae_v1_profile_member_proto_goTypes = []any{
+	(*ProfileMember)(nil),               // 0: mantrae.v1.ProfileMember
+	(*CreateProfileMemberRequest)(nil),  // 1: mantrae.v1.CreateProfileMemberRequest
+	(*CreateProfileMemberResponse)(nil), // 2: mantrae.v1.CreateProfileMemberResponse
+	(*UpdateProfileMemberRequest)(nil),  // 3: mantrae.v1.UpdateProfileMemberRequest
+	(*UpdateProfileMemberResponse)(nil), // 4: mantrae.v1.UpdateProfileMemberResponse
+	(*DeleteProfileMemberRequest)(nil),  // 5: mantrae.v1.DeleteProfileMemberRequest
+	(*DeleteProfileMemberResponse)(nil), // 6: mantrae.v1.DeleteProfileMemberResponse
+	(*ListProfileMembersRequest)(nil),   // 7: mantrae.v1.ListProfileMembersRequest
+	(*ListProfileMembersResponse)(nil),  // 8: mantrae.v1.ListProfileMembersResponse
+	(Role)(0),                           // 9: mantrae.v1.Role
+	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+}
+var file_mantrae_v1_profile_member_proto_depIdxs = []int32{
+	9,  // 0: mantrae.v1.ProfileMember.role:type_name -> mantrae.v1.Role
+	10, // 1: mantrae.v1.ProfileMember.created_at:type_name -> google.protobuf.Timestamp
+	10, // 2: mantrae.v1.ProfileMember.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: mantrae.v1.CreateProfileMemberRequest.role:type_name -> mantrae.v1.Role
+	0,  // 4: mantrae.v1.CreateProfileMemberResponse.member:type_name -> mantrae.v1.ProfileMember
+	9,  // 5: mantrae.v1.UpdateProfileMemberRequest.role:type_name -> mantrae.v1.Role
+	0,  // 6: mantrae.v1.UpdateProfileMemberResponse.member:type_name -> mantrae.v1.ProfileMember
+	0,  // 7: mantrae.v1.ListProfileMembersResponse.members:type_name -> mantrae.v1.ProfileMember
+	1,  // 8: mantrae.v1.ProfileMemberService.CreateProfileMember:input_type -> mantrae.v1.CreateProfileMemberRequest
+	3,  // 9: mantrae.v1.ProfileMemberService.UpdateProfileMember:input_type -> mantrae.v1.UpdateProfileMemberRequest
+	5,  // 10: mantrae.v1.ProfileMemberService.DeleteProfileMember:input_type -> mantrae.v1.DeleteProfileMemberRequest
+	7,  // 11: mantrae.v1.ProfileMemberService.ListProfileMembers:input_type -> mantrae.v1.ListProfileMembersRequest
+	2,  // 12: mantrae.v1.ProfileMemberService.CreateProfileMember:output_type -> mantrae.v1.CreateProfileMemberResponse
+	4,  // 13: mantrae.v1.ProfileMemberService.UpdateProfileMember:output_type -> mantrae.v1.UpdateProfileMemberResponse
+	6,  // 14: mantrae.v1.ProfileMemberService.DeleteProfileMember:output_type -> mantrae.v1.DeleteProfileMemberResponse
+	8,  // 15: mantrae.v1.ProfileMemberService.ListProfileMembers:output_type -> mantrae.v1.ListProfileMembersResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_mantrae_v1_profile_member_proto_init() }
+func file_mantrae_v1_profile_member_proto_init() {
+	if File_mantrae_v1_profile_member_proto != nil {
+		return
+	}
+	file_mantrae_v1_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mantrae_v1_profile_member_proto_rawDesc), len(file_mantrae_v1_profile_member_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mantrae_v1_profile_member_proto_goTypes,
+		DependencyIndexes: file_mantrae_v1_profile_member_proto_depIdxs,
+		MessageInfos:      file_mantrae_v1_profile_member_proto_msgTypes,
+	}.Build()
+	File_mantrae_v1_profile_member_proto = out.File
+	file_mantrae_v1_profile_member_proto_goTypes = nil
+	file_mantrae_v1_profile_member_proto_depIdxs = nil
+}
@@ -0,0 +1,731 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mantrae/v1/profile_variable.proto
+
+package mantraev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProfileVariable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProfileId     int64                  `protobuf:"varint,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileVariable) Reset() {
+	*x = ProfileVariable{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileVariable) ProtoMessage() {}
+
+func (x *ProfileVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileVariable.ProtoReflect.Descriptor instead.
+func (*ProfileVariable) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProfileVariable) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProfileVariable) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *ProfileVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProfileVariable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ProfileVariable) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ProfileVariable) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProfileVariable) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetProfileVariableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileVariableRequest) Reset() {
+	*x = GetProfileVariableRequest{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileVariableRequest) ProtoMessage() {}
+
+func (x *GetProfileVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileVariableRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileVariableRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetProfileVariableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetProfileVariableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variable      *ProfileVariable       `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileVariableResponse) Reset() {
+	*x = GetProfileVariableResponse{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileVariableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileVariableResponse) ProtoMessage() {}
+
+func (x *GetProfileVariableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileVariableResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileVariableResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetProfileVariableResponse) GetVariable() *ProfileVariable {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+type CreateProfileVariableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProfileVariableRequest) Reset() {
+	*x = CreateProfileVariableRequest{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProfileVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProfileVariableRequest) ProtoMessage() {}
+
+func (x *CreateProfileVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProfileVariableRequest.ProtoReflect.Descriptor instead.
+func (*CreateProfileVariableRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateProfileVariableRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *CreateProfileVariableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProfileVariableRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CreateProfileVariableRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type CreateProfileVariableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variable      *ProfileVariable       `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProfileVariableResponse) Reset() {
+	*x = CreateProfileVariableResponse{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProfileVariableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProfileVariableResponse) ProtoMessage() {}
+
+func (x *CreateProfileVariableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProfileVariableResponse.ProtoReflect.Descriptor instead.
+func (*CreateProfileVariableResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateProfileVariableResponse) GetVariable() *ProfileVariable {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+type UpdateProfileVariableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileVariableRequest) Reset() {
+	*x = UpdateProfileVariableRequest{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileVariableRequest) ProtoMessage() {}
+
+func (x *UpdateProfileVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileVariableRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileVariableRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateProfileVariableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProfileVariableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProfileVariableRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UpdateProfileVariableRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type UpdateProfileVariableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variable      *ProfileVariable       `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileVariableResponse) Reset() {
+	*x = UpdateProfileVariableResponse{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileVariableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileVariableResponse) ProtoMessage() {}
+
+func (x *UpdateProfileVariableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileVariableResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileVariableResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateProfileVariableResponse) GetVariable() *ProfileVariable {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+type DeleteProfileVariableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProfileVariableRequest) Reset() {
+	*x = DeleteProfileVariableRequest{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProfileVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProfileVariableRequest) ProtoMessage() {}
+
+func (x *DeleteProfileVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProfileVariableRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProfileVariableRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteProfileVariableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProfileVariableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProfileVariableResponse) Reset() {
+	*x = DeleteProfileVariableResponse{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProfileVariableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProfileVariableResponse) ProtoMessage() {}
+
+func (x *DeleteProfileVariableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProfileVariableResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProfileVariableResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{8}
+}
+
+type ListProfileVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfileVariablesRequest) Reset() {
+	*x = ListProfileVariablesRequest{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfileVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfileVariablesRequest) ProtoMessage() {}
+
+func (x *ListProfileVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfileVariablesRequest.ProtoReflect.Descriptor instead.
+func (*ListProfileVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListProfileVariablesRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+type ListProfileVariablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variables     []*ProfileVariable     `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfileVariablesResponse) Reset() {
+	*x = ListProfileVariablesResponse{}
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfileVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfileVariablesResponse) ProtoMessage() {}
+
+func (x *ListProfileVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_profile_variable_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfileVariablesResponse.ProtoReflect.Descriptor instead.
+func (*ListProfileVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_profile_variable_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListProfileVariablesResponse) GetVariables() []*ProfileVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+var File_mantrae_v1_profile_variable_proto protoreflect.FileDescriptor
+
+const file_mantrae_v1_profile_variable_proto_rawDesc = "" +
+	"\n" +
+	"!mantrae/v1/profile_variable.proto\x12\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x02\n" +
+	"\x0fProfileVariable\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\x03R\tprofileId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_description\"4\n" +
+	"\x19GetProfileVariableRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\"U\n" +
+	"\x1aGetProfileVariableResponse\x127\n" +
+	"\bvariable\x18\x01 \x01(\v2\x1b.mantrae.v1.ProfileVariableR\bvariable\"\xc8\x01\n" +
+	"\x1cCreateProfileVariableRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\x123\n" +
+	"\x04name\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[A-Za-z_][A-Za-z0-9_]*$R\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"X\n" +
+	"\x1dCreateProfileVariableResponse\x127\n" +
+	"\bvariable\x18\x01 \x01(\v2\x1b.mantrae.v1.ProfileVariableR\bvariable\"\xb9\x01\n" +
+	"\x1cUpdateProfileVariableRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x123\n" +
+	"\x04name\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[A-Za-z_][A-Za-z0-9_]*$R\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"X\n" +
+	"\x1dUpdateProfileVariableResponse\x127\n" +
+	"\bvariable\x18\x01 \x01(\v2\x1b.mantrae.v1.ProfileVariableR\bvariable\"7\n" +
+	"\x1cDeleteProfileVariableRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\"\x1f\n" +
+	"\x1dDeleteProfileVariableResponse\"E\n" +
+	"\x1bListProfileVariablesRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\"Y\n" +
+	"\x1cListProfileVariablesResponse\x129\n" +
+	"\tvariables\x18\x01 \x03(\v2\x1b.mantrae.v1.ProfileVariableR\tvariables2\xbc\x04\n" +
+	"\x16ProfileVariableService\x12h\n" +
+	"\x12GetProfileVariable\x12%.mantrae.v1.GetProfileVariableRequest\x1a&.mantrae.v1.GetProfileVariableResponse\"\x03\x90\x02\x01\x12l\n" +
+	"\x15CreateProfileVariable\x12(.mantrae.v1.CreateProfileVariableRequest\x1a).mantrae.v1.CreateProfileVariableResponse\x12l\n" +
+	"\x15UpdateProfileVariable\x12(.mantrae.v1.UpdateProfileVariableRequest\x1a).mantrae.v1.UpdateProfileVariableResponse\x12l\n" +
+	"\x15DeleteProfileVariable\x12(.mantrae.v1.DeleteProfileVariableRequest\x1a).mantrae.v1.DeleteProfileVariableResponse\x12n\n" +
+	"\x14ListProfileVariables\x12'.mantrae.v1.ListProfileVariablesRequest\x1a(.mantrae.v1.ListProfileVariablesResponse\"\x03\x90\x02\x01B\xb1\x01\n" +
+	"\x0ecom.mantrae.v1B\x14ProfileVariableProtoP\x01Z@github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1;mantraev1\xa2\x02\x03MXX\xaa\x02\n" +
+	"Mantrae.V1\xca\x02\n" +
+	"Mantrae\\V1\xe2\x02\x16Mantrae\\V1\\GPBMetadata\xea\x02\vMantrae::V1b\x06proto3"
+
+var (
+	file_mantrae_v1_profile_variable_proto_rawDescOnce sync.Once
+	file_mantrae_v1_profile_variable_proto_rawDescData []byte
+)
+
+func file_mantrae_v1_profile_variable_proto_rawDescGZIP() []byte {
+	file_mantrae_v1_profile_variable_proto_rawDescOnce.Do(func() {
+		file_mantrae_v1_profile_variable_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mantrae_v1_profile_variable_proto_rawDesc), len(file_mantrae_v1_profile_variable_proto_rawDesc)))
+	})
+	return file_mantrae_v1_profile_variable_proto_rawDescData
+}
+
+var file_mantrae_v1_profile_variable_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_mantrae_v1_profile_variable_proto_goTypes = []any{
+	(*ProfileVariable)(nil),               // 0: mantrae.v1.ProfileVariable
+	(*GetProfileVariableRequest)(nil),     // 1: mantrae.v1.GetProfileVariableRequest
+	(*GetProfileVariableResponse)(nil),    // 2: mantrae.v1.GetProfileVariableResponse
+	(*CreateProfileVariableRequest)(nil),  // 3: mantrae.v1.CreateProfileVariableRequest
+	(*CreateProfileVariableResponse)(nil), // 4: mantrae.v1.CreateProfileVariableResponse
+	(*UpdateProfileVariableRequest)(nil),  // 5: mantrae.v1.UpdateProfileVariableRequest
+	(*UpdateProfileVariableResponse)(nil), // 6: mantrae.v1.UpdateProfileVariableResponse
+	(*DeleteProfileVariableRequest)(nil),  // 7: mantrae.v1.DeleteProfileVariableRequest
+	(*DeleteProfileVariableResponse)(nil), // 8: mantrae.v1.DeleteProfileVariableResponse
+	(*ListProfileVariablesRequest)(nil),   // 9: mantrae.v1.ListProfileVariablesRequest
+	(*ListProfileVariablesResponse)(nil),  // 10: mantrae.v1.ListProfileVariablesResponse
+	(*timestamppb.Timestamp)(nil),         // 11: google.protobuf.Timestamp
+}
+var file_mantrae_v1_profile_variable_proto_depIdxs = []int32{
+	11, // 0: mantrae.v1.ProfileVariable.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: mantrae.v1.ProfileVariable.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: mantrae.v1.GetProfileVariableResponse.variable:type_name -> mantrae.v1.ProfileVariable
+	0,  // 3: mantrae.v1.CreateProfileVariableResponse.variable:type_name -> mantrae.v1.ProfileVariable
+	0,  // 4: mantrae.v1.UpdateProfileVariableResponse.variable:type_name -> mantrae.v1.ProfileVariable
+	0,  // 5: mantrae.v1.ListProfileVariablesResponse.variables:type_name -> mantrae.v1.ProfileVariable
+	1,  // 6: mantrae.v1.ProfileVariableService.GetProfileVariable:input_type -> mantrae.v1.GetProfileVariableRequest
+	3,  // 7: mantrae.v1.ProfileVariableService.CreateProfileVariable:input_type -> mantrae.v1.CreateProfileVariableRequest
+	5,  // 8: mantrae.v1.ProfileVariableService.UpdateProfileVariable:input_type -> mantrae.v1.UpdateProfileVariableRequest
+	7,  // 9: mantrae.v1.ProfileVariableService.DeleteProfileVariable:input_type -> mantrae.v1.DeleteProfileVariableRequest
+	9,  // 10: mantrae.v1.ProfileVariableService.ListProfileVariables:input_type -> mantrae.v1.ListProfileVariablesRequest
+	2,  // 11: mantrae.v1.ProfileVariableService.GetProfileVariable:output_type -> mantrae.v1.GetProfileVariableResponse
+	4,  // 12: mantrae.v1.ProfileVariableService.CreateProfileVariable:output_type -> mantrae.v1.CreateProfileVariableResponse
+	6,  // 13: mantrae.v1.ProfileVariableService.UpdateProfileVariable:output_type -> mantrae.v1.UpdateProfileVariableResponse
+	8,  // 14: mantrae.v1.ProfileVariableService.DeleteProfileVariable:output_type -> mantrae.v1.DeleteProfileVariableResponse
+	10, // 15: mantrae.v1.ProfileVariableService.ListProfileVariables:output_type -> mantrae.v1.ListProfileVariablesResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_mantrae_v1_profile_variable_proto_init() }
+func file_mantrae_v1_profile_variable_proto_init() {
+	if File_mantrae_v1_profile_variable_proto != nil {
+		return
+	}
+	file_mantrae_v1_profile_variable_proto_msgTypes[0].OneofWrappers = []any{}
+	file_mantrae_v1_profile_variable_proto_msgTypes[3].OneofWrappers = []any{}
+	file_mantrae_v1_profile_variable_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mantrae_v1_profile_variable_proto_rawDesc), len(file_mantrae_v1_profile_variable_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mantrae_v1_profile_variable_proto_goTypes,
+		DependencyIndexes: file_mantrae_v1_profile_variable_proto_depIdxs,
+		MessageInfos:      file_mantrae_v1_profile_variable_proto_msgTypes,
+	}.Build()
+	File_mantrae_v1_profile_variable_proto = out.File
+	file_mantrae_v1_profile_variable_proto_goTypes = nil
+	file_mantrae_v1_profile_variable_proto_depIdxs = nil
+}
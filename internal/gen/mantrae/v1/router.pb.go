@@ -24,6 +24,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ConflictKind int32
+
+const (
+	ConflictKind_CONFLICT_KIND_UNSPECIFIED ConflictKind = 0
+	ConflictKind_CONFLICT_KIND_SHADOWED    ConflictKind = 1
+	ConflictKind_CONFLICT_KIND_AMBIGUOUS   ConflictKind = 2
+)
+
+// Enum value maps for ConflictKind.
+var (
+	ConflictKind_name = map[int32]string{
+		0: "CONFLICT_KIND_UNSPECIFIED",
+		1: "CONFLICT_KIND_SHADOWED",
+		2: "CONFLICT_KIND_AMBIGUOUS",
+	}
+	ConflictKind_value = map[string]int32{
+		"CONFLICT_KIND_UNSPECIFIED": 0,
+		"CONFLICT_KIND_SHADOWED":    1,
+		"CONFLICT_KIND_AMBIGUOUS":   2,
+	}
+)
+
+func (x ConflictKind) Enum() *ConflictKind {
+	p := new(ConflictKind)
+	*p = x
+	return p
+}
+
+func (x ConflictKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_mantrae_v1_router_proto_enumTypes[0].Descriptor()
+}
+
+func (ConflictKind) Type() protoreflect.EnumType {
+	return &file_mantrae_v1_router_proto_enumTypes[0]
+}
+
+func (x ConflictKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictKind.Descriptor instead.
+func (ConflictKind) EnumDescriptor() ([]byte, []int) {
+	return file_mantrae_v1_router_proto_rawDescGZIP(), []int{0}
+}
+
 type Router struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -323,6 +372,7 @@ func (x *CreateRouterRequest) GetType() ProtocolType {
 type CreateRouterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Router        *Router                `protobuf:"bytes,1,opt,name=router,proto3" json:"router,omitempty"`
+	Issues        []*ValidationIssue     `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -364,6 +414,13 @@ func (x *CreateRouterResponse) GetRouter() *Router {
 	return nil
 }
 
+func (x *CreateRouterResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 type UpdateRouterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -451,6 +508,7 @@ func (x *UpdateRouterRequest) GetDnsProviders() []*DNSProvider {
 type UpdateRouterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Router        *Router                `protobuf:"bytes,1,opt,name=router,proto3" json:"router,omitempty"`
+	Issues        []*ValidationIssue     `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -492,6 +550,13 @@ func (x *UpdateRouterResponse) GetRouter() *Router {
 	return nil
 }
 
+func (x *UpdateRouterResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 type DeleteRouterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -708,12 +773,556 @@ func (x *ListRoutersResponse) GetTotalCount() int64 {
 	return 0
 }
 
+type RouteConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ConflictKind           `protobuf:"varint,1,opt,name=kind,proto3,enum=mantrae.v1.ConflictKind" json:"kind,omitempty"`
+	Type          ProtocolType           `protobuf:"varint,2,opt,name=type,proto3,enum=mantrae.v1.ProtocolType" json:"type,omitempty"`
+	Router        string                 `protobuf:"bytes,3,opt,name=router,proto3" json:"router,omitempty"`
+	Other         string                 `protobuf:"bytes,4,opt,name=other,proto3" json:"other,omitempty"`
+	Priority      int32                  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	OtherPriority int32                  `protobuf:"varint,6,opt,name=other_priority,json=otherPriority,proto3" json:"other_priority,omitempty"`
+	EntryPoints   []string               `protobuf:"bytes,7,rep,name=entry_points,json=entryPoints,proto3" json:"entry_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteConflict) Reset() {
+	*x = RouteConflict{}
+	mi := &file_mantrae_v1_router_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteConflict) ProtoMessage() {}
+
+func (x *RouteConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_router_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteConflict.ProtoReflect.Descriptor instead.
+func (*RouteConflict) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_router_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RouteConflict) GetKind() ConflictKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ConflictKind_CONFLICT_KIND_UNSPECIFIED
+}
+
+func (x *RouteConflict) GetType() ProtocolType {
+	if x != nil {
+		return x.Type
+	}
+	return ProtocolType_PROTOCOL_TYPE_UNSPECIFIED
+}
+
+func (x *RouteConflict) GetRouter() string {
+	if x != nil {
+		return x.Router
+	}
+	return ""
+}
+
+func (x *RouteConflict) GetOther() string {
+	if x != nil {
+		return x.Other
+	}
+	return ""
+}
+
+func (x *RouteConflict) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RouteConflict) GetOtherPriority() int32 {
+	if x != nil {
+		return x.OtherPriority
+	}
+	return 0
+}
+
+func (x *RouteConflict) GetEntryPoints() []string {
+	if x != nil {
+		return x.EntryPoints
+	}
+	return nil
+}
+
+type ListRouteConflictsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRouteConflictsRequest) Reset() {
+	*x = ListRouteConflictsRequest{}
+	mi := &file_mantrae_v1_router_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRouteConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRouteConflictsRequest) ProtoMessage() {}
+
+func (x *ListRouteConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_router_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRouteConflictsRequest.ProtoReflect.Descriptor instead.
+func (*ListRouteConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_router_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListRouteConflictsRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+type ListRouteConflictsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conflicts     []*RouteConflict       `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRouteConflictsResponse) Reset() {
+	*x = ListRouteConflictsResponse{}
+	mi := &file_mantrae_v1_router_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRouteConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRouteConflictsResponse) ProtoMessage() {}
+
+func (x *ListRouteConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_router_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRouteConflictsResponse.ProtoReflect.Descriptor instead.
+func (*ListRouteConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_router_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListRouteConflictsResponse) GetConflicts() []*RouteConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type SimulateRouteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	EntryPoint    string                 `protobuf:"bytes,2,opt,name=entry_point,json=entryPoint,proto3" json:"entry_point,omitempty"`
+	Tls           bool                   `protobuf:"varint,3,opt,name=tls,proto3" json:"tls,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Host          string                 `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`
+	Path          string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ClientIp      string                 `protobuf:"bytes,8,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateRouteRequest) Reset() {
+	*x = SimulateRouteRequest{}
+	mi := &file_mantrae_v1_router_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateRouteRequest) ProtoMessage() {}
+
+func (x *SimulateRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_router_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateRouteRequest.ProtoReflect.Descriptor instead.
+func (*SimulateRouteRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_router_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SimulateRouteRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *SimulateRouteRequest) GetEntryPoint() string {
+	if x != nil {
+		return x.EntryPoint
+	}
+	return ""
+}
+
+func (x *SimulateRouteRequest) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *SimulateRouteRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SimulateRouteRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SimulateRouteRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SimulateRouteRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *SimulateRouteRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+type RouteCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Router        string                 `protobuf:"bytes,1,opt,name=router,proto3" json:"router,omitempty"`
+	Rule          string                 `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Matches       bool                   `protobuf:"varint,4,opt,name=matches,proto3" json:"matches,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteCandidate) Reset() {
+	*x = RouteCandidate{}
+	mi := &file_mantrae_v1_router_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteCandidate) ProtoMessage() {}
+
+func (x *RouteCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_router_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteCandidate.ProtoReflect.Descriptor instead.
+func (*RouteCandidate) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_router_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RouteCandidate) GetRouter() string {
+	if x != nil {
+		return x.Router
+	}
+	return ""
+}
+
+func (x *RouteCandidate) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RouteCandidate) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RouteCandidate) GetMatches() bool {
+	if x != nil {
+		return x.Matches
+	}
+	return false
+}
+
+func (x *RouteCandidate) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SimulatedMiddleware struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulatedMiddleware) Reset() {
+	*x = SimulatedMiddleware{}
+	mi := &file_mantrae_v1_router_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatedMiddleware) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedMiddleware) ProtoMessage() {}
+
+func (x *SimulatedMiddleware) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_router_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedMiddleware.ProtoReflect.Descriptor instead.
+func (*SimulatedMiddleware) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_router_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SimulatedMiddleware) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SimulatedMiddleware) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SimulatedMiddleware) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+type SimulatedService struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Servers       []string               `protobuf:"bytes,3,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulatedService) Reset() {
+	*x = SimulatedService{}
+	mi := &file_mantrae_v1_router_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatedService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedService) ProtoMessage() {}
+
+func (x *SimulatedService) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_router_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedService.ProtoReflect.Descriptor instead.
+func (*SimulatedService) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_router_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SimulatedService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SimulatedService) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SimulatedService) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type SimulateRouteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Router        string                 `protobuf:"bytes,1,opt,name=router,proto3" json:"router,omitempty"`
+	Candidates    []*RouteCandidate      `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Middlewares   []*SimulatedMiddleware `protobuf:"bytes,3,rep,name=middlewares,proto3" json:"middlewares,omitempty"`
+	Services      []*SimulatedService    `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateRouteResponse) Reset() {
+	*x = SimulateRouteResponse{}
+	mi := &file_mantrae_v1_router_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateRouteResponse) ProtoMessage() {}
+
+func (x *SimulateRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_router_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateRouteResponse.ProtoReflect.Descriptor instead.
+func (*SimulateRouteResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_router_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SimulateRouteResponse) GetRouter() string {
+	if x != nil {
+		return x.Router
+	}
+	return ""
+}
+
+func (x *SimulateRouteResponse) GetCandidates() []*RouteCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *SimulateRouteResponse) GetMiddlewares() []*SimulatedMiddleware {
+	if x != nil {
+		return x.Middlewares
+	}
+	return nil
+}
+
+func (x *SimulateRouteResponse) GetServices() []*SimulatedService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
 var File_mantrae_v1_router_proto protoreflect.FileDescriptor
 
 const file_mantrae_v1_router_proto_rawDesc = "" +
 	"\n" +
 	"\x17mantrae/v1/router.proto\x12\n" +
-	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1dmantrae/v1/dns_provider.proto\x1a\x19mantrae/v1/protocol.proto\"\x93\x03\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1dmantrae/v1/dns_provider.proto\x1a\x19mantrae/v1/protocol.proto\x1a\x1bmantrae/v1/validation.proto\"\x93\x03\n" +
 	"\x06Router\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -742,18 +1351,20 @@ const file_mantrae_v1_router_proto_rawDesc = "" +
 	"\x06config\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x18\n" +
 	"\aenabled\x18\x05 \x01(\bR\aenabled\x126\n" +
 	"\x04type\x18\x06 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04typeB\v\n" +
-	"\t_agent_id\"B\n" +
+	"\t_agent_id\"w\n" +
 	"\x14CreateRouterResponse\x12*\n" +
-	"\x06router\x18\x01 \x01(\v2\x12.mantrae.v1.RouterR\x06router\"\x8c\x02\n" +
+	"\x06router\x18\x01 \x01(\v2\x12.mantrae.v1.RouterR\x06router\x123\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1b.mantrae.v1.ValidationIssueR\x06issues\"\x8c\x02\n" +
 	"\x13UpdateRouterRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1b\n" +
 	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x126\n" +
 	"\x04type\x18\x03 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\x12/\n" +
 	"\x06config\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x18\n" +
 	"\aenabled\x18\x05 \x01(\bR\aenabled\x12<\n" +
-	"\rdns_providers\x18\x06 \x03(\v2\x17.mantrae.v1.DNSProviderR\fdnsProviders\"B\n" +
+	"\rdns_providers\x18\x06 \x03(\v2\x17.mantrae.v1.DNSProviderR\fdnsProviders\"w\n" +
 	"\x14UpdateRouterResponse\x12*\n" +
-	"\x06router\x18\x01 \x01(\v2\x12.mantrae.v1.RouterR\x06router\"f\n" +
+	"\x06router\x18\x01 \x01(\v2\x12.mantrae.v1.RouterR\x06router\x123\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1b.mantrae.v1.ValidationIssueR\x06issues\"f\n" +
 	"\x13DeleteRouterRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x126\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\"\x16\n" +
@@ -773,13 +1384,67 @@ const file_mantrae_v1_router_proto_rawDesc = "" +
 	"\x13ListRoutersResponse\x12,\n" +
 	"\arouters\x18\x01 \x03(\v2\x12.mantrae.v1.RouterR\arouters\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
-	"totalCount2\xac\x03\n" +
+	"totalCount\"\xff\x01\n" +
+	"\rRouteConflict\x12,\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x18.mantrae.v1.ConflictKindR\x04kind\x12,\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeR\x04type\x12\x16\n" +
+	"\x06router\x18\x03 \x01(\tR\x06router\x12\x14\n" +
+	"\x05other\x18\x04 \x01(\tR\x05other\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\x12%\n" +
+	"\x0eother_priority\x18\x06 \x01(\x05R\rotherPriority\x12!\n" +
+	"\fentry_points\x18\a \x03(\tR\ventryPoints\"C\n" +
+	"\x19ListRouteConflictsRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\"U\n" +
+	"\x1aListRouteConflictsResponse\x127\n" +
+	"\tconflicts\x18\x01 \x03(\v2\x19.mantrae.v1.RouteConflictR\tconflicts\"\xd3\x02\n" +
+	"\x14SimulateRouteRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\x12\x1f\n" +
+	"\ventry_point\x18\x02 \x01(\tR\n" +
+	"entryPoint\x12\x10\n" +
+	"\x03tls\x18\x03 \x01(\bR\x03tls\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x12\n" +
+	"\x04host\x18\x05 \x01(\tR\x04host\x12\x12\n" +
+	"\x04path\x18\x06 \x01(\tR\x04path\x12G\n" +
+	"\aheaders\x18\a \x03(\v2-.mantrae.v1.SimulateRouteRequest.HeadersEntryR\aheaders\x12\x1b\n" +
+	"\tclient_ip\x18\b \x01(\tR\bclientIp\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x88\x01\n" +
+	"\x0eRouteCandidate\x12\x16\n" +
+	"\x06router\x18\x01 \x01(\tR\x06router\x12\x12\n" +
+	"\x04rule\x18\x02 \x01(\tR\x04rule\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12\x18\n" +
+	"\amatches\x18\x04 \x01(\bR\amatches\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"W\n" +
+	"\x13SimulatedMiddleware\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\"T\n" +
+	"\x10SimulatedService\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\aservers\x18\x03 \x03(\tR\aservers\"\xe8\x01\n" +
+	"\x15SimulateRouteResponse\x12\x16\n" +
+	"\x06router\x18\x01 \x01(\tR\x06router\x12:\n" +
+	"\n" +
+	"candidates\x18\x02 \x03(\v2\x1a.mantrae.v1.RouteCandidateR\n" +
+	"candidates\x12A\n" +
+	"\vmiddlewares\x18\x03 \x03(\v2\x1f.mantrae.v1.SimulatedMiddlewareR\vmiddlewares\x128\n" +
+	"\bservices\x18\x04 \x03(\v2\x1c.mantrae.v1.SimulatedServiceR\bservices*f\n" +
+	"\fConflictKind\x12\x1d\n" +
+	"\x19CONFLICT_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CONFLICT_KIND_SHADOWED\x10\x01\x12\x1b\n" +
+	"\x17CONFLICT_KIND_AMBIGUOUS\x10\x022\xf1\x04\n" +
 	"\rRouterService\x12M\n" +
 	"\tGetRouter\x12\x1c.mantrae.v1.GetRouterRequest\x1a\x1d.mantrae.v1.GetRouterResponse\"\x03\x90\x02\x01\x12Q\n" +
 	"\fCreateRouter\x12\x1f.mantrae.v1.CreateRouterRequest\x1a .mantrae.v1.CreateRouterResponse\x12Q\n" +
 	"\fUpdateRouter\x12\x1f.mantrae.v1.UpdateRouterRequest\x1a .mantrae.v1.UpdateRouterResponse\x12Q\n" +
 	"\fDeleteRouter\x12\x1f.mantrae.v1.DeleteRouterRequest\x1a .mantrae.v1.DeleteRouterResponse\x12S\n" +
-	"\vListRouters\x12\x1e.mantrae.v1.ListRoutersRequest\x1a\x1f.mantrae.v1.ListRoutersResponse\"\x03\x90\x02\x01B\xa8\x01\n" +
+	"\vListRouters\x12\x1e.mantrae.v1.ListRoutersRequest\x1a\x1f.mantrae.v1.ListRoutersResponse\"\x03\x90\x02\x01\x12h\n" +
+	"\x12ListRouteConflicts\x12%.mantrae.v1.ListRouteConflictsRequest\x1a&.mantrae.v1.ListRouteConflictsResponse\"\x03\x90\x02\x01\x12Y\n" +
+	"\rSimulateRoute\x12 .mantrae.v1.SimulateRouteRequest\x1a!.mantrae.v1.SimulateRouteResponse\"\x03\x90\x02\x01B\xa8\x01\n" +
 	"\x0ecom.mantrae.v1B\vRouterProtoP\x01Z@github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1;mantraev1\xa2\x02\x03MXX\xaa\x02\n" +
 	"Mantrae.V1\xca\x02\n" +
 	"Mantrae\\V1\xe2\x02\x16Mantrae\\V1\\GPBMetadata\xea\x02\vMantrae::V1b\x06proto3"
@@ -796,57 +1461,82 @@ func file_mantrae_v1_router_proto_rawDescGZIP() []byte {
 	return file_mantrae_v1_router_proto_rawDescData
 }
 
-var file_mantrae_v1_router_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_mantrae_v1_router_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mantrae_v1_router_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_mantrae_v1_router_proto_goTypes = []any{
-	(*Router)(nil),                // 0: mantrae.v1.Router
-	(*GetRouterRequest)(nil),      // 1: mantrae.v1.GetRouterRequest
-	(*GetRouterResponse)(nil),     // 2: mantrae.v1.GetRouterResponse
-	(*CreateRouterRequest)(nil),   // 3: mantrae.v1.CreateRouterRequest
-	(*CreateRouterResponse)(nil),  // 4: mantrae.v1.CreateRouterResponse
-	(*UpdateRouterRequest)(nil),   // 5: mantrae.v1.UpdateRouterRequest
-	(*UpdateRouterResponse)(nil),  // 6: mantrae.v1.UpdateRouterResponse
-	(*DeleteRouterRequest)(nil),   // 7: mantrae.v1.DeleteRouterRequest
-	(*DeleteRouterResponse)(nil),  // 8: mantrae.v1.DeleteRouterResponse
-	(*ListRoutersRequest)(nil),    // 9: mantrae.v1.ListRoutersRequest
-	(*ListRoutersResponse)(nil),   // 10: mantrae.v1.ListRoutersResponse
-	(*structpb.Struct)(nil),       // 11: google.protobuf.Struct
-	(ProtocolType)(0),             // 12: mantrae.v1.ProtocolType
-	(*DNSProvider)(nil),           // 13: mantrae.v1.DNSProvider
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(ConflictKind)(0),                  // 0: mantrae.v1.ConflictKind
+	(*Router)(nil),                     // 1: mantrae.v1.Router
+	(*GetRouterRequest)(nil),           // 2: mantrae.v1.GetRouterRequest
+	(*GetRouterResponse)(nil),          // 3: mantrae.v1.GetRouterResponse
+	(*CreateRouterRequest)(nil),        // 4: mantrae.v1.CreateRouterRequest
+	(*CreateRouterResponse)(nil),       // 5: mantrae.v1.CreateRouterResponse
+	(*UpdateRouterRequest)(nil),        // 6: mantrae.v1.UpdateRouterRequest
+	(*UpdateRouterResponse)(nil),       // 7: mantrae.v1.UpdateRouterResponse
+	(*DeleteRouterRequest)(nil),        // 8: mantrae.v1.DeleteRouterRequest
+	(*DeleteRouterResponse)(nil),       // 9: mantrae.v1.DeleteRouterResponse
+	(*ListRoutersRequest)(nil),         // 10: mantrae.v1.ListRoutersRequest
+	(*ListRoutersResponse)(nil),        // 11: mantrae.v1.ListRoutersResponse
+	(*RouteConflict)(nil),              // 12: mantrae.v1.RouteConflict
+	(*ListRouteConflictsRequest)(nil),  // 13: mantrae.v1.ListRouteConflictsRequest
+	(*ListRouteConflictsResponse)(nil), // 14: mantrae.v1.ListRouteConflictsResponse
+	(*SimulateRouteRequest)(nil),       // 15: mantrae.v1.SimulateRouteRequest
+	(*RouteCandidate)(nil),             // 16: mantrae.v1.RouteCandidate
+	(*SimulatedMiddleware)(nil),        // 17: mantrae.v1.SimulatedMiddleware
+	(*SimulatedService)(nil),           // 18: mantrae.v1.SimulatedService
+	(*SimulateRouteResponse)(nil),      // 19: mantrae.v1.SimulateRouteResponse
+	nil,                                // 20: mantrae.v1.SimulateRouteRequest.HeadersEntry
+	(*structpb.Struct)(nil),            // 21: google.protobuf.Struct
+	(ProtocolType)(0),                  // 22: mantrae.v1.ProtocolType
+	(*DNSProvider)(nil),                // 23: mantrae.v1.DNSProvider
+	(*timestamppb.Timestamp)(nil),      // 24: google.protobuf.Timestamp
+	(*ValidationIssue)(nil),            // 25: mantrae.v1.ValidationIssue
 }
 var file_mantrae_v1_router_proto_depIdxs = []int32{
-	11, // 0: mantrae.v1.Router.config:type_name -> google.protobuf.Struct
-	12, // 1: mantrae.v1.Router.type:type_name -> mantrae.v1.ProtocolType
-	13, // 2: mantrae.v1.Router.dns_providers:type_name -> mantrae.v1.DNSProvider
-	14, // 3: mantrae.v1.Router.created_at:type_name -> google.protobuf.Timestamp
-	14, // 4: mantrae.v1.Router.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 5: mantrae.v1.GetRouterRequest.type:type_name -> mantrae.v1.ProtocolType
-	0,  // 6: mantrae.v1.GetRouterResponse.router:type_name -> mantrae.v1.Router
-	11, // 7: mantrae.v1.CreateRouterRequest.config:type_name -> google.protobuf.Struct
-	12, // 8: mantrae.v1.CreateRouterRequest.type:type_name -> mantrae.v1.ProtocolType
-	0,  // 9: mantrae.v1.CreateRouterResponse.router:type_name -> mantrae.v1.Router
-	12, // 10: mantrae.v1.UpdateRouterRequest.type:type_name -> mantrae.v1.ProtocolType
-	11, // 11: mantrae.v1.UpdateRouterRequest.config:type_name -> google.protobuf.Struct
-	13, // 12: mantrae.v1.UpdateRouterRequest.dns_providers:type_name -> mantrae.v1.DNSProvider
-	0,  // 13: mantrae.v1.UpdateRouterResponse.router:type_name -> mantrae.v1.Router
-	12, // 14: mantrae.v1.DeleteRouterRequest.type:type_name -> mantrae.v1.ProtocolType
-	12, // 15: mantrae.v1.ListRoutersRequest.type:type_name -> mantrae.v1.ProtocolType
-	0,  // 16: mantrae.v1.ListRoutersResponse.routers:type_name -> mantrae.v1.Router
-	1,  // 17: mantrae.v1.RouterService.GetRouter:input_type -> mantrae.v1.GetRouterRequest
-	3,  // 18: mantrae.v1.RouterService.CreateRouter:input_type -> mantrae.v1.CreateRouterRequest
-	5,  // 19: mantrae.v1.RouterService.UpdateRouter:input_type -> mantrae.v1.UpdateRouterRequest
-	7,  // 20: mantrae.v1.RouterService.DeleteRouter:input_type -> mantrae.v1.DeleteRouterRequest
-	9,  // 21: mantrae.v1.RouterService.ListRouters:input_type -> mantrae.v1.ListRoutersRequest
-	2,  // 22: mantrae.v1.RouterService.GetRouter:output_type -> mantrae.v1.GetRouterResponse
-	4,  // 23: mantrae.v1.RouterService.CreateRouter:output_type -> mantrae.v1.CreateRouterResponse
-	6,  // 24: mantrae.v1.RouterService.UpdateRouter:output_type -> mantrae.v1.UpdateRouterResponse
-	8,  // 25: mantrae.v1.RouterService.DeleteRouter:output_type -> mantrae.v1.DeleteRouterResponse
-	10, // 26: mantrae.v1.RouterService.ListRouters:output_type -> mantrae.v1.ListRoutersResponse
-	22, // [22:27] is the sub-list for method output_type
-	17, // [17:22] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	21, // 0: mantrae.v1.Router.config:type_name -> google.protobuf.Struct
+	22, // 1: mantrae.v1.Router.type:type_name -> mantrae.v1.ProtocolType
+	23, // 2: mantrae.v1.Router.dns_providers:type_name -> mantrae.v1.DNSProvider
+	24, // 3: mantrae.v1.Router.created_at:type_name -> google.protobuf.Timestamp
+	24, // 4: mantrae.v1.Router.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 5: mantrae.v1.GetRouterRequest.type:type_name -> mantrae.v1.ProtocolType
+	1,  // 6: mantrae.v1.GetRouterResponse.router:type_name -> mantrae.v1.Router
+	21, // 7: mantrae.v1.CreateRouterRequest.config:type_name -> google.protobuf.Struct
+	22, // 8: mantrae.v1.CreateRouterRequest.type:type_name -> mantrae.v1.ProtocolType
+	1,  // 9: mantrae.v1.CreateRouterResponse.router:type_name -> mantrae.v1.Router
+	25, // 10: mantrae.v1.CreateRouterResponse.issues:type_name -> mantrae.v1.ValidationIssue
+	22, // 11: mantrae.v1.UpdateRouterRequest.type:type_name -> mantrae.v1.ProtocolType
+	21, // 12: mantrae.v1.UpdateRouterRequest.config:type_name -> google.protobuf.Struct
+	23, // 13: mantrae.v1.UpdateRouterRequest.dns_providers:type_name -> mantrae.v1.DNSProvider
+	1,  // 14: mantrae.v1.UpdateRouterResponse.router:type_name -> mantrae.v1.Router
+	25, // 15: mantrae.v1.UpdateRouterResponse.issues:type_name -> mantrae.v1.ValidationIssue
+	22, // 16: mantrae.v1.DeleteRouterRequest.type:type_name -> mantrae.v1.ProtocolType
+	22, // 17: mantrae.v1.ListRoutersRequest.type:type_name -> mantrae.v1.ProtocolType
+	1,  // 18: mantrae.v1.ListRoutersResponse.routers:type_name -> mantrae.v1.Router
+	0,  // 19: mantrae.v1.RouteConflict.kind:type_name -> mantrae.v1.ConflictKind
+	22, // 20: mantrae.v1.RouteConflict.type:type_name -> mantrae.v1.ProtocolType
+	12, // 21: mantrae.v1.ListRouteConflictsResponse.conflicts:type_name -> mantrae.v1.RouteConflict
+	20, // 22: mantrae.v1.SimulateRouteRequest.headers:type_name -> mantrae.v1.SimulateRouteRequest.HeadersEntry
+	16, // 23: mantrae.v1.SimulateRouteResponse.candidates:type_name -> mantrae.v1.RouteCandidate
+	17, // 24: mantrae.v1.SimulateRouteResponse.middlewares:type_name -> mantrae.v1.SimulatedMiddleware
+	18, // 25: mantrae.v1.SimulateRouteResponse.services:type_name -> mantrae.v1.SimulatedService
+	2,  // 26: mantrae.v1.RouterService.GetRouter:input_type -> mantrae.v1.GetRouterRequest
+	4,  // 27: mantrae.v1.RouterService.CreateRouter:input_type -> mantrae.v1.CreateRouterRequest
+	6,  // 28: mantrae.v1.RouterService.UpdateRouter:input_type -> mantrae.v1.UpdateRouterRequest
+	8,  // 29: mantrae.v1.RouterService.DeleteRouter:input_type -> mantrae.v1.DeleteRouterRequest
+	10, // 30: mantrae.v1.RouterService.ListRouters:input_type -> mantrae.v1.ListRoutersRequest
+	13, // 31: mantrae.v1.RouterService.ListRouteConflicts:input_type -> mantrae.v1.ListRouteConflictsRequest
+	15, // 32: mantrae.v1.RouterService.SimulateRoute:input_type -> mantrae.v1.SimulateRouteRequest
+	3,  // 33: mantrae.v1.RouterService.GetRouter:output_type -> mantrae.v1.GetRouterResponse
+	5,  // 34: mantrae.v1.RouterService.CreateRouter:output_type -> mantrae.v1.CreateRouterResponse
+	7,  // 35: mantrae.v1.RouterService.UpdateRouter:output_type -> mantrae.v1.UpdateRouterResponse
+	9,  // 36: mantrae.v1.RouterService.DeleteRouter:output_type -> mantrae.v1.DeleteRouterResponse
+	11, // 37: mantrae.v1.RouterService.ListRouters:output_type -> mantrae.v1.ListRoutersResponse
+	14, // 38: mantrae.v1.RouterService.ListRouteConflicts:output_type -> mantrae.v1.ListRouteConflictsResponse
+	19, // 39: mantrae.v1.RouterService.SimulateRoute:output_type -> mantrae.v1.SimulateRouteResponse
+	33, // [33:40] is the sub-list for method output_type
+	26, // [26:33] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_mantrae_v1_router_proto_init() }
@@ -856,6 +1546,7 @@ func file_mantrae_v1_router_proto_init() {
 	}
 	file_mantrae_v1_dns_provider_proto_init()
 	file_mantrae_v1_protocol_proto_init()
+	file_mantrae_v1_validation_proto_init()
 	file_mantrae_v1_router_proto_msgTypes[3].OneofWrappers = []any{}
 	file_mantrae_v1_router_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
@@ -863,13 +1554,14 @@ func file_mantrae_v1_router_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mantrae_v1_router_proto_rawDesc), len(file_mantrae_v1_router_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_mantrae_v1_router_proto_goTypes,
 		DependencyIndexes: file_mantrae_v1_router_proto_depIdxs,
+		EnumInfos:         file_mantrae_v1_router_proto_enumTypes,
 		MessageInfos:      file_mantrae_v1_router_proto_msgTypes,
 	}.Build()
 	File_mantrae_v1_router_proto = out.File
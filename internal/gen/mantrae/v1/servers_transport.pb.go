@@ -315,6 +315,7 @@ func (x *CreateServersTransportRequest) GetType() ProtocolType {
 type CreateServersTransportResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	ServersTransport *ServersTransport      `protobuf:"bytes,1,opt,name=servers_transport,json=serversTransport,proto3" json:"servers_transport,omitempty"`
+	Issues           []*ValidationIssue     `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -356,6 +357,13 @@ func (x *CreateServersTransportResponse) GetServersTransport() *ServersTransport
 	return nil
 }
 
+func (x *CreateServersTransportResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 type UpdateServersTransportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -435,6 +443,7 @@ func (x *UpdateServersTransportRequest) GetType() ProtocolType {
 type UpdateServersTransportResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	ServersTransport *ServersTransport      `protobuf:"bytes,1,opt,name=servers_transport,json=serversTransport,proto3" json:"servers_transport,omitempty"`
+	Issues           []*ValidationIssue     `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -476,6 +485,13 @@ func (x *UpdateServersTransportResponse) GetServersTransport() *ServersTransport
 	return nil
 }
 
+func (x *UpdateServersTransportResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 type DeleteServersTransportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -697,7 +713,7 @@ var File_mantrae_v1_servers_transport_proto protoreflect.FileDescriptor
 const file_mantrae_v1_servers_transport_proto_rawDesc = "" +
 	"\n" +
 	"\"mantrae/v1/servers_transport.proto\x12\n" +
-	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19mantrae/v1/protocol.proto\"\xdf\x02\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19mantrae/v1/protocol.proto\x1a\x1bmantrae/v1/validation.proto\"\xdf\x02\n" +
 	"\x10ServersTransport\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -724,17 +740,19 @@ const file_mantrae_v1_servers_transport_proto_rawDesc = "" +
 	"\x06config\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x18\n" +
 	"\aenabled\x18\x05 \x01(\bR\aenabled\x126\n" +
 	"\x04type\x18\x06 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04typeB\v\n" +
-	"\t_agent_id\"k\n" +
+	"\t_agent_id\"\xa0\x01\n" +
 	"\x1eCreateServersTransportResponse\x12I\n" +
-	"\x11servers_transport\x18\x01 \x01(\v2\x1c.mantrae.v1.ServersTransportR\x10serversTransport\"\xd8\x01\n" +
+	"\x11servers_transport\x18\x01 \x01(\v2\x1c.mantrae.v1.ServersTransportR\x10serversTransport\x123\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1b.mantrae.v1.ValidationIssueR\x06issues\"\xd8\x01\n" +
 	"\x1dUpdateServersTransportRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1b\n" +
 	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12/\n" +
 	"\x06config\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x18\n" +
 	"\aenabled\x18\x04 \x01(\bR\aenabled\x126\n" +
-	"\x04type\x18\x05 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\"k\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\"\xa0\x01\n" +
 	"\x1eUpdateServersTransportResponse\x12I\n" +
-	"\x11servers_transport\x18\x01 \x01(\v2\x1c.mantrae.v1.ServersTransportR\x10serversTransport\"p\n" +
+	"\x11servers_transport\x18\x01 \x01(\v2\x1c.mantrae.v1.ServersTransportR\x10serversTransport\x123\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1b.mantrae.v1.ValidationIssueR\x06issues\"p\n" +
 	"\x1dDeleteServersTransportRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x126\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\" \n" +
@@ -793,6 +811,7 @@ var file_mantrae_v1_servers_transport_proto_goTypes = []any{
 	(*structpb.Struct)(nil),                // 11: google.protobuf.Struct
 	(ProtocolType)(0),                      // 12: mantrae.v1.ProtocolType
 	(*timestamppb.Timestamp)(nil),          // 13: google.protobuf.Timestamp
+	(*ValidationIssue)(nil),                // 14: mantrae.v1.ValidationIssue
 }
 var file_mantrae_v1_servers_transport_proto_depIdxs = []int32{
 	11, // 0: mantrae.v1.ServersTransport.config:type_name -> google.protobuf.Struct
@@ -804,27 +823,29 @@ var file_mantrae_v1_servers_transport_proto_depIdxs = []int32{
 	11, // 6: mantrae.v1.CreateServersTransportRequest.config:type_name -> google.protobuf.Struct
 	12, // 7: mantrae.v1.CreateServersTransportRequest.type:type_name -> mantrae.v1.ProtocolType
 	0,  // 8: mantrae.v1.CreateServersTransportResponse.servers_transport:type_name -> mantrae.v1.ServersTransport
-	11, // 9: mantrae.v1.UpdateServersTransportRequest.config:type_name -> google.protobuf.Struct
-	12, // 10: mantrae.v1.UpdateServersTransportRequest.type:type_name -> mantrae.v1.ProtocolType
-	0,  // 11: mantrae.v1.UpdateServersTransportResponse.servers_transport:type_name -> mantrae.v1.ServersTransport
-	12, // 12: mantrae.v1.DeleteServersTransportRequest.type:type_name -> mantrae.v1.ProtocolType
-	12, // 13: mantrae.v1.ListServersTransportsRequest.type:type_name -> mantrae.v1.ProtocolType
-	0,  // 14: mantrae.v1.ListServersTransportsResponse.servers_transports:type_name -> mantrae.v1.ServersTransport
-	1,  // 15: mantrae.v1.ServersTransportService.GetServersTransport:input_type -> mantrae.v1.GetServersTransportRequest
-	3,  // 16: mantrae.v1.ServersTransportService.CreateServersTransport:input_type -> mantrae.v1.CreateServersTransportRequest
-	5,  // 17: mantrae.v1.ServersTransportService.UpdateServersTransport:input_type -> mantrae.v1.UpdateServersTransportRequest
-	7,  // 18: mantrae.v1.ServersTransportService.DeleteServersTransport:input_type -> mantrae.v1.DeleteServersTransportRequest
-	9,  // 19: mantrae.v1.ServersTransportService.ListServersTransports:input_type -> mantrae.v1.ListServersTransportsRequest
-	2,  // 20: mantrae.v1.ServersTransportService.GetServersTransport:output_type -> mantrae.v1.GetServersTransportResponse
-	4,  // 21: mantrae.v1.ServersTransportService.CreateServersTransport:output_type -> mantrae.v1.CreateServersTransportResponse
-	6,  // 22: mantrae.v1.ServersTransportService.UpdateServersTransport:output_type -> mantrae.v1.UpdateServersTransportResponse
-	8,  // 23: mantrae.v1.ServersTransportService.DeleteServersTransport:output_type -> mantrae.v1.DeleteServersTransportResponse
-	10, // 24: mantrae.v1.ServersTransportService.ListServersTransports:output_type -> mantrae.v1.ListServersTransportsResponse
-	20, // [20:25] is the sub-list for method output_type
-	15, // [15:20] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	14, // 9: mantrae.v1.CreateServersTransportResponse.issues:type_name -> mantrae.v1.ValidationIssue
+	11, // 10: mantrae.v1.UpdateServersTransportRequest.config:type_name -> google.protobuf.Struct
+	12, // 11: mantrae.v1.UpdateServersTransportRequest.type:type_name -> mantrae.v1.ProtocolType
+	0,  // 12: mantrae.v1.UpdateServersTransportResponse.servers_transport:type_name -> mantrae.v1.ServersTransport
+	14, // 13: mantrae.v1.UpdateServersTransportResponse.issues:type_name -> mantrae.v1.ValidationIssue
+	12, // 14: mantrae.v1.DeleteServersTransportRequest.type:type_name -> mantrae.v1.ProtocolType
+	12, // 15: mantrae.v1.ListServersTransportsRequest.type:type_name -> mantrae.v1.ProtocolType
+	0,  // 16: mantrae.v1.ListServersTransportsResponse.servers_transports:type_name -> mantrae.v1.ServersTransport
+	1,  // 17: mantrae.v1.ServersTransportService.GetServersTransport:input_type -> mantrae.v1.GetServersTransportRequest
+	3,  // 18: mantrae.v1.ServersTransportService.CreateServersTransport:input_type -> mantrae.v1.CreateServersTransportRequest
+	5,  // 19: mantrae.v1.ServersTransportService.UpdateServersTransport:input_type -> mantrae.v1.UpdateServersTransportRequest
+	7,  // 20: mantrae.v1.ServersTransportService.DeleteServersTransport:input_type -> mantrae.v1.DeleteServersTransportRequest
+	9,  // 21: mantrae.v1.ServersTransportService.ListServersTransports:input_type -> mantrae.v1.ListServersTransportsRequest
+	2,  // 22: mantrae.v1.ServersTransportService.GetServersTransport:output_type -> mantrae.v1.GetServersTransportResponse
+	4,  // 23: mantrae.v1.ServersTransportService.CreateServersTransport:output_type -> mantrae.v1.CreateServersTransportResponse
+	6,  // 24: mantrae.v1.ServersTransportService.UpdateServersTransport:output_type -> mantrae.v1.UpdateServersTransportResponse
+	8,  // 25: mantrae.v1.ServersTransportService.DeleteServersTransport:output_type -> mantrae.v1.DeleteServersTransportResponse
+	10, // 26: mantrae.v1.ServersTransportService.ListServersTransports:output_type -> mantrae.v1.ListServersTransportsResponse
+	22, // [22:27] is the sub-list for method output_type
+	17, // [17:22] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_mantrae_v1_servers_transport_proto_init() }
@@ -833,6 +854,7 @@ func file_mantrae_v1_servers_transport_proto_init() {
 		return
 	}
 	file_mantrae_v1_protocol_proto_init()
+	file_mantrae_v1_validation_proto_init()
 	file_mantrae_v1_servers_transport_proto_msgTypes[3].OneofWrappers = []any{}
 	file_mantrae_v1_servers_transport_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
@@ -361,6 +361,7 @@ func (x *CreateServiceRequest) GetType() ProtocolType {
 type CreateServiceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Service       *Service               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Issues        []*ValidationIssue     `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -402,6 +403,13 @@ func (x *CreateServiceResponse) GetService() *Service {
 	return nil
 }
 
+func (x *CreateServiceResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 type UpdateServiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -481,6 +489,7 @@ func (x *UpdateServiceRequest) GetType() ProtocolType {
 type UpdateServiceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Service       *Service               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Issues        []*ValidationIssue     `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -522,6 +531,13 @@ func (x *UpdateServiceResponse) GetService() *Service {
 	return nil
 }
 
+func (x *UpdateServiceResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 type DeleteServiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -738,12 +754,320 @@ func (x *ListServicesResponse) GetTotalCount() int64 {
 	return 0
 }
 
+type ServerHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceId     string                 `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Type          ProtocolType           `protobuf:"varint,3,opt,name=type,proto3,enum=mantrae.v1.ProtocolType" json:"type,omitempty"`
+	Server        string                 `protobuf:"bytes,4,opt,name=server,proto3" json:"server,omitempty"`
+	Healthy       bool                   `protobuf:"varint,5,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	StatusCode    *int32                 `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,7,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Error         *string                `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerHealth) Reset() {
+	*x = ServerHealth{}
+	mi := &file_mantrae_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerHealth) ProtoMessage() {}
+
+func (x *ServerHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerHealth.ProtoReflect.Descriptor instead.
+func (*ServerHealth) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ServerHealth) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServerHealth) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServerHealth) GetType() ProtocolType {
+	if x != nil {
+		return x.Type
+	}
+	return ProtocolType_PROTOCOL_TYPE_UNSPECIFIED
+}
+
+func (x *ServerHealth) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *ServerHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ServerHealth) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return 0
+}
+
+func (x *ServerHealth) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ServerHealth) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ServerHealth) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+type ListServiceHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceHealthRequest) Reset() {
+	*x = ListServiceHealthRequest{}
+	mi := &file_mantrae_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceHealthRequest) ProtoMessage() {}
+
+func (x *ListServiceHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceHealthRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceHealthRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListServiceHealthRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+type ListServiceHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Servers       []*ServerHealth        `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceHealthResponse) Reset() {
+	*x = ListServiceHealthResponse{}
+	mi := &file_mantrae_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceHealthResponse) ProtoMessage() {}
+
+func (x *ListServiceHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceHealthResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceHealthResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListServiceHealthResponse) GetServers() []*ServerHealth {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type ListServiceHealthHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Type          ProtocolType           `protobuf:"varint,2,opt,name=type,proto3,enum=mantrae.v1.ProtocolType" json:"type,omitempty"`
+	Limit         *int64                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int64                 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceHealthHistoryRequest) Reset() {
+	*x = ListServiceHealthHistoryRequest{}
+	mi := &file_mantrae_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceHealthHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceHealthHistoryRequest) ProtoMessage() {}
+
+func (x *ListServiceHealthHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceHealthHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceHealthHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListServiceHealthHistoryRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ListServiceHealthHistoryRequest) GetType() ProtocolType {
+	if x != nil {
+		return x.Type
+	}
+	return ProtocolType_PROTOCOL_TYPE_UNSPECIFIED
+}
+
+func (x *ListServiceHealthHistoryRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListServiceHealthHistoryRequest) GetOffset() int64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type ListServiceHealthHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checks        []*ServerHealth        `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceHealthHistoryResponse) Reset() {
+	*x = ListServiceHealthHistoryResponse{}
+	mi := &file_mantrae_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceHealthHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceHealthHistoryResponse) ProtoMessage() {}
+
+func (x *ListServiceHealthHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceHealthHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceHealthHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListServiceHealthHistoryResponse) GetChecks() []*ServerHealth {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
 var File_mantrae_v1_service_proto protoreflect.FileDescriptor
 
 const file_mantrae_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"\x18mantrae/v1/service.proto\x12\n" +
-	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19mantrae/v1/protocol.proto\"\xd6\x02\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19mantrae/v1/protocol.proto\x1a\x1bmantrae/v1/validation.proto\"\xd6\x02\n" +
 	"\aService\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -775,17 +1099,19 @@ const file_mantrae_v1_service_proto_rawDesc = "" +
 	"\x06config\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x18\n" +
 	"\aenabled\x18\x05 \x01(\bR\aenabled\x126\n" +
 	"\x04type\x18\x06 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04typeB\v\n" +
-	"\t_agent_id\"F\n" +
+	"\t_agent_id\"{\n" +
 	"\x15CreateServiceResponse\x12-\n" +
-	"\aservice\x18\x01 \x01(\v2\x13.mantrae.v1.ServiceR\aservice\"\xcf\x01\n" +
+	"\aservice\x18\x01 \x01(\v2\x13.mantrae.v1.ServiceR\aservice\x123\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1b.mantrae.v1.ValidationIssueR\x06issues\"\xcf\x01\n" +
 	"\x14UpdateServiceRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1b\n" +
 	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12\x18\n" +
 	"\aenabled\x18\x03 \x01(\bR\aenabled\x12/\n" +
 	"\x06config\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06config\x126\n" +
-	"\x04type\x18\x05 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\"F\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\"{\n" +
 	"\x15UpdateServiceResponse\x12-\n" +
-	"\aservice\x18\x01 \x01(\v2\x13.mantrae.v1.ServiceR\aservice\"g\n" +
+	"\aservice\x18\x01 \x01(\v2\x13.mantrae.v1.ServiceR\aservice\x123\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1b.mantrae.v1.ValidationIssueR\x06issues\"g\n" +
 	"\x14DeleteServiceRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x126\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\"\x17\n" +
@@ -805,14 +1131,48 @@ const file_mantrae_v1_service_proto_rawDesc = "" +
 	"\x14ListServicesResponse\x12/\n" +
 	"\bservices\x18\x01 \x03(\v2\x13.mantrae.v1.ServiceR\bservices\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
-	"totalCount2\xbc\x03\n" +
+	"totalCount\"\xd2\x02\n" +
+	"\fServerHealth\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\x12,\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeR\x04type\x12\x16\n" +
+	"\x06server\x18\x04 \x01(\tR\x06server\x12\x18\n" +
+	"\ahealthy\x18\x05 \x01(\bR\ahealthy\x12$\n" +
+	"\vstatus_code\x18\x06 \x01(\x05H\x00R\n" +
+	"statusCode\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\a \x01(\x03R\tlatencyMs\x12\x19\n" +
+	"\x05error\x18\b \x01(\tH\x01R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"checked_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAtB\x0e\n" +
+	"\f_status_codeB\b\n" +
+	"\x06_error\"B\n" +
+	"\x18ListServiceHealthRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\"O\n" +
+	"\x19ListServiceHealthResponse\x122\n" +
+	"\aservers\x18\x01 \x03(\v2\x18.mantrae.v1.ServerHealthR\aservers\"\xa5\x02\n" +
+	"\x1fListServiceHealthHistoryRequest\x12&\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tserviceId\x12,\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x18.mantrae.v1.ProtocolTypeR\x04type\x12q\n" +
+	"\x05limit\x18\x03 \x01(\x03BV\xbaHS\xba\x01P\n" +
+	"\vlimit.valid\x12)limit must be either -1 or greater than 0\x1a\x16this == -1 || this > 0H\x00R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x01R\x06offset\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"T\n" +
+	" ListServiceHealthHistoryResponse\x120\n" +
+	"\x06checks\x18\x01 \x03(\v2\x18.mantrae.v1.ServerHealthR\x06checks2\x9f\x05\n" +
 	"\x0eServiceService\x12P\n" +
 	"\n" +
 	"GetService\x12\x1d.mantrae.v1.GetServiceRequest\x1a\x1e.mantrae.v1.GetServiceResponse\"\x03\x90\x02\x01\x12T\n" +
 	"\rCreateService\x12 .mantrae.v1.CreateServiceRequest\x1a!.mantrae.v1.CreateServiceResponse\x12T\n" +
 	"\rUpdateService\x12 .mantrae.v1.UpdateServiceRequest\x1a!.mantrae.v1.UpdateServiceResponse\x12T\n" +
 	"\rDeleteService\x12 .mantrae.v1.DeleteServiceRequest\x1a!.mantrae.v1.DeleteServiceResponse\x12V\n" +
-	"\fListServices\x12\x1f.mantrae.v1.ListServicesRequest\x1a .mantrae.v1.ListServicesResponse\"\x03\x90\x02\x01B\xa9\x01\n" +
+	"\fListServices\x12\x1f.mantrae.v1.ListServicesRequest\x1a .mantrae.v1.ListServicesResponse\"\x03\x90\x02\x01\x12e\n" +
+	"\x11ListServiceHealth\x12$.mantrae.v1.ListServiceHealthRequest\x1a%.mantrae.v1.ListServiceHealthResponse\"\x03\x90\x02\x01\x12z\n" +
+	"\x18ListServiceHealthHistory\x12+.mantrae.v1.ListServiceHealthHistoryRequest\x1a,.mantrae.v1.ListServiceHealthHistoryResponse\"\x03\x90\x02\x01B\xa9\x01\n" +
 	"\x0ecom.mantrae.v1B\fServiceProtoP\x01Z@github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1;mantraev1\xa2\x02\x03MXX\xaa\x02\n" +
 	"Mantrae.V1\xca\x02\n" +
 	"Mantrae\\V1\xe2\x02\x16Mantrae\\V1\\GPBMetadata\xea\x02\vMantrae::V1b\x06proto3"
@@ -829,54 +1189,71 @@ func file_mantrae_v1_service_proto_rawDescGZIP() []byte {
 	return file_mantrae_v1_service_proto_rawDescData
 }
 
-var file_mantrae_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_mantrae_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_mantrae_v1_service_proto_goTypes = []any{
-	(*Service)(nil),               // 0: mantrae.v1.Service
-	(*GetServiceRequest)(nil),     // 1: mantrae.v1.GetServiceRequest
-	(*GetServiceResponse)(nil),    // 2: mantrae.v1.GetServiceResponse
-	(*CreateServiceRequest)(nil),  // 3: mantrae.v1.CreateServiceRequest
-	(*CreateServiceResponse)(nil), // 4: mantrae.v1.CreateServiceResponse
-	(*UpdateServiceRequest)(nil),  // 5: mantrae.v1.UpdateServiceRequest
-	(*UpdateServiceResponse)(nil), // 6: mantrae.v1.UpdateServiceResponse
-	(*DeleteServiceRequest)(nil),  // 7: mantrae.v1.DeleteServiceRequest
-	(*DeleteServiceResponse)(nil), // 8: mantrae.v1.DeleteServiceResponse
-	(*ListServicesRequest)(nil),   // 9: mantrae.v1.ListServicesRequest
-	(*ListServicesResponse)(nil),  // 10: mantrae.v1.ListServicesResponse
-	(*structpb.Struct)(nil),       // 11: google.protobuf.Struct
-	(ProtocolType)(0),             // 12: mantrae.v1.ProtocolType
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(*Service)(nil),                          // 0: mantrae.v1.Service
+	(*GetServiceRequest)(nil),                // 1: mantrae.v1.GetServiceRequest
+	(*GetServiceResponse)(nil),               // 2: mantrae.v1.GetServiceResponse
+	(*CreateServiceRequest)(nil),             // 3: mantrae.v1.CreateServiceRequest
+	(*CreateServiceResponse)(nil),            // 4: mantrae.v1.CreateServiceResponse
+	(*UpdateServiceRequest)(nil),             // 5: mantrae.v1.UpdateServiceRequest
+	(*UpdateServiceResponse)(nil),            // 6: mantrae.v1.UpdateServiceResponse
+	(*DeleteServiceRequest)(nil),             // 7: mantrae.v1.DeleteServiceRequest
+	(*DeleteServiceResponse)(nil),            // 8: mantrae.v1.DeleteServiceResponse
+	(*ListServicesRequest)(nil),              // 9: mantrae.v1.ListServicesRequest
+	(*ListServicesResponse)(nil),             // 10: mantrae.v1.ListServicesResponse
+	(*ServerHealth)(nil),                     // 11: mantrae.v1.ServerHealth
+	(*ListServiceHealthRequest)(nil),         // 12: mantrae.v1.ListServiceHealthRequest
+	(*ListServiceHealthResponse)(nil),        // 13: mantrae.v1.ListServiceHealthResponse
+	(*ListServiceHealthHistoryRequest)(nil),  // 14: mantrae.v1.ListServiceHealthHistoryRequest
+	(*ListServiceHealthHistoryResponse)(nil), // 15: mantrae.v1.ListServiceHealthHistoryResponse
+	(*structpb.Struct)(nil),                  // 16: google.protobuf.Struct
+	(ProtocolType)(0),                        // 17: mantrae.v1.ProtocolType
+	(*timestamppb.Timestamp)(nil),            // 18: google.protobuf.Timestamp
+	(*ValidationIssue)(nil),                  // 19: mantrae.v1.ValidationIssue
 }
 var file_mantrae_v1_service_proto_depIdxs = []int32{
-	11, // 0: mantrae.v1.Service.config:type_name -> google.protobuf.Struct
-	12, // 1: mantrae.v1.Service.type:type_name -> mantrae.v1.ProtocolType
-	13, // 2: mantrae.v1.Service.created_at:type_name -> google.protobuf.Timestamp
-	13, // 3: mantrae.v1.Service.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 4: mantrae.v1.GetServiceRequest.type:type_name -> mantrae.v1.ProtocolType
+	16, // 0: mantrae.v1.Service.config:type_name -> google.protobuf.Struct
+	17, // 1: mantrae.v1.Service.type:type_name -> mantrae.v1.ProtocolType
+	18, // 2: mantrae.v1.Service.created_at:type_name -> google.protobuf.Timestamp
+	18, // 3: mantrae.v1.Service.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 4: mantrae.v1.GetServiceRequest.type:type_name -> mantrae.v1.ProtocolType
 	0,  // 5: mantrae.v1.GetServiceResponse.service:type_name -> mantrae.v1.Service
-	11, // 6: mantrae.v1.CreateServiceRequest.config:type_name -> google.protobuf.Struct
-	12, // 7: mantrae.v1.CreateServiceRequest.type:type_name -> mantrae.v1.ProtocolType
+	16, // 6: mantrae.v1.CreateServiceRequest.config:type_name -> google.protobuf.Struct
+	17, // 7: mantrae.v1.CreateServiceRequest.type:type_name -> mantrae.v1.ProtocolType
 	0,  // 8: mantrae.v1.CreateServiceResponse.service:type_name -> mantrae.v1.Service
-	11, // 9: mantrae.v1.UpdateServiceRequest.config:type_name -> google.protobuf.Struct
-	12, // 10: mantrae.v1.UpdateServiceRequest.type:type_name -> mantrae.v1.ProtocolType
-	0,  // 11: mantrae.v1.UpdateServiceResponse.service:type_name -> mantrae.v1.Service
-	12, // 12: mantrae.v1.DeleteServiceRequest.type:type_name -> mantrae.v1.ProtocolType
-	12, // 13: mantrae.v1.ListServicesRequest.type:type_name -> mantrae.v1.ProtocolType
-	0,  // 14: mantrae.v1.ListServicesResponse.services:type_name -> mantrae.v1.Service
-	1,  // 15: mantrae.v1.ServiceService.GetService:input_type -> mantrae.v1.GetServiceRequest
-	3,  // 16: mantrae.v1.ServiceService.CreateService:input_type -> mantrae.v1.CreateServiceRequest
-	5,  // 17: mantrae.v1.ServiceService.UpdateService:input_type -> mantrae.v1.UpdateServiceRequest
-	7,  // 18: mantrae.v1.ServiceService.DeleteService:input_type -> mantrae.v1.DeleteServiceRequest
-	9,  // 19: mantrae.v1.ServiceService.ListServices:input_type -> mantrae.v1.ListServicesRequest
-	2,  // 20: mantrae.v1.ServiceService.GetService:output_type -> mantrae.v1.GetServiceResponse
-	4,  // 21: mantrae.v1.ServiceService.CreateService:output_type -> mantrae.v1.CreateServiceResponse
-	6,  // 22: mantrae.v1.ServiceService.UpdateService:output_type -> mantrae.v1.UpdateServiceResponse
-	8,  // 23: mantrae.v1.ServiceService.DeleteService:output_type -> mantrae.v1.DeleteServiceResponse
-	10, // 24: mantrae.v1.ServiceService.ListServices:output_type -> mantrae.v1.ListServicesResponse
-	20, // [20:25] is the sub-list for method output_type
-	15, // [15:20] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	19, // 9: mantrae.v1.CreateServiceResponse.issues:type_name -> mantrae.v1.ValidationIssue
+	16, // 10: mantrae.v1.UpdateServiceRequest.config:type_name -> google.protobuf.Struct
+	17, // 11: mantrae.v1.UpdateServiceRequest.type:type_name -> mantrae.v1.ProtocolType
+	0,  // 12: mantrae.v1.UpdateServiceResponse.service:type_name -> mantrae.v1.Service
+	19, // 13: mantrae.v1.UpdateServiceResponse.issues:type_name -> mantrae.v1.ValidationIssue
+	17, // 14: mantrae.v1.DeleteServiceRequest.type:type_name -> mantrae.v1.ProtocolType
+	17, // 15: mantrae.v1.ListServicesRequest.type:type_name -> mantrae.v1.ProtocolType
+	0,  // 16: mantrae.v1.ListServicesResponse.services:type_name -> mantrae.v1.Service
+	17, // 17: mantrae.v1.ServerHealth.type:type_name -> mantrae.v1.ProtocolType
+	18, // 18: mantrae.v1.ServerHealth.checked_at:type_name -> google.protobuf.Timestamp
+	11, // 19: mantrae.v1.ListServiceHealthResponse.servers:type_name -> mantrae.v1.ServerHealth
+	17, // 20: mantrae.v1.ListServiceHealthHistoryRequest.type:type_name -> mantrae.v1.ProtocolType
+	11, // 21: mantrae.v1.ListServiceHealthHistoryResponse.checks:type_name -> mantrae.v1.ServerHealth
+	1,  // 22: mantrae.v1.ServiceService.GetService:input_type -> mantrae.v1.GetServiceRequest
+	3,  // 23: mantrae.v1.ServiceService.CreateService:input_type -> mantrae.v1.CreateServiceRequest
+	5,  // 24: mantrae.v1.ServiceService.UpdateService:input_type -> mantrae.v1.UpdateServiceRequest
+	7,  // 25: mantrae.v1.ServiceService.DeleteService:input_type -> mantrae.v1.DeleteServiceRequest
+	9,  // 26: mantrae.v1.ServiceService.ListServices:input_type -> mantrae.v1.ListServicesRequest
+	12, // 27: mantrae.v1.ServiceService.ListServiceHealth:input_type -> mantrae.v1.ListServiceHealthRequest
+	14, // 28: mantrae.v1.ServiceService.ListServiceHealthHistory:input_type -> mantrae.v1.ListServiceHealthHistoryRequest
+	2,  // 29: mantrae.v1.ServiceService.GetService:output_type -> mantrae.v1.GetServiceResponse
+	4,  // 30: mantrae.v1.ServiceService.CreateService:output_type -> mantrae.v1.CreateServiceResponse
+	6,  // 31: mantrae.v1.ServiceService.UpdateService:output_type -> mantrae.v1.UpdateServiceResponse
+	8,  // 32: mantrae.v1.ServiceService.DeleteService:output_type -> mantrae.v1.DeleteServiceResponse
+	10, // 33: mantrae.v1.ServiceService.ListServices:output_type -> mantrae.v1.ListServicesResponse
+	13, // 34: mantrae.v1.ServiceService.ListServiceHealth:output_type -> mantrae.v1.ListServiceHealthResponse
+	15, // 35: mantrae.v1.ServiceService.ListServiceHealthHistory:output_type -> mantrae.v1.ListServiceHealthHistoryResponse
+	29, // [29:36] is the sub-list for method output_type
+	22, // [22:29] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_mantrae_v1_service_proto_init() }
@@ -885,19 +1262,22 @@ func file_mantrae_v1_service_proto_init() {
 		return
 	}
 	file_mantrae_v1_protocol_proto_init()
+	file_mantrae_v1_validation_proto_init()
 	file_mantrae_v1_service_proto_msgTypes[1].OneofWrappers = []any{
 		(*GetServiceRequest_Id)(nil),
 		(*GetServiceRequest_Name)(nil),
 	}
 	file_mantrae_v1_service_proto_msgTypes[3].OneofWrappers = []any{}
 	file_mantrae_v1_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_mantrae_v1_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_mantrae_v1_service_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mantrae_v1_service_proto_rawDesc), len(file_mantrae_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,1085 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mantrae/v1/template.proto
+
+package mantraev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConfigTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Variables     []*TemplateVariable    `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Builtin       bool                   `protobuf:"varint,6,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigTemplate) Reset() {
+	*x = ConfigTemplate{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigTemplate) ProtoMessage() {}
+
+func (x *ConfigTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigTemplate.ProtoReflect.Descriptor instead.
+func (*ConfigTemplate) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConfigTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ConfigTemplate) GetVariables() []*TemplateVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *ConfigTemplate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ConfigTemplate) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+func (x *ConfigTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ConfigTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type TemplateVariable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateVariable) Reset() {
+	*x = TemplateVariable{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateVariable) ProtoMessage() {}
+
+func (x *TemplateVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateVariable.ProtoReflect.Descriptor instead.
+func (*TemplateVariable) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TemplateVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateVariable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TemplateVariable) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *TemplateVariable) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{2}
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*ConfigTemplate      `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*ConfigTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GetTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ConfigTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateResponse) Reset() {
+	*x = GetTemplateResponse{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateResponse) ProtoMessage() {}
+
+func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTemplateResponse) GetTemplate() *ConfigTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Variables     []*TemplateVariable    `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetVariables() []*TemplateVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CreateTemplateRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CreateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ConfigTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateResponse) Reset() {
+	*x = CreateTemplateResponse{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateTemplateResponse) GetTemplate() *ConfigTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Variables     []*TemplateVariable    `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetVariables() []*TemplateVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *UpdateTemplateRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UpdateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ConfigTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateResponse) Reset() {
+	*x = UpdateTemplateResponse{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateTemplateResponse) GetTemplate() *ConfigTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{11}
+}
+
+type PreviewTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Variables     map[string]string      `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTemplateRequest) Reset() {
+	*x = PreviewTemplateRequest{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTemplateRequest) ProtoMessage() {}
+
+func (x *PreviewTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTemplateRequest.ProtoReflect.Descriptor instead.
+func (*PreviewTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PreviewTemplateRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *PreviewTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PreviewTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type PreviewTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Items         []*ImportItem          `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTemplateResponse) Reset() {
+	*x = PreviewTemplateResponse{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTemplateResponse) ProtoMessage() {}
+
+func (x *PreviewTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTemplateResponse.ProtoReflect.Descriptor instead.
+func (*PreviewTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PreviewTemplateResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PreviewTemplateResponse) GetItems() []*ImportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type InstantiateTemplateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId      int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Variables      map[string]string      `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Strategy       ImportStrategy         `protobuf:"varint,4,opt,name=strategy,proto3,enum=mantrae.v1.ImportStrategy" json:"strategy,omitempty"`
+	DnsProviderIds []string               `protobuf:"bytes,5,rep,name=dns_provider_ids,json=dnsProviderIds,proto3" json:"dns_provider_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateRequest) Reset() {
+	*x = InstantiateTemplateRequest{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateRequest) ProtoMessage() {}
+
+func (x *InstantiateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *InstantiateTemplateRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *InstantiateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *InstantiateTemplateRequest) GetStrategy() ImportStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ImportStrategy_IMPORT_STRATEGY_UNSPECIFIED
+}
+
+func (x *InstantiateTemplateRequest) GetDnsProviderIds() []string {
+	if x != nil {
+		return x.DnsProviderIds
+	}
+	return nil
+}
+
+type InstantiateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ImportItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateResponse) Reset() {
+	*x = InstantiateTemplateResponse{}
+	mi := &file_mantrae_v1_template_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateResponse) ProtoMessage() {}
+
+func (x *InstantiateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_template_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_template_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InstantiateTemplateResponse) GetItems() []*ImportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_mantrae_v1_template_proto protoreflect.FileDescriptor
+
+const file_mantrae_v1_template_proto_rawDesc = "" +
+	"\n" +
+	"\x19mantrae/v1/template.proto\x12\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17mantrae/v1/backup.proto\"\xbc\x02\n" +
+	"\x0eConfigTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12:\n" +
+	"\tvariables\x18\x04 \x03(\v2\x1c.mantrae.v1.TemplateVariableR\tvariables\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x18\n" +
+	"\abuiltin\x18\x06 \x01(\bR\abuiltin\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa3\x01\n" +
+	"\x10TemplateVariable\x12,\n" +
+	"\x04name\x18\x01 \x01(\tB\x18\xbaH\x15r\x132\x11^[a-z][a-z0-9_]*$R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\tR\fdefaultValue\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\"\x16\n" +
+	"\x14ListTemplatesRequest\"Q\n" +
+	"\x15ListTemplatesResponse\x128\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1a.mantrae.v1.ConfigTemplateR\ttemplates\"1\n" +
+	"\x12GetTemplateRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\"M\n" +
+	"\x13GetTemplateResponse\x126\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1a.mantrae.v1.ConfigTemplateR\btemplate\"\xb8\x01\n" +
+	"\x15CreateTemplateRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12:\n" +
+	"\tvariables\x18\x03 \x03(\v2\x1c.mantrae.v1.TemplateVariableR\tvariables\x12!\n" +
+	"\acontent\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acontent\"P\n" +
+	"\x16CreateTemplateResponse\x126\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1a.mantrae.v1.ConfigTemplateR\btemplate\"\xd1\x01\n" +
+	"\x15UpdateTemplateRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12:\n" +
+	"\tvariables\x18\x04 \x03(\v2\x1c.mantrae.v1.TemplateVariableR\tvariables\x12!\n" +
+	"\acontent\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acontent\"P\n" +
+	"\x16UpdateTemplateResponse\x126\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1a.mantrae.v1.ConfigTemplateR\btemplate\"0\n" +
+	"\x15DeleteTemplateRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\"\x18\n" +
+	"\x16DeleteTemplateResponse\"\xec\x01\n" +
+	"\x16PreviewTemplateRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12O\n" +
+	"\tvariables\x18\x03 \x03(\v21.mantrae.v1.PreviewTemplateRequest.VariablesEntryR\tvariables\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"a\n" +
+	"\x17PreviewTemplateResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12,\n" +
+	"\x05items\x18\x02 \x03(\v2\x16.mantrae.v1.ImportItemR\x05items\"\xd6\x02\n" +
+	"\x1aInstantiateTemplateRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12S\n" +
+	"\tvariables\x18\x03 \x03(\v25.mantrae.v1.InstantiateTemplateRequest.VariablesEntryR\tvariables\x126\n" +
+	"\bstrategy\x18\x04 \x01(\x0e2\x1a.mantrae.v1.ImportStrategyR\bstrategy\x12(\n" +
+	"\x10dns_provider_ids\x18\x05 \x03(\tR\x0ednsProviderIds\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"K\n" +
+	"\x1bInstantiateTemplateResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.mantrae.v1.ImportItemR\x05items2\x95\x05\n" +
+	"\x0fTemplateService\x12Y\n" +
+	"\rListTemplates\x12 .mantrae.v1.ListTemplatesRequest\x1a!.mantrae.v1.ListTemplatesResponse\"\x03\x90\x02\x01\x12S\n" +
+	"\vGetTemplate\x12\x1e.mantrae.v1.GetTemplateRequest\x1a\x1f.mantrae.v1.GetTemplateResponse\"\x03\x90\x02\x01\x12W\n" +
+	"\x0eCreateTemplate\x12!.mantrae.v1.CreateTemplateRequest\x1a\".mantrae.v1.CreateTemplateResponse\x12W\n" +
+	"\x0eUpdateTemplate\x12!.mantrae.v1.UpdateTemplateRequest\x1a\".mantrae.v1.UpdateTemplateResponse\x12W\n" +
+	"\x0eDeleteTemplate\x12!.mantrae.v1.DeleteTemplateRequest\x1a\".mantrae.v1.DeleteTemplateResponse\x12_\n" +
+	"\x0fPreviewTemplate\x12\".mantrae.v1.PreviewTemplateRequest\x1a#.mantrae.v1.PreviewTemplateResponse\"\x03\x90\x02\x01\x12f\n" +
+	"\x13InstantiateTemplate\x12&.mantrae.v1.InstantiateTemplateRequest\x1a'.mantrae.v1.InstantiateTemplateResponseB\xaa\x01\n" +
+	"\x0ecom.mantrae.v1B\rTemplateProtoP\x01Z@github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1;mantraev1\xa2\x02\x03MXX\xaa\x02\n" +
+	"Mantrae.V1\xca\x02\n" +
+	"Mantrae\\V1\xe2\x02\x16Mantrae\\V1\\GPBMetadata\xea\x02\vMantrae::V1b\x06proto3"
+
+var (
+	file_mantrae_v1_template_proto_rawDescOnce sync.Once
+	file_mantrae_v1_template_proto_rawDescData []byte
+)
+
+func file_mantrae_v1_template_proto_rawDescGZIP() []byte {
+	file_mantrae_v1_template_proto_rawDescOnce.Do(func() {
+		file_mantrae_v1_template_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mantrae_v1_template_proto_rawDesc), len(file_mantrae_v1_template_proto_rawDesc)))
+	})
+	return file_mantrae_v1_template_proto_rawDescData
+}
+
+var file_mantrae_v1_template_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_mantrae_v1_template_proto_goTypes = []any{
+	(*ConfigTemplate)(nil),              // 0: mantrae.v1.ConfigTemplate
+	(*TemplateVariable)(nil),            // 1: mantrae.v1.TemplateVariable
+	(*ListTemplatesRequest)(nil),        // 2: mantrae.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),       // 3: mantrae.v1.ListTemplatesResponse
+	(*GetTemplateRequest)(nil),          // 4: mantrae.v1.GetTemplateRequest
+	(*GetTemplateResponse)(nil),         // 5: mantrae.v1.GetTemplateResponse
+	(*CreateTemplateRequest)(nil),       // 6: mantrae.v1.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),      // 7: mantrae.v1.CreateTemplateResponse
+	(*UpdateTemplateRequest)(nil),       // 8: mantrae.v1.UpdateTemplateRequest
+	(*UpdateTemplateResponse)(nil),      // 9: mantrae.v1.UpdateTemplateResponse
+	(*DeleteTemplateRequest)(nil),       // 10: mantrae.v1.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),      // 11: mantrae.v1.DeleteTemplateResponse
+	(*PreviewTemplateRequest)(nil),      // 12: mantrae.v1.PreviewTemplateRequest
+	(*PreviewTemplateResponse)(nil),     // 13: mantrae.v1.PreviewTemplateResponse
+	(*InstantiateTemplateRequest)(nil),  // 14: mantrae.v1.InstantiateTemplateRequest
+	(*InstantiateTemplateResponse)(nil), // 15: mantrae.v1.InstantiateTemplateResponse
+	nil,                                 // 16: mantrae.v1.PreviewTemplateRequest.VariablesEntry
+	nil,                                 // 17: mantrae.v1.InstantiateTemplateRequest.VariablesEntry
+	(*timestamppb.Timestamp)(nil),       // 18: google.protobuf.Timestamp
+	(*ImportItem)(nil),                  // 19: mantrae.v1.ImportItem
+	(ImportStrategy)(0),                 // 20: mantrae.v1.ImportStrategy
+}
+var file_mantrae_v1_template_proto_depIdxs = []int32{
+	1,  // 0: mantrae.v1.ConfigTemplate.variables:type_name -> mantrae.v1.TemplateVariable
+	18, // 1: mantrae.v1.ConfigTemplate.created_at:type_name -> google.protobuf.Timestamp
+	18, // 2: mantrae.v1.ConfigTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: mantrae.v1.ListTemplatesResponse.templates:type_name -> mantrae.v1.ConfigTemplate
+	0,  // 4: mantrae.v1.GetTemplateResponse.template:type_name -> mantrae.v1.ConfigTemplate
+	1,  // 5: mantrae.v1.CreateTemplateRequest.variables:type_name -> mantrae.v1.TemplateVariable
+	0,  // 6: mantrae.v1.CreateTemplateResponse.template:type_name -> mantrae.v1.ConfigTemplate
+	1,  // 7: mantrae.v1.UpdateTemplateRequest.variables:type_name -> mantrae.v1.TemplateVariable
+	0,  // 8: mantrae.v1.UpdateTemplateResponse.template:type_name -> mantrae.v1.ConfigTemplate
+	16, // 9: mantrae.v1.PreviewTemplateRequest.variables:type_name -> mantrae.v1.PreviewTemplateRequest.VariablesEntry
+	19, // 10: mantrae.v1.PreviewTemplateResponse.items:type_name -> mantrae.v1.ImportItem
+	17, // 11: mantrae.v1.InstantiateTemplateRequest.variables:type_name -> mantrae.v1.InstantiateTemplateRequest.VariablesEntry
+	20, // 12: mantrae.v1.InstantiateTemplateRequest.strategy:type_name -> mantrae.v1.ImportStrategy
+	19, // 13: mantrae.v1.InstantiateTemplateResponse.items:type_name -> mantrae.v1.ImportItem
+	2,  // 14: mantrae.v1.TemplateService.ListTemplates:input_type -> mantrae.v1.ListTemplatesRequest
+	4,  // 15: mantrae.v1.TemplateService.GetTemplate:input_type -> mantrae.v1.GetTemplateRequest
+	6,  // 16: mantrae.v1.TemplateService.CreateTemplate:input_type -> mantrae.v1.CreateTemplateRequest
+	8,  // 17: mantrae.v1.TemplateService.UpdateTemplate:input_type -> mantrae.v1.UpdateTemplateRequest
+	10, // 18: mantrae.v1.TemplateService.DeleteTemplate:input_type -> mantrae.v1.DeleteTemplateRequest
+	12, // 19: mantrae.v1.TemplateService.PreviewTemplate:input_type -> mantrae.v1.PreviewTemplateRequest
+	14, // 20: mantrae.v1.TemplateService.InstantiateTemplate:input_type -> mantrae.v1.InstantiateTemplateRequest
+	3,  // 21: mantrae.v1.TemplateService.ListTemplates:output_type -> mantrae.v1.ListTemplatesResponse
+	5,  // 22: mantrae.v1.TemplateService.GetTemplate:output_type -> mantrae.v1.GetTemplateResponse
+	7,  // 23: mantrae.v1.TemplateService.CreateTemplate:output_type -> mantrae.v1.CreateTemplateResponse
+	9,  // 24: mantrae.v1.TemplateService.UpdateTemplate:output_type -> mantrae.v1.UpdateTemplateResponse
+	11, // 25: mantrae.v1.TemplateService.DeleteTemplate:output_type -> mantrae.v1.DeleteTemplateResponse
+	13, // 26: mantrae.v1.TemplateService.PreviewTemplate:output_type -> mantrae.v1.PreviewTemplateResponse
+	15, // 27: mantrae.v1.TemplateService.InstantiateTemplate:output_type -> mantrae.v1.InstantiateTemplateResponse
+	21, // [21:28] is the sub-list for method output_type
+	14, // [14:21] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_mantrae_v1_template_proto_init() }
+func file_mantrae_v1_template_proto_init() {
+	if File_mantrae_v1_template_proto != nil {
+		return
+	}
+	file_mantrae_v1_backup_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mantrae_v1_template_proto_rawDesc), len(file_mantrae_v1_template_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mantrae_v1_template_proto_goTypes,
+		DependencyIndexes: file_mantrae_v1_template_proto_depIdxs,
+		MessageInfos:      file_mantrae_v1_template_proto_msgTypes,
+	}.Build()
+	File_mantrae_v1_template_proto = out.File
+	file_mantrae_v1_template_proto_goTypes = nil
+	file_mantrae_v1_template_proto_depIdxs = nil
+}
@@ -0,0 +1,639 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mantrae/v1/traefik_instance.proto
+
+package mantraev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DriftKind int32
+
+const (
+	DriftKind_DRIFT_KIND_UNSPECIFIED DriftKind = 0
+	DriftKind_DRIFT_KIND_MISSING     DriftKind = 1
+	DriftKind_DRIFT_KIND_EXTRA       DriftKind = 2
+	DriftKind_DRIFT_KIND_DIVERGENT   DriftKind = 3
+)
+
+// Enum value maps for DriftKind.
+var (
+	DriftKind_name = map[int32]string{
+		0: "DRIFT_KIND_UNSPECIFIED",
+		1: "DRIFT_KIND_MISSING",
+		2: "DRIFT_KIND_EXTRA",
+		3: "DRIFT_KIND_DIVERGENT",
+	}
+	DriftKind_value = map[string]int32{
+		"DRIFT_KIND_UNSPECIFIED": 0,
+		"DRIFT_KIND_MISSING":     1,
+		"DRIFT_KIND_EXTRA":       2,
+		"DRIFT_KIND_DIVERGENT":   3,
+	}
+)
+
+func (x DriftKind) Enum() *DriftKind {
+	p := new(DriftKind)
+	*p = x
+	return p
+}
+
+func (x DriftKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriftKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_mantrae_v1_traefik_instance_proto_enumTypes[0].Descriptor()
+}
+
+func (DriftKind) Type() protoreflect.EnumType {
+	return &file_mantrae_v1_traefik_instance_proto_enumTypes[0]
+}
+
+func (x DriftKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriftKind.Descriptor instead.
+func (DriftKind) EnumDescriptor() ([]byte, []int) {
+	return file_mantrae_v1_traefik_instance_proto_rawDescGZIP(), []int{0}
+}
+
+type TraefikInstance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProfileId     int64                  `protobuf:"varint,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Revision      *int64                 `protobuf:"varint,5,opt,name=revision,proto3,oneof" json:"revision,omitempty"`
+	LastFetch     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_fetch,json=lastFetch,proto3" json:"last_fetch,omitempty"`
+	Runtime       []*RuntimeItem         `protobuf:"bytes,7,rep,name=runtime,proto3" json:"runtime,omitempty"`
+	RuntimeError  *string                `protobuf:"bytes,8,opt,name=runtime_error,json=runtimeError,proto3,oneof" json:"runtime_error,omitempty"`
+	RuntimeAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=runtime_at,json=runtimeAt,proto3" json:"runtime_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Drift         []*DriftItem           `protobuf:"bytes,11,rep,name=drift,proto3" json:"drift,omitempty"`
+	DriftAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=drift_at,json=driftAt,proto3" json:"drift_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraefikInstance) Reset() {
+	*x = TraefikInstance{}
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraefikInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraefikInstance) ProtoMessage() {}
+
+func (x *TraefikInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraefikInstance.ProtoReflect.Descriptor instead.
+func (*TraefikInstance) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_traefik_instance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TraefikInstance) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TraefikInstance) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *TraefikInstance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TraefikInstance) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TraefikInstance) GetRevision() int64 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
+}
+
+func (x *TraefikInstance) GetLastFetch() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFetch
+	}
+	return nil
+}
+
+func (x *TraefikInstance) GetRuntime() []*RuntimeItem {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+func (x *TraefikInstance) GetRuntimeError() string {
+	if x != nil && x.RuntimeError != nil {
+		return *x.RuntimeError
+	}
+	return ""
+}
+
+func (x *TraefikInstance) GetRuntimeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RuntimeAt
+	}
+	return nil
+}
+
+func (x *TraefikInstance) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TraefikInstance) GetDrift() []*DriftItem {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *TraefikInstance) GetDriftAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DriftAt
+	}
+	return nil
+}
+
+type RuntimeItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuntimeItem) Reset() {
+	*x = RuntimeItem{}
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuntimeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeItem) ProtoMessage() {}
+
+func (x *RuntimeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeItem.ProtoReflect.Descriptor instead.
+func (*RuntimeItem) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_traefik_instance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RuntimeItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RuntimeItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RuntimeItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RuntimeItem) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ListTraefikInstancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTraefikInstancesRequest) Reset() {
+	*x = ListTraefikInstancesRequest{}
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTraefikInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTraefikInstancesRequest) ProtoMessage() {}
+
+func (x *ListTraefikInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTraefikInstancesRequest.ProtoReflect.Descriptor instead.
+func (*ListTraefikInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_traefik_instance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTraefikInstancesRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+type ListTraefikInstancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instances     []*TraefikInstance     `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTraefikInstancesResponse) Reset() {
+	*x = ListTraefikInstancesResponse{}
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTraefikInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTraefikInstancesResponse) ProtoMessage() {}
+
+func (x *ListTraefikInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTraefikInstancesResponse.ProtoReflect.Descriptor instead.
+func (*ListTraefikInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_traefik_instance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTraefikInstancesResponse) GetInstances() []*TraefikInstance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+type DeleteTraefikInstanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTraefikInstanceRequest) Reset() {
+	*x = DeleteTraefikInstanceRequest{}
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTraefikInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTraefikInstanceRequest) ProtoMessage() {}
+
+func (x *DeleteTraefikInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTraefikInstanceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTraefikInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_traefik_instance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteTraefikInstanceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTraefikInstanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTraefikInstanceResponse) Reset() {
+	*x = DeleteTraefikInstanceResponse{}
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTraefikInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTraefikInstanceResponse) ProtoMessage() {}
+
+func (x *DeleteTraefikInstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTraefikInstanceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTraefikInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_traefik_instance_proto_rawDescGZIP(), []int{5}
+}
+
+type DriftItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          DriftKind              `protobuf:"varint,1,opt,name=kind,proto3,enum=mantrae.v1.DriftKind" json:"kind,omitempty"`
+	Section       string                 `protobuf:"bytes,2,opt,name=section,proto3" json:"section,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Fields        []string               `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftItem) Reset() {
+	*x = DriftItem{}
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftItem) ProtoMessage() {}
+
+func (x *DriftItem) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_traefik_instance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftItem.ProtoReflect.Descriptor instead.
+func (*DriftItem) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_traefik_instance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DriftItem) GetKind() DriftKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DriftKind_DRIFT_KIND_UNSPECIFIED
+}
+
+func (x *DriftItem) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *DriftItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DriftItem) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+var File_mantrae_v1_traefik_instance_proto protoreflect.FileDescriptor
+
+const file_mantrae_v1_traefik_instance_proto_rawDesc = "" +
+	"\n" +
+	"!mantrae/v1/traefik_instance.proto\x12\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x98\x04\n" +
+	"\x0fTraefikInstance\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\x03R\tprofileId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x1f\n" +
+	"\brevision\x18\x05 \x01(\x03H\x00R\brevision\x88\x01\x01\x129\n" +
+	"\n" +
+	"last_fetch\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tlastFetch\x121\n" +
+	"\aruntime\x18\a \x03(\v2\x17.mantrae.v1.RuntimeItemR\aruntime\x12(\n" +
+	"\rruntime_error\x18\b \x01(\tH\x01R\fruntimeError\x88\x01\x01\x129\n" +
+	"\n" +
+	"runtime_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\truntimeAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12+\n" +
+	"\x05drift\x18\v \x03(\v2\x15.mantrae.v1.DriftItemR\x05drift\x125\n" +
+	"\bdrift_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\adriftAtB\v\n" +
+	"\t_revisionB\x10\n" +
+	"\x0e_runtime_error\"e\n" +
+	"\vRuntimeItem\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"E\n" +
+	"\x1bListTraefikInstancesRequest\x12&\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\"Y\n" +
+	"\x1cListTraefikInstancesResponse\x129\n" +
+	"\tinstances\x18\x01 \x03(\v2\x1b.mantrae.v1.TraefikInstanceR\tinstances\"7\n" +
+	"\x1cDeleteTraefikInstanceRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x1f\n" +
+	"\x1dDeleteTraefikInstanceResponse\"|\n" +
+	"\tDriftItem\x12)\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x15.mantrae.v1.DriftKindR\x04kind\x12\x18\n" +
+	"\asection\x18\x02 \x01(\tR\asection\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields*o\n" +
+	"\tDriftKind\x12\x1a\n" +
+	"\x16DRIFT_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12DRIFT_KIND_MISSING\x10\x01\x12\x14\n" +
+	"\x10DRIFT_KIND_EXTRA\x10\x02\x12\x18\n" +
+	"\x14DRIFT_KIND_DIVERGENT\x10\x032\xf6\x01\n" +
+	"\x16TraefikInstanceService\x12n\n" +
+	"\x14ListTraefikInstances\x12'.mantrae.v1.ListTraefikInstancesRequest\x1a(.mantrae.v1.ListTraefikInstancesResponse\"\x03\x90\x02\x01\x12l\n" +
+	"\x15DeleteTraefikInstance\x12(.mantrae.v1.DeleteTraefikInstanceRequest\x1a).mantrae.v1.DeleteTraefikInstanceResponseB\xb1\x01\n" +
+	"\x0ecom.mantrae.v1B\x14TraefikInstanceProtoP\x01Z@github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1;mantraev1\xa2\x02\x03MXX\xaa\x02\n" +
+	"Mantrae.V1\xca\x02\n" +
+	"Mantrae\\V1\xe2\x02\x16Mantrae\\V1\\GPBMetadata\xea\x02\vMantrae::V1b\x06proto3"
+
+var (
+	file_mantrae_v1_traefik_instance_proto_rawDescOnce sync.Once
+	file_mantrae_v1_traefik_instance_proto_rawDescData []byte
+)
+
+func file_mantrae_v1_traefik_instance_proto_rawDescGZIP() []byte {
+	file_mantrae_v1_traefik_instance_proto_rawDescOnce.Do(func() {
+		file_mantrae_v1_traefik_instance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mantrae_v1_traefik_instance_proto_rawDesc), len(file_mantrae_v1_traefik_instance_proto_rawDesc)))
+	})
+	return file_mantrae_v1_traefik_instance_proto_rawDescData
+}
+
+var file_mantrae_v1_traefik_instance_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mantrae_v1_traefik_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_mantrae_v1_traefik_instance_proto_goTypes = []any{
+	(DriftKind)(0),                        // 0: mantrae.v1.DriftKind
+	(*TraefikInstance)(nil),               // 1: mantrae.v1.TraefikInstance
+	(*RuntimeItem)(nil),                   // 2: mantrae.v1.RuntimeItem
+	(*ListTraefikInstancesRequest)(nil),   // 3: mantrae.v1.ListTraefikInstancesRequest
+	(*ListTraefikInstancesResponse)(nil),  // 4: mantrae.v1.ListTraefikInstancesResponse
+	(*DeleteTraefikInstanceRequest)(nil),  // 5: mantrae.v1.DeleteTraefikInstanceRequest
+	(*DeleteTraefikInstanceResponse)(nil), // 6: mantrae.v1.DeleteTraefikInstanceResponse
+	(*DriftItem)(nil),                     // 7: mantrae.v1.DriftItem
+	(*timestamppb.Timestamp)(nil),         // 8: google.protobuf.Timestamp
+}
+var file_mantrae_v1_traefik_instance_proto_depIdxs = []int32{
+	8,  // 0: mantrae.v1.TraefikInstance.last_fetch:type_name -> google.protobuf.Timestamp
+	2,  // 1: mantrae.v1.TraefikInstance.runtime:type_name -> mantrae.v1.RuntimeItem
+	8,  // 2: mantrae.v1.TraefikInstance.runtime_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: mantrae.v1.TraefikInstance.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: mantrae.v1.TraefikInstance.drift:type_name -> mantrae.v1.DriftItem
+	8,  // 5: mantrae.v1.TraefikInstance.drift_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: mantrae.v1.ListTraefikInstancesResponse.instances:type_name -> mantrae.v1.TraefikInstance
+	0,  // 7: mantrae.v1.DriftItem.kind:type_name -> mantrae.v1.DriftKind
+	3,  // 8: mantrae.v1.TraefikInstanceService.ListTraefikInstances:input_type -> mantrae.v1.ListTraefikInstancesRequest
+	5,  // 9: mantrae.v1.TraefikInstanceService.DeleteTraefikInstance:input_type -> mantrae.v1.DeleteTraefikInstanceRequest
+	4,  // 10: mantrae.v1.TraefikInstanceService.ListTraefikInstances:output_type -> mantrae.v1.ListTraefikInstancesResponse
+	6,  // 11: mantrae.v1.TraefikInstanceService.DeleteTraefikInstance:output_type -> mantrae.v1.DeleteTraefikInstanceResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_mantrae_v1_traefik_instance_proto_init() }
+func file_mantrae_v1_traefik_instance_proto_init() {
+	if File_mantrae_v1_traefik_instance_proto != nil {
+		return
+	}
+	file_mantrae_v1_traefik_instance_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mantrae_v1_traefik_instance_proto_rawDesc), len(file_mantrae_v1_traefik_instance_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mantrae_v1_traefik_instance_proto_goTypes,
+		DependencyIndexes: file_mantrae_v1_traefik_instance_proto_depIdxs,
+		EnumInfos:         file_mantrae_v1_traefik_instance_proto_enumTypes,
+		MessageInfos:      file_mantrae_v1_traefik_instance_proto_msgTypes,
+	}.Build()
+	File_mantrae_v1_traefik_instance_proto = out.File
+	file_mantrae_v1_traefik_instance_proto_goTypes = nil
+	file_mantrae_v1_traefik_instance_proto_depIdxs = nil
+}
@@ -23,16 +23,70 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	Role_ROLE_ADMIN       Role = 1
+	Role_ROLE_EDITOR      Role = 2
+	Role_ROLE_VIEWER      Role = 3
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_ADMIN",
+		2: "ROLE_EDITOR",
+		3: "ROLE_VIEWER",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"ROLE_ADMIN":       1,
+		"ROLE_EDITOR":      2,
+		"ROLE_VIEWER":      3,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_mantrae_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_mantrae_v1_user_proto_enumTypes[0]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	LastLogin     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username         string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email            string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	LastLogin        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Role             Role                   `protobuf:"varint,9,opt,name=role,proto3,enum=mantrae.v1.Role" json:"role,omitempty"`
+	TwoFactorEnabled bool                   `protobuf:"varint,10,opt,name=two_factor_enabled,json=twoFactorEnabled,proto3" json:"two_factor_enabled,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -107,6 +161,20 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *User) GetTwoFactorEnabled() bool {
+	if x != nil {
+		return x.TwoFactorEnabled
+	}
+	return false
+}
+
 type LoginUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Identifier:
@@ -198,10 +266,13 @@ func (*LoginUserRequest_Username) isLoginUserRequest_Identifier() {}
 func (*LoginUserRequest_Email) isLoginUserRequest_Identifier() {}
 
 type LoginUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Token                  string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TwoFactorRequired      bool                   `protobuf:"varint,2,opt,name=two_factor_required,json=twoFactorRequired,proto3" json:"two_factor_required,omitempty"`
+	ChallengeToken         string                 `protobuf:"bytes,3,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`
+	TwoFactorSetupRequired bool                   `protobuf:"varint,4,opt,name=two_factor_setup_required,json=twoFactorSetupRequired,proto3" json:"two_factor_setup_required,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *LoginUserResponse) Reset() {
@@ -241,6 +312,27 @@ func (x *LoginUserResponse) GetToken() string {
 	return ""
 }
 
+func (x *LoginUserResponse) GetTwoFactorRequired() bool {
+	if x != nil {
+		return x.TwoFactorRequired
+	}
+	return false
+}
+
+func (x *LoginUserResponse) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+func (x *LoginUserResponse) GetTwoFactorSetupRequired() bool {
+	if x != nil {
+		return x.TwoFactorSetupRequired
+	}
+	return false
+}
+
 type LogoutUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -460,6 +552,7 @@ type CreateUserRequest struct {
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Email         *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Role          Role                   `protobuf:"varint,4,opt,name=role,proto3,enum=mantrae.v1.Role" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -515,6 +608,13 @@ func (x *CreateUserRequest) GetEmail() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -951,78 +1051,1422 @@ func (x *GetOIDCStatusResponse) GetProvider() string {
 	return ""
 }
 
-var File_mantrae_v1_user_proto protoreflect.FileDescriptor
+type UpdateUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          Role                   `protobuf:"varint,2,opt,name=role,proto3,enum=mantrae.v1.Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_mantrae_v1_user_proto_rawDesc = "" +
-	"\n" +
-	"\x15mantrae/v1/user.proto\x12\n" +
-	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf9\x01\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x129\n" +
-	"\n" +
-	"last_login\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tlastLogin\x129\n" +
-	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x97\x01\n" +
-	"\x10LoginUserRequest\x12%\n" +
-	"\busername\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x03H\x00R\busername\x12\x1f\n" +
-	"\x05email\x18\x02 \x01(\tB\a\xbaH\x04r\x02`\x01H\x00R\x05email\x12&\n" +
-	"\bpassword\x18\x03 \x01(\tB\n" +
-	"\xbaH\a\xc8\x01\x01r\x02\x10\bR\bpasswordB\x13\n" +
-	"\n" +
-	"identifier\x12\x05\xbaH\x02\b\x01\")\n" +
-	"\x11LoginUserResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"\x13\n" +
-	"\x11LogoutUserRequest\"\x14\n" +
-	"\x12LogoutUserResponse\"\x81\x01\n" +
-	"\x0eGetUserRequest\x12\x19\n" +
-	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\x02id\x12%\n" +
-	"\busername\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x03H\x00R\busername\x12\x1f\n" +
-	"\x05email\x18\x03 \x01(\tB\a\xbaH\x04r\x02`\x01H\x00R\x05emailB\f\n" +
-	"\n" +
-	"identifier\"7\n" +
-	"\x0fGetUserResponse\x12$\n" +
-	"\x04user\x18\x01 \x01(\v2\x10.mantrae.v1.UserR\x04user\"\x8e\x01\n" +
-	"\x11CreateUserRequest\x12#\n" +
-	"\busername\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x03R\busername\x12#\n" +
-	"\bpassword\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\bR\bpassword\x12%\n" +
-	"\x05email\x18\x03 \x01(\tB\n" +
-	"\xbaH\a\xd8\x01\x00r\x02`\x01H\x00R\x05email\x88\x01\x01B\b\n" +
-	"\x06_email\":\n" +
-	"\x12CreateUserResponse\x12$\n" +
-	"\x04user\x18\x01 \x01(\v2\x10.mantrae.v1.UserR\x04user\"\xbc\x01\n" +
-	"\x11UpdateUserRequest\x12\x17\n" +
-	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12#\n" +
-	"\busername\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x03R\busername\x12%\n" +
-	"\x05email\x18\x03 \x01(\tB\n" +
-	"\xbaH\a\xd8\x01\x00r\x02`\x01H\x00R\x05email\x88\x01\x01\x12+\n" +
-	"\bpassword\x18\x04 \x01(\tB\n" +
-	"\xbaH\a\xd8\x01\x00r\x02\x10\bH\x01R\bpassword\x88\x01\x01B\b\n" +
-	"\x06_emailB\v\n" +
-	"\t_password\":\n" +
-	"\x12UpdateUserResponse\x12$\n" +
-	"\x04user\x18\x01 \x01(\v2\x10.mantrae.v1.UserR\x04user\",\n" +
-	"\x11DeleteUserRequest\x12\x17\n" +
-	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\"\x14\n" +
-	"\x12DeleteUserResponse\"\xc0\x01\n" +
-	"\x10ListUsersRequest\x12q\n" +
-	"\x05limit\x18\x01 \x01(\x03BV\xbaHS\xba\x01P\n" +
-	"\vlimit.valid\x12)limit must be either -1 or greater than 0\x1a\x16this == -1 || this > 0H\x00R\x05limit\x88\x01\x01\x12$\n" +
-	"\x06offset\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x01R\x06offset\x88\x01\x01B\b\n" +
-	"\x06_limitB\t\n" +
-	"\a_offset\"\\\n" +
-	"\x11ListUsersResponse\x12&\n" +
-	"\x05users\x18\x01 \x03(\v2\x10.mantrae.v1.UserR\x05users\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x03R\n" +
-	"totalCount\"\x16\n" +
-	"\x14GetOIDCStatusRequest\"{\n" +
-	"\x15GetOIDCStatusResponse\x12!\n" +
-	"\foidc_enabled\x18\x01 \x01(\bR\voidcEnabled\x12#\n" +
-	"\rlogin_enabled\x18\x02 \x01(\bR\floginEnabled\x12\x1a\n" +
-	"\bprovider\x18\x03 \x01(\tR\bprovider2\xf9\x04\n" +
+func (x *UpdateUserRoleRequest) Reset() {
+	*x = UpdateUserRoleRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleRequest) ProtoMessage() {}
+
+func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateUserRoleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateUserRoleRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+type UpdateUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleResponse) Reset() {
+	*x = UpdateUserRoleResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleResponse) ProtoMessage() {}
+
+func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateUserRoleResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type APIToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=mantrae.v1.Role" json:"role,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIToken) Reset() {
+	*x = APIToken{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIToken) ProtoMessage() {}
+
+func (x *APIToken) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIToken.ProtoReflect.Descriptor instead.
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *APIToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIToken) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *APIToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIToken) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *APIToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role          Role                   `protobuf:"varint,2,opt,name=role,proto3,enum=mantrae.v1.Role" json:"role,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPITokenRequest) Reset() {
+	*x = CreateAPITokenRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPITokenRequest) ProtoMessage() {}
+
+func (x *CreateAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateAPITokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPITokenRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *CreateAPITokenRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiToken      *APIToken              `protobuf:"bytes,1,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPITokenResponse) Reset() {
+	*x = CreateAPITokenResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPITokenResponse) ProtoMessage() {}
+
+func (x *CreateAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateAPITokenResponse) GetApiToken() *APIToken {
+	if x != nil {
+		return x.ApiToken
+	}
+	return nil
+}
+
+func (x *CreateAPITokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListAPITokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensRequest) Reset() {
+	*x = ListAPITokensRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensRequest) ProtoMessage() {}
+
+func (x *ListAPITokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensRequest.ProtoReflect.Descriptor instead.
+func (*ListAPITokensRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+type ListAPITokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiTokens     []*APIToken            `protobuf:"bytes,1,rep,name=api_tokens,json=apiTokens,proto3" json:"api_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensResponse) Reset() {
+	*x = ListAPITokensResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensResponse) ProtoMessage() {}
+
+func (x *ListAPITokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAPITokensResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListAPITokensResponse) GetApiTokens() []*APIToken {
+	if x != nil {
+		return x.ApiTokens
+	}
+	return nil
+}
+
+type DeleteAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAPITokenRequest) Reset() {
+	*x = DeleteAPITokenRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAPITokenRequest) ProtoMessage() {}
+
+func (x *DeleteAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteAPITokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAPITokenResponse) Reset() {
+	*x = DeleteAPITokenResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAPITokenResponse) ProtoMessage() {}
+
+func (x *DeleteAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Current       bool                   `protobuf:"varint,8,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListSessionsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RevokeSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{30}
+}
+
+type RevokeAllSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsRequest) Reset() {
+	*x = RevokeAllSessionsRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeAllSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RevokeAllSessionsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type RevokeAllSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsResponse) Reset() {
+	*x = RevokeAllSessionsResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeAllSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+type VerifyTwoFactorLoginRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeToken string                 `protobuf:"bytes,1,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VerifyTwoFactorLoginRequest) Reset() {
+	*x = VerifyTwoFactorLoginRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTwoFactorLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTwoFactorLoginRequest) ProtoMessage() {}
+
+func (x *VerifyTwoFactorLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTwoFactorLoginRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTwoFactorLoginRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VerifyTwoFactorLoginRequest) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+func (x *VerifyTwoFactorLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyTwoFactorLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTwoFactorLoginResponse) Reset() {
+	*x = VerifyTwoFactorLoginResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTwoFactorLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTwoFactorLoginResponse) ProtoMessage() {}
+
+func (x *VerifyTwoFactorLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTwoFactorLoginResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTwoFactorLoginResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *VerifyTwoFactorLoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SetupTwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetupTwoFactorRequest) Reset() {
+	*x = SetupTwoFactorRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetupTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupTwoFactorRequest) ProtoMessage() {}
+
+func (x *SetupTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*SetupTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{35}
+}
+
+type SetupTwoFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetupTwoFactorResponse) Reset() {
+	*x = SetupTwoFactorResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetupTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupTwoFactorResponse) ProtoMessage() {}
+
+func (x *SetupTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*SetupTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetupTwoFactorResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *SetupTwoFactorResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type EnableTwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableTwoFactorRequest) Reset() {
+	*x = EnableTwoFactorRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableTwoFactorRequest) ProtoMessage() {}
+
+func (x *EnableTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnableTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EnableTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type EnableTwoFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableTwoFactorResponse) Reset() {
+	*x = EnableTwoFactorResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableTwoFactorResponse) ProtoMessage() {}
+
+func (x *EnableTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*EnableTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EnableTwoFactorResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Code          *string                `protobuf:"bytes,2,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTwoFactorRequest) Reset() {
+	*x = DisableTwoFactorRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFactorRequest) ProtoMessage() {}
+
+func (x *DisableTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DisableTwoFactorRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *DisableTwoFactorRequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+type DisableTwoFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTwoFactorResponse) Reset() {
+	*x = DisableTwoFactorResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFactorResponse) ProtoMessage() {}
+
+func (x *DisableTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{40}
+}
+
+type RegenerateRecoveryCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesResponse) Reset() {
+	*x = RegenerateRecoveryCodesResponse{}
+	mi := &file_mantrae_v1_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mantrae_v1_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_mantrae_v1_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+var File_mantrae_v1_user_proto protoreflect.FileDescriptor
+
+const file_mantrae_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x15mantrae/v1/user.proto\x12\n" +
+	"mantrae.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcd\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x129\n" +
+	"\n" +
+	"last_login\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tlastLogin\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12$\n" +
+	"\x04role\x18\t \x01(\x0e2\x10.mantrae.v1.RoleR\x04role\x12,\n" +
+	"\x12two_factor_enabled\x18\n" +
+	" \x01(\bR\x10twoFactorEnabled\"\x97\x01\n" +
+	"\x10LoginUserRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x03H\x00R\busername\x12\x1f\n" +
+	"\x05email\x18\x02 \x01(\tB\a\xbaH\x04r\x02`\x01H\x00R\x05email\x12&\n" +
+	"\bpassword\x18\x03 \x01(\tB\n" +
+	"\xbaH\a\xc8\x01\x01r\x02\x10\bR\bpasswordB\x13\n" +
+	"\n" +
+	"identifier\x12\x05\xbaH\x02\b\x01\"\xbd\x01\n" +
+	"\x11LoginUserResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12.\n" +
+	"\x13two_factor_required\x18\x02 \x01(\bR\x11twoFactorRequired\x12'\n" +
+	"\x0fchallenge_token\x18\x03 \x01(\tR\x0echallengeToken\x129\n" +
+	"\x19two_factor_setup_required\x18\x04 \x01(\bR\x16twoFactorSetupRequired\"\x13\n" +
+	"\x11LogoutUserRequest\"\x14\n" +
+	"\x12LogoutUserResponse\"\x81\x01\n" +
+	"\x0eGetUserRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\x02id\x12%\n" +
+	"\busername\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x03H\x00R\busername\x12\x1f\n" +
+	"\x05email\x18\x03 \x01(\tB\a\xbaH\x04r\x02`\x01H\x00R\x05emailB\f\n" +
+	"\n" +
+	"identifier\"7\n" +
+	"\x0fGetUserResponse\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.mantrae.v1.UserR\x04user\"\xbe\x01\n" +
+	"\x11CreateUserRequest\x12#\n" +
+	"\busername\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x03R\busername\x12#\n" +
+	"\bpassword\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\bR\bpassword\x12%\n" +
+	"\x05email\x18\x03 \x01(\tB\n" +
+	"\xbaH\a\xd8\x01\x00r\x02`\x01H\x00R\x05email\x88\x01\x01\x12.\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x10.mantrae.v1.RoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04roleB\b\n" +
+	"\x06_email\":\n" +
+	"\x12CreateUserResponse\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.mantrae.v1.UserR\x04user\"\xbc\x01\n" +
+	"\x11UpdateUserRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12#\n" +
+	"\busername\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x03R\busername\x12%\n" +
+	"\x05email\x18\x03 \x01(\tB\n" +
+	"\xbaH\a\xd8\x01\x00r\x02`\x01H\x00R\x05email\x88\x01\x01\x12+\n" +
+	"\bpassword\x18\x04 \x01(\tB\n" +
+	"\xbaH\a\xd8\x01\x00r\x02\x10\bH\x01R\bpassword\x88\x01\x01B\b\n" +
+	"\x06_emailB\v\n" +
+	"\t_password\":\n" +
+	"\x12UpdateUserResponse\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.mantrae.v1.UserR\x04user\",\n" +
+	"\x11DeleteUserRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\"\x14\n" +
+	"\x12DeleteUserResponse\"\xc0\x01\n" +
+	"\x10ListUsersRequest\x12q\n" +
+	"\x05limit\x18\x01 \x01(\x03BV\xbaHS\xba\x01P\n" +
+	"\vlimit.valid\x12)limit must be either -1 or greater than 0\x1a\x16this == -1 || this > 0H\x00R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x01R\x06offset\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"\\\n" +
+	"\x11ListUsersResponse\x12&\n" +
+	"\x05users\x18\x01 \x03(\v2\x10.mantrae.v1.UserR\x05users\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\"\x16\n" +
+	"\x14GetOIDCStatusRequest\"{\n" +
+	"\x15GetOIDCStatusResponse\x12!\n" +
+	"\foidc_enabled\x18\x01 \x01(\bR\voidcEnabled\x12#\n" +
+	"\rlogin_enabled\x18\x02 \x01(\bR\floginEnabled\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\"`\n" +
+	"\x15UpdateUserRoleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12.\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.mantrae.v1.RoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\">\n" +
+	"\x16UpdateUserRoleResponse\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.mantrae.v1.UserR\x04user\"\x88\x02\n" +
+	"\bAPIToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.mantrae.v1.RoleR\x04role\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9f\x01\n" +
+	"\x15CreateAPITokenRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12.\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.mantrae.v1.RoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"a\n" +
+	"\x16CreateAPITokenResponse\x121\n" +
+	"\tapi_token\x18\x01 \x01(\v2\x14.mantrae.v1.APITokenR\bapiToken\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x16\n" +
+	"\x14ListAPITokensRequest\"L\n" +
+	"\x15ListAPITokensResponse\x123\n" +
+	"\n" +
+	"api_tokens\x18\x01 \x03(\v2\x14.mantrae.v1.APITokenR\tapiTokens\"0\n" +
+	"\x15DeleteAPITokenRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\"\x18\n" +
+	"\x16DeleteAPITokenResponse\"\xbe\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_seen_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\acurrent\x18\b \x01(\bR\acurrent\"?\n" +
+	"\x13ListSessionsRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"G\n" +
+	"\x14ListSessionsResponse\x12/\n" +
+	"\bsessions\x18\x01 \x03(\v2\x13.mantrae.v1.SessionR\bsessions\"/\n" +
+	"\x14RevokeSessionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\"\x17\n" +
+	"\x15RevokeSessionResponse\"D\n" +
+	"\x18RevokeAllSessionsRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"\x1b\n" +
+	"\x19RevokeAllSessionsResponse\"l\n" +
+	"\x1bVerifyTwoFactorLoginRequest\x120\n" +
+	"\x0fchallenge_token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x0echallengeToken\x12\x1b\n" +
+	"\x04code\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x06R\x04code\"4\n" +
+	"\x1cVerifyTwoFactorLoginResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x17\n" +
+	"\x15SetupTwoFactorRequest\"Q\n" +
+	"\x16SetupTwoFactorResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"5\n" +
+	"\x16EnableTwoFactorRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x06R\x04code\"@\n" +
+	"\x17EnableTwoFactorResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"e\n" +
+	"\x17DisableTwoFactorRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tH\x01R\x04code\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\a\n" +
+	"\x05_code\"\x1a\n" +
+	"\x18DisableTwoFactorResponse\"=\n" +
+	"\x1eRegenerateRecoveryCodesRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x06R\x04code\"H\n" +
+	"\x1fRegenerateRecoveryCodesResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes*N\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"ROLE_ADMIN\x10\x01\x12\x0f\n" +
+	"\vROLE_EDITOR\x10\x02\x12\x0f\n" +
+	"\vROLE_VIEWER\x10\x032\xe2\r\n" +
 	"\vUserService\x12H\n" +
 	"\tLoginUser\x12\x1c.mantrae.v1.LoginUserRequest\x1a\x1d.mantrae.v1.LoginUserResponse\x12K\n" +
 	"\n" +
@@ -1035,7 +2479,19 @@ const file_mantrae_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"DeleteUser\x12\x1d.mantrae.v1.DeleteUserRequest\x1a\x1e.mantrae.v1.DeleteUserResponse\x12M\n" +
 	"\tListUsers\x12\x1c.mantrae.v1.ListUsersRequest\x1a\x1d.mantrae.v1.ListUsersResponse\"\x03\x90\x02\x01\x12T\n" +
-	"\rGetOIDCStatus\x12 .mantrae.v1.GetOIDCStatusRequest\x1a!.mantrae.v1.GetOIDCStatusResponseB\xa6\x01\n" +
+	"\rGetOIDCStatus\x12 .mantrae.v1.GetOIDCStatusRequest\x1a!.mantrae.v1.GetOIDCStatusResponse\x12W\n" +
+	"\x0eUpdateUserRole\x12!.mantrae.v1.UpdateUserRoleRequest\x1a\".mantrae.v1.UpdateUserRoleResponse\x12W\n" +
+	"\x0eCreateAPIToken\x12!.mantrae.v1.CreateAPITokenRequest\x1a\".mantrae.v1.CreateAPITokenResponse\x12Y\n" +
+	"\rListAPITokens\x12 .mantrae.v1.ListAPITokensRequest\x1a!.mantrae.v1.ListAPITokensResponse\"\x03\x90\x02\x01\x12W\n" +
+	"\x0eDeleteAPIToken\x12!.mantrae.v1.DeleteAPITokenRequest\x1a\".mantrae.v1.DeleteAPITokenResponse\x12V\n" +
+	"\fListSessions\x12\x1f.mantrae.v1.ListSessionsRequest\x1a .mantrae.v1.ListSessionsResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\rRevokeSession\x12 .mantrae.v1.RevokeSessionRequest\x1a!.mantrae.v1.RevokeSessionResponse\x12`\n" +
+	"\x11RevokeAllSessions\x12$.mantrae.v1.RevokeAllSessionsRequest\x1a%.mantrae.v1.RevokeAllSessionsResponse\x12i\n" +
+	"\x14VerifyTwoFactorLogin\x12'.mantrae.v1.VerifyTwoFactorLoginRequest\x1a(.mantrae.v1.VerifyTwoFactorLoginResponse\x12W\n" +
+	"\x0eSetupTwoFactor\x12!.mantrae.v1.SetupTwoFactorRequest\x1a\".mantrae.v1.SetupTwoFactorResponse\x12Z\n" +
+	"\x0fEnableTwoFactor\x12\".mantrae.v1.EnableTwoFactorRequest\x1a#.mantrae.v1.EnableTwoFactorResponse\x12]\n" +
+	"\x10DisableTwoFactor\x12#.mantrae.v1.DisableTwoFactorRequest\x1a$.mantrae.v1.DisableTwoFactorResponse\x12r\n" +
+	"\x17RegenerateRecoveryCodes\x12*.mantrae.v1.RegenerateRecoveryCodesRequest\x1a+.mantrae.v1.RegenerateRecoveryCodesResponseB\xa6\x01\n" +
 	"\x0ecom.mantrae.v1B\tUserProtoP\x01Z@github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1;mantraev1\xa2\x02\x03MXX\xaa\x02\n" +
 	"Mantrae.V1\xca\x02\n" +
 	"Mantrae\\V1\xe2\x02\x16Mantrae\\V1\\GPBMetadata\xea\x02\vMantrae::V1b\x06proto3"
@@ -1052,56 +2508,124 @@ func file_mantrae_v1_user_proto_rawDescGZIP() []byte {
 	return file_mantrae_v1_user_proto_rawDescData
 }
 
-var file_mantrae_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_mantrae_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mantrae_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_mantrae_v1_user_proto_goTypes = []any{
-	(*User)(nil),                  // 0: mantrae.v1.User
-	(*LoginUserRequest)(nil),      // 1: mantrae.v1.LoginUserRequest
-	(*LoginUserResponse)(nil),     // 2: mantrae.v1.LoginUserResponse
-	(*LogoutUserRequest)(nil),     // 3: mantrae.v1.LogoutUserRequest
-	(*LogoutUserResponse)(nil),    // 4: mantrae.v1.LogoutUserResponse
-	(*GetUserRequest)(nil),        // 5: mantrae.v1.GetUserRequest
-	(*GetUserResponse)(nil),       // 6: mantrae.v1.GetUserResponse
-	(*CreateUserRequest)(nil),     // 7: mantrae.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),    // 8: mantrae.v1.CreateUserResponse
-	(*UpdateUserRequest)(nil),     // 9: mantrae.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),    // 10: mantrae.v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),     // 11: mantrae.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),    // 12: mantrae.v1.DeleteUserResponse
-	(*ListUsersRequest)(nil),      // 13: mantrae.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),     // 14: mantrae.v1.ListUsersResponse
-	(*GetOIDCStatusRequest)(nil),  // 15: mantrae.v1.GetOIDCStatusRequest
-	(*GetOIDCStatusResponse)(nil), // 16: mantrae.v1.GetOIDCStatusResponse
-	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(Role)(0),                               // 0: mantrae.v1.Role
+	(*User)(nil),                            // 1: mantrae.v1.User
+	(*LoginUserRequest)(nil),                // 2: mantrae.v1.LoginUserRequest
+	(*LoginUserResponse)(nil),               // 3: mantrae.v1.LoginUserResponse
+	(*LogoutUserRequest)(nil),               // 4: mantrae.v1.LogoutUserRequest
+	(*LogoutUserResponse)(nil),              // 5: mantrae.v1.LogoutUserResponse
+	(*GetUserRequest)(nil),                  // 6: mantrae.v1.GetUserRequest
+	(*GetUserResponse)(nil),                 // 7: mantrae.v1.GetUserResponse
+	(*CreateUserRequest)(nil),               // 8: mantrae.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),              // 9: mantrae.v1.CreateUserResponse
+	(*UpdateUserRequest)(nil),               // 10: mantrae.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),              // 11: mantrae.v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),               // 12: mantrae.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),              // 13: mantrae.v1.DeleteUserResponse
+	(*ListUsersRequest)(nil),                // 14: mantrae.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),               // 15: mantrae.v1.ListUsersResponse
+	(*GetOIDCStatusRequest)(nil),            // 16: mantrae.v1.GetOIDCStatusRequest
+	(*GetOIDCStatusResponse)(nil),           // 17: mantrae.v1.GetOIDCStatusResponse
+	(*UpdateUserRoleRequest)(nil),           // 18: mantrae.v1.UpdateUserRoleRequest
+	(*UpdateUserRoleResponse)(nil),          // 19: mantrae.v1.UpdateUserRoleResponse
+	(*APIToken)(nil),                        // 20: mantrae.v1.APIToken
+	(*CreateAPITokenRequest)(nil),           // 21: mantrae.v1.CreateAPITokenRequest
+	(*CreateAPITokenResponse)(nil),          // 22: mantrae.v1.CreateAPITokenResponse
+	(*ListAPITokensRequest)(nil),            // 23: mantrae.v1.ListAPITokensRequest
+	(*ListAPITokensResponse)(nil),           // 24: mantrae.v1.ListAPITokensResponse
+	(*DeleteAPITokenRequest)(nil),           // 25: mantrae.v1.DeleteAPITokenRequest
+	(*DeleteAPITokenResponse)(nil),          // 26: mantrae.v1.DeleteAPITokenResponse
+	(*Session)(nil),                         // 27: mantrae.v1.Session
+	(*ListSessionsRequest)(nil),             // 28: mantrae.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),            // 29: mantrae.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),            // 30: mantrae.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),           // 31: mantrae.v1.RevokeSessionResponse
+	(*RevokeAllSessionsRequest)(nil),        // 32: mantrae.v1.RevokeAllSessionsRequest
+	(*RevokeAllSessionsResponse)(nil),       // 33: mantrae.v1.RevokeAllSessionsResponse
+	(*VerifyTwoFactorLoginRequest)(nil),     // 34: mantrae.v1.VerifyTwoFactorLoginRequest
+	(*VerifyTwoFactorLoginResponse)(nil),    // 35: mantrae.v1.VerifyTwoFactorLoginResponse
+	(*SetupTwoFactorRequest)(nil),           // 36: mantrae.v1.SetupTwoFactorRequest
+	(*SetupTwoFactorResponse)(nil),          // 37: mantrae.v1.SetupTwoFactorResponse
+	(*EnableTwoFactorRequest)(nil),          // 38: mantrae.v1.EnableTwoFactorRequest
+	(*EnableTwoFactorResponse)(nil),         // 39: mantrae.v1.EnableTwoFactorResponse
+	(*DisableTwoFactorRequest)(nil),         // 40: mantrae.v1.DisableTwoFactorRequest
+	(*DisableTwoFactorResponse)(nil),        // 41: mantrae.v1.DisableTwoFactorResponse
+	(*RegenerateRecoveryCodesRequest)(nil),  // 42: mantrae.v1.RegenerateRecoveryCodesRequest
+	(*RegenerateRecoveryCodesResponse)(nil), // 43: mantrae.v1.RegenerateRecoveryCodesResponse
+	(*timestamppb.Timestamp)(nil),           // 44: google.protobuf.Timestamp
 }
 var file_mantrae_v1_user_proto_depIdxs = []int32{
-	17, // 0: mantrae.v1.User.last_login:type_name -> google.protobuf.Timestamp
-	17, // 1: mantrae.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	17, // 2: mantrae.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: mantrae.v1.GetUserResponse.user:type_name -> mantrae.v1.User
-	0,  // 4: mantrae.v1.CreateUserResponse.user:type_name -> mantrae.v1.User
-	0,  // 5: mantrae.v1.UpdateUserResponse.user:type_name -> mantrae.v1.User
-	0,  // 6: mantrae.v1.ListUsersResponse.users:type_name -> mantrae.v1.User
-	1,  // 7: mantrae.v1.UserService.LoginUser:input_type -> mantrae.v1.LoginUserRequest
-	3,  // 8: mantrae.v1.UserService.LogoutUser:input_type -> mantrae.v1.LogoutUserRequest
-	5,  // 9: mantrae.v1.UserService.GetUser:input_type -> mantrae.v1.GetUserRequest
-	7,  // 10: mantrae.v1.UserService.CreateUser:input_type -> mantrae.v1.CreateUserRequest
-	9,  // 11: mantrae.v1.UserService.UpdateUser:input_type -> mantrae.v1.UpdateUserRequest
-	11, // 12: mantrae.v1.UserService.DeleteUser:input_type -> mantrae.v1.DeleteUserRequest
-	13, // 13: mantrae.v1.UserService.ListUsers:input_type -> mantrae.v1.ListUsersRequest
-	15, // 14: mantrae.v1.UserService.GetOIDCStatus:input_type -> mantrae.v1.GetOIDCStatusRequest
-	2,  // 15: mantrae.v1.UserService.LoginUser:output_type -> mantrae.v1.LoginUserResponse
-	4,  // 16: mantrae.v1.UserService.LogoutUser:output_type -> mantrae.v1.LogoutUserResponse
-	6,  // 17: mantrae.v1.UserService.GetUser:output_type -> mantrae.v1.GetUserResponse
-	8,  // 18: mantrae.v1.UserService.CreateUser:output_type -> mantrae.v1.CreateUserResponse
-	10, // 19: mantrae.v1.UserService.UpdateUser:output_type -> mantrae.v1.UpdateUserResponse
-	12, // 20: mantrae.v1.UserService.DeleteUser:output_type -> mantrae.v1.DeleteUserResponse
-	14, // 21: mantrae.v1.UserService.ListUsers:output_type -> mantrae.v1.ListUsersResponse
-	16, // 22: mantrae.v1.UserService.GetOIDCStatus:output_type -> mantrae.v1.GetOIDCStatusResponse
-	15, // [15:23] is the sub-list for method output_type
-	7,  // [7:15] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	44, // 0: mantrae.v1.User.last_login:type_name -> google.protobuf.Timestamp
+	44, // 1: mantrae.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	44, // 2: mantrae.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: mantrae.v1.User.role:type_name -> mantrae.v1.Role
+	1,  // 4: mantrae.v1.GetUserResponse.user:type_name -> mantrae.v1.User
+	0,  // 5: mantrae.v1.CreateUserRequest.role:type_name -> mantrae.v1.Role
+	1,  // 6: mantrae.v1.CreateUserResponse.user:type_name -> mantrae.v1.User
+	1,  // 7: mantrae.v1.UpdateUserResponse.user:type_name -> mantrae.v1.User
+	1,  // 8: mantrae.v1.ListUsersResponse.users:type_name -> mantrae.v1.User
+	0,  // 9: mantrae.v1.UpdateUserRoleRequest.role:type_name -> mantrae.v1.Role
+	1,  // 10: mantrae.v1.UpdateUserRoleResponse.user:type_name -> mantrae.v1.User
+	0,  // 11: mantrae.v1.APIToken.role:type_name -> mantrae.v1.Role
+	44, // 12: mantrae.v1.APIToken.expires_at:type_name -> google.protobuf.Timestamp
+	44, // 13: mantrae.v1.APIToken.last_used_at:type_name -> google.protobuf.Timestamp
+	44, // 14: mantrae.v1.APIToken.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 15: mantrae.v1.CreateAPITokenRequest.role:type_name -> mantrae.v1.Role
+	44, // 16: mantrae.v1.CreateAPITokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 17: mantrae.v1.CreateAPITokenResponse.api_token:type_name -> mantrae.v1.APIToken
+	20, // 18: mantrae.v1.ListAPITokensResponse.api_tokens:type_name -> mantrae.v1.APIToken
+	44, // 19: mantrae.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	44, // 20: mantrae.v1.Session.last_seen_at:type_name -> google.protobuf.Timestamp
+	44, // 21: mantrae.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	27, // 22: mantrae.v1.ListSessionsResponse.sessions:type_name -> mantrae.v1.Session
+	2,  // 23: mantrae.v1.UserService.LoginUser:input_type -> mantrae.v1.LoginUserRequest
+	4,  // 24: mantrae.v1.UserService.LogoutUser:input_type -> mantrae.v1.LogoutUserRequest
+	6,  // 25: mantrae.v1.UserService.GetUser:input_type -> mantrae.v1.GetUserRequest
+	8,  // 26: mantrae.v1.UserService.CreateUser:input_type -> mantrae.v1.CreateUserRequest
+	10, // 27: mantrae.v1.UserService.UpdateUser:input_type -> mantrae.v1.UpdateUserRequest
+	12, // 28: mantrae.v1.UserService.DeleteUser:input_type -> mantrae.v1.DeleteUserRequest
+	14, // 29: mantrae.v1.UserService.ListUsers:input_type -> mantrae.v1.ListUsersRequest
+	16, // 30: mantrae.v1.UserService.GetOIDCStatus:input_type -> mantrae.v1.GetOIDCStatusRequest
+	18, // 31: mantrae.v1.UserService.UpdateUserRole:input_type -> mantrae.v1.UpdateUserRoleRequest
+	21, // 32: mantrae.v1.UserService.CreateAPIToken:input_type -> mantrae.v1.CreateAPITokenRequest
+	23, // 33: mantrae.v1.UserService.ListAPITokens:input_type -> mantrae.v1.ListAPITokensRequest
+	25, // 34: mantrae.v1.UserService.DeleteAPIToken:input_type -> mantrae.v1.DeleteAPITokenRequest
+	28, // 35: mantrae.v1.UserService.ListSessions:input_type -> mantrae.v1.ListSessionsRequest
+	30, // 36: mantrae.v1.UserService.RevokeSession:input_type -> mantrae.v1.RevokeSessionRequest
+	32, // 37: mantrae.v1.UserService.RevokeAllSessions:input_type -> mantrae.v1.RevokeAllSessionsRequest
+	34, // 38: mantrae.v1.UserService.VerifyTwoFactorLogin:input_type -> mantrae.v1.VerifyTwoFactorLoginRequest
+	36, // 39: mantrae.v1.UserService.SetupTwoFactor:input_type -> mantrae.v1.SetupTwoFactorRequest
+	38, // 40: mantrae.v1.UserService.EnableTwoFactor:input_type -> mantrae.v1.EnableTwoFactorRequest
+	40, // 41: mantrae.v1.UserService.DisableTwoFactor:input_type -> mantrae.v1.DisableTwoFactorRequest
+	42, // 42: mantrae.v1.UserService.RegenerateRecoveryCodes:input_type -> mantrae.v1.RegenerateRecoveryCodesRequest
+	3,  // 43: mantrae.v1.UserService.LoginUser:output_type -> mantrae.v1.LoginUserResponse
+	5,  // 44: mantrae.v1.UserService.LogoutUser:output_type -> mantrae.v1.LogoutUserResponse
+	7,  // 45: mantrae.v1.UserService.GetUser:output_type -> mantrae.v1.GetUserResponse
+	9,  // 46: mantrae.v1.UserService.CreateUser:output_type -> mantrae.v1.CreateUserResponse
+	11, // 47: mantrae.v1.UserService.UpdateUser:output_type -> mantrae.v1.UpdateUserResponse
+	13, // 48: mantrae.v1.UserService.DeleteUser:output_type -> mantrae.v1.DeleteUserResponse
+	15, // 49: mantrae.v1.UserService.ListUsers:output_type -> mantrae.v1.ListUsersResponse
+	17, // 50: mantrae.v1.UserService.GetOIDCStatus:output_type -> mantrae.v1.GetOIDCStatusResponse
+	19, // 51: mantrae.v1.UserService.UpdateUserRole:output_type -> mantrae.v1.UpdateUserRoleResponse
+	22, // 52: mantrae.v1.UserService.CreateAPIToken:output_type -> mantrae.v1.CreateAPITokenResponse
+	24, // 53: mantrae.v1.UserService.ListAPITokens:output_type -> mantrae.v1.ListAPITokensResponse
+	26, // 54: mantrae.v1.UserService.DeleteAPIToken:output_type -> mantrae.v1.DeleteAPITokenResponse
+	29, // 55: mantrae.v1.UserService.ListSessions:output_type -> mantrae.v1.ListSessionsResponse
+	31, // 56: mantrae.v1.UserService.RevokeSession:output_type -> mantrae.v1.RevokeSessionResponse
+	33, // 57: mantrae.v1.UserService.RevokeAllSessions:output_type -> mantrae.v1.RevokeAllSessionsResponse
+	35, // 58: mantrae.v1.UserService.VerifyTwoFactorLogin:output_type -> mantrae.v1.VerifyTwoFactorLoginResponse
+	37, // 59: mantrae.v1.UserService.SetupTwoFactor:output_type -> mantrae.v1.SetupTwoFactorResponse
+	39, // 60: mantrae.v1.UserService.EnableTwoFactor:output_type -> mantrae.v1.EnableTwoFactorResponse
+	41, // 61: mantrae.v1.UserService.DisableTwoFactor:output_type -> mantrae.v1.DisableTwoFactorResponse
+	43, // 62: mantrae.v1.UserService.RegenerateRecoveryCodes:output_type -> mantrae.v1.RegenerateRecoveryCodesResponse
+	43, // [43:63] is the sub-list for method output_type
+	23, // [23:43] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_mantrae_v1_user_proto_init() }
@@ -1121,18 +2645,22 @@ func file_mantrae_v1_user_proto_init() {
 	file_mantrae_v1_user_proto_msgTypes[7].OneofWrappers = []any{}
 	file_mantrae_v1_user_proto_msgTypes[9].OneofWrappers = []any{}
 	file_mantrae_v1_user_proto_msgTypes[13].OneofWrappers = []any{}
+	file_mantrae_v1_user_proto_msgTypes[27].OneofWrappers = []any{}
+	file_mantrae_v1_user_proto_msgTypes[31].OneofWrappers = []any{}
+	file_mantrae_v1_user_proto_msgTypes[39].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mantrae_v1_user_proto_rawDesc), len(file_mantrae_v1_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      1,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_mantrae_v1_user_proto_goTypes,
 		DependencyIndexes: file_mantrae_v1_user_proto_depIdxs,
+		EnumInfos:         file_mantrae_v1_user_proto_enumTypes,
 		MessageInfos:      file_mantrae_v1_user_proto_msgTypes,
 	}.Build()
 	File_mantrae_v1_user_proto = out.File
@@ -104,6 +104,7 @@ func (x *GetVersionResponse) GetVersion() string {
 type GetDynamicConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -145,6 +146,13 @@ func (x *GetDynamicConfigRequest) GetProfileId() int64 {
 	return 0
 }
 
+func (x *GetDynamicConfigRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
 type GetDynamicConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Config        string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
@@ -285,10 +293,11 @@ const file_mantrae_v1_util_proto_rawDesc = "" +
 	"mantrae.v1\"\x13\n" +
 	"\x11GetVersionRequest\".\n" +
 	"\x12GetVersionResponse\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\"8\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"P\n" +
 	"\x17GetDynamicConfigRequest\x12\x1d\n" +
 	"\n" +
-	"profile_id\x18\x01 \x01(\x03R\tprofileId\"2\n" +
+	"profile_id\x18\x01 \x01(\x03R\tprofileId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"2\n" +
 	"\x18GetDynamicConfigResponse\x12\x16\n" +
 	"\x06config\x18\x01 \x01(\tR\x06config\"\x14\n" +
 	"\x12GetPublicIPRequest\"=\n" +
@@ -18,6 +18,9 @@ func (u *UserClaims) Valid() error {
 	if u.UserID == "" {
 		return errors.New("user id is required")
 	}
+	if u.ID == "" {
+		return errors.New("session id is required")
+	}
 	return nil
 }
 
@@ -50,13 +53,16 @@ func DecodeUserToken(tokenStr string, secret string) (*UserClaims, error) {
 	return claims, nil
 }
 
+// EncodeUserToken signs a token for a user session, the session ID is stored
+// as the jti claim.
 func EncodeUserToken(
-	userID, secret string,
+	userID, sessionID, secret string,
 	expirationTime time.Time,
 ) (string, error) {
 	claims := &UserClaims{
 		UserID: userID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        sessionID,
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
@@ -67,3 +73,47 @@ func EncodeUserToken(
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(secret))
 }
+
+// challengeAudience marks tokens issued after a verified password, they can
+// only be used to finish a two-factor login.
+const challengeAudience = "two_factor_challenge"
+
+// EncodeChallengeToken signs a token proving that a user passed the password
+// step of a two-factor login.
+func EncodeChallengeToken(userID, secret string, expirationTime time.Time) (string, error) {
+	if userID == "" {
+		return "", errors.New("user id is required")
+	}
+	claims := &jwt.RegisteredClaims{
+		Subject:   userID,
+		Audience:  jwt.ClaimStrings{challengeAudience},
+		ExpiresAt: jwt.NewNumericDate(expirationTime),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(secret))
+}
+
+// DecodeChallengeToken verifies a challenge token and returns its user ID.
+func DecodeChallengeToken(tokenStr, secret string) (string, error) {
+	token, err := jwt.ParseWithClaims(
+		tokenStr,
+		&jwt.RegisteredClaims{},
+		func(token *jwt.Token) (any, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return []byte(secret), nil
+		},
+		jwt.WithAudience(challengeAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return "", err
+	}
+	claims, ok := token.Claims.(*jwt.RegisteredClaims)
+	if !ok || !token.Valid || claims.Subject == "" {
+		return "", errors.New("invalid token")
+	}
+	return claims.Subject, nil
+}
@@ -6,4 +6,12 @@ const (
 	HeaderTraefikName  = "Traefik-Instance-Name"
 	HeaderTraefikURL   = "Traefik-Instance-Url"
 	HeaderTraefikToken = "Traefik-Instance-Token"
+	APITokenPrefix     = "mantrae_"
+)
+
+// User roles, from most to least privileged.
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+	RoleViewer = "viewer"
 )
@@ -34,7 +34,10 @@ const (
 	KeyOIDCIssuerURL        = "oidc_issuer_url"
 	KeyOIDCScopes           = "oidc_scopes"
 	KeyOIDCPKCE             = "oidc_pkce"
+	KeyOIDCDefaultRole      = "oidc_default_role"
 	KeyPasswordLoginEnabled = "password_login_enabled"
+	KeySessionLifetime      = "session_lifetime"
+	KeyTwoFactorRequired    = "two_factor_required"
 
 	// Agent settings
 	KeyAgentCleanupEnabled  = "agent_cleanup_enabled"
@@ -44,4 +47,10 @@ const (
 	KeyTraefikSyncInterval = "traefik_sync_interval"
 	KeyDNSSyncInterval     = "dns_sync_interval"
 	KeyAgentSyncInterval   = "agent_sync_interval"
+	KeyTraefikPollEnabled  = "traefik_poll_enabled"
+
+	// Service health settings
+	KeyServiceHealthEnabled   = "service_health_enabled"
+	KeyServiceHealthInterval  = "service_health_interval"
+	KeyServiceHealthRetention = "service_health_retention"
 )
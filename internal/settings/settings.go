@@ -18,35 +18,42 @@ import (
 
 // Settings defines all application settings
 type Settings struct {
-	ServerURL            string        `setting:"server_url"             default:""`
-	Storage              string        `setting:"storage_select"         default:"local"`
-	BackupEnabled        bool          `setting:"backup_enabled"         default:"true"`
-	BackupInterval       time.Duration `setting:"backup_interval"        default:"24h"`
-	BackupKeep           int           `setting:"backup_keep"            default:"3"`
-	S3Endpoint           string        `setting:"s3_endpoint"            default:""`
-	S3Bucket             string        `setting:"s3_bucket"              default:"mantrae"`
-	S3Region             string        `setting:"s3_region"              default:"us-east-1"`
-	S3AccessKey          string        `setting:"s3_access_key"          default:""`
-	S3SecretKey          string        `setting:"s3_secret_key"          default:""`
-	S3UsePathStyle       bool          `setting:"s3_use_path_style"      default:"false"`
-	EmailHost            string        `setting:"email_host"             default:""`
-	EmailPort            int           `setting:"email_port"             default:"587"`
-	EmailUser            string        `setting:"email_user"             default:""`
-	EmailPassword        string        `setting:"email_password"         default:""`
-	EmailFrom            string        `setting:"email_from"             default:"mantrae@localhost"`
-	PasswordLoginEnabled bool          `setting:"password_login_enabled" default:"true"`
-	OIDCEnabled          bool          `setting:"oidc_enabled"           default:"false"`
-	OIDCClientID         string        `setting:"oidc_client_id"         default:""`
-	OIDCClientSecret     string        `setting:"oidc_client_secret"     default:""`
-	OIDCIssuerURL        string        `setting:"oidc_issuer_url"        default:""`
-	OIDCProviderName     string        `setting:"oidc_provider_name"     default:""`
-	OIDCScopes           string        `setting:"oidc_scopes"            default:""`
-	OIDCPKCE             bool          `setting:"oidc_pkce"              default:"false"`
-	AgentCleanupEnabled  bool          `setting:"agent_cleanup_enabled"  default:"true"`
-	AgentCleanupInterval time.Duration `setting:"agent_cleanup_interval" default:"24h"`
-	TraefikSyncInterval  time.Duration `setting:"traefik_sync_interval"  default:"20s"`
-	DNSSyncInterval      time.Duration `setting:"dns_sync_interval"      default:"3m"`
-	AgentCheckInterval   time.Duration `setting:"agent_check_interval"   default:"5m"`
+	ServerURL              string        `setting:"server_url"               default:""`
+	Storage                string        `setting:"storage_select"           default:"local"`
+	BackupEnabled          bool          `setting:"backup_enabled"           default:"true"`
+	BackupInterval         time.Duration `setting:"backup_interval"          default:"24h"`
+	BackupKeep             int           `setting:"backup_keep"              default:"3"`
+	S3Endpoint             string        `setting:"s3_endpoint"              default:""`
+	S3Bucket               string        `setting:"s3_bucket"                default:"mantrae"`
+	S3Region               string        `setting:"s3_region"                default:"us-east-1"`
+	S3AccessKey            string        `setting:"s3_access_key"            default:""`
+	S3SecretKey            string        `setting:"s3_secret_key"            default:""`
+	S3UsePathStyle         bool          `setting:"s3_use_path_style"        default:"false"`
+	EmailHost              string        `setting:"email_host"               default:""`
+	EmailPort              int           `setting:"email_port"               default:"587"`
+	EmailUser              string        `setting:"email_user"               default:""`
+	EmailPassword          string        `setting:"email_password"           default:""`
+	EmailFrom              string        `setting:"email_from"               default:"mantrae@localhost"`
+	PasswordLoginEnabled   bool          `setting:"password_login_enabled"   default:"true"`
+	SessionLifetime        time.Duration `setting:"session_lifetime"         default:"24h"`
+	TwoFactorRequired      bool          `setting:"two_factor_required"      default:"false"`
+	OIDCEnabled            bool          `setting:"oidc_enabled"             default:"false"`
+	OIDCClientID           string        `setting:"oidc_client_id"           default:""`
+	OIDCClientSecret       string        `setting:"oidc_client_secret"       default:""`
+	OIDCIssuerURL          string        `setting:"oidc_issuer_url"          default:""`
+	OIDCProviderName       string        `setting:"oidc_provider_name"       default:""`
+	OIDCScopes             string        `setting:"oidc_scopes"              default:""`
+	OIDCPKCE               bool          `setting:"oidc_pkce"                default:"false"`
+	OIDCDefaultRole        string        `setting:"oidc_default_role"        default:"viewer"`
+	AgentCleanupEnabled    bool          `setting:"agent_cleanup_enabled"    default:"true"`
+	AgentCleanupInterval   time.Duration `setting:"agent_cleanup_interval"   default:"24h"`
+	TraefikSyncInterval    time.Duration `setting:"traefik_sync_interval"    default:"20s"`
+	TraefikPollEnabled     bool          `setting:"traefik_poll_enabled"     default:"false"`
+	DNSSyncInterval        time.Duration `setting:"dns_sync_interval"        default:"3m"`
+	AgentCheckInterval     time.Duration `setting:"agent_check_interval"     default:"5m"`
+	ServiceHealthEnabled   bool          `setting:"service_health_enabled"   default:"false"`
+	ServiceHealthInterval  time.Duration `setting:"service_health_interval"  default:"30s"`
+	ServiceHealthRetention time.Duration `setting:"service_health_retention" default:"24h"`
 }
 
 type SettingsManager struct {
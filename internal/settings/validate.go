@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/mizuchilabs/mantrae/internal/meta"
 	"github.com/mizuchilabs/mantrae/internal/store/db"
 	"github.com/mizuchilabs/mantrae/internal/util"
 )
@@ -35,6 +36,18 @@ func (sm *SettingsManager) validate(ctx context.Context, params *db.UpsertSettin
 		}
 		params.Value = util.CleanURL(params.Value)
 
+	case KeyOIDCDefaultRole:
+		switch params.Value {
+		case meta.RoleAdmin, meta.RoleEditor, meta.RoleViewer:
+		default:
+			return errors.New("OIDC default role must be admin, editor or viewer")
+		}
+
+	case KeySessionLifetime:
+		if AsDuration(params.Value) <= 0 {
+			return errors.New("session lifetime must be a positive duration")
+		}
+
 	case KeyEmailPort:
 		port, err := strconv.Atoi(params.Value)
 		if err != nil || port < 1 || port > 65535 {
@@ -34,12 +34,12 @@ type Connection struct {
 // If `path` is empty, it defaults to "mantrae.db" in the data dir.
 // If `path` is ":memory:" or "file::memory:?cache=shared", opens in-memory.
 func NewConnection(ctx context.Context, path string) *Connection {
-	dataSource := fmt.Sprintf(
-		"file:%s?_txlock=immediate",
-		filepath.ToSlash(util.ResolvePath("mantrae.db")),
-	)
-	if path != "" {
-		dataSource = path
+	dataSource := path
+	if dataSource == "" {
+		dataSource = fmt.Sprintf(
+			"file:%s?_txlock=immediate",
+			filepath.ToSlash(util.ResolvePath("mantrae.db")),
+		)
 	}
 
 	sqliteDB, err := sql.Open("sqlite", dataSource)
@@ -100,6 +100,30 @@ func (c *Connection) Get() *sql.DB {
 	return c.db
 }
 
+// WithTx runs fn with queries bound to a new transaction, which is committed
+// if fn succeeds and rolled back otherwise. As the pool holds a single
+// connection, fn must only use the given queries, never c.Q.
+func (c *Connection) WithTx(ctx context.Context, fn func(q *db.Queries) error) error {
+	c.mu.RLock()
+	conn, queries := c.db, c.Q
+	c.mu.RUnlock()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	if err = fn(queries.WithTx(tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			slog.Warn("rollback transaction failed", "error", rbErr)
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
 // Replace replaces the on‐disk DB with srcPath, then reopens it.
 func (c *Connection) Replace(srcPath string) error {
 	c.mu.Lock()
@@ -32,10 +32,11 @@ INSERT INTO
     agent_id,
     event,
     details,
+    token_id,
     created_at
   )
 VALUES
-  (?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+  (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
 `
 
 type CreateAuditLogParams struct {
@@ -44,6 +45,7 @@ type CreateAuditLogParams struct {
 	AgentID   *string `json:"agentId"`
 	Event     string  `json:"event"`
 	Details   *string `json:"details"`
+	TokenID   *string `json:"tokenId"`
 }
 
 func (q *Queries) CreateAuditLog(ctx context.Context, arg *CreateAuditLogParams) error {
@@ -53,6 +55,7 @@ func (q *Queries) CreateAuditLog(ctx context.Context, arg *CreateAuditLogParams)
 		arg.AgentID,
 		arg.Event,
 		arg.Details,
+		arg.TokenID,
 	)
 	return err
 }
@@ -77,6 +80,8 @@ SELECT
   u.username AS user_name,
   a.agent_id,
   ag.hostname AS agent_name,
+  a.token_id,
+  t.name AS token_name,
   a.event,
   a.details,
   a.created_at
@@ -85,6 +90,7 @@ FROM
   LEFT JOIN profiles p ON a.profile_id = p.id
   LEFT JOIN users u ON a.user_id = u.id
   LEFT JOIN agents ag ON a.agent_id = ag.id
+  LEFT JOIN api_tokens t ON a.token_id = t.id
 ORDER BY
   a.created_at DESC
 LIMIT
@@ -106,6 +112,8 @@ type ListAuditLogsRow struct {
 	UserName    *string    `json:"userName"`
 	AgentID     *string    `json:"agentId"`
 	AgentName   *string    `json:"agentName"`
+	TokenID     *string    `json:"tokenId"`
+	TokenName   *string    `json:"tokenName"`
 	Event       string     `json:"event"`
 	Details     *string    `json:"details"`
 	CreatedAt   *time.Time `json:"createdAt"`
@@ -128,6 +136,8 @@ func (q *Queries) ListAuditLogs(ctx context.Context, arg *ListAuditLogsParams) (
 			&i.UserName,
 			&i.AgentID,
 			&i.AgentName,
+			&i.TokenID,
+			&i.TokenName,
 			&i.Event,
 			&i.Details,
 			&i.CreatedAt,
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"strings"
 	"time"
 
 	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
@@ -18,12 +20,35 @@ import (
 
 func (p *Profile) ToProto() *mantraev1.Profile {
 	return &mantraev1.Profile{
-		Id:          p.ID,
-		Name:        p.Name,
-		Description: SafeString(p.Description),
-		Token:       p.Token,
-		CreatedAt:   SafeTimestamp(p.CreatedAt),
-		UpdatedAt:   SafeTimestamp(p.UpdatedAt),
+		Id:                p.ID,
+		Name:              p.Name,
+		Description:       SafeString(p.Description),
+		Token:             p.Token,
+		CreatedAt:         SafeTimestamp(p.CreatedAt),
+		UpdatedAt:         SafeTimestamp(p.UpdatedAt),
+		PublishedRevision: p.PublishedRevision,
+	}
+}
+
+func (v *ProfileVariable) ToProto() *mantraev1.ProfileVariable {
+	return &mantraev1.ProfileVariable{
+		Id:          v.ID,
+		ProfileId:   v.ProfileID,
+		Name:        v.Name,
+		Value:       v.Value,
+		Description: v.Description,
+		CreatedAt:   SafeTimestamp(v.CreatedAt),
+		UpdatedAt:   SafeTimestamp(v.UpdatedAt),
+	}
+}
+
+func (m *ProfileMember) ToProto() *mantraev1.ProfileMember {
+	return &mantraev1.ProfileMember{
+		ProfileId: m.ProfileID,
+		UserId:    m.UserID,
+		Role:      roleToProto(m.Role),
+		CreatedAt: SafeTimestamp(m.CreatedAt),
+		UpdatedAt: SafeTimestamp(m.UpdatedAt),
 	}
 }
 
@@ -183,15 +208,45 @@ func (m *TcpServersTransport) ToProto() *mantraev1.ServersTransport {
 
 func (u *User) ToProto() *mantraev1.User {
 	return &mantraev1.User{
-		Id:        u.ID,
-		Username:  u.Username,
-		Email:     SafeString(u.Email),
-		LastLogin: SafeTimestamp(u.LastLogin),
-		CreatedAt: SafeTimestamp(u.CreatedAt),
-		UpdatedAt: SafeTimestamp(u.UpdatedAt),
+		Id:               u.ID,
+		Username:         u.Username,
+		Email:            SafeString(u.Email),
+		LastLogin:        SafeTimestamp(u.LastLogin),
+		CreatedAt:        SafeTimestamp(u.CreatedAt),
+		UpdatedAt:        SafeTimestamp(u.UpdatedAt),
+		Role:             roleToProto(u.Role),
+		TwoFactorEnabled: u.TotpEnabled,
 	}
 }
 
+func (t *ApiToken) ToProto() *mantraev1.APIToken {
+	return &mantraev1.APIToken{
+		Id:         t.ID,
+		Name:       t.Name,
+		Role:       roleToProto(t.Role),
+		ExpiresAt:  SafeTimestamp(t.ExpiresAt),
+		LastUsedAt: SafeTimestamp(t.LastUsedAt),
+		CreatedAt:  SafeTimestamp(t.CreatedAt),
+	}
+}
+
+func (s *Session) ToProto() *mantraev1.Session {
+	return &mantraev1.Session{
+		Id:         s.ID,
+		UserId:     s.UserID,
+		UserAgent:  SafeString(s.UserAgent),
+		IpAddress:  SafeString(s.IpAddress),
+		ExpiresAt:  timestamppb.New(s.ExpiresAt),
+		LastSeenAt: SafeTimestamp(s.LastSeenAt),
+		CreatedAt:  SafeTimestamp(s.CreatedAt),
+	}
+}
+
+// roleToProto maps a stored role name, e.g. "editor", to its enum value.
+func roleToProto(role string) mantraev1.Role {
+	return mantraev1.Role(mantraev1.Role_value["ROLE_"+strings.ToUpper(role)])
+}
+
 func (a *Agent) ToProto() *mantraev1.Agent {
 	containers := make([]*mantraev1.Container, 0)
 	if a.Containers != nil {
@@ -238,7 +293,90 @@ func (a *ListAuditLogsRow) ToProto() *mantraev1.AuditLog {
 		AgentId:     SafeString(a.AgentID),
 		AgentName:   SafeString(a.AgentName),
 		CreatedAt:   SafeTimestamp(a.CreatedAt),
+		TokenId:     SafeString(a.TokenID),
+		TokenName:   SafeString(a.TokenName),
+	}
+}
+
+func (r *ConfigRevision) ToProto() *mantraev1.Revision {
+	return &mantraev1.Revision{
+		ProfileId: r.ProfileID,
+		Revision:  r.Revision,
+		CreatedAt: SafeTimestamp(r.CreatedAt),
+	}
+}
+
+func (h *ServiceHealthCheck) ToProto() *mantraev1.ServerHealth {
+	protocol := mantraev1.ProtocolType_PROTOCOL_TYPE_HTTP
+	if h.Protocol == "tcp" {
+		protocol = mantraev1.ProtocolType_PROTOCOL_TYPE_TCP
+	}
+	var statusCode *int32
+	if h.StatusCode != nil {
+		code := int32(*h.StatusCode)
+		statusCode = &code
+	}
+	return &mantraev1.ServerHealth{
+		Id:         h.ID,
+		ServiceId:  h.ServiceID,
+		Type:       protocol,
+		Server:     h.Server,
+		Healthy:    h.Healthy,
+		StatusCode: statusCode,
+		LatencyMs:  h.LatencyMs,
+		Error:      h.Error,
+		CheckedAt:  SafeTimestamp(h.CheckedAt),
+	}
+}
+
+func (t *TraefikInstance) ToProto() *mantraev1.TraefikInstance {
+	instance := &mantraev1.TraefikInstance{
+		Id:           t.ID,
+		ProfileId:    t.ProfileID,
+		Name:         t.Name,
+		Revision:     t.Revision,
+		LastFetch:    SafeTimestamp(t.LastFetch),
+		RuntimeError: t.RuntimeError,
+		RuntimeAt:    SafeTimestamp(t.RuntimeAt),
+		CreatedAt:    SafeTimestamp(t.CreatedAt),
+		DriftAt:      SafeTimestamp(t.DriftAt),
+	}
+	if t.Url != nil {
+		// The URL may carry credentials for the Traefik API
+		if u, err := url.Parse(*t.Url); err == nil {
+			instance.Url = u.Redacted()
+		}
+	}
+	if t.Runtime != nil && t.Runtime.Data != nil {
+		for _, item := range *t.Runtime.Data {
+			instance.Runtime = append(instance.Runtime, &mantraev1.RuntimeItem{
+				Kind:   item.Kind,
+				Name:   item.Name,
+				Status: item.Status,
+				Errors: item.Errors,
+			})
+		}
+	}
+	if t.Drift != nil && t.Drift.Data != nil {
+		for _, item := range *t.Drift.Data {
+			kind := mantraev1.DriftKind_DRIFT_KIND_UNSPECIFIED
+			switch item.Kind {
+			case "missing":
+				kind = mantraev1.DriftKind_DRIFT_KIND_MISSING
+			case "extra":
+				kind = mantraev1.DriftKind_DRIFT_KIND_EXTRA
+			case "divergent":
+				kind = mantraev1.DriftKind_DRIFT_KIND_DIVERGENT
+			}
+			instance.Drift = append(instance.Drift, &mantraev1.DriftItem{
+				Kind:    kind,
+				Section: item.Section,
+				Name:    item.Name,
+				Fields:  item.Fields,
+			})
+		}
 	}
+	return instance
 }
 
 // Proto to SQL ---------------------------------------------------------------
@@ -30,6 +30,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.countAuditLogsStmt, err = db.PrepareContext(ctx, countAuditLogs); err != nil {
 		return nil, fmt.Errorf("error preparing query CountAuditLogs: %w", err)
 	}
+	if q.countConfigRevisionsStmt, err = db.PrepareContext(ctx, countConfigRevisions); err != nil {
+		return nil, fmt.Errorf("error preparing query CountConfigRevisions: %w", err)
+	}
 	if q.countDnsProvidersStmt, err = db.PrepareContext(ctx, countDnsProviders); err != nil {
 		return nil, fmt.Errorf("error preparing query CountDnsProviders: %w", err)
 	}
@@ -51,6 +54,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.countProfilesStmt, err = db.PrepareContext(ctx, countProfiles); err != nil {
 		return nil, fmt.Errorf("error preparing query CountProfiles: %w", err)
 	}
+	if q.countProfilesByMemberStmt, err = db.PrepareContext(ctx, countProfilesByMember); err != nil {
+		return nil, fmt.Errorf("error preparing query CountProfilesByMember: %w", err)
+	}
+	if q.countRecoveryCodesStmt, err = db.PrepareContext(ctx, countRecoveryCodes); err != nil {
+		return nil, fmt.Errorf("error preparing query CountRecoveryCodes: %w", err)
+	}
 	if q.countTcpMiddlewaresStmt, err = db.PrepareContext(ctx, countTcpMiddlewares); err != nil {
 		return nil, fmt.Errorf("error preparing query CountTcpMiddlewares: %w", err)
 	}
@@ -72,12 +81,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.countUsersStmt, err = db.PrepareContext(ctx, countUsers); err != nil {
 		return nil, fmt.Errorf("error preparing query CountUsers: %w", err)
 	}
+	if q.countUsersByRoleStmt, err = db.PrepareContext(ctx, countUsersByRole); err != nil {
+		return nil, fmt.Errorf("error preparing query CountUsersByRole: %w", err)
+	}
 	if q.createAgentStmt, err = db.PrepareContext(ctx, createAgent); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateAgent: %w", err)
 	}
+	if q.createApiTokenStmt, err = db.PrepareContext(ctx, createApiToken); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateApiToken: %w", err)
+	}
 	if q.createAuditLogStmt, err = db.PrepareContext(ctx, createAuditLog); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateAuditLog: %w", err)
 	}
+	if q.createConfigRevisionStmt, err = db.PrepareContext(ctx, createConfigRevision); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateConfigRevision: %w", err)
+	}
+	if q.createConfigTemplateStmt, err = db.PrepareContext(ctx, createConfigTemplate); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateConfigTemplate: %w", err)
+	}
 	if q.createDnsProviderStmt, err = db.PrepareContext(ctx, createDnsProvider); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateDnsProvider: %w", err)
 	}
@@ -102,6 +123,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createProfileStmt, err = db.PrepareContext(ctx, createProfile); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateProfile: %w", err)
 	}
+	if q.createProfileMemberStmt, err = db.PrepareContext(ctx, createProfileMember); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProfileMember: %w", err)
+	}
+	if q.createProfileVariableStmt, err = db.PrepareContext(ctx, createProfileVariable); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProfileVariable: %w", err)
+	}
+	if q.createRecoveryCodeStmt, err = db.PrepareContext(ctx, createRecoveryCode); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRecoveryCode: %w", err)
+	}
+	if q.createServiceHealthCheckStmt, err = db.PrepareContext(ctx, createServiceHealthCheck); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateServiceHealthCheck: %w", err)
+	}
+	if q.createSessionStmt, err = db.PrepareContext(ctx, createSession); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateSession: %w", err)
+	}
 	if q.createTcpMiddlewareStmt, err = db.PrepareContext(ctx, createTcpMiddleware); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateTcpMiddleware: %w", err)
 	}
@@ -129,12 +165,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteAgentStmt, err = db.PrepareContext(ctx, deleteAgent); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAgent: %w", err)
 	}
+	if q.deleteApiTokenStmt, err = db.PrepareContext(ctx, deleteApiToken); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteApiToken: %w", err)
+	}
+	if q.deleteConfigTemplateStmt, err = db.PrepareContext(ctx, deleteConfigTemplate); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteConfigTemplate: %w", err)
+	}
 	if q.deleteDnsProviderStmt, err = db.PrepareContext(ctx, deleteDnsProvider); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteDnsProvider: %w", err)
 	}
 	if q.deleteEntryPointByIDStmt, err = db.PrepareContext(ctx, deleteEntryPointByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteEntryPointByID: %w", err)
 	}
+	if q.deleteExpiredSessionsStmt, err = db.PrepareContext(ctx, deleteExpiredSessions); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredSessions: %w", err)
+	}
 	if q.deleteHttpMiddlewareStmt, err = db.PrepareContext(ctx, deleteHttpMiddleware); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteHttpMiddleware: %w", err)
 	}
@@ -153,9 +198,36 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteOldAuditLogsStmt, err = db.PrepareContext(ctx, deleteOldAuditLogs); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteOldAuditLogs: %w", err)
 	}
+	if q.deleteOldConfigRevisionsStmt, err = db.PrepareContext(ctx, deleteOldConfigRevisions); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteOldConfigRevisions: %w", err)
+	}
+	if q.deleteOldServiceHealthChecksStmt, err = db.PrepareContext(ctx, deleteOldServiceHealthChecks); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteOldServiceHealthChecks: %w", err)
+	}
+	if q.deleteOtherSessionsByUserStmt, err = db.PrepareContext(ctx, deleteOtherSessionsByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteOtherSessionsByUser: %w", err)
+	}
 	if q.deleteProfileStmt, err = db.PrepareContext(ctx, deleteProfile); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteProfile: %w", err)
 	}
+	if q.deleteProfileMemberStmt, err = db.PrepareContext(ctx, deleteProfileMember); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProfileMember: %w", err)
+	}
+	if q.deleteProfileVariableStmt, err = db.PrepareContext(ctx, deleteProfileVariable); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProfileVariable: %w", err)
+	}
+	if q.deleteRecoveryCodeStmt, err = db.PrepareContext(ctx, deleteRecoveryCode); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRecoveryCode: %w", err)
+	}
+	if q.deleteRecoveryCodesByUserStmt, err = db.PrepareContext(ctx, deleteRecoveryCodesByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRecoveryCodesByUser: %w", err)
+	}
+	if q.deleteSessionStmt, err = db.PrepareContext(ctx, deleteSession); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteSession: %w", err)
+	}
+	if q.deleteSessionsByUserStmt, err = db.PrepareContext(ctx, deleteSessionsByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteSessionsByUser: %w", err)
+	}
 	if q.deleteSettingStmt, err = db.PrepareContext(ctx, deleteSetting); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteSetting: %w", err)
 	}
@@ -174,6 +246,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteTcpServiceStmt, err = db.PrepareContext(ctx, deleteTcpService); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteTcpService: %w", err)
 	}
+	if q.deleteTraefikInstanceStmt, err = db.PrepareContext(ctx, deleteTraefikInstance); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTraefikInstance: %w", err)
+	}
 	if q.deleteUdpRouterStmt, err = db.PrepareContext(ctx, deleteUdpRouter); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteUdpRouter: %w", err)
 	}
@@ -186,6 +261,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getAgentStmt, err = db.PrepareContext(ctx, getAgent); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAgent: %w", err)
 	}
+	if q.getApiTokenStmt, err = db.PrepareContext(ctx, getApiToken); err != nil {
+		return nil, fmt.Errorf("error preparing query GetApiToken: %w", err)
+	}
+	if q.getApiTokenByHashStmt, err = db.PrepareContext(ctx, getApiTokenByHash); err != nil {
+		return nil, fmt.Errorf("error preparing query GetApiTokenByHash: %w", err)
+	}
+	if q.getConfigRevisionStmt, err = db.PrepareContext(ctx, getConfigRevision); err != nil {
+		return nil, fmt.Errorf("error preparing query GetConfigRevision: %w", err)
+	}
+	if q.getConfigTemplateStmt, err = db.PrepareContext(ctx, getConfigTemplate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetConfigTemplate: %w", err)
+	}
+	if q.getConfigTemplateByNameStmt, err = db.PrepareContext(ctx, getConfigTemplateByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetConfigTemplateByName: %w", err)
+	}
 	if q.getDefaultDNSProviderStmt, err = db.PrepareContext(ctx, getDefaultDNSProvider); err != nil {
 		return nil, fmt.Errorf("error preparing query GetDefaultDNSProvider: %w", err)
 	}
@@ -213,6 +303,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getHttpRouterStmt, err = db.PrepareContext(ctx, getHttpRouter); err != nil {
 		return nil, fmt.Errorf("error preparing query GetHttpRouter: %w", err)
 	}
+	if q.getHttpRouterByNameStmt, err = db.PrepareContext(ctx, getHttpRouterByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetHttpRouterByName: %w", err)
+	}
 	if q.getHttpRouterDomainsStmt, err = db.PrepareContext(ctx, getHttpRouterDomains); err != nil {
 		return nil, fmt.Errorf("error preparing query GetHttpRouterDomains: %w", err)
 	}
@@ -231,12 +324,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getHttpServiceByNameStmt, err = db.PrepareContext(ctx, getHttpServiceByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetHttpServiceByName: %w", err)
 	}
+	if q.getLatestConfigRevisionStmt, err = db.PrepareContext(ctx, getLatestConfigRevision); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLatestConfigRevision: %w", err)
+	}
 	if q.getProfileStmt, err = db.PrepareContext(ctx, getProfile); err != nil {
 		return nil, fmt.Errorf("error preparing query GetProfile: %w", err)
 	}
 	if q.getProfileByNameStmt, err = db.PrepareContext(ctx, getProfileByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetProfileByName: %w", err)
 	}
+	if q.getProfileMemberStmt, err = db.PrepareContext(ctx, getProfileMember); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProfileMember: %w", err)
+	}
+	if q.getProfileVariableStmt, err = db.PrepareContext(ctx, getProfileVariable); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProfileVariable: %w", err)
+	}
+	if q.getSessionStmt, err = db.PrepareContext(ctx, getSession); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSession: %w", err)
+	}
 	if q.getSettingStmt, err = db.PrepareContext(ctx, getSetting); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSetting: %w", err)
 	}
@@ -246,6 +351,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getTcpRouterStmt, err = db.PrepareContext(ctx, getTcpRouter); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTcpRouter: %w", err)
 	}
+	if q.getTcpRouterByNameStmt, err = db.PrepareContext(ctx, getTcpRouterByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTcpRouterByName: %w", err)
+	}
 	if q.getTcpRouterDomainsStmt, err = db.PrepareContext(ctx, getTcpRouterDomains); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTcpRouterDomains: %w", err)
 	}
@@ -264,6 +372,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getTcpServiceByNameStmt, err = db.PrepareContext(ctx, getTcpServiceByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTcpServiceByName: %w", err)
 	}
+	if q.getTraefikInstanceStmt, err = db.PrepareContext(ctx, getTraefikInstance); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTraefikInstance: %w", err)
+	}
 	if q.getUdpRouterStmt, err = db.PrepareContext(ctx, getUdpRouter); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUdpRouter: %w", err)
 	}
@@ -285,12 +396,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getUserByUsernameStmt, err = db.PrepareContext(ctx, getUserByUsername); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByUsername: %w", err)
 	}
+	if q.incrementUserTotpFailuresStmt, err = db.PrepareContext(ctx, incrementUserTotpFailures); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementUserTotpFailures: %w", err)
+	}
 	if q.listAgentsStmt, err = db.PrepareContext(ctx, listAgents); err != nil {
 		return nil, fmt.Errorf("error preparing query ListAgents: %w", err)
 	}
+	if q.listApiTokensByUserStmt, err = db.PrepareContext(ctx, listApiTokensByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query ListApiTokensByUser: %w", err)
+	}
 	if q.listAuditLogsStmt, err = db.PrepareContext(ctx, listAuditLogs); err != nil {
 		return nil, fmt.Errorf("error preparing query ListAuditLogs: %w", err)
 	}
+	if q.listConfigRevisionsStmt, err = db.PrepareContext(ctx, listConfigRevisions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListConfigRevisions: %w", err)
+	}
+	if q.listConfigTemplatesStmt, err = db.PrepareContext(ctx, listConfigTemplates); err != nil {
+		return nil, fmt.Errorf("error preparing query ListConfigTemplates: %w", err)
+	}
 	if q.listDnsProvidersStmt, err = db.PrepareContext(ctx, listDnsProviders); err != nil {
 		return nil, fmt.Errorf("error preparing query ListDnsProviders: %w", err)
 	}
@@ -321,9 +444,30 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listHttpServicesEnabledStmt, err = db.PrepareContext(ctx, listHttpServicesEnabled); err != nil {
 		return nil, fmt.Errorf("error preparing query ListHttpServicesEnabled: %w", err)
 	}
+	if q.listLatestServiceHealthChecksStmt, err = db.PrepareContext(ctx, listLatestServiceHealthChecks); err != nil {
+		return nil, fmt.Errorf("error preparing query ListLatestServiceHealthChecks: %w", err)
+	}
+	if q.listPollableTraefikInstancesStmt, err = db.PrepareContext(ctx, listPollableTraefikInstances); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPollableTraefikInstances: %w", err)
+	}
+	if q.listProfileMembersStmt, err = db.PrepareContext(ctx, listProfileMembers); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProfileMembers: %w", err)
+	}
+	if q.listProfileVariablesStmt, err = db.PrepareContext(ctx, listProfileVariables); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProfileVariables: %w", err)
+	}
 	if q.listProfilesStmt, err = db.PrepareContext(ctx, listProfiles); err != nil {
 		return nil, fmt.Errorf("error preparing query ListProfiles: %w", err)
 	}
+	if q.listProfilesByMemberStmt, err = db.PrepareContext(ctx, listProfilesByMember); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProfilesByMember: %w", err)
+	}
+	if q.listServiceHealthChecksStmt, err = db.PrepareContext(ctx, listServiceHealthChecks); err != nil {
+		return nil, fmt.Errorf("error preparing query ListServiceHealthChecks: %w", err)
+	}
+	if q.listSessionsByUserStmt, err = db.PrepareContext(ctx, listSessionsByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSessionsByUser: %w", err)
+	}
 	if q.listSettingsStmt, err = db.PrepareContext(ctx, listSettings); err != nil {
 		return nil, fmt.Errorf("error preparing query ListSettings: %w", err)
 	}
@@ -351,6 +495,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listTcpServicesEnabledStmt, err = db.PrepareContext(ctx, listTcpServicesEnabled); err != nil {
 		return nil, fmt.Errorf("error preparing query ListTcpServicesEnabled: %w", err)
 	}
+	if q.listTraefikInstancesStmt, err = db.PrepareContext(ctx, listTraefikInstances); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTraefikInstances: %w", err)
+	}
 	if q.listUdpRoutersStmt, err = db.PrepareContext(ctx, listUdpRouters); err != nil {
 		return nil, fmt.Errorf("error preparing query ListUdpRouters: %w", err)
 	}
@@ -366,6 +513,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listUsersStmt, err = db.PrepareContext(ctx, listUsers); err != nil {
 		return nil, fmt.Errorf("error preparing query ListUsers: %w", err)
 	}
+	if q.lockUserTotpStmt, err = db.PrepareContext(ctx, lockUserTotp); err != nil {
+		return nil, fmt.Errorf("error preparing query LockUserTotp: %w", err)
+	}
+	if q.resetUserTotpFailuresStmt, err = db.PrepareContext(ctx, resetUserTotpFailures); err != nil {
+		return nil, fmt.Errorf("error preparing query ResetUserTotpFailures: %w", err)
+	}
 	if q.unsetDefaultDNSProviderStmt, err = db.PrepareContext(ctx, unsetDefaultDNSProvider); err != nil {
 		return nil, fmt.Errorf("error preparing query UnsetDefaultDNSProvider: %w", err)
 	}
@@ -381,6 +534,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateAgentStmt, err = db.PrepareContext(ctx, updateAgent); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateAgent: %w", err)
 	}
+	if q.updateApiTokenLastUsedStmt, err = db.PrepareContext(ctx, updateApiTokenLastUsed); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateApiTokenLastUsed: %w", err)
+	}
+	if q.updateConfigTemplateStmt, err = db.PrepareContext(ctx, updateConfigTemplate); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateConfigTemplate: %w", err)
+	}
 	if q.updateDnsProviderStmt, err = db.PrepareContext(ctx, updateDnsProvider); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateDnsProvider: %w", err)
 	}
@@ -402,6 +561,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateProfileStmt, err = db.PrepareContext(ctx, updateProfile); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateProfile: %w", err)
 	}
+	if q.updateProfileMemberStmt, err = db.PrepareContext(ctx, updateProfileMember); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProfileMember: %w", err)
+	}
+	if q.updateProfilePublishedRevisionStmt, err = db.PrepareContext(ctx, updateProfilePublishedRevision); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProfilePublishedRevision: %w", err)
+	}
+	if q.updateProfileVariableStmt, err = db.PrepareContext(ctx, updateProfileVariable); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProfileVariable: %w", err)
+	}
+	if q.updateSessionLastSeenStmt, err = db.PrepareContext(ctx, updateSessionLastSeen); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateSessionLastSeen: %w", err)
+	}
 	if q.updateTcpMiddlewareStmt, err = db.PrepareContext(ctx, updateTcpMiddleware); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateTcpMiddleware: %w", err)
 	}
@@ -414,6 +585,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateTcpServiceStmt, err = db.PrepareContext(ctx, updateTcpService); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateTcpService: %w", err)
 	}
+	if q.updateTraefikInstanceDriftStmt, err = db.PrepareContext(ctx, updateTraefikInstanceDrift); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTraefikInstanceDrift: %w", err)
+	}
+	if q.updateTraefikInstanceRuntimeStmt, err = db.PrepareContext(ctx, updateTraefikInstanceRuntime); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTraefikInstanceRuntime: %w", err)
+	}
+	if q.updateTraefikInstanceRuntimeErrorStmt, err = db.PrepareContext(ctx, updateTraefikInstanceRuntimeError); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTraefikInstanceRuntimeError: %w", err)
+	}
 	if q.updateUdpRouterStmt, err = db.PrepareContext(ctx, updateUdpRouter); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUdpRouter: %w", err)
 	}
@@ -429,9 +609,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateUserPasswordStmt, err = db.PrepareContext(ctx, updateUserPassword); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUserPassword: %w", err)
 	}
+	if q.updateUserRoleStmt, err = db.PrepareContext(ctx, updateUserRole); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUserRole: %w", err)
+	}
+	if q.updateUserTotpStmt, err = db.PrepareContext(ctx, updateUserTotp); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUserTotp: %w", err)
+	}
+	if q.updateUserTotpStepStmt, err = db.PrepareContext(ctx, updateUserTotpStep); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUserTotpStep: %w", err)
+	}
 	if q.upsertSettingStmt, err = db.PrepareContext(ctx, upsertSetting); err != nil {
 		return nil, fmt.Errorf("error preparing query UpsertSetting: %w", err)
 	}
+	if q.upsertTraefikInstanceStmt, err = db.PrepareContext(ctx, upsertTraefikInstance); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertTraefikInstance: %w", err)
+	}
 	return &q, nil
 }
 
@@ -447,6 +639,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countAuditLogsStmt: %w", cerr)
 		}
 	}
+	if q.countConfigRevisionsStmt != nil {
+		if cerr := q.countConfigRevisionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countConfigRevisionsStmt: %w", cerr)
+		}
+	}
 	if q.countDnsProvidersStmt != nil {
 		if cerr := q.countDnsProvidersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countDnsProvidersStmt: %w", cerr)
@@ -482,6 +679,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countProfilesStmt: %w", cerr)
 		}
 	}
+	if q.countProfilesByMemberStmt != nil {
+		if cerr := q.countProfilesByMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countProfilesByMemberStmt: %w", cerr)
+		}
+	}
+	if q.countRecoveryCodesStmt != nil {
+		if cerr := q.countRecoveryCodesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countRecoveryCodesStmt: %w", cerr)
+		}
+	}
 	if q.countTcpMiddlewaresStmt != nil {
 		if cerr := q.countTcpMiddlewaresStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countTcpMiddlewaresStmt: %w", cerr)
@@ -517,16 +724,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countUsersStmt: %w", cerr)
 		}
 	}
+	if q.countUsersByRoleStmt != nil {
+		if cerr := q.countUsersByRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countUsersByRoleStmt: %w", cerr)
+		}
+	}
 	if q.createAgentStmt != nil {
 		if cerr := q.createAgentStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createAgentStmt: %w", cerr)
 		}
 	}
+	if q.createApiTokenStmt != nil {
+		if cerr := q.createApiTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createApiTokenStmt: %w", cerr)
+		}
+	}
 	if q.createAuditLogStmt != nil {
 		if cerr := q.createAuditLogStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createAuditLogStmt: %w", cerr)
 		}
 	}
+	if q.createConfigRevisionStmt != nil {
+		if cerr := q.createConfigRevisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createConfigRevisionStmt: %w", cerr)
+		}
+	}
+	if q.createConfigTemplateStmt != nil {
+		if cerr := q.createConfigTemplateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createConfigTemplateStmt: %w", cerr)
+		}
+	}
 	if q.createDnsProviderStmt != nil {
 		if cerr := q.createDnsProviderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createDnsProviderStmt: %w", cerr)
@@ -567,6 +794,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createProfileStmt: %w", cerr)
 		}
 	}
+	if q.createProfileMemberStmt != nil {
+		if cerr := q.createProfileMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProfileMemberStmt: %w", cerr)
+		}
+	}
+	if q.createProfileVariableStmt != nil {
+		if cerr := q.createProfileVariableStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProfileVariableStmt: %w", cerr)
+		}
+	}
+	if q.createRecoveryCodeStmt != nil {
+		if cerr := q.createRecoveryCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRecoveryCodeStmt: %w", cerr)
+		}
+	}
+	if q.createServiceHealthCheckStmt != nil {
+		if cerr := q.createServiceHealthCheckStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createServiceHealthCheckStmt: %w", cerr)
+		}
+	}
+	if q.createSessionStmt != nil {
+		if cerr := q.createSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createSessionStmt: %w", cerr)
+		}
+	}
 	if q.createTcpMiddlewareStmt != nil {
 		if cerr := q.createTcpMiddlewareStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createTcpMiddlewareStmt: %w", cerr)
@@ -612,6 +864,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteAgentStmt: %w", cerr)
 		}
 	}
+	if q.deleteApiTokenStmt != nil {
+		if cerr := q.deleteApiTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteApiTokenStmt: %w", cerr)
+		}
+	}
+	if q.deleteConfigTemplateStmt != nil {
+		if cerr := q.deleteConfigTemplateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteConfigTemplateStmt: %w", cerr)
+		}
+	}
 	if q.deleteDnsProviderStmt != nil {
 		if cerr := q.deleteDnsProviderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteDnsProviderStmt: %w", cerr)
@@ -622,6 +884,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteEntryPointByIDStmt: %w", cerr)
 		}
 	}
+	if q.deleteExpiredSessionsStmt != nil {
+		if cerr := q.deleteExpiredSessionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredSessionsStmt: %w", cerr)
+		}
+	}
 	if q.deleteHttpMiddlewareStmt != nil {
 		if cerr := q.deleteHttpMiddlewareStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteHttpMiddlewareStmt: %w", cerr)
@@ -652,11 +919,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteOldAuditLogsStmt: %w", cerr)
 		}
 	}
+	if q.deleteOldConfigRevisionsStmt != nil {
+		if cerr := q.deleteOldConfigRevisionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteOldConfigRevisionsStmt: %w", cerr)
+		}
+	}
+	if q.deleteOldServiceHealthChecksStmt != nil {
+		if cerr := q.deleteOldServiceHealthChecksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteOldServiceHealthChecksStmt: %w", cerr)
+		}
+	}
+	if q.deleteOtherSessionsByUserStmt != nil {
+		if cerr := q.deleteOtherSessionsByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteOtherSessionsByUserStmt: %w", cerr)
+		}
+	}
 	if q.deleteProfileStmt != nil {
 		if cerr := q.deleteProfileStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteProfileStmt: %w", cerr)
 		}
 	}
+	if q.deleteProfileMemberStmt != nil {
+		if cerr := q.deleteProfileMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProfileMemberStmt: %w", cerr)
+		}
+	}
+	if q.deleteProfileVariableStmt != nil {
+		if cerr := q.deleteProfileVariableStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProfileVariableStmt: %w", cerr)
+		}
+	}
+	if q.deleteRecoveryCodeStmt != nil {
+		if cerr := q.deleteRecoveryCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRecoveryCodeStmt: %w", cerr)
+		}
+	}
+	if q.deleteRecoveryCodesByUserStmt != nil {
+		if cerr := q.deleteRecoveryCodesByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRecoveryCodesByUserStmt: %w", cerr)
+		}
+	}
+	if q.deleteSessionStmt != nil {
+		if cerr := q.deleteSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteSessionStmt: %w", cerr)
+		}
+	}
+	if q.deleteSessionsByUserStmt != nil {
+		if cerr := q.deleteSessionsByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteSessionsByUserStmt: %w", cerr)
+		}
+	}
 	if q.deleteSettingStmt != nil {
 		if cerr := q.deleteSettingStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteSettingStmt: %w", cerr)
@@ -687,6 +999,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteTcpServiceStmt: %w", cerr)
 		}
 	}
+	if q.deleteTraefikInstanceStmt != nil {
+		if cerr := q.deleteTraefikInstanceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTraefikInstanceStmt: %w", cerr)
+		}
+	}
 	if q.deleteUdpRouterStmt != nil {
 		if cerr := q.deleteUdpRouterStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteUdpRouterStmt: %w", cerr)
@@ -707,6 +1024,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getAgentStmt: %w", cerr)
 		}
 	}
+	if q.getApiTokenStmt != nil {
+		if cerr := q.getApiTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getApiTokenStmt: %w", cerr)
+		}
+	}
+	if q.getApiTokenByHashStmt != nil {
+		if cerr := q.getApiTokenByHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getApiTokenByHashStmt: %w", cerr)
+		}
+	}
+	if q.getConfigRevisionStmt != nil {
+		if cerr := q.getConfigRevisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getConfigRevisionStmt: %w", cerr)
+		}
+	}
+	if q.getConfigTemplateStmt != nil {
+		if cerr := q.getConfigTemplateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getConfigTemplateStmt: %w", cerr)
+		}
+	}
+	if q.getConfigTemplateByNameStmt != nil {
+		if cerr := q.getConfigTemplateByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getConfigTemplateByNameStmt: %w", cerr)
+		}
+	}
 	if q.getDefaultDNSProviderStmt != nil {
 		if cerr := q.getDefaultDNSProviderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getDefaultDNSProviderStmt: %w", cerr)
@@ -752,6 +1094,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getHttpRouterStmt: %w", cerr)
 		}
 	}
+	if q.getHttpRouterByNameStmt != nil {
+		if cerr := q.getHttpRouterByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getHttpRouterByNameStmt: %w", cerr)
+		}
+	}
 	if q.getHttpRouterDomainsStmt != nil {
 		if cerr := q.getHttpRouterDomainsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getHttpRouterDomainsStmt: %w", cerr)
@@ -782,6 +1129,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getHttpServiceByNameStmt: %w", cerr)
 		}
 	}
+	if q.getLatestConfigRevisionStmt != nil {
+		if cerr := q.getLatestConfigRevisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLatestConfigRevisionStmt: %w", cerr)
+		}
+	}
 	if q.getProfileStmt != nil {
 		if cerr := q.getProfileStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getProfileStmt: %w", cerr)
@@ -792,6 +1144,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getProfileByNameStmt: %w", cerr)
 		}
 	}
+	if q.getProfileMemberStmt != nil {
+		if cerr := q.getProfileMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProfileMemberStmt: %w", cerr)
+		}
+	}
+	if q.getProfileVariableStmt != nil {
+		if cerr := q.getProfileVariableStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProfileVariableStmt: %w", cerr)
+		}
+	}
+	if q.getSessionStmt != nil {
+		if cerr := q.getSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSessionStmt: %w", cerr)
+		}
+	}
 	if q.getSettingStmt != nil {
 		if cerr := q.getSettingStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getSettingStmt: %w", cerr)
@@ -807,6 +1174,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getTcpRouterStmt: %w", cerr)
 		}
 	}
+	if q.getTcpRouterByNameStmt != nil {
+		if cerr := q.getTcpRouterByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTcpRouterByNameStmt: %w", cerr)
+		}
+	}
 	if q.getTcpRouterDomainsStmt != nil {
 		if cerr := q.getTcpRouterDomainsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTcpRouterDomainsStmt: %w", cerr)
@@ -837,6 +1209,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getTcpServiceByNameStmt: %w", cerr)
 		}
 	}
+	if q.getTraefikInstanceStmt != nil {
+		if cerr := q.getTraefikInstanceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTraefikInstanceStmt: %w", cerr)
+		}
+	}
 	if q.getUdpRouterStmt != nil {
 		if cerr := q.getUdpRouterStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUdpRouterStmt: %w", cerr)
@@ -872,16 +1249,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserByUsernameStmt: %w", cerr)
 		}
 	}
+	if q.incrementUserTotpFailuresStmt != nil {
+		if cerr := q.incrementUserTotpFailuresStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementUserTotpFailuresStmt: %w", cerr)
+		}
+	}
 	if q.listAgentsStmt != nil {
 		if cerr := q.listAgentsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listAgentsStmt: %w", cerr)
 		}
 	}
+	if q.listApiTokensByUserStmt != nil {
+		if cerr := q.listApiTokensByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listApiTokensByUserStmt: %w", cerr)
+		}
+	}
 	if q.listAuditLogsStmt != nil {
 		if cerr := q.listAuditLogsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listAuditLogsStmt: %w", cerr)
 		}
 	}
+	if q.listConfigRevisionsStmt != nil {
+		if cerr := q.listConfigRevisionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listConfigRevisionsStmt: %w", cerr)
+		}
+	}
+	if q.listConfigTemplatesStmt != nil {
+		if cerr := q.listConfigTemplatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listConfigTemplatesStmt: %w", cerr)
+		}
+	}
 	if q.listDnsProvidersStmt != nil {
 		if cerr := q.listDnsProvidersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listDnsProvidersStmt: %w", cerr)
@@ -932,11 +1329,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listHttpServicesEnabledStmt: %w", cerr)
 		}
 	}
+	if q.listLatestServiceHealthChecksStmt != nil {
+		if cerr := q.listLatestServiceHealthChecksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listLatestServiceHealthChecksStmt: %w", cerr)
+		}
+	}
+	if q.listPollableTraefikInstancesStmt != nil {
+		if cerr := q.listPollableTraefikInstancesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPollableTraefikInstancesStmt: %w", cerr)
+		}
+	}
+	if q.listProfileMembersStmt != nil {
+		if cerr := q.listProfileMembersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProfileMembersStmt: %w", cerr)
+		}
+	}
+	if q.listProfileVariablesStmt != nil {
+		if cerr := q.listProfileVariablesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProfileVariablesStmt: %w", cerr)
+		}
+	}
 	if q.listProfilesStmt != nil {
 		if cerr := q.listProfilesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listProfilesStmt: %w", cerr)
 		}
 	}
+	if q.listProfilesByMemberStmt != nil {
+		if cerr := q.listProfilesByMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProfilesByMemberStmt: %w", cerr)
+		}
+	}
+	if q.listServiceHealthChecksStmt != nil {
+		if cerr := q.listServiceHealthChecksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listServiceHealthChecksStmt: %w", cerr)
+		}
+	}
+	if q.listSessionsByUserStmt != nil {
+		if cerr := q.listSessionsByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSessionsByUserStmt: %w", cerr)
+		}
+	}
 	if q.listSettingsStmt != nil {
 		if cerr := q.listSettingsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listSettingsStmt: %w", cerr)
@@ -982,6 +1414,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listTcpServicesEnabledStmt: %w", cerr)
 		}
 	}
+	if q.listTraefikInstancesStmt != nil {
+		if cerr := q.listTraefikInstancesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTraefikInstancesStmt: %w", cerr)
+		}
+	}
 	if q.listUdpRoutersStmt != nil {
 		if cerr := q.listUdpRoutersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listUdpRoutersStmt: %w", cerr)
@@ -1007,6 +1444,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listUsersStmt: %w", cerr)
 		}
 	}
+	if q.lockUserTotpStmt != nil {
+		if cerr := q.lockUserTotpStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockUserTotpStmt: %w", cerr)
+		}
+	}
+	if q.resetUserTotpFailuresStmt != nil {
+		if cerr := q.resetUserTotpFailuresStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resetUserTotpFailuresStmt: %w", cerr)
+		}
+	}
 	if q.unsetDefaultDNSProviderStmt != nil {
 		if cerr := q.unsetDefaultDNSProviderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing unsetDefaultDNSProviderStmt: %w", cerr)
@@ -1032,6 +1479,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateAgentStmt: %w", cerr)
 		}
 	}
+	if q.updateApiTokenLastUsedStmt != nil {
+		if cerr := q.updateApiTokenLastUsedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateApiTokenLastUsedStmt: %w", cerr)
+		}
+	}
+	if q.updateConfigTemplateStmt != nil {
+		if cerr := q.updateConfigTemplateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateConfigTemplateStmt: %w", cerr)
+		}
+	}
 	if q.updateDnsProviderStmt != nil {
 		if cerr := q.updateDnsProviderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateDnsProviderStmt: %w", cerr)
@@ -1067,6 +1524,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateProfileStmt: %w", cerr)
 		}
 	}
+	if q.updateProfileMemberStmt != nil {
+		if cerr := q.updateProfileMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProfileMemberStmt: %w", cerr)
+		}
+	}
+	if q.updateProfilePublishedRevisionStmt != nil {
+		if cerr := q.updateProfilePublishedRevisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProfilePublishedRevisionStmt: %w", cerr)
+		}
+	}
+	if q.updateProfileVariableStmt != nil {
+		if cerr := q.updateProfileVariableStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProfileVariableStmt: %w", cerr)
+		}
+	}
+	if q.updateSessionLastSeenStmt != nil {
+		if cerr := q.updateSessionLastSeenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateSessionLastSeenStmt: %w", cerr)
+		}
+	}
 	if q.updateTcpMiddlewareStmt != nil {
 		if cerr := q.updateTcpMiddlewareStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateTcpMiddlewareStmt: %w", cerr)
@@ -1087,6 +1564,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateTcpServiceStmt: %w", cerr)
 		}
 	}
+	if q.updateTraefikInstanceDriftStmt != nil {
+		if cerr := q.updateTraefikInstanceDriftStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTraefikInstanceDriftStmt: %w", cerr)
+		}
+	}
+	if q.updateTraefikInstanceRuntimeStmt != nil {
+		if cerr := q.updateTraefikInstanceRuntimeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTraefikInstanceRuntimeStmt: %w", cerr)
+		}
+	}
+	if q.updateTraefikInstanceRuntimeErrorStmt != nil {
+		if cerr := q.updateTraefikInstanceRuntimeErrorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTraefikInstanceRuntimeErrorStmt: %w", cerr)
+		}
+	}
 	if q.updateUdpRouterStmt != nil {
 		if cerr := q.updateUdpRouterStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateUdpRouterStmt: %w", cerr)
@@ -1112,11 +1604,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateUserPasswordStmt: %w", cerr)
 		}
 	}
+	if q.updateUserRoleStmt != nil {
+		if cerr := q.updateUserRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserRoleStmt: %w", cerr)
+		}
+	}
+	if q.updateUserTotpStmt != nil {
+		if cerr := q.updateUserTotpStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserTotpStmt: %w", cerr)
+		}
+	}
+	if q.updateUserTotpStepStmt != nil {
+		if cerr := q.updateUserTotpStepStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserTotpStepStmt: %w", cerr)
+		}
+	}
 	if q.upsertSettingStmt != nil {
 		if cerr := q.upsertSettingStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing upsertSettingStmt: %w", cerr)
 		}
 	}
+	if q.upsertTraefikInstanceStmt != nil {
+		if cerr := q.upsertTraefikInstanceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertTraefikInstanceStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -1154,285 +1666,413 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                                   DBTX
-	tx                                   *sql.Tx
-	countAgentsStmt                      *sql.Stmt
-	countAuditLogsStmt                   *sql.Stmt
-	countDnsProvidersStmt                *sql.Stmt
-	countEntryPointsStmt                 *sql.Stmt
-	countHttpMiddlewaresStmt             *sql.Stmt
-	countHttpRoutersStmt                 *sql.Stmt
-	countHttpServersTransportsStmt       *sql.Stmt
-	countHttpServicesStmt                *sql.Stmt
-	countProfilesStmt                    *sql.Stmt
-	countTcpMiddlewaresStmt              *sql.Stmt
-	countTcpRoutersStmt                  *sql.Stmt
-	countTcpServersTransportsStmt        *sql.Stmt
-	countTcpServicesStmt                 *sql.Stmt
-	countUdpRoutersStmt                  *sql.Stmt
-	countUdpServicesStmt                 *sql.Stmt
-	countUsersStmt                       *sql.Stmt
-	createAgentStmt                      *sql.Stmt
-	createAuditLogStmt                   *sql.Stmt
-	createDnsProviderStmt                *sql.Stmt
-	createEntryPointStmt                 *sql.Stmt
-	createHttpMiddlewareStmt             *sql.Stmt
-	createHttpRouterStmt                 *sql.Stmt
-	createHttpRouterDNSProviderStmt      *sql.Stmt
-	createHttpServersTransportStmt       *sql.Stmt
-	createHttpServiceStmt                *sql.Stmt
-	createProfileStmt                    *sql.Stmt
-	createTcpMiddlewareStmt              *sql.Stmt
-	createTcpRouterStmt                  *sql.Stmt
-	createTcpRouterDNSProviderStmt       *sql.Stmt
-	createTcpServersTransportStmt        *sql.Stmt
-	createTcpServiceStmt                 *sql.Stmt
-	createUdpRouterStmt                  *sql.Stmt
-	createUdpServiceStmt                 *sql.Stmt
-	createUserStmt                       *sql.Stmt
-	deleteAgentStmt                      *sql.Stmt
-	deleteDnsProviderStmt                *sql.Stmt
-	deleteEntryPointByIDStmt             *sql.Stmt
-	deleteHttpMiddlewareStmt             *sql.Stmt
-	deleteHttpRouterStmt                 *sql.Stmt
-	deleteHttpRouterDNSProviderStmt      *sql.Stmt
-	deleteHttpServersTransportStmt       *sql.Stmt
-	deleteHttpServiceStmt                *sql.Stmt
-	deleteOldAuditLogsStmt               *sql.Stmt
-	deleteProfileStmt                    *sql.Stmt
-	deleteSettingStmt                    *sql.Stmt
-	deleteTcpMiddlewareStmt              *sql.Stmt
-	deleteTcpRouterStmt                  *sql.Stmt
-	deleteTcpRouterDNSProviderStmt       *sql.Stmt
-	deleteTcpServersTransportStmt        *sql.Stmt
-	deleteTcpServiceStmt                 *sql.Stmt
-	deleteUdpRouterStmt                  *sql.Stmt
-	deleteUdpServiceStmt                 *sql.Stmt
-	deleteUserStmt                       *sql.Stmt
-	getAgentStmt                         *sql.Stmt
-	getDefaultDNSProviderStmt            *sql.Stmt
-	getDefaultEntryPointStmt             *sql.Stmt
-	getDnsProviderStmt                   *sql.Stmt
-	getDnsProviderByNameStmt             *sql.Stmt
-	getDnsProvidersByHttpRouterStmt      *sql.Stmt
-	getDnsProvidersByTcpRouterStmt       *sql.Stmt
-	getEntryPointStmt                    *sql.Stmt
-	getHttpMiddlewareStmt                *sql.Stmt
-	getHttpRouterStmt                    *sql.Stmt
-	getHttpRouterDomainsStmt             *sql.Stmt
-	getHttpRoutersUsingEntryPointStmt    *sql.Stmt
-	getHttpRoutersUsingMiddlewareStmt    *sql.Stmt
-	getHttpServersTransportStmt          *sql.Stmt
-	getHttpServiceStmt                   *sql.Stmt
-	getHttpServiceByNameStmt             *sql.Stmt
-	getProfileStmt                       *sql.Stmt
-	getProfileByNameStmt                 *sql.Stmt
-	getSettingStmt                       *sql.Stmt
-	getTcpMiddlewareStmt                 *sql.Stmt
-	getTcpRouterStmt                     *sql.Stmt
-	getTcpRouterDomainsStmt              *sql.Stmt
-	getTcpRoutersUsingEntryPointStmt     *sql.Stmt
-	getTcpRoutersUsingMiddlewareStmt     *sql.Stmt
-	getTcpServersTransportStmt           *sql.Stmt
-	getTcpServiceStmt                    *sql.Stmt
-	getTcpServiceByNameStmt              *sql.Stmt
-	getUdpRouterStmt                     *sql.Stmt
-	getUdpRoutersUsingEntryPointStmt     *sql.Stmt
-	getUdpServiceStmt                    *sql.Stmt
-	getUdpServiceByNameStmt              *sql.Stmt
-	getUserByEmailStmt                   *sql.Stmt
-	getUserByIDStmt                      *sql.Stmt
-	getUserByUsernameStmt                *sql.Stmt
-	listAgentsStmt                       *sql.Stmt
-	listAuditLogsStmt                    *sql.Stmt
-	listDnsProvidersStmt                 *sql.Stmt
-	listEntryPointsStmt                  *sql.Stmt
-	listHttpMiddlewaresStmt              *sql.Stmt
-	listHttpMiddlewaresEnabledStmt       *sql.Stmt
-	listHttpRoutersStmt                  *sql.Stmt
-	listHttpRoutersEnabledStmt           *sql.Stmt
-	listHttpServersTransportsStmt        *sql.Stmt
-	listHttpServersTransportsEnabledStmt *sql.Stmt
-	listHttpServicesStmt                 *sql.Stmt
-	listHttpServicesEnabledStmt          *sql.Stmt
-	listProfilesStmt                     *sql.Stmt
-	listSettingsStmt                     *sql.Stmt
-	listTcpMiddlewaresStmt               *sql.Stmt
-	listTcpMiddlewaresEnabledStmt        *sql.Stmt
-	listTcpRoutersStmt                   *sql.Stmt
-	listTcpRoutersEnabledStmt            *sql.Stmt
-	listTcpServersTransportsStmt         *sql.Stmt
-	listTcpServersTransportsEnabledStmt  *sql.Stmt
-	listTcpServicesStmt                  *sql.Stmt
-	listTcpServicesEnabledStmt           *sql.Stmt
-	listUdpRoutersStmt                   *sql.Stmt
-	listUdpRoutersEnabledStmt            *sql.Stmt
-	listUdpServicesStmt                  *sql.Stmt
-	listUdpServicesEnabledStmt           *sql.Stmt
-	listUsersStmt                        *sql.Stmt
-	unsetDefaultDNSProviderStmt          *sql.Stmt
-	unsetDefaultEntryPointStmt           *sql.Stmt
-	unsetDefaultHttpMiddlewareStmt       *sql.Stmt
-	unsetDefaultTcpMiddlewareStmt        *sql.Stmt
-	updateAgentStmt                      *sql.Stmt
-	updateDnsProviderStmt                *sql.Stmt
-	updateEntryPointStmt                 *sql.Stmt
-	updateHttpMiddlewareStmt             *sql.Stmt
-	updateHttpRouterStmt                 *sql.Stmt
-	updateHttpServersTransportStmt       *sql.Stmt
-	updateHttpServiceStmt                *sql.Stmt
-	updateProfileStmt                    *sql.Stmt
-	updateTcpMiddlewareStmt              *sql.Stmt
-	updateTcpRouterStmt                  *sql.Stmt
-	updateTcpServersTransportStmt        *sql.Stmt
-	updateTcpServiceStmt                 *sql.Stmt
-	updateUdpRouterStmt                  *sql.Stmt
-	updateUdpServiceStmt                 *sql.Stmt
-	updateUserStmt                       *sql.Stmt
-	updateUserLastLoginStmt              *sql.Stmt
-	updateUserPasswordStmt               *sql.Stmt
-	upsertSettingStmt                    *sql.Stmt
+	db                                    DBTX
+	tx                                    *sql.Tx
+	countAgentsStmt                       *sql.Stmt
+	countAuditLogsStmt                    *sql.Stmt
+	countConfigRevisionsStmt              *sql.Stmt
+	countDnsProvidersStmt                 *sql.Stmt
+	countEntryPointsStmt                  *sql.Stmt
+	countHttpMiddlewaresStmt              *sql.Stmt
+	countHttpRoutersStmt                  *sql.Stmt
+	countHttpServersTransportsStmt        *sql.Stmt
+	countHttpServicesStmt                 *sql.Stmt
+	countProfilesStmt                     *sql.Stmt
+	countProfilesByMemberStmt             *sql.Stmt
+	countRecoveryCodesStmt                *sql.Stmt
+	countTcpMiddlewaresStmt               *sql.Stmt
+	countTcpRoutersStmt                   *sql.Stmt
+	countTcpServersTransportsStmt         *sql.Stmt
+	countTcpServicesStmt                  *sql.Stmt
+	countUdpRoutersStmt                   *sql.Stmt
+	countUdpServicesStmt                  *sql.Stmt
+	countUsersStmt                        *sql.Stmt
+	countUsersByRoleStmt                  *sql.Stmt
+	createAgentStmt                       *sql.Stmt
+	createApiTokenStmt                    *sql.Stmt
+	createAuditLogStmt                    *sql.Stmt
+	createConfigRevisionStmt              *sql.Stmt
+	createConfigTemplateStmt              *sql.Stmt
+	createDnsProviderStmt                 *sql.Stmt
+	createEntryPointStmt                  *sql.Stmt
+	createHttpMiddlewareStmt              *sql.Stmt
+	createHttpRouterStmt                  *sql.Stmt
+	createHttpRouterDNSProviderStmt       *sql.Stmt
+	createHttpServersTransportStmt        *sql.Stmt
+	createHttpServiceStmt                 *sql.Stmt
+	createProfileStmt                     *sql.Stmt
+	createProfileMemberStmt               *sql.Stmt
+	createProfileVariableStmt             *sql.Stmt
+	createRecoveryCodeStmt                *sql.Stmt
+	createServiceHealthCheckStmt          *sql.Stmt
+	createSessionStmt                     *sql.Stmt
+	createTcpMiddlewareStmt               *sql.Stmt
+	createTcpRouterStmt                   *sql.Stmt
+	createTcpRouterDNSProviderStmt        *sql.Stmt
+	createTcpServersTransportStmt         *sql.Stmt
+	createTcpServiceStmt                  *sql.Stmt
+	createUdpRouterStmt                   *sql.Stmt
+	createUdpServiceStmt                  *sql.Stmt
+	createUserStmt                        *sql.Stmt
+	deleteAgentStmt                       *sql.Stmt
+	deleteApiTokenStmt                    *sql.Stmt
+	deleteConfigTemplateStmt              *sql.Stmt
+	deleteDnsProviderStmt                 *sql.Stmt
+	deleteEntryPointByIDStmt              *sql.Stmt
+	deleteExpiredSessionsStmt             *sql.Stmt
+	deleteHttpMiddlewareStmt              *sql.Stmt
+	deleteHttpRouterStmt                  *sql.Stmt
+	deleteHttpRouterDNSProviderStmt       *sql.Stmt
+	deleteHttpServersTransportStmt        *sql.Stmt
+	deleteHttpServiceStmt                 *sql.Stmt
+	deleteOldAuditLogsStmt                *sql.Stmt
+	deleteOldConfigRevisionsStmt          *sql.Stmt
+	deleteOldServiceHealthChecksStmt      *sql.Stmt
+	deleteOtherSessionsByUserStmt         *sql.Stmt
+	deleteProfileStmt                     *sql.Stmt
+	deleteProfileMemberStmt               *sql.Stmt
+	deleteProfileVariableStmt             *sql.Stmt
+	deleteRecoveryCodeStmt                *sql.Stmt
+	deleteRecoveryCodesByUserStmt         *sql.Stmt
+	deleteSessionStmt                     *sql.Stmt
+	deleteSessionsByUserStmt              *sql.Stmt
+	deleteSettingStmt                     *sql.Stmt
+	deleteTcpMiddlewareStmt               *sql.Stmt
+	deleteTcpRouterStmt                   *sql.Stmt
+	deleteTcpRouterDNSProviderStmt        *sql.Stmt
+	deleteTcpServersTransportStmt         *sql.Stmt
+	deleteTcpServiceStmt                  *sql.Stmt
+	deleteTraefikInstanceStmt             *sql.Stmt
+	deleteUdpRouterStmt                   *sql.Stmt
+	deleteUdpServiceStmt                  *sql.Stmt
+	deleteUserStmt                        *sql.Stmt
+	getAgentStmt                          *sql.Stmt
+	getApiTokenStmt                       *sql.Stmt
+	getApiTokenByHashStmt                 *sql.Stmt
+	getConfigRevisionStmt                 *sql.Stmt
+	getConfigTemplateStmt                 *sql.Stmt
+	getConfigTemplateByNameStmt           *sql.Stmt
+	getDefaultDNSProviderStmt             *sql.Stmt
+	getDefaultEntryPointStmt              *sql.Stmt
+	getDnsProviderStmt                    *sql.Stmt
+	getDnsProviderByNameStmt              *sql.Stmt
+	getDnsProvidersByHttpRouterStmt       *sql.Stmt
+	getDnsProvidersByTcpRouterStmt        *sql.Stmt
+	getEntryPointStmt                     *sql.Stmt
+	getHttpMiddlewareStmt                 *sql.Stmt
+	getHttpRouterStmt                     *sql.Stmt
+	getHttpRouterByNameStmt               *sql.Stmt
+	getHttpRouterDomainsStmt              *sql.Stmt
+	getHttpRoutersUsingEntryPointStmt     *sql.Stmt
+	getHttpRoutersUsingMiddlewareStmt     *sql.Stmt
+	getHttpServersTransportStmt           *sql.Stmt
+	getHttpServiceStmt                    *sql.Stmt
+	getHttpServiceByNameStmt              *sql.Stmt
+	getLatestConfigRevisionStmt           *sql.Stmt
+	getProfileStmt                        *sql.Stmt
+	getProfileByNameStmt                  *sql.Stmt
+	getProfileMemberStmt                  *sql.Stmt
+	getProfileVariableStmt                *sql.Stmt
+	getSessionStmt                        *sql.Stmt
+	getSettingStmt                        *sql.Stmt
+	getTcpMiddlewareStmt                  *sql.Stmt
+	getTcpRouterStmt                      *sql.Stmt
+	getTcpRouterByNameStmt                *sql.Stmt
+	getTcpRouterDomainsStmt               *sql.Stmt
+	getTcpRoutersUsingEntryPointStmt      *sql.Stmt
+	getTcpRoutersUsingMiddlewareStmt      *sql.Stmt
+	getTcpServersTransportStmt            *sql.Stmt
+	getTcpServiceStmt                     *sql.Stmt
+	getTcpServiceByNameStmt               *sql.Stmt
+	getTraefikInstanceStmt                *sql.Stmt
+	getUdpRouterStmt                      *sql.Stmt
+	getUdpRoutersUsingEntryPointStmt      *sql.Stmt
+	getUdpServiceStmt                     *sql.Stmt
+	getUdpServiceByNameStmt               *sql.Stmt
+	getUserByEmailStmt                    *sql.Stmt
+	getUserByIDStmt                       *sql.Stmt
+	getUserByUsernameStmt                 *sql.Stmt
+	incrementUserTotpFailuresStmt         *sql.Stmt
+	listAgentsStmt                        *sql.Stmt
+	listApiTokensByUserStmt               *sql.Stmt
+	listAuditLogsStmt                     *sql.Stmt
+	listConfigRevisionsStmt               *sql.Stmt
+	listConfigTemplatesStmt               *sql.Stmt
+	listDnsProvidersStmt                  *sql.Stmt
+	listEntryPointsStmt                   *sql.Stmt
+	listHttpMiddlewaresStmt               *sql.Stmt
+	listHttpMiddlewaresEnabledStmt        *sql.Stmt
+	listHttpRoutersStmt                   *sql.Stmt
+	listHttpRoutersEnabledStmt            *sql.Stmt
+	listHttpServersTransportsStmt         *sql.Stmt
+	listHttpServersTransportsEnabledStmt  *sql.Stmt
+	listHttpServicesStmt                  *sql.Stmt
+	listHttpServicesEnabledStmt           *sql.Stmt
+	listLatestServiceHealthChecksStmt     *sql.Stmt
+	listPollableTraefikInstancesStmt      *sql.Stmt
+	listProfileMembersStmt                *sql.Stmt
+	listProfileVariablesStmt              *sql.Stmt
+	listProfilesStmt                      *sql.Stmt
+	listProfilesByMemberStmt              *sql.Stmt
+	listServiceHealthChecksStmt           *sql.Stmt
+	listSessionsByUserStmt                *sql.Stmt
+	listSettingsStmt                      *sql.Stmt
+	listTcpMiddlewaresStmt                *sql.Stmt
+	listTcpMiddlewaresEnabledStmt         *sql.Stmt
+	listTcpRoutersStmt                    *sql.Stmt
+	listTcpRoutersEnabledStmt             *sql.Stmt
+	listTcpServersTransportsStmt          *sql.Stmt
+	listTcpServersTransportsEnabledStmt   *sql.Stmt
+	listTcpServicesStmt                   *sql.Stmt
+	listTcpServicesEnabledStmt            *sql.Stmt
+	listTraefikInstancesStmt              *sql.Stmt
+	listUdpRoutersStmt                    *sql.Stmt
+	listUdpRoutersEnabledStmt             *sql.Stmt
+	listUdpServicesStmt                   *sql.Stmt
+	listUdpServicesEnabledStmt            *sql.Stmt
+	listUsersStmt                         *sql.Stmt
+	lockUserTotpStmt                      *sql.Stmt
+	resetUserTotpFailuresStmt             *sql.Stmt
+	unsetDefaultDNSProviderStmt           *sql.Stmt
+	unsetDefaultEntryPointStmt            *sql.Stmt
+	unsetDefaultHttpMiddlewareStmt        *sql.Stmt
+	unsetDefaultTcpMiddlewareStmt         *sql.Stmt
+	updateAgentStmt                       *sql.Stmt
+	updateApiTokenLastUsedStmt            *sql.Stmt
+	updateConfigTemplateStmt              *sql.Stmt
+	updateDnsProviderStmt                 *sql.Stmt
+	updateEntryPointStmt                  *sql.Stmt
+	updateHttpMiddlewareStmt              *sql.Stmt
+	updateHttpRouterStmt                  *sql.Stmt
+	updateHttpServersTransportStmt        *sql.Stmt
+	updateHttpServiceStmt                 *sql.Stmt
+	updateProfileStmt                     *sql.Stmt
+	updateProfileMemberStmt               *sql.Stmt
+	updateProfilePublishedRevisionStmt    *sql.Stmt
+	updateProfileVariableStmt             *sql.Stmt
+	updateSessionLastSeenStmt             *sql.Stmt
+	updateTcpMiddlewareStmt               *sql.Stmt
+	updateTcpRouterStmt                   *sql.Stmt
+	updateTcpServersTransportStmt         *sql.Stmt
+	updateTcpServiceStmt                  *sql.Stmt
+	updateTraefikInstanceDriftStmt        *sql.Stmt
+	updateTraefikInstanceRuntimeStmt      *sql.Stmt
+	updateTraefikInstanceRuntimeErrorStmt *sql.Stmt
+	updateUdpRouterStmt                   *sql.Stmt
+	updateUdpServiceStmt                  *sql.Stmt
+	updateUserStmt                        *sql.Stmt
+	updateUserLastLoginStmt               *sql.Stmt
+	updateUserPasswordStmt                *sql.Stmt
+	updateUserRoleStmt                    *sql.Stmt
+	updateUserTotpStmt                    *sql.Stmt
+	updateUserTotpStepStmt                *sql.Stmt
+	upsertSettingStmt                     *sql.Stmt
+	upsertTraefikInstanceStmt             *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                                   tx,
-		tx:                                   tx,
-		countAgentsStmt:                      q.countAgentsStmt,
-		countAuditLogsStmt:                   q.countAuditLogsStmt,
-		countDnsProvidersStmt:                q.countDnsProvidersStmt,
-		countEntryPointsStmt:                 q.countEntryPointsStmt,
-		countHttpMiddlewaresStmt:             q.countHttpMiddlewaresStmt,
-		countHttpRoutersStmt:                 q.countHttpRoutersStmt,
-		countHttpServersTransportsStmt:       q.countHttpServersTransportsStmt,
-		countHttpServicesStmt:                q.countHttpServicesStmt,
-		countProfilesStmt:                    q.countProfilesStmt,
-		countTcpMiddlewaresStmt:              q.countTcpMiddlewaresStmt,
-		countTcpRoutersStmt:                  q.countTcpRoutersStmt,
-		countTcpServersTransportsStmt:        q.countTcpServersTransportsStmt,
-		countTcpServicesStmt:                 q.countTcpServicesStmt,
-		countUdpRoutersStmt:                  q.countUdpRoutersStmt,
-		countUdpServicesStmt:                 q.countUdpServicesStmt,
-		countUsersStmt:                       q.countUsersStmt,
-		createAgentStmt:                      q.createAgentStmt,
-		createAuditLogStmt:                   q.createAuditLogStmt,
-		createDnsProviderStmt:                q.createDnsProviderStmt,
-		createEntryPointStmt:                 q.createEntryPointStmt,
-		createHttpMiddlewareStmt:             q.createHttpMiddlewareStmt,
-		createHttpRouterStmt:                 q.createHttpRouterStmt,
-		createHttpRouterDNSProviderStmt:      q.createHttpRouterDNSProviderStmt,
-		createHttpServersTransportStmt:       q.createHttpServersTransportStmt,
-		createHttpServiceStmt:                q.createHttpServiceStmt,
-		createProfileStmt:                    q.createProfileStmt,
-		createTcpMiddlewareStmt:              q.createTcpMiddlewareStmt,
-		createTcpRouterStmt:                  q.createTcpRouterStmt,
-		createTcpRouterDNSProviderStmt:       q.createTcpRouterDNSProviderStmt,
-		createTcpServersTransportStmt:        q.createTcpServersTransportStmt,
-		createTcpServiceStmt:                 q.createTcpServiceStmt,
-		createUdpRouterStmt:                  q.createUdpRouterStmt,
-		createUdpServiceStmt:                 q.createUdpServiceStmt,
-		createUserStmt:                       q.createUserStmt,
-		deleteAgentStmt:                      q.deleteAgentStmt,
-		deleteDnsProviderStmt:                q.deleteDnsProviderStmt,
-		deleteEntryPointByIDStmt:             q.deleteEntryPointByIDStmt,
-		deleteHttpMiddlewareStmt:             q.deleteHttpMiddlewareStmt,
-		deleteHttpRouterStmt:                 q.deleteHttpRouterStmt,
-		deleteHttpRouterDNSProviderStmt:      q.deleteHttpRouterDNSProviderStmt,
-		deleteHttpServersTransportStmt:       q.deleteHttpServersTransportStmt,
-		deleteHttpServiceStmt:                q.deleteHttpServiceStmt,
-		deleteOldAuditLogsStmt:               q.deleteOldAuditLogsStmt,
-		deleteProfileStmt:                    q.deleteProfileStmt,
-		deleteSettingStmt:                    q.deleteSettingStmt,
-		deleteTcpMiddlewareStmt:              q.deleteTcpMiddlewareStmt,
-		deleteTcpRouterStmt:                  q.deleteTcpRouterStmt,
-		deleteTcpRouterDNSProviderStmt:       q.deleteTcpRouterDNSProviderStmt,
-		deleteTcpServersTransportStmt:        q.deleteTcpServersTransportStmt,
-		deleteTcpServiceStmt:                 q.deleteTcpServiceStmt,
-		deleteUdpRouterStmt:                  q.deleteUdpRouterStmt,
-		deleteUdpServiceStmt:                 q.deleteUdpServiceStmt,
-		deleteUserStmt:                       q.deleteUserStmt,
-		getAgentStmt:                         q.getAgentStmt,
-		getDefaultDNSProviderStmt:            q.getDefaultDNSProviderStmt,
-		getDefaultEntryPointStmt:             q.getDefaultEntryPointStmt,
-		getDnsProviderStmt:                   q.getDnsProviderStmt,
-		getDnsProviderByNameStmt:             q.getDnsProviderByNameStmt,
-		getDnsProvidersByHttpRouterStmt:      q.getDnsProvidersByHttpRouterStmt,
-		getDnsProvidersByTcpRouterStmt:       q.getDnsProvidersByTcpRouterStmt,
-		getEntryPointStmt:                    q.getEntryPointStmt,
-		getHttpMiddlewareStmt:                q.getHttpMiddlewareStmt,
-		getHttpRouterStmt:                    q.getHttpRouterStmt,
-		getHttpRouterDomainsStmt:             q.getHttpRouterDomainsStmt,
-		getHttpRoutersUsingEntryPointStmt:    q.getHttpRoutersUsingEntryPointStmt,
-		getHttpRoutersUsingMiddlewareStmt:    q.getHttpRoutersUsingMiddlewareStmt,
-		getHttpServersTransportStmt:          q.getHttpServersTransportStmt,
-		getHttpServiceStmt:                   q.getHttpServiceStmt,
-		getHttpServiceByNameStmt:             q.getHttpServiceByNameStmt,
-		getProfileStmt:                       q.getProfileStmt,
-		getProfileByNameStmt:                 q.getProfileByNameStmt,
-		getSettingStmt:                       q.getSettingStmt,
-		getTcpMiddlewareStmt:                 q.getTcpMiddlewareStmt,
-		getTcpRouterStmt:                     q.getTcpRouterStmt,
-		getTcpRouterDomainsStmt:              q.getTcpRouterDomainsStmt,
-		getTcpRoutersUsingEntryPointStmt:     q.getTcpRoutersUsingEntryPointStmt,
-		getTcpRoutersUsingMiddlewareStmt:     q.getTcpRoutersUsingMiddlewareStmt,
-		getTcpServersTransportStmt:           q.getTcpServersTransportStmt,
-		getTcpServiceStmt:                    q.getTcpServiceStmt,
-		getTcpServiceByNameStmt:              q.getTcpServiceByNameStmt,
-		getUdpRouterStmt:                     q.getUdpRouterStmt,
-		getUdpRoutersUsingEntryPointStmt:     q.getUdpRoutersUsingEntryPointStmt,
-		getUdpServiceStmt:                    q.getUdpServiceStmt,
-		getUdpServiceByNameStmt:              q.getUdpServiceByNameStmt,
-		getUserByEmailStmt:                   q.getUserByEmailStmt,
-		getUserByIDStmt:                      q.getUserByIDStmt,
-		getUserByUsernameStmt:                q.getUserByUsernameStmt,
-		listAgentsStmt:                       q.listAgentsStmt,
-		listAuditLogsStmt:                    q.listAuditLogsStmt,
-		listDnsProvidersStmt:                 q.listDnsProvidersStmt,
-		listEntryPointsStmt:                  q.listEntryPointsStmt,
-		listHttpMiddlewaresStmt:              q.listHttpMiddlewaresStmt,
-		listHttpMiddlewaresEnabledStmt:       q.listHttpMiddlewaresEnabledStmt,
-		listHttpRoutersStmt:                  q.listHttpRoutersStmt,
-		listHttpRoutersEnabledStmt:           q.listHttpRoutersEnabledStmt,
-		listHttpServersTransportsStmt:        q.listHttpServersTransportsStmt,
-		listHttpServersTransportsEnabledStmt: q.listHttpServersTransportsEnabledStmt,
-		listHttpServicesStmt:                 q.listHttpServicesStmt,
-		listHttpServicesEnabledStmt:          q.listHttpServicesEnabledStmt,
-		listProfilesStmt:                     q.listProfilesStmt,
-		listSettingsStmt:                     q.listSettingsStmt,
-		listTcpMiddlewaresStmt:               q.listTcpMiddlewaresStmt,
-		listTcpMiddlewaresEnabledStmt:        q.listTcpMiddlewaresEnabledStmt,
-		listTcpRoutersStmt:                   q.listTcpRoutersStmt,
-		listTcpRoutersEnabledStmt:            q.listTcpRoutersEnabledStmt,
-		listTcpServersTransportsStmt:         q.listTcpServersTransportsStmt,
-		listTcpServersTransportsEnabledStmt:  q.listTcpServersTransportsEnabledStmt,
-		listTcpServicesStmt:                  q.listTcpServicesStmt,
-		listTcpServicesEnabledStmt:           q.listTcpServicesEnabledStmt,
-		listUdpRoutersStmt:                   q.listUdpRoutersStmt,
-		listUdpRoutersEnabledStmt:            q.listUdpRoutersEnabledStmt,
-		listUdpServicesStmt:                  q.listUdpServicesStmt,
-		listUdpServicesEnabledStmt:           q.listUdpServicesEnabledStmt,
-		listUsersStmt:                        q.listUsersStmt,
-		unsetDefaultDNSProviderStmt:          q.unsetDefaultDNSProviderStmt,
-		unsetDefaultEntryPointStmt:           q.unsetDefaultEntryPointStmt,
-		unsetDefaultHttpMiddlewareStmt:       q.unsetDefaultHttpMiddlewareStmt,
-		unsetDefaultTcpMiddlewareStmt:        q.unsetDefaultTcpMiddlewareStmt,
-		updateAgentStmt:                      q.updateAgentStmt,
-		updateDnsProviderStmt:                q.updateDnsProviderStmt,
-		updateEntryPointStmt:                 q.updateEntryPointStmt,
-		updateHttpMiddlewareStmt:             q.updateHttpMiddlewareStmt,
-		updateHttpRouterStmt:                 q.updateHttpRouterStmt,
-		updateHttpServersTransportStmt:       q.updateHttpServersTransportStmt,
-		updateHttpServiceStmt:                q.updateHttpServiceStmt,
-		updateProfileStmt:                    q.updateProfileStmt,
-		updateTcpMiddlewareStmt:              q.updateTcpMiddlewareStmt,
-		updateTcpRouterStmt:                  q.updateTcpRouterStmt,
-		updateTcpServersTransportStmt:        q.updateTcpServersTransportStmt,
-		updateTcpServiceStmt:                 q.updateTcpServiceStmt,
-		updateUdpRouterStmt:                  q.updateUdpRouterStmt,
-		updateUdpServiceStmt:                 q.updateUdpServiceStmt,
-		updateUserStmt:                       q.updateUserStmt,
-		updateUserLastLoginStmt:              q.updateUserLastLoginStmt,
-		updateUserPasswordStmt:               q.updateUserPasswordStmt,
-		upsertSettingStmt:                    q.upsertSettingStmt,
+		db:                                    tx,
+		tx:                                    tx,
+		countAgentsStmt:                       q.countAgentsStmt,
+		countAuditLogsStmt:                    q.countAuditLogsStmt,
+		countConfigRevisionsStmt:              q.countConfigRevisionsStmt,
+		countDnsProvidersStmt:                 q.countDnsProvidersStmt,
+		countEntryPointsStmt:                  q.countEntryPointsStmt,
+		countHttpMiddlewaresStmt:              q.countHttpMiddlewaresStmt,
+		countHttpRoutersStmt:                  q.countHttpRoutersStmt,
+		countHttpServersTransportsStmt:        q.countHttpServersTransportsStmt,
+		countHttpServicesStmt:                 q.countHttpServicesStmt,
+		countProfilesStmt:                     q.countProfilesStmt,
+		countProfilesByMemberStmt:             q.countProfilesByMemberStmt,
+		countRecoveryCodesStmt:                q.countRecoveryCodesStmt,
+		countTcpMiddlewaresStmt:               q.countTcpMiddlewaresStmt,
+		countTcpRoutersStmt:                   q.countTcpRoutersStmt,
+		countTcpServersTransportsStmt:         q.countTcpServersTransportsStmt,
+		countTcpServicesStmt:                  q.countTcpServicesStmt,
+		countUdpRoutersStmt:                   q.countUdpRoutersStmt,
+		countUdpServicesStmt:                  q.countUdpServicesStmt,
+		countUsersStmt:                        q.countUsersStmt,
+		countUsersByRoleStmt:                  q.countUsersByRoleStmt,
+		createAgentStmt:                       q.createAgentStmt,
+		createApiTokenStmt:                    q.createApiTokenStmt,
+		createAuditLogStmt:                    q.createAuditLogStmt,
+		createConfigRevisionStmt:              q.createConfigRevisionStmt,
+		createConfigTemplateStmt:              q.createConfigTemplateStmt,
+		createDnsProviderStmt:                 q.createDnsProviderStmt,
+		createEntryPointStmt:                  q.createEntryPointStmt,
+		createHttpMiddlewareStmt:              q.createHttpMiddlewareStmt,
+		createHttpRouterStmt:                  q.createHttpRouterStmt,
+		createHttpRouterDNSProviderStmt:       q.createHttpRouterDNSProviderStmt,
+		createHttpServersTransportStmt:        q.createHttpServersTransportStmt,
+		createHttpServiceStmt:                 q.createHttpServiceStmt,
+		createProfileStmt:                     q.createProfileStmt,
+		createProfileMemberStmt:               q.createProfileMemberStmt,
+		createProfileVariableStmt:             q.createProfileVariableStmt,
+		createRecoveryCodeStmt:                q.createRecoveryCodeStmt,
+		createServiceHealthCheckStmt:          q.createServiceHealthCheckStmt,
+		createSessionStmt:                     q.createSessionStmt,
+		createTcpMiddlewareStmt:               q.createTcpMiddlewareStmt,
+		createTcpRouterStmt:                   q.createTcpRouterStmt,
+		createTcpRouterDNSProviderStmt:        q.createTcpRouterDNSProviderStmt,
+		createTcpServersTransportStmt:         q.createTcpServersTransportStmt,
+		createTcpServiceStmt:                  q.createTcpServiceStmt,
+		createUdpRouterStmt:                   q.createUdpRouterStmt,
+		createUdpServiceStmt:                  q.createUdpServiceStmt,
+		createUserStmt:                        q.createUserStmt,
+		deleteAgentStmt:                       q.deleteAgentStmt,
+		deleteApiTokenStmt:                    q.deleteApiTokenStmt,
+		deleteConfigTemplateStmt:              q.deleteConfigTemplateStmt,
+		deleteDnsProviderStmt:                 q.deleteDnsProviderStmt,
+		deleteEntryPointByIDStmt:              q.deleteEntryPointByIDStmt,
+		deleteExpiredSessionsStmt:             q.deleteExpiredSessionsStmt,
+		deleteHttpMiddlewareStmt:              q.deleteHttpMiddlewareStmt,
+		deleteHttpRouterStmt:                  q.deleteHttpRouterStmt,
+		deleteHttpRouterDNSProviderStmt:       q.deleteHttpRouterDNSProviderStmt,
+		deleteHttpServersTransportStmt:        q.deleteHttpServersTransportStmt,
+		deleteHttpServiceStmt:                 q.deleteHttpServiceStmt,
+		deleteOldAuditLogsStmt:                q.deleteOldAuditLogsStmt,
+		deleteOldConfigRevisionsStmt:          q.deleteOldConfigRevisionsStmt,
+		deleteOldServiceHealthChecksStmt:      q.deleteOldServiceHealthChecksStmt,
+		deleteOtherSessionsByUserStmt:         q.deleteOtherSessionsByUserStmt,
+		deleteProfileStmt:                     q.deleteProfileStmt,
+		deleteProfileMemberStmt:               q.deleteProfileMemberStmt,
+		deleteProfileVariableStmt:             q.deleteProfileVariableStmt,
+		deleteRecoveryCodeStmt:                q.deleteRecoveryCodeStmt,
+		deleteRecoveryCodesByUserStmt:         q.deleteRecoveryCodesByUserStmt,
+		deleteSessionStmt:                     q.deleteSessionStmt,
+		deleteSessionsByUserStmt:              q.deleteSessionsByUserStmt,
+		deleteSettingStmt:                     q.deleteSettingStmt,
+		deleteTcpMiddlewareStmt:               q.deleteTcpMiddlewareStmt,
+		deleteTcpRouterStmt:                   q.deleteTcpRouterStmt,
+		deleteTcpRouterDNSProviderStmt:        q.deleteTcpRouterDNSProviderStmt,
+		deleteTcpServersTransportStmt:         q.deleteTcpServersTransportStmt,
+		deleteTcpServiceStmt:                  q.deleteTcpServiceStmt,
+		deleteTraefikInstanceStmt:             q.deleteTraefikInstanceStmt,
+		deleteUdpRouterStmt:                   q.deleteUdpRouterStmt,
+		deleteUdpServiceStmt:                  q.deleteUdpServiceStmt,
+		deleteUserStmt:                        q.deleteUserStmt,
+		getAgentStmt:                          q.getAgentStmt,
+		getApiTokenStmt:                       q.getApiTokenStmt,
+		getApiTokenByHashStmt:                 q.getApiTokenByHashStmt,
+		getConfigRevisionStmt:                 q.getConfigRevisionStmt,
+		getConfigTemplateStmt:                 q.getConfigTemplateStmt,
+		getConfigTemplateByNameStmt:           q.getConfigTemplateByNameStmt,
+		getDefaultDNSProviderStmt:             q.getDefaultDNSProviderStmt,
+		getDefaultEntryPointStmt:              q.getDefaultEntryPointStmt,
+		getDnsProviderStmt:                    q.getDnsProviderStmt,
+		getDnsProviderByNameStmt:              q.getDnsProviderByNameStmt,
+		getDnsProvidersByHttpRouterStmt:       q.getDnsProvidersByHttpRouterStmt,
+		getDnsProvidersByTcpRouterStmt:        q.getDnsProvidersByTcpRouterStmt,
+		getEntryPointStmt:                     q.getEntryPointStmt,
+		getHttpMiddlewareStmt:                 q.getHttpMiddlewareStmt,
+		getHttpRouterStmt:                     q.getHttpRouterStmt,
+		getHttpRouterByNameStmt:               q.getHttpRouterByNameStmt,
+		getHttpRouterDomainsStmt:              q.getHttpRouterDomainsStmt,
+		getHttpRoutersUsingEntryPointStmt:     q.getHttpRoutersUsingEntryPointStmt,
+		getHttpRoutersUsingMiddlewareStmt:     q.getHttpRoutersUsingMiddlewareStmt,
+		getHttpServersTransportStmt:           q.getHttpServersTransportStmt,
+		getHttpServiceStmt:                    q.getHttpServiceStmt,
+		getHttpServiceByNameStmt:              q.getHttpServiceByNameStmt,
+		getLatestConfigRevisionStmt:           q.getLatestConfigRevisionStmt,
+		getProfileStmt:                        q.getProfileStmt,
+		getProfileByNameStmt:                  q.getProfileByNameStmt,
+		getProfileMemberStmt:                  q.getProfileMemberStmt,
+		getProfileVariableStmt:                q.getProfileVariableStmt,
+		getSessionStmt:                        q.getSessionStmt,
+		getSettingStmt:                        q.getSettingStmt,
+		getTcpMiddlewareStmt:                  q.getTcpMiddlewareStmt,
+		getTcpRouterStmt:                      q.getTcpRouterStmt,
+		getTcpRouterByNameStmt:                q.getTcpRouterByNameStmt,
+		getTcpRouterDomainsStmt:               q.getTcpRouterDomainsStmt,
+		getTcpRoutersUsingEntryPointStmt:      q.getTcpRoutersUsingEntryPointStmt,
+		getTcpRoutersUsingMiddlewareStmt:      q.getTcpRoutersUsingMiddlewareStmt,
+		getTcpServersTransportStmt:            q.getTcpServersTransportStmt,
+		getTcpServiceStmt:                     q.getTcpServiceStmt,
+		getTcpServiceByNameStmt:               q.getTcpServiceByNameStmt,
+		getTraefikInstanceStmt:                q.getTraefikInstanceStmt,
+		getUdpRouterStmt:                      q.getUdpRouterStmt,
+		getUdpRoutersUsingEntryPointStmt:      q.getUdpRoutersUsingEntryPointStmt,
+		getUdpServiceStmt:                     q.getUdpServiceStmt,
+		getUdpServiceByNameStmt:               q.getUdpServiceByNameStmt,
+		getUserByEmailStmt:                    q.getUserByEmailStmt,
+		getUserByIDStmt:                       q.getUserByIDStmt,
+		getUserByUsernameStmt:                 q.getUserByUsernameStmt,
+		incrementUserTotpFailuresStmt:         q.incrementUserTotpFailuresStmt,
+		listAgentsStmt:                        q.listAgentsStmt,
+		listApiTokensByUserStmt:               q.listApiTokensByUserStmt,
+		listAuditLogsStmt:                     q.listAuditLogsStmt,
+		listConfigRevisionsStmt:               q.listConfigRevisionsStmt,
+		listConfigTemplatesStmt:               q.listConfigTemplatesStmt,
+		listDnsProvidersStmt:                  q.listDnsProvidersStmt,
+		listEntryPointsStmt:                   q.listEntryPointsStmt,
+		listHttpMiddlewaresStmt:               q.listHttpMiddlewaresStmt,
+		listHttpMiddlewaresEnabledStmt:        q.listHttpMiddlewaresEnabledStmt,
+		listHttpRoutersStmt:                   q.listHttpRoutersStmt,
+		listHttpRoutersEnabledStmt:            q.listHttpRoutersEnabledStmt,
+		listHttpServersTransportsStmt:         q.listHttpServersTransportsStmt,
+		listHttpServersTransportsEnabledStmt:  q.listHttpServersTransportsEnabledStmt,
+		listHttpServicesStmt:                  q.listHttpServicesStmt,
+		listHttpServicesEnabledStmt:           q.listHttpServicesEnabledStmt,
+		listLatestServiceHealthChecksStmt:     q.listLatestServiceHealthChecksStmt,
+		listPollableTraefikInstancesStmt:      q.listPollableTraefikInstancesStmt,
+		listProfileMembersStmt:                q.listProfileMembersStmt,
+		listProfileVariablesStmt:              q.listProfileVariablesStmt,
+		listProfilesStmt:                      q.listProfilesStmt,
+		listProfilesByMemberStmt:              q.listProfilesByMemberStmt,
+		listServiceHealthChecksStmt:           q.listServiceHealthChecksStmt,
+		listSessionsByUserStmt:                q.listSessionsByUserStmt,
+		listSettingsStmt:                      q.listSettingsStmt,
+		listTcpMiddlewaresStmt:                q.listTcpMiddlewaresStmt,
+		listTcpMiddlewaresEnabledStmt:         q.listTcpMiddlewaresEnabledStmt,
+		listTcpRoutersStmt:                    q.listTcpRoutersStmt,
+		listTcpRoutersEnabledStmt:             q.listTcpRoutersEnabledStmt,
+		listTcpServersTransportsStmt:          q.listTcpServersTransportsStmt,
+		listTcpServersTransportsEnabledStmt:   q.listTcpServersTransportsEnabledStmt,
+		listTcpServicesStmt:                   q.listTcpServicesStmt,
+		listTcpServicesEnabledStmt:            q.listTcpServicesEnabledStmt,
+		listTraefikInstancesStmt:              q.listTraefikInstancesStmt,
+		listUdpRoutersStmt:                    q.listUdpRoutersStmt,
+		listUdpRoutersEnabledStmt:             q.listUdpRoutersEnabledStmt,
+		listUdpServicesStmt:                   q.listUdpServicesStmt,
+		listUdpServicesEnabledStmt:            q.listUdpServicesEnabledStmt,
+		listUsersStmt:                         q.listUsersStmt,
+		lockUserTotpStmt:                      q.lockUserTotpStmt,
+		resetUserTotpFailuresStmt:             q.resetUserTotpFailuresStmt,
+		unsetDefaultDNSProviderStmt:           q.unsetDefaultDNSProviderStmt,
+		unsetDefaultEntryPointStmt:            q.unsetDefaultEntryPointStmt,
+		unsetDefaultHttpMiddlewareStmt:        q.unsetDefaultHttpMiddlewareStmt,
+		unsetDefaultTcpMiddlewareStmt:         q.unsetDefaultTcpMiddlewareStmt,
+		updateAgentStmt:                       q.updateAgentStmt,
+		updateApiTokenLastUsedStmt:            q.updateApiTokenLastUsedStmt,
+		updateConfigTemplateStmt:              q.updateConfigTemplateStmt,
+		updateDnsProviderStmt:                 q.updateDnsProviderStmt,
+		updateEntryPointStmt:                  q.updateEntryPointStmt,
+		updateHttpMiddlewareStmt:              q.updateHttpMiddlewareStmt,
+		updateHttpRouterStmt:                  q.updateHttpRouterStmt,
+		updateHttpServersTransportStmt:        q.updateHttpServersTransportStmt,
+		updateHttpServiceStmt:                 q.updateHttpServiceStmt,
+		updateProfileStmt:                     q.updateProfileStmt,
+		updateProfileMemberStmt:               q.updateProfileMemberStmt,
+		updateProfilePublishedRevisionStmt:    q.updateProfilePublishedRevisionStmt,
+		updateProfileVariableStmt:             q.updateProfileVariableStmt,
+		updateSessionLastSeenStmt:             q.updateSessionLastSeenStmt,
+		updateTcpMiddlewareStmt:               q.updateTcpMiddlewareStmt,
+		updateTcpRouterStmt:                   q.updateTcpRouterStmt,
+		updateTcpServersTransportStmt:         q.updateTcpServersTransportStmt,
+		updateTcpServiceStmt:                  q.updateTcpServiceStmt,
+		updateTraefikInstanceDriftStmt:        q.updateTraefikInstanceDriftStmt,
+		updateTraefikInstanceRuntimeStmt:      q.updateTraefikInstanceRuntimeStmt,
+		updateTraefikInstanceRuntimeErrorStmt: q.updateTraefikInstanceRuntimeErrorStmt,
+		updateUdpRouterStmt:                   q.updateUdpRouterStmt,
+		updateUdpServiceStmt:                  q.updateUdpServiceStmt,
+		updateUserStmt:                        q.updateUserStmt,
+		updateUserLastLoginStmt:               q.updateUserLastLoginStmt,
+		updateUserPasswordStmt:                q.updateUserPasswordStmt,
+		updateUserRoleStmt:                    q.updateUserRoleStmt,
+		updateUserTotpStmt:                    q.updateUserTotpStmt,
+		updateUserTotpStepStmt:                q.updateUserTotpStepStmt,
+		upsertSettingStmt:                     q.upsertSettingStmt,
+		upsertTraefikInstanceStmt:             q.upsertTraefikInstanceStmt,
 	}
 }
@@ -107,6 +107,37 @@ func (q *Queries) GetHttpRouter(ctx context.Context, id string) (*HttpRouter, er
 	return &i, err
 }
 
+const getHttpRouterByName = `-- name: GetHttpRouterByName :one
+SELECT
+  id, profile_id, agent_id, name, config, enabled, created_at, updated_at
+FROM
+  http_routers
+WHERE
+  profile_id = ?
+  AND name = ?
+`
+
+type GetHttpRouterByNameParams struct {
+	ProfileID int64  `json:"profileId"`
+	Name      string `json:"name"`
+}
+
+func (q *Queries) GetHttpRouterByName(ctx context.Context, arg *GetHttpRouterByNameParams) (*HttpRouter, error) {
+	row := q.queryRow(ctx, q.getHttpRouterByNameStmt, getHttpRouterByName, arg.ProfileID, arg.Name)
+	var i HttpRouter
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.AgentID,
+		&i.Name,
+		&i.Config,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
 const getHttpRoutersUsingEntryPoint = `-- name: GetHttpRoutersUsingEntryPoint :many
 WITH
   ep_name AS (
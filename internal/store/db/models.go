@@ -21,6 +21,17 @@ type Agent struct {
 	UpdatedAt  *time.Time `json:"updatedAt"`
 }
 
+type ApiToken struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"userId"`
+	Name       string     `json:"name"`
+	TokenHash  string     `json:"tokenHash"`
+	Role       string     `json:"role"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	CreatedAt  *time.Time `json:"createdAt"`
+}
+
 type AuditLog struct {
 	ID        int64      `json:"id"`
 	ProfileID *int64     `json:"profileId"`
@@ -29,6 +40,26 @@ type AuditLog struct {
 	Event     string     `json:"event"`
 	Details   *string    `json:"details"`
 	CreatedAt *time.Time `json:"createdAt"`
+	TokenID   *string    `json:"tokenId"`
+}
+
+type ConfigRevision struct {
+	ID        int64             `json:"id"`
+	ProfileID int64             `json:"profileId"`
+	Revision  int64             `json:"revision"`
+	Config    *DynamicConfig    `json:"config"`
+	Snapshot  *RevisionSnapshot `json:"snapshot"`
+	CreatedAt *time.Time        `json:"createdAt"`
+}
+
+type ConfigTemplate struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description *string            `json:"description"`
+	Variables   *TemplateVariables `json:"variables"`
+	Content     string             `json:"content"`
+	CreatedAt   *time.Time         `json:"createdAt"`
+	UpdatedAt   *time.Time         `json:"updatedAt"`
 }
 
 type DnsProvider struct {
@@ -102,14 +133,62 @@ type HttpService struct {
 }
 
 type Profile struct {
-	ID          int64      `json:"id"`
+	ID                int64      `json:"id"`
+	Name              string     `json:"name"`
+	Description       *string    `json:"description"`
+	Token             string     `json:"token"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	UpdatedAt         *time.Time `json:"updatedAt"`
+	PublishedRevision *int64     `json:"publishedRevision"`
+}
+
+type ProfileMember struct {
+	ProfileID int64      `json:"profileId"`
+	UserID    string     `json:"userId"`
+	Role      string     `json:"role"`
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+type ProfileVariable struct {
+	ID          string     `json:"id"`
+	ProfileID   int64      `json:"profileId"`
 	Name        string     `json:"name"`
+	Value       string     `json:"value"`
 	Description *string    `json:"description"`
-	Token       string     `json:"token"`
 	CreatedAt   *time.Time `json:"createdAt"`
 	UpdatedAt   *time.Time `json:"updatedAt"`
 }
 
+type RecoveryCode struct {
+	UserID    string     `json:"userId"`
+	CodeHash  string     `json:"codeHash"`
+	CreatedAt *time.Time `json:"createdAt"`
+}
+
+type ServiceHealthCheck struct {
+	ID         int64      `json:"id"`
+	ProfileID  int64      `json:"profileId"`
+	ServiceID  string     `json:"serviceId"`
+	Protocol   string     `json:"protocol"`
+	Server     string     `json:"server"`
+	Healthy    bool       `json:"healthy"`
+	StatusCode *int64     `json:"statusCode"`
+	LatencyMs  int64      `json:"latencyMs"`
+	Error      *string    `json:"error"`
+	CheckedAt  *time.Time `json:"checkedAt"`
+}
+
+type Session struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"userId"`
+	UserAgent  *string    `json:"userAgent"`
+	IpAddress  *string    `json:"ipAddress"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	LastSeenAt *time.Time `json:"lastSeenAt"`
+	CreatedAt  *time.Time `json:"createdAt"`
+}
+
 type Setting struct {
 	Key       string     `json:"key"`
 	Value     string     `json:"value"`
@@ -166,6 +245,21 @@ type TcpService struct {
 	UpdatedAt *time.Time        `json:"updatedAt"`
 }
 
+type TraefikInstance struct {
+	ID           int64           `json:"id"`
+	ProfileID    int64           `json:"profileId"`
+	Name         string          `json:"name"`
+	Url          *string         `json:"url"`
+	Revision     *int64          `json:"revision"`
+	LastFetch    *time.Time      `json:"lastFetch"`
+	Runtime      *TraefikRuntime `json:"runtime"`
+	RuntimeError *string         `json:"runtimeError"`
+	RuntimeAt    *time.Time      `json:"runtimeAt"`
+	CreatedAt    *time.Time      `json:"createdAt"`
+	Drift        *TraefikDrift   `json:"drift"`
+	DriftAt      *time.Time      `json:"driftAt"`
+}
+
 type UdpRouter struct {
 	ID        string           `json:"id"`
 	ProfileID int64            `json:"profileId"`
@@ -189,11 +283,17 @@ type UdpService struct {
 }
 
 type User struct {
-	ID        string     `json:"id"`
-	Username  string     `json:"username"`
-	Password  string     `json:"password"`
-	Email     *string    `json:"email"`
-	LastLogin *time.Time `json:"lastLogin"`
-	CreatedAt *time.Time `json:"createdAt"`
-	UpdatedAt *time.Time `json:"updatedAt"`
+	ID                 string     `json:"id"`
+	Username           string     `json:"username"`
+	Password           string     `json:"password"`
+	Email              *string    `json:"email"`
+	LastLogin          *time.Time `json:"lastLogin"`
+	CreatedAt          *time.Time `json:"createdAt"`
+	UpdatedAt          *time.Time `json:"updatedAt"`
+	Role               string     `json:"role"`
+	TotpSecret         *string    `json:"totpSecret"`
+	TotpEnabled        bool       `json:"totpEnabled"`
+	TotpLastStep       *int64     `json:"totpLastStep"`
+	TotpFailedAttempts int64      `json:"totpFailedAttempts"`
+	TotpLockedUntil    *time.Time `json:"totpLockedUntil"`
 }
@@ -23,11 +23,27 @@ func (q *Queries) CountProfiles(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countProfilesByMember = `-- name: CountProfilesByMember :one
+SELECT
+  COUNT(*)
+FROM
+  profile_members
+WHERE
+  user_id = ?
+`
+
+func (q *Queries) CountProfilesByMember(ctx context.Context, userID string) (int64, error) {
+	row := q.queryRow(ctx, q.countProfilesByMemberStmt, countProfilesByMember, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createProfile = `-- name: CreateProfile :one
 INSERT INTO
   profiles (name, description, token)
 VALUES
-  (?, ?, ?) RETURNING id, name, description, token, created_at, updated_at
+  (?, ?, ?) RETURNING id, name, description, token, created_at, updated_at, published_revision
 `
 
 type CreateProfileParams struct {
@@ -46,6 +62,7 @@ func (q *Queries) CreateProfile(ctx context.Context, arg *CreateProfileParams) (
 		&i.Token,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PublishedRevision,
 	)
 	return &i, err
 }
@@ -63,7 +80,7 @@ func (q *Queries) DeleteProfile(ctx context.Context, id int64) error {
 
 const getProfile = `-- name: GetProfile :one
 SELECT
-  id, name, description, token, created_at, updated_at
+  id, name, description, token, created_at, updated_at, published_revision
 FROM
   profiles
 WHERE
@@ -80,13 +97,14 @@ func (q *Queries) GetProfile(ctx context.Context, id int64) (*Profile, error) {
 		&i.Token,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PublishedRevision,
 	)
 	return &i, err
 }
 
 const getProfileByName = `-- name: GetProfileByName :one
 SELECT
-  id, name, description, token, created_at, updated_at
+  id, name, description, token, created_at, updated_at, published_revision
 FROM
   profiles
 WHERE
@@ -103,13 +121,14 @@ func (q *Queries) GetProfileByName(ctx context.Context, name string) (*Profile,
 		&i.Token,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PublishedRevision,
 	)
 	return &i, err
 }
 
 const listProfiles = `-- name: ListProfiles :many
 SELECT
-  id, name, description, token, created_at, updated_at
+  id, name, description, token, created_at, updated_at, published_revision
 FROM
   profiles
 ORDER BY
@@ -141,6 +160,60 @@ func (q *Queries) ListProfiles(ctx context.Context, arg *ListProfilesParams) ([]
 			&i.Token,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PublishedRevision,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProfilesByMember = `-- name: ListProfilesByMember :many
+SELECT
+  p.id, p.name, p.description, p.token, p.created_at, p.updated_at, p.published_revision
+FROM
+  profiles p
+  JOIN profile_members m ON m.profile_id = p.id
+WHERE
+  m.user_id = ?1
+ORDER BY
+  p.created_at DESC
+LIMIT
+  COALESCE(CAST(?3 AS INTEGER), -1)
+OFFSET
+  COALESCE(CAST(?2 AS INTEGER), 0)
+`
+
+type ListProfilesByMemberParams struct {
+	UserID string `json:"userId"`
+	Offset *int64 `json:"offset"`
+	Limit  *int64 `json:"limit"`
+}
+
+func (q *Queries) ListProfilesByMember(ctx context.Context, arg *ListProfilesByMemberParams) ([]*Profile, error) {
+	rows, err := q.query(ctx, q.listProfilesByMemberStmt, listProfilesByMember, arg.UserID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Profile
+	for rows.Next() {
+		var i Profile
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Token,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PublishedRevision,
 		); err != nil {
 			return nil, err
 		}
@@ -163,7 +236,7 @@ SET
   token = ?,
   updated_at = CURRENT_TIMESTAMP
 WHERE
-  id = ? RETURNING id, name, description, token, created_at, updated_at
+  id = ? RETURNING id, name, description, token, created_at, updated_at, published_revision
 `
 
 type UpdateProfileParams struct {
@@ -188,6 +261,36 @@ func (q *Queries) UpdateProfile(ctx context.Context, arg *UpdateProfileParams) (
 		&i.Token,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PublishedRevision,
+	)
+	return &i, err
+}
+
+const updateProfilePublishedRevision = `-- name: UpdateProfilePublishedRevision :one
+UPDATE profiles
+SET
+  published_revision = ?,
+  updated_at = CURRENT_TIMESTAMP
+WHERE
+  id = ? RETURNING id, name, description, token, created_at, updated_at, published_revision
+`
+
+type UpdateProfilePublishedRevisionParams struct {
+	PublishedRevision *int64 `json:"publishedRevision"`
+	ID                int64  `json:"id"`
+}
+
+func (q *Queries) UpdateProfilePublishedRevision(ctx context.Context, arg *UpdateProfilePublishedRevisionParams) (*Profile, error) {
+	row := q.queryRow(ctx, q.updateProfilePublishedRevisionStmt, updateProfilePublishedRevision, arg.PublishedRevision, arg.ID)
+	var i Profile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Token,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PublishedRevision,
 	)
 	return &i, err
 }
@@ -6,11 +6,13 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
 	CountAgents(ctx context.Context, profileID int64) (int64, error)
 	CountAuditLogs(ctx context.Context) (int64, error)
+	CountConfigRevisions(ctx context.Context, profileID int64) (int64, error)
 	CountDnsProviders(ctx context.Context) (int64, error)
 	CountEntryPoints(ctx context.Context, profileID int64) (int64, error)
 	CountHttpMiddlewares(ctx context.Context, arg *CountHttpMiddlewaresParams) (int64, error)
@@ -18,6 +20,8 @@ type Querier interface {
 	CountHttpServersTransports(ctx context.Context, arg *CountHttpServersTransportsParams) (int64, error)
 	CountHttpServices(ctx context.Context, arg *CountHttpServicesParams) (int64, error)
 	CountProfiles(ctx context.Context) (int64, error)
+	CountProfilesByMember(ctx context.Context, userID string) (int64, error)
+	CountRecoveryCodes(ctx context.Context, userID string) (int64, error)
 	CountTcpMiddlewares(ctx context.Context, arg *CountTcpMiddlewaresParams) (int64, error)
 	CountTcpRouters(ctx context.Context, arg *CountTcpRoutersParams) (int64, error)
 	CountTcpServersTransports(ctx context.Context, arg *CountTcpServersTransportsParams) (int64, error)
@@ -25,8 +29,12 @@ type Querier interface {
 	CountUdpRouters(ctx context.Context, arg *CountUdpRoutersParams) (int64, error)
 	CountUdpServices(ctx context.Context, arg *CountUdpServicesParams) (int64, error)
 	CountUsers(ctx context.Context) (int64, error)
+	CountUsersByRole(ctx context.Context, role string) (int64, error)
 	CreateAgent(ctx context.Context, arg *CreateAgentParams) (*Agent, error)
+	CreateApiToken(ctx context.Context, arg *CreateApiTokenParams) (*ApiToken, error)
 	CreateAuditLog(ctx context.Context, arg *CreateAuditLogParams) error
+	CreateConfigRevision(ctx context.Context, arg *CreateConfigRevisionParams) (*ConfigRevision, error)
+	CreateConfigTemplate(ctx context.Context, arg *CreateConfigTemplateParams) (*ConfigTemplate, error)
 	CreateDnsProvider(ctx context.Context, arg *CreateDnsProviderParams) (*DnsProvider, error)
 	CreateEntryPoint(ctx context.Context, arg *CreateEntryPointParams) (*EntryPoint, error)
 	CreateHttpMiddleware(ctx context.Context, arg *CreateHttpMiddlewareParams) (*HttpMiddleware, error)
@@ -35,6 +43,11 @@ type Querier interface {
 	CreateHttpServersTransport(ctx context.Context, arg *CreateHttpServersTransportParams) (*HttpServersTransport, error)
 	CreateHttpService(ctx context.Context, arg *CreateHttpServiceParams) (*HttpService, error)
 	CreateProfile(ctx context.Context, arg *CreateProfileParams) (*Profile, error)
+	CreateProfileMember(ctx context.Context, arg *CreateProfileMemberParams) (*ProfileMember, error)
+	CreateProfileVariable(ctx context.Context, arg *CreateProfileVariableParams) (*ProfileVariable, error)
+	CreateRecoveryCode(ctx context.Context, arg *CreateRecoveryCodeParams) error
+	CreateServiceHealthCheck(ctx context.Context, arg *CreateServiceHealthCheckParams) (*ServiceHealthCheck, error)
+	CreateSession(ctx context.Context, arg *CreateSessionParams) (*Session, error)
 	CreateTcpMiddleware(ctx context.Context, arg *CreateTcpMiddlewareParams) (*TcpMiddleware, error)
 	CreateTcpRouter(ctx context.Context, arg *CreateTcpRouterParams) (*TcpRouter, error)
 	CreateTcpRouterDNSProvider(ctx context.Context, arg *CreateTcpRouterDNSProviderParams) error
@@ -44,25 +57,43 @@ type Querier interface {
 	CreateUdpService(ctx context.Context, arg *CreateUdpServiceParams) (*UdpService, error)
 	CreateUser(ctx context.Context, arg *CreateUserParams) (*User, error)
 	DeleteAgent(ctx context.Context, id string) error
+	DeleteApiToken(ctx context.Context, id string) error
+	DeleteConfigTemplate(ctx context.Context, id string) error
 	DeleteDnsProvider(ctx context.Context, id string) error
 	DeleteEntryPointByID(ctx context.Context, id string) error
+	DeleteExpiredSessions(ctx context.Context, expiresAt time.Time) error
 	DeleteHttpMiddleware(ctx context.Context, id string) error
 	DeleteHttpRouter(ctx context.Context, id string) error
 	DeleteHttpRouterDNSProvider(ctx context.Context, arg *DeleteHttpRouterDNSProviderParams) error
 	DeleteHttpServersTransport(ctx context.Context, id string) error
 	DeleteHttpService(ctx context.Context, id string) error
 	DeleteOldAuditLogs(ctx context.Context) error
+	DeleteOldConfigRevisions(ctx context.Context, arg *DeleteOldConfigRevisionsParams) error
+	DeleteOldServiceHealthChecks(ctx context.Context, maxAgeSeconds int64) error
+	DeleteOtherSessionsByUser(ctx context.Context, arg *DeleteOtherSessionsByUserParams) error
 	DeleteProfile(ctx context.Context, id int64) error
+	DeleteProfileMember(ctx context.Context, arg *DeleteProfileMemberParams) error
+	DeleteProfileVariable(ctx context.Context, id string) error
+	DeleteRecoveryCode(ctx context.Context, arg *DeleteRecoveryCodeParams) (int64, error)
+	DeleteRecoveryCodesByUser(ctx context.Context, userID string) error
+	DeleteSession(ctx context.Context, id string) error
+	DeleteSessionsByUser(ctx context.Context, userID string) error
 	DeleteSetting(ctx context.Context, key string) error
 	DeleteTcpMiddleware(ctx context.Context, id string) error
 	DeleteTcpRouter(ctx context.Context, id string) error
 	DeleteTcpRouterDNSProvider(ctx context.Context, arg *DeleteTcpRouterDNSProviderParams) error
 	DeleteTcpServersTransport(ctx context.Context, id string) error
 	DeleteTcpService(ctx context.Context, id string) error
+	DeleteTraefikInstance(ctx context.Context, id int64) error
 	DeleteUdpRouter(ctx context.Context, id string) error
 	DeleteUdpService(ctx context.Context, id string) error
 	DeleteUser(ctx context.Context, id string) error
 	GetAgent(ctx context.Context, id string) (*Agent, error)
+	GetApiToken(ctx context.Context, id string) (*ApiToken, error)
+	GetApiTokenByHash(ctx context.Context, tokenHash string) (*ApiToken, error)
+	GetConfigRevision(ctx context.Context, arg *GetConfigRevisionParams) (*ConfigRevision, error)
+	GetConfigTemplate(ctx context.Context, id string) (*ConfigTemplate, error)
+	GetConfigTemplateByName(ctx context.Context, name string) (*ConfigTemplate, error)
 	GetDefaultDNSProvider(ctx context.Context) (*DnsProvider, error)
 	GetDefaultEntryPoint(ctx context.Context) (*EntryPoint, error)
 	GetDnsProvider(ctx context.Context, id string) (*DnsProvider, error)
@@ -72,23 +103,30 @@ type Querier interface {
 	GetEntryPoint(ctx context.Context, id string) (*EntryPoint, error)
 	GetHttpMiddleware(ctx context.Context, id string) (*HttpMiddleware, error)
 	GetHttpRouter(ctx context.Context, id string) (*HttpRouter, error)
+	GetHttpRouterByName(ctx context.Context, arg *GetHttpRouterByNameParams) (*HttpRouter, error)
 	GetHttpRouterDomains(ctx context.Context) ([]*GetHttpRouterDomainsRow, error)
 	GetHttpRoutersUsingEntryPoint(ctx context.Context, arg *GetHttpRoutersUsingEntryPointParams) ([]*GetHttpRoutersUsingEntryPointRow, error)
 	GetHttpRoutersUsingMiddleware(ctx context.Context, arg *GetHttpRoutersUsingMiddlewareParams) ([]*GetHttpRoutersUsingMiddlewareRow, error)
 	GetHttpServersTransport(ctx context.Context, id string) (*HttpServersTransport, error)
 	GetHttpService(ctx context.Context, id string) (*HttpService, error)
 	GetHttpServiceByName(ctx context.Context, arg *GetHttpServiceByNameParams) (*HttpService, error)
+	GetLatestConfigRevision(ctx context.Context, profileID int64) (*ConfigRevision, error)
 	GetProfile(ctx context.Context, id int64) (*Profile, error)
 	GetProfileByName(ctx context.Context, name string) (*Profile, error)
+	GetProfileMember(ctx context.Context, arg *GetProfileMemberParams) (*ProfileMember, error)
+	GetProfileVariable(ctx context.Context, id string) (*ProfileVariable, error)
+	GetSession(ctx context.Context, id string) (*Session, error)
 	GetSetting(ctx context.Context, key string) (*Setting, error)
 	GetTcpMiddleware(ctx context.Context, id string) (*TcpMiddleware, error)
 	GetTcpRouter(ctx context.Context, id string) (*TcpRouter, error)
+	GetTcpRouterByName(ctx context.Context, arg *GetTcpRouterByNameParams) (*TcpRouter, error)
 	GetTcpRouterDomains(ctx context.Context) ([]*GetTcpRouterDomainsRow, error)
 	GetTcpRoutersUsingEntryPoint(ctx context.Context, arg *GetTcpRoutersUsingEntryPointParams) ([]*GetTcpRoutersUsingEntryPointRow, error)
 	GetTcpRoutersUsingMiddleware(ctx context.Context, arg *GetTcpRoutersUsingMiddlewareParams) ([]*GetTcpRoutersUsingMiddlewareRow, error)
 	GetTcpServersTransport(ctx context.Context, id string) (*TcpServersTransport, error)
 	GetTcpService(ctx context.Context, id string) (*TcpService, error)
 	GetTcpServiceByName(ctx context.Context, arg *GetTcpServiceByNameParams) (*TcpService, error)
+	GetTraefikInstance(ctx context.Context, id int64) (*TraefikInstance, error)
 	GetUdpRouter(ctx context.Context, id string) (*UdpRouter, error)
 	GetUdpRoutersUsingEntryPoint(ctx context.Context, arg *GetUdpRoutersUsingEntryPointParams) ([]*GetUdpRoutersUsingEntryPointRow, error)
 	GetUdpService(ctx context.Context, id string) (*UdpService, error)
@@ -96,8 +134,12 @@ type Querier interface {
 	GetUserByEmail(ctx context.Context, email *string) (*User, error)
 	GetUserByID(ctx context.Context, id string) (*User, error)
 	GetUserByUsername(ctx context.Context, username string) (*User, error)
+	IncrementUserTotpFailures(ctx context.Context, id string) (int64, error)
 	ListAgents(ctx context.Context, arg *ListAgentsParams) ([]*Agent, error)
+	ListApiTokensByUser(ctx context.Context, userID string) ([]*ApiToken, error)
 	ListAuditLogs(ctx context.Context, arg *ListAuditLogsParams) ([]*ListAuditLogsRow, error)
+	ListConfigRevisions(ctx context.Context, arg *ListConfigRevisionsParams) ([]*ListConfigRevisionsRow, error)
+	ListConfigTemplates(ctx context.Context) ([]*ConfigTemplate, error)
 	ListDnsProviders(ctx context.Context, arg *ListDnsProvidersParams) ([]*DnsProvider, error)
 	ListEntryPoints(ctx context.Context, arg *ListEntryPointsParams) ([]*EntryPoint, error)
 	ListHttpMiddlewares(ctx context.Context, arg *ListHttpMiddlewaresParams) ([]*HttpMiddleware, error)
@@ -108,7 +150,14 @@ type Querier interface {
 	ListHttpServersTransportsEnabled(ctx context.Context, profileID int64) ([]*HttpServersTransport, error)
 	ListHttpServices(ctx context.Context, arg *ListHttpServicesParams) ([]*HttpService, error)
 	ListHttpServicesEnabled(ctx context.Context, profileID int64) ([]*HttpService, error)
+	ListLatestServiceHealthChecks(ctx context.Context, profileID int64) ([]*ServiceHealthCheck, error)
+	ListPollableTraefikInstances(ctx context.Context) ([]*TraefikInstance, error)
+	ListProfileMembers(ctx context.Context, profileID int64) ([]*ProfileMember, error)
+	ListProfileVariables(ctx context.Context, profileID int64) ([]*ProfileVariable, error)
 	ListProfiles(ctx context.Context, arg *ListProfilesParams) ([]*Profile, error)
+	ListProfilesByMember(ctx context.Context, arg *ListProfilesByMemberParams) ([]*Profile, error)
+	ListServiceHealthChecks(ctx context.Context, arg *ListServiceHealthChecksParams) ([]*ServiceHealthCheck, error)
+	ListSessionsByUser(ctx context.Context, userID string) ([]*Session, error)
 	ListSettings(ctx context.Context) ([]*Setting, error)
 	ListTcpMiddlewares(ctx context.Context, arg *ListTcpMiddlewaresParams) ([]*TcpMiddleware, error)
 	ListTcpMiddlewaresEnabled(ctx context.Context, profileID int64) ([]*TcpMiddleware, error)
@@ -118,16 +167,21 @@ type Querier interface {
 	ListTcpServersTransportsEnabled(ctx context.Context, profileID int64) ([]*TcpServersTransport, error)
 	ListTcpServices(ctx context.Context, arg *ListTcpServicesParams) ([]*TcpService, error)
 	ListTcpServicesEnabled(ctx context.Context, profileID int64) ([]*TcpService, error)
+	ListTraefikInstances(ctx context.Context, profileID int64) ([]*TraefikInstance, error)
 	ListUdpRouters(ctx context.Context, arg *ListUdpRoutersParams) ([]*UdpRouter, error)
 	ListUdpRoutersEnabled(ctx context.Context, profileID int64) ([]*UdpRouter, error)
 	ListUdpServices(ctx context.Context, arg *ListUdpServicesParams) ([]*UdpService, error)
 	ListUdpServicesEnabled(ctx context.Context, profileID int64) ([]*UdpService, error)
 	ListUsers(ctx context.Context, arg *ListUsersParams) ([]*User, error)
+	LockUserTotp(ctx context.Context, arg *LockUserTotpParams) error
+	ResetUserTotpFailures(ctx context.Context, id string) error
 	UnsetDefaultDNSProvider(ctx context.Context) error
 	UnsetDefaultEntryPoint(ctx context.Context, profileID int64) error
 	UnsetDefaultHttpMiddleware(ctx context.Context, profileID int64) error
 	UnsetDefaultTcpMiddleware(ctx context.Context, profileID int64) error
 	UpdateAgent(ctx context.Context, arg *UpdateAgentParams) (*Agent, error)
+	UpdateApiTokenLastUsed(ctx context.Context, id string) error
+	UpdateConfigTemplate(ctx context.Context, arg *UpdateConfigTemplateParams) (*ConfigTemplate, error)
 	UpdateDnsProvider(ctx context.Context, arg *UpdateDnsProviderParams) (*DnsProvider, error)
 	UpdateEntryPoint(ctx context.Context, arg *UpdateEntryPointParams) (*EntryPoint, error)
 	UpdateHttpMiddleware(ctx context.Context, arg *UpdateHttpMiddlewareParams) (*HttpMiddleware, error)
@@ -135,16 +189,27 @@ type Querier interface {
 	UpdateHttpServersTransport(ctx context.Context, arg *UpdateHttpServersTransportParams) (*HttpServersTransport, error)
 	UpdateHttpService(ctx context.Context, arg *UpdateHttpServiceParams) (*HttpService, error)
 	UpdateProfile(ctx context.Context, arg *UpdateProfileParams) (*Profile, error)
+	UpdateProfileMember(ctx context.Context, arg *UpdateProfileMemberParams) (*ProfileMember, error)
+	UpdateProfilePublishedRevision(ctx context.Context, arg *UpdateProfilePublishedRevisionParams) (*Profile, error)
+	UpdateProfileVariable(ctx context.Context, arg *UpdateProfileVariableParams) (*ProfileVariable, error)
+	UpdateSessionLastSeen(ctx context.Context, id string) error
 	UpdateTcpMiddleware(ctx context.Context, arg *UpdateTcpMiddlewareParams) (*TcpMiddleware, error)
 	UpdateTcpRouter(ctx context.Context, arg *UpdateTcpRouterParams) (*TcpRouter, error)
 	UpdateTcpServersTransport(ctx context.Context, arg *UpdateTcpServersTransportParams) (*TcpServersTransport, error)
 	UpdateTcpService(ctx context.Context, arg *UpdateTcpServiceParams) (*TcpService, error)
+	UpdateTraefikInstanceDrift(ctx context.Context, arg *UpdateTraefikInstanceDriftParams) error
+	UpdateTraefikInstanceRuntime(ctx context.Context, arg *UpdateTraefikInstanceRuntimeParams) error
+	UpdateTraefikInstanceRuntimeError(ctx context.Context, arg *UpdateTraefikInstanceRuntimeErrorParams) error
 	UpdateUdpRouter(ctx context.Context, arg *UpdateUdpRouterParams) (*UdpRouter, error)
 	UpdateUdpService(ctx context.Context, arg *UpdateUdpServiceParams) (*UdpService, error)
 	UpdateUser(ctx context.Context, arg *UpdateUserParams) (*User, error)
 	UpdateUserLastLogin(ctx context.Context, id string) error
 	UpdateUserPassword(ctx context.Context, arg *UpdateUserPasswordParams) error
+	UpdateUserRole(ctx context.Context, arg *UpdateUserRoleParams) (*User, error)
+	UpdateUserTotp(ctx context.Context, arg *UpdateUserTotpParams) error
+	UpdateUserTotpStep(ctx context.Context, arg *UpdateUserTotpStepParams) (int64, error)
 	UpsertSetting(ctx context.Context, arg *UpsertSettingParams) error
+	UpsertTraefikInstance(ctx context.Context, arg *UpsertTraefikInstanceParams) (*TraefikInstance, error)
 }
 
 var _ Querier = (*Queries)(nil)
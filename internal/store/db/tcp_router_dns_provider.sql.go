@@ -89,6 +89,7 @@ SELECT
   tr.id AS router_id,
   tr.name AS router_name,
   tr.config AS config_json,
+  p.id AS profile_id,
   p.name AS profile_name,
   dp.id AS dns_provider_id,
   dp.name AS dns_provider_name
@@ -103,6 +104,7 @@ type GetTcpRouterDomainsRow struct {
 	RouterID        string           `json:"routerId"`
 	RouterName      string           `json:"routerName"`
 	ConfigJson      *TCPRouterConfig `json:"configJson"`
+	ProfileID       int64            `json:"profileId"`
 	ProfileName     string           `json:"profileName"`
 	DnsProviderID   *string          `json:"dnsProviderId"`
 	DnsProviderName *string          `json:"dnsProviderName"`
@@ -121,6 +123,7 @@ func (q *Queries) GetTcpRouterDomains(ctx context.Context) ([]*GetTcpRouterDomai
 			&i.RouterID,
 			&i.RouterName,
 			&i.ConfigJson,
+			&i.ProfileID,
 			&i.ProfileName,
 			&i.DnsProviderID,
 			&i.DnsProviderName,
@@ -107,6 +107,37 @@ func (q *Queries) GetTcpRouter(ctx context.Context, id string) (*TcpRouter, erro
 	return &i, err
 }
 
+const getTcpRouterByName = `-- name: GetTcpRouterByName :one
+SELECT
+  id, profile_id, agent_id, name, config, enabled, created_at, updated_at
+FROM
+  tcp_routers
+WHERE
+  profile_id = ?
+  AND name = ?
+`
+
+type GetTcpRouterByNameParams struct {
+	ProfileID int64  `json:"profileId"`
+	Name      string `json:"name"`
+}
+
+func (q *Queries) GetTcpRouterByName(ctx context.Context, arg *GetTcpRouterByNameParams) (*TcpRouter, error) {
+	row := q.queryRow(ctx, q.getTcpRouterByNameStmt, getTcpRouterByName, arg.ProfileID, arg.Name)
+	var i TcpRouter
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.AgentID,
+		&i.Name,
+		&i.Config,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
 const getTcpRoutersUsingEntryPoint = `-- name: GetTcpRoutersUsingEntryPoint :many
 WITH
   ep_name AS (
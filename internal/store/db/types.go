@@ -50,4 +50,61 @@ type (
 	ServersTransportConfig    = JSONType[dynamic.ServersTransport]
 	TCPServersTransportConfig = JSONType[dynamic.TCPServersTransport]
 	DNSProviderConfig         = JSONType[mantraev1.DNSProviderConfig]
+	DynamicConfig             = JSONType[dynamic.Configuration]
+	RevisionSnapshot          = JSONType[Snapshot]
+	TraefikRuntime            = JSONType[[]RuntimeItem]
+	TraefikDrift              = JSONType[[]DriftItem]
+	TemplateVariables         = JSONType[[]TemplateVariable]
 )
+
+// Snapshot holds the user managed rows of a profile as they were at a config
+// revision. Rows owned by agents are left out, as agents keep them in sync.
+type Snapshot struct {
+	HTTPRouters           []SnapshotRow[dynamic.Router]              `json:"httpRouters,omitempty"`
+	HTTPServices          []SnapshotRow[dynamic.Service]             `json:"httpServices,omitempty"`
+	HTTPMiddlewares       []SnapshotRow[dynamic.Middleware]          `json:"httpMiddlewares,omitempty"`
+	HTTPServersTransports []SnapshotRow[dynamic.ServersTransport]    `json:"httpServersTransports,omitempty"`
+	TCPRouters            []SnapshotRow[dynamic.TCPRouter]           `json:"tcpRouters,omitempty"`
+	TCPServices           []SnapshotRow[dynamic.TCPService]          `json:"tcpServices,omitempty"`
+	TCPMiddlewares        []SnapshotRow[dynamic.TCPMiddleware]       `json:"tcpMiddlewares,omitempty"`
+	TCPServersTransports  []SnapshotRow[dynamic.TCPServersTransport] `json:"tcpServersTransports,omitempty"`
+	UDPRouters            []SnapshotRow[dynamic.UDPRouter]           `json:"udpRouters,omitempty"`
+	UDPServices           []SnapshotRow[dynamic.UDPService]          `json:"udpServices,omitempty"`
+
+	// DNSProviders maps routers, e.g. "http.web", to the IDs of their DNS
+	// providers. Nil for snapshots taken before the links were recorded.
+	DNSProviders map[string][]string `json:"dnsProviders"`
+}
+
+type SnapshotRow[T any] struct {
+	Name      string `json:"name"`
+	Enabled   bool   `json:"enabled"`
+	IsDefault bool   `json:"isDefault,omitempty"`
+	Config    *T     `json:"config"`
+}
+
+// RuntimeItem is a router, middleware or service as a Traefik instance loaded
+// it, from its /api/rawdata endpoint.
+type RuntimeItem struct {
+	Kind   string   `json:"kind"` // e.g. "tcpRouters"
+	Name   string   `json:"name"`
+	Status string   `json:"status"` // "enabled", "disabled" or "warning"
+	Errors []string `json:"errors,omitempty"`
+}
+
+// DriftItem is a router, middleware or service that differs between the
+// configuration of a profile and what a Traefik instance runs.
+type DriftItem struct {
+	Kind    string   `json:"kind"`    // "missing", "extra" or "divergent"
+	Section string   `json:"section"` // e.g. "tcpRouters"
+	Name    string   `json:"name"`
+	Fields  []string `json:"fields,omitempty"` // diverging fields, e.g. "loadBalancer.servers"
+}
+
+// TemplateVariable is a parameter of a configuration template.
+type TemplateVariable struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
 const countUsers = `-- name: CountUsers :one
@@ -23,11 +24,27 @@ func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countUsersByRole = `-- name: CountUsersByRole :one
+SELECT
+  COUNT(*)
+FROM
+  users
+WHERE
+  role = ?
+`
+
+func (q *Queries) CountUsersByRole(ctx context.Context, role string) (int64, error) {
+	row := q.queryRow(ctx, q.countUsersByRoleStmt, countUsersByRole, role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO
-  users (id, username, password, email)
+  users (id, username, password, email, role)
 VALUES
-  (?, ?, ?, ?) RETURNING id, username, password, email, last_login, created_at, updated_at
+  (?, ?, ?, ?, ?) RETURNING id, username, password, email, last_login, created_at, updated_at, role, totp_secret, totp_enabled, totp_last_step, totp_failed_attempts, totp_locked_until
 `
 
 type CreateUserParams struct {
@@ -35,6 +52,7 @@ type CreateUserParams struct {
 	Username string  `json:"username"`
 	Password string  `json:"password"`
 	Email    *string `json:"email"`
+	Role     string  `json:"role"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg *CreateUserParams) (*User, error) {
@@ -43,6 +61,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg *CreateUserParams) (*User,
 		arg.Username,
 		arg.Password,
 		arg.Email,
+		arg.Role,
 	)
 	var i User
 	err := row.Scan(
@@ -53,6 +72,12 @@ func (q *Queries) CreateUser(ctx context.Context, arg *CreateUserParams) (*User,
 		&i.LastLogin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.TotpSecret,
+		&i.TotpEnabled,
+		&i.TotpLastStep,
+		&i.TotpFailedAttempts,
+		&i.TotpLockedUntil,
 	)
 	return &i, err
 }
@@ -70,7 +95,7 @@ func (q *Queries) DeleteUser(ctx context.Context, id string) error {
 
 const getUserByEmail = `-- name: GetUserByEmail :one
 SELECT
-  id, username, password, email, last_login, created_at, updated_at
+  id, username, password, email, last_login, created_at, updated_at, role, totp_secret, totp_enabled, totp_last_step, totp_failed_attempts, totp_locked_until
 FROM
   users
 WHERE
@@ -88,13 +113,19 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email *string) (*User, err
 		&i.LastLogin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.TotpSecret,
+		&i.TotpEnabled,
+		&i.TotpLastStep,
+		&i.TotpFailedAttempts,
+		&i.TotpLockedUntil,
 	)
 	return &i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
 SELECT
-  id, username, password, email, last_login, created_at, updated_at
+  id, username, password, email, last_login, created_at, updated_at, role, totp_secret, totp_enabled, totp_last_step, totp_failed_attempts, totp_locked_until
 FROM
   users
 WHERE
@@ -112,13 +143,19 @@ func (q *Queries) GetUserByID(ctx context.Context, id string) (*User, error) {
 		&i.LastLogin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.TotpSecret,
+		&i.TotpEnabled,
+		&i.TotpLastStep,
+		&i.TotpFailedAttempts,
+		&i.TotpLockedUntil,
 	)
 	return &i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
 SELECT
-  id, username, password, email, last_login, created_at, updated_at
+  id, username, password, email, last_login, created_at, updated_at, role, totp_secret, totp_enabled, totp_last_step, totp_failed_attempts, totp_locked_until
 FROM
   users
 WHERE
@@ -136,13 +173,34 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (*User
 		&i.LastLogin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.TotpSecret,
+		&i.TotpEnabled,
+		&i.TotpLastStep,
+		&i.TotpFailedAttempts,
+		&i.TotpLockedUntil,
 	)
 	return &i, err
 }
 
+const incrementUserTotpFailures = `-- name: IncrementUserTotpFailures :one
+UPDATE users
+SET
+  totp_failed_attempts = totp_failed_attempts + 1
+WHERE
+  id = ? RETURNING totp_failed_attempts
+`
+
+func (q *Queries) IncrementUserTotpFailures(ctx context.Context, id string) (int64, error) {
+	row := q.queryRow(ctx, q.incrementUserTotpFailuresStmt, incrementUserTotpFailures, id)
+	var totp_failed_attempts int64
+	err := row.Scan(&totp_failed_attempts)
+	return totp_failed_attempts, err
+}
+
 const listUsers = `-- name: ListUsers :many
 SELECT
-  id, username, password, email, last_login, created_at, updated_at
+  id, username, password, email, last_login, created_at, updated_at, role, totp_secret, totp_enabled, totp_last_step, totp_failed_attempts, totp_locked_until
 FROM
   users
 ORDER BY
@@ -175,6 +233,12 @@ func (q *Queries) ListUsers(ctx context.Context, arg *ListUsersParams) ([]*User,
 			&i.LastLogin,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Role,
+			&i.TotpSecret,
+			&i.TotpEnabled,
+			&i.TotpLastStep,
+			&i.TotpFailedAttempts,
+			&i.TotpLockedUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -189,6 +253,39 @@ func (q *Queries) ListUsers(ctx context.Context, arg *ListUsersParams) ([]*User,
 	return items, nil
 }
 
+const lockUserTotp = `-- name: LockUserTotp :exec
+UPDATE users
+SET
+  totp_failed_attempts = 0,
+  totp_locked_until = ?
+WHERE
+  id = ?
+`
+
+type LockUserTotpParams struct {
+	TotpLockedUntil *time.Time `json:"totpLockedUntil"`
+	ID              string     `json:"id"`
+}
+
+func (q *Queries) LockUserTotp(ctx context.Context, arg *LockUserTotpParams) error {
+	_, err := q.exec(ctx, q.lockUserTotpStmt, lockUserTotp, arg.TotpLockedUntil, arg.ID)
+	return err
+}
+
+const resetUserTotpFailures = `-- name: ResetUserTotpFailures :exec
+UPDATE users
+SET
+  totp_failed_attempts = 0,
+  totp_locked_until = NULL
+WHERE
+  id = ?
+`
+
+func (q *Queries) ResetUserTotpFailures(ctx context.Context, id string) error {
+	_, err := q.exec(ctx, q.resetUserTotpFailuresStmt, resetUserTotpFailures, id)
+	return err
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE users
 SET
@@ -196,7 +293,7 @@ SET
   email = ?,
   updated_at = CURRENT_TIMESTAMP
 WHERE
-  id = ? RETURNING id, username, password, email, last_login, created_at, updated_at
+  id = ? RETURNING id, username, password, email, last_login, created_at, updated_at, role, totp_secret, totp_enabled, totp_last_step, totp_failed_attempts, totp_locked_until
 `
 
 type UpdateUserParams struct {
@@ -216,6 +313,12 @@ func (q *Queries) UpdateUser(ctx context.Context, arg *UpdateUserParams) (*User,
 		&i.LastLogin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.TotpSecret,
+		&i.TotpEnabled,
+		&i.TotpLastStep,
+		&i.TotpFailedAttempts,
+		&i.TotpLockedUntil,
 	)
 	return &i, err
 }
@@ -233,6 +336,92 @@ func (q *Queries) UpdateUserLastLogin(ctx context.Context, id string) error {
 	return err
 }
 
+const updateUserRole = `-- name: UpdateUserRole :one
+UPDATE users
+SET
+  role = ?,
+  updated_at = CURRENT_TIMESTAMP
+WHERE
+  id = ? RETURNING id, username, password, email, last_login, created_at, updated_at, role, totp_secret, totp_enabled, totp_last_step, totp_failed_attempts, totp_locked_until
+`
+
+type UpdateUserRoleParams struct {
+	Role string `json:"role"`
+	ID   string `json:"id"`
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg *UpdateUserRoleParams) (*User, error) {
+	row := q.queryRow(ctx, q.updateUserRoleStmt, updateUserRole, arg.Role, arg.ID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Password,
+		&i.Email,
+		&i.LastLogin,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.TotpSecret,
+		&i.TotpEnabled,
+		&i.TotpLastStep,
+		&i.TotpFailedAttempts,
+		&i.TotpLockedUntil,
+	)
+	return &i, err
+}
+
+const updateUserTotp = `-- name: UpdateUserTotp :exec
+UPDATE users
+SET
+  totp_secret = ?,
+  totp_enabled = ?,
+  totp_last_step = NULL,
+  totp_failed_attempts = 0,
+  totp_locked_until = NULL,
+  updated_at = CURRENT_TIMESTAMP
+WHERE
+  id = ?
+`
+
+type UpdateUserTotpParams struct {
+	TotpSecret  *string `json:"totpSecret"`
+	TotpEnabled bool    `json:"totpEnabled"`
+	ID          string  `json:"id"`
+}
+
+func (q *Queries) UpdateUserTotp(ctx context.Context, arg *UpdateUserTotpParams) error {
+	_, err := q.exec(ctx, q.updateUserTotpStmt, updateUserTotp, arg.TotpSecret, arg.TotpEnabled, arg.ID)
+	return err
+}
+
+const updateUserTotpStep = `-- name: UpdateUserTotpStep :execrows
+UPDATE users
+SET
+  totp_last_step = ?1,
+  totp_failed_attempts = 0,
+  totp_locked_until = NULL
+WHERE
+  id = ?2
+  AND (
+    totp_last_step IS NULL
+    OR totp_last_step < ?1
+  )
+`
+
+type UpdateUserTotpStepParams struct {
+	Step int64  `json:"step"`
+	ID   string `json:"id"`
+}
+
+func (q *Queries) UpdateUserTotpStep(ctx context.Context, arg *UpdateUserTotpStepParams) (int64, error) {
+	result, err := q.exec(ctx, q.updateUserTotpStepStmt, updateUserTotpStep, arg.Step, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateUserPassword = `-- name: UpdateUserPassword :exec
 UPDATE users
 SET
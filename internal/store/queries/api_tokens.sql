@@ -0,0 +1,43 @@
+-- name: CreateApiToken :one
+INSERT INTO
+  api_tokens (id, user_id, name, token_hash, role, expires_at)
+VALUES
+  (?, ?, ?, ?, ?, ?) RETURNING *;
+
+-- name: GetApiToken :one
+SELECT
+  *
+FROM
+  api_tokens
+WHERE
+  id = ?;
+
+-- name: GetApiTokenByHash :one
+SELECT
+  *
+FROM
+  api_tokens
+WHERE
+  token_hash = ?;
+
+-- name: ListApiTokensByUser :many
+SELECT
+  *
+FROM
+  api_tokens
+WHERE
+  user_id = ?
+ORDER BY
+  created_at DESC;
+
+-- name: UpdateApiTokenLastUsed :exec
+UPDATE api_tokens
+SET
+  last_used_at = CURRENT_TIMESTAMP
+WHERE
+  id = ?;
+
+-- name: DeleteApiToken :exec
+DELETE FROM api_tokens
+WHERE
+  id = ?;
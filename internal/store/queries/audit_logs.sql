@@ -7,6 +7,8 @@ SELECT
   u.username AS user_name,
   a.agent_id,
   ag.hostname AS agent_name,
+  a.token_id,
+  t.name AS token_name,
   a.event,
   a.details,
   a.created_at
@@ -15,6 +17,7 @@ FROM
   LEFT JOIN profiles p ON a.profile_id = p.id
   LEFT JOIN users u ON a.user_id = u.id
   LEFT JOIN agents ag ON a.agent_id = ag.id
+  LEFT JOIN api_tokens t ON a.token_id = t.id
 ORDER BY
   a.created_at DESC
 LIMIT
@@ -36,10 +39,11 @@ INSERT INTO
     agent_id,
     event,
     details,
+    token_id,
     created_at
   )
 VALUES
-  (?, ?, ?, ?, ?, CURRENT_TIMESTAMP);
+  (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP);
 
 -- name: DeleteOldAuditLogs :exec
 DELETE FROM audit_logs
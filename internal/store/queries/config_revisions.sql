@@ -0,0 +1,82 @@
+-- name: CreateConfigRevision :one
+INSERT INTO
+  config_revisions (profile_id, revision, config, snapshot)
+VALUES
+  (
+    sqlc.arg ('profile_id'),
+    (
+      SELECT
+        COALESCE(MAX(revision), 0) + 1
+      FROM
+        config_revisions
+      WHERE
+        profile_id = sqlc.arg ('profile_id')
+    ),
+    sqlc.arg ('config'),
+    sqlc.arg ('snapshot')
+  ) RETURNING *;
+
+-- name: GetConfigRevision :one
+SELECT
+  *
+FROM
+  config_revisions
+WHERE
+  profile_id = ?
+  AND revision = ?;
+
+-- name: GetLatestConfigRevision :one
+SELECT
+  *
+FROM
+  config_revisions
+WHERE
+  profile_id = ?
+ORDER BY
+  revision DESC
+LIMIT
+  1;
+
+-- name: ListConfigRevisions :many
+SELECT
+  revision,
+  created_at
+FROM
+  config_revisions
+WHERE
+  profile_id = sqlc.arg ('profile_id')
+ORDER BY
+  revision DESC
+LIMIT
+  COALESCE(CAST(sqlc.narg ('limit') AS INTEGER), -1)
+OFFSET
+  COALESCE(CAST(sqlc.narg ('offset') AS INTEGER), 0);
+
+-- name: CountConfigRevisions :one
+SELECT
+  COUNT(*)
+FROM
+  config_revisions
+WHERE
+  profile_id = ?;
+
+-- name: DeleteOldConfigRevisions :exec
+DELETE FROM config_revisions
+WHERE
+  profile_id = sqlc.arg ('profile_id')
+  AND revision <= (
+    SELECT
+      MAX(revision)
+    FROM
+      config_revisions
+    WHERE
+      profile_id = sqlc.arg ('profile_id')
+  ) - sqlc.arg ('keep')
+  AND revision IS NOT (
+    SELECT
+      published_revision
+    FROM
+      profiles
+    WHERE
+      id = sqlc.arg ('profile_id')
+  );
@@ -0,0 +1,45 @@
+-- name: CreateConfigTemplate :one
+INSERT INTO
+  config_templates (id, name, description, variables, content)
+VALUES
+  (?, ?, ?, ?, ?) RETURNING *;
+
+-- name: GetConfigTemplate :one
+SELECT
+  *
+FROM
+  config_templates
+WHERE
+  id = ?;
+
+-- name: GetConfigTemplateByName :one
+SELECT
+  *
+FROM
+  config_templates
+WHERE
+  name = ?;
+
+-- name: ListConfigTemplates :many
+SELECT
+  *
+FROM
+  config_templates
+ORDER BY
+  name;
+
+-- name: UpdateConfigTemplate :one
+UPDATE config_templates
+SET
+  name = ?,
+  description = ?,
+  variables = ?,
+  content = ?,
+  updated_at = CURRENT_TIMESTAMP
+WHERE
+  id = ? RETURNING *;
+
+-- name: DeleteConfigTemplate :exec
+DELETE FROM config_templates
+WHERE
+  id = ?;
@@ -24,6 +24,7 @@ SELECT
   hr.id AS router_id,
   hr.name AS router_name,
   hr.config AS config_json,
+  p.id AS profile_id,
   p.name AS profile_name,
   dp.id AS dns_provider_id,
   dp.name AS dns_provider_name
@@ -12,6 +12,15 @@ FROM
 WHERE
   id = ?;
 
+-- name: GetHttpRouterByName :one
+SELECT
+  *
+FROM
+  http_routers
+WHERE
+  profile_id = ?
+  AND name = ?;
+
 -- name: GetHttpRoutersUsingEntryPoint :many
 WITH
   ep_name AS (
@@ -0,0 +1,39 @@
+-- name: CreateProfileMember :one
+INSERT INTO
+  profile_members (profile_id, user_id, role)
+VALUES
+  (?, ?, ?) RETURNING *;
+
+-- name: GetProfileMember :one
+SELECT
+  *
+FROM
+  profile_members
+WHERE
+  profile_id = ?
+  AND user_id = ?;
+
+-- name: ListProfileMembers :many
+SELECT
+  *
+FROM
+  profile_members
+WHERE
+  profile_id = ?
+ORDER BY
+  created_at;
+
+-- name: UpdateProfileMember :one
+UPDATE profile_members
+SET
+  role = ?,
+  updated_at = CURRENT_TIMESTAMP
+WHERE
+  profile_id = ?
+  AND user_id = ? RETURNING *;
+
+-- name: DeleteProfileMember :exec
+DELETE FROM profile_members
+WHERE
+  profile_id = ?
+  AND user_id = ?;
@@ -0,0 +1,38 @@
+-- name: CreateProfileVariable :one
+INSERT INTO
+  profile_variables (id, profile_id, name, value, description)
+VALUES
+  (?, ?, ?, ?, ?) RETURNING *;
+
+-- name: GetProfileVariable :one
+SELECT
+  *
+FROM
+  profile_variables
+WHERE
+  id = ?;
+
+-- name: ListProfileVariables :many
+SELECT
+  *
+FROM
+  profile_variables
+WHERE
+  profile_id = ?
+ORDER BY
+  name;
+
+-- name: UpdateProfileVariable :one
+UPDATE profile_variables
+SET
+  name = ?,
+  value = ?,
+  description = ?,
+  updated_at = CURRENT_TIMESTAMP
+WHERE
+  id = ? RETURNING *;
+
+-- name: DeleteProfileVariable :exec
+DELETE FROM profile_variables
+WHERE
+  id = ?;
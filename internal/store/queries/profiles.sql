@@ -32,12 +32,35 @@ LIMIT
 OFFSET
   COALESCE(CAST(sqlc.narg ('offset') AS INTEGER), 0);
 
+-- name: ListProfilesByMember :many
+SELECT
+  p.*
+FROM
+  profiles p
+  JOIN profile_members m ON m.profile_id = p.id
+WHERE
+  m.user_id = sqlc.arg ('user_id')
+ORDER BY
+  p.created_at DESC
+LIMIT
+  COALESCE(CAST(sqlc.narg ('limit') AS INTEGER), -1)
+OFFSET
+  COALESCE(CAST(sqlc.narg ('offset') AS INTEGER), 0);
+
 -- name: CountProfiles :one
 SELECT
   COUNT(*)
 FROM
   profiles;
 
+-- name: CountProfilesByMember :one
+SELECT
+  COUNT(*)
+FROM
+  profile_members
+WHERE
+  user_id = ?;
+
 -- name: UpdateProfile :one
 UPDATE profiles
 SET
@@ -48,6 +71,14 @@ SET
 WHERE
   id = ? RETURNING *;
 
+-- name: UpdateProfilePublishedRevision :one
+UPDATE profiles
+SET
+  published_revision = ?,
+  updated_at = CURRENT_TIMESTAMP
+WHERE
+  id = ? RETURNING *;
+
 -- name: DeleteProfile :exec
 DELETE FROM profiles
 WHERE
@@ -0,0 +1,24 @@
+-- name: CreateRecoveryCode :exec
+INSERT INTO
+  recovery_codes (user_id, code_hash)
+VALUES
+  (?, ?);
+
+-- name: CountRecoveryCodes :one
+SELECT
+  COUNT(*)
+FROM
+  recovery_codes
+WHERE
+  user_id = ?;
+
+-- name: DeleteRecoveryCode :execrows
+DELETE FROM recovery_codes
+WHERE
+  user_id = ?
+  AND code_hash = ?;
+
+-- name: DeleteRecoveryCodesByUser :exec
+DELETE FROM recovery_codes
+WHERE
+  user_id = ?;
@@ -0,0 +1,78 @@
+-- name: CreateServiceHealthCheck :one
+INSERT INTO
+  service_health_checks (
+    profile_id,
+    service_id,
+    protocol,
+    server,
+    healthy,
+    status_code,
+    latency_ms,
+    error
+  )
+VALUES
+  (?, ?, ?, ?, ?, ?, ?, ?) RETURNING *;
+
+-- name: ListLatestServiceHealthChecks :many
+SELECT
+  *
+FROM
+  service_health_checks
+WHERE
+  id IN (
+    SELECT
+      MAX(id)
+    FROM
+      service_health_checks
+    WHERE
+      profile_id = ?
+    GROUP BY
+      service_id,
+      protocol,
+      server
+  )
+ORDER BY
+  service_id,
+  server;
+
+-- name: ListServiceHealthChecks :many
+SELECT
+  *
+FROM
+  service_health_checks
+WHERE
+  service_id = sqlc.arg ('service_id')
+  AND protocol = sqlc.arg ('protocol')
+ORDER BY
+  checked_at DESC,
+  id DESC
+LIMIT
+  COALESCE(CAST(sqlc.narg ('limit') AS INTEGER), -1)
+OFFSET
+  COALESCE(CAST(sqlc.narg ('offset') AS INTEGER), 0);
+
+-- name: DeleteOldServiceHealthChecks :exec
+DELETE FROM service_health_checks
+WHERE
+  checked_at < DATETIME (
+    'now',
+    '-' || CAST(sqlc.arg ('max_age_seconds') AS INTEGER) || ' seconds'
+  )
+  OR (
+    protocol = 'http'
+    AND service_id NOT IN (
+      SELECT
+        id
+      FROM
+        http_services
+    )
+  )
+  OR (
+    protocol = 'tcp'
+    AND service_id NOT IN (
+      SELECT
+        id
+      FROM
+        tcp_services
+    )
+  );
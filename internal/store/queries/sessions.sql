@@ -0,0 +1,51 @@
+-- name: CreateSession :one
+INSERT INTO
+  sessions (id, user_id, user_agent, ip_address, expires_at)
+VALUES
+  (?, ?, ?, ?, ?) RETURNING *;
+
+-- name: GetSession :one
+SELECT
+  *
+FROM
+  sessions
+WHERE
+  id = ?;
+
+-- name: ListSessionsByUser :many
+SELECT
+  *
+FROM
+  sessions
+WHERE
+  user_id = ?
+ORDER BY
+  created_at DESC;
+
+-- name: UpdateSessionLastSeen :exec
+UPDATE sessions
+SET
+  last_seen_at = CURRENT_TIMESTAMP
+WHERE
+  id = ?;
+
+-- name: DeleteSession :exec
+DELETE FROM sessions
+WHERE
+  id = ?;
+
+-- name: DeleteSessionsByUser :exec
+DELETE FROM sessions
+WHERE
+  user_id = ?;
+
+-- name: DeleteOtherSessionsByUser :exec
+DELETE FROM sessions
+WHERE
+  user_id = ?
+  AND id != ?;
+
+-- name: DeleteExpiredSessions :exec
+DELETE FROM sessions
+WHERE
+  expires_at < ?;
@@ -24,6 +24,7 @@ SELECT
   tr.id AS router_id,
   tr.name AS router_name,
   tr.config AS config_json,
+  p.id AS profile_id,
   p.name AS profile_name,
   dp.id AS dns_provider_id,
   dp.name AS dns_provider_name
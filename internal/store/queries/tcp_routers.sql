@@ -12,6 +12,15 @@ FROM
 WHERE
   id = ?;
 
+-- name: GetTcpRouterByName :one
+SELECT
+  *
+FROM
+  tcp_routers
+WHERE
+  profile_id = ?
+  AND name = ?;
+
 -- name: GetTcpRoutersUsingEntryPoint :many
 WITH
   ep_name AS (
@@ -0,0 +1,85 @@
+-- name: UpsertTraefikInstance :one
+INSERT INTO
+  traefik_instances (profile_id, name, url, revision)
+VALUES
+  (
+    sqlc.arg ('profile_id'),
+    sqlc.arg ('name'),
+    sqlc.narg ('url'),
+    COALESCE(
+      CAST(sqlc.narg ('revision') AS INTEGER),
+      (
+        SELECT
+          MAX(revision)
+        FROM
+          config_revisions
+        WHERE
+          profile_id = sqlc.arg ('profile_id')
+      )
+    )
+  ) ON CONFLICT (profile_id, name) DO
+UPDATE
+SET
+  url = COALESCE(excluded.url, url),
+  revision = excluded.revision,
+  last_fetch = CURRENT_TIMESTAMP RETURNING *;
+
+-- name: GetTraefikInstance :one
+SELECT
+  *
+FROM
+  traefik_instances
+WHERE
+  id = ?;
+
+-- name: ListTraefikInstances :many
+SELECT
+  *
+FROM
+  traefik_instances
+WHERE
+  profile_id = ?
+ORDER BY
+  name;
+
+-- name: ListPollableTraefikInstances :many
+SELECT
+  *
+FROM
+  traefik_instances
+WHERE
+  url IS NOT NULL
+  AND url != ''
+ORDER BY
+  profile_id,
+  name;
+
+-- name: UpdateTraefikInstanceRuntime :exec
+UPDATE traefik_instances
+SET
+  runtime = ?,
+  runtime_error = NULL,
+  runtime_at = CURRENT_TIMESTAMP
+WHERE
+  id = ?;
+
+-- name: UpdateTraefikInstanceRuntimeError :exec
+UPDATE traefik_instances
+SET
+  runtime_error = ?,
+  runtime_at = CURRENT_TIMESTAMP
+WHERE
+  id = ?;
+
+-- name: UpdateTraefikInstanceDrift :exec
+UPDATE traefik_instances
+SET
+  drift = ?,
+  drift_at = CURRENT_TIMESTAMP
+WHERE
+  id = ?;
+
+-- name: DeleteTraefikInstance :exec
+DELETE FROM traefik_instances
+WHERE
+  id = ?;
@@ -1,8 +1,8 @@
 -- name: CreateUser :one
 INSERT INTO
-  users (id, username, password, email)
+  users (id, username, password, email, role)
 VALUES
-  (?, ?, ?, ?) RETURNING *;
+  (?, ?, ?, ?, ?) RETURNING *;
 
 -- name: GetUserByID :one
 SELECT
@@ -46,6 +46,14 @@ SELECT
 FROM
   users;
 
+-- name: CountUsersByRole :one
+SELECT
+  COUNT(*)
+FROM
+  users
+WHERE
+  role = ?;
+
 -- name: UpdateUser :one
 UPDATE users
 SET
@@ -55,6 +63,62 @@ SET
 WHERE
   id = ? RETURNING *;
 
+-- name: UpdateUserRole :one
+UPDATE users
+SET
+  role = ?,
+  updated_at = CURRENT_TIMESTAMP
+WHERE
+  id = ? RETURNING *;
+
+-- name: UpdateUserTotp :exec
+UPDATE users
+SET
+  totp_secret = ?,
+  totp_enabled = ?,
+  totp_last_step = NULL,
+  totp_failed_attempts = 0,
+  totp_locked_until = NULL,
+  updated_at = CURRENT_TIMESTAMP
+WHERE
+  id = ?;
+
+-- name: UpdateUserTotpStep :execrows
+UPDATE users
+SET
+  totp_last_step = sqlc.arg ('step'),
+  totp_failed_attempts = 0,
+  totp_locked_until = NULL
+WHERE
+  id = sqlc.arg ('id')
+  AND (
+    totp_last_step IS NULL
+    OR totp_last_step < sqlc.arg ('step')
+  );
+
+-- name: IncrementUserTotpFailures :one
+UPDATE users
+SET
+  totp_failed_attempts = totp_failed_attempts + 1
+WHERE
+  id = ? RETURNING totp_failed_attempts;
+
+-- name: LockUserTotp :exec
+UPDATE users
+SET
+  totp_failed_attempts = 0,
+  totp_locked_until = ?
+WHERE
+  id = ?;
+
+-- name: ResetUserTotpFailures :exec
+UPDATE users
+SET
+  totp_failed_attempts = 0,
+  totp_locked_until = NULL
+WHERE
+  id = ?;
+
 -- name: UpdateUserLastLogin :exec
 UPDATE users
 SET
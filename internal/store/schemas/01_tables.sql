@@ -5,7 +5,13 @@ CREATE TABLE IF NOT EXISTS users (
   email TEXT,
   last_login TIMESTAMP,
   created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  role TEXT NOT NULL DEFAULT 'admin',
+  totp_secret TEXT,
+  totp_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  totp_last_step INTEGER,
+  totp_failed_attempts INTEGER NOT NULL DEFAULT 0,
+  totp_locked_until TIMESTAMP
 );
 
 CREATE TABLE IF NOT EXISTS profiles (
@@ -15,6 +21,7 @@ CREATE TABLE IF NOT EXISTS profiles (
   token TEXT NOT NULL,
   created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
   updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  published_revision INTEGER,
   FOREIGN KEY (id) REFERENCES profiles (id) ON DELETE CASCADE
 );
 
@@ -55,7 +62,115 @@ CREATE TABLE IF NOT EXISTS audit_logs (
   agent_id TEXT,
   event TEXT NOT NULL,
   details TEXT,
-  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  token_id TEXT
+);
+
+CREATE TABLE IF NOT EXISTS config_revisions (
+  id INTEGER PRIMARY KEY,
+  profile_id INTEGER NOT NULL,
+  revision INTEGER NOT NULL,
+  config TEXT NOT NULL,
+  snapshot TEXT NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (profile_id) REFERENCES profiles (id) ON DELETE CASCADE,
+  UNIQUE (profile_id, revision)
+);
+
+CREATE TABLE IF NOT EXISTS service_health_checks (
+  id INTEGER PRIMARY KEY,
+  profile_id INTEGER NOT NULL,
+  service_id TEXT NOT NULL,
+  protocol TEXT NOT NULL,
+  server TEXT NOT NULL,
+  healthy BOOLEAN NOT NULL,
+  status_code INTEGER,
+  latency_ms INTEGER NOT NULL,
+  error TEXT,
+  checked_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (profile_id) REFERENCES profiles (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS traefik_instances (
+  id INTEGER PRIMARY KEY,
+  profile_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  url TEXT,
+  revision INTEGER,
+  last_fetch TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  runtime TEXT,
+  runtime_error TEXT,
+  runtime_at TIMESTAMP,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  drift TEXT,
+  drift_at TIMESTAMP,
+  FOREIGN KEY (profile_id) REFERENCES profiles (id) ON DELETE CASCADE,
+  UNIQUE (profile_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS config_templates (
+  id TEXT PRIMARY KEY,
+  name TEXT NOT NULL UNIQUE,
+  description TEXT,
+  variables TEXT NOT NULL,
+  content TEXT NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS profile_variables (
+  id TEXT PRIMARY KEY,
+  profile_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  value TEXT NOT NULL,
+  description TEXT,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (profile_id) REFERENCES profiles (id) ON DELETE CASCADE,
+  UNIQUE (profile_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS profile_members (
+  profile_id INTEGER NOT NULL,
+  user_id TEXT NOT NULL,
+  role TEXT NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (profile_id, user_id),
+  FOREIGN KEY (profile_id) REFERENCES profiles (id) ON DELETE CASCADE,
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS api_tokens (
+  id TEXT PRIMARY KEY,
+  user_id TEXT NOT NULL,
+  name TEXT NOT NULL,
+  token_hash TEXT NOT NULL UNIQUE,
+  role TEXT NOT NULL,
+  expires_at TIMESTAMP,
+  last_used_at TIMESTAMP,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+  UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS sessions (
+  id TEXT PRIMARY KEY,
+  user_id TEXT NOT NULL,
+  user_agent TEXT,
+  ip_address TEXT,
+  expires_at TIMESTAMP NOT NULL,
+  last_seen_at TIMESTAMP,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+  user_id TEXT NOT NULL,
+  code_hash TEXT NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id, code_hash),
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
 );
 
 CREATE TABLE IF NOT EXISTS entry_points (
@@ -6,6 +6,8 @@ CREATE INDEX idx_http_servers_transports_profile_name ON http_servers_transports
 
 CREATE INDEX idx_http_services_profile_name ON http_services (profile_id, name);
 
+CREATE INDEX idx_service_health_checks_service ON service_health_checks (service_id, protocol, checked_at);
+
 CREATE INDEX idx_tcp_middlewares_profile_name ON tcp_middlewares (profile_id, name);
 
 CREATE INDEX idx_tcp_routers_profile_name ON tcp_routers (profile_id, name);
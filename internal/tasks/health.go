@@ -0,0 +1,385 @@
+package tasks
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mizuchilabs/mantrae/internal/settings"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/traefik"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+const (
+	defaultProbeTimeout = 5 * time.Second
+	maxConcurrentProbes = 16
+)
+
+// Probes check reachability, certificates are Traefik's concern
+var probeTLSConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402
+
+// probeTarget is a server of a service to probe.
+type probeTarget struct {
+	profileID int64
+	serviceID string
+	protocol  string
+	server    string
+	interval  time.Duration // from the service's health check, 0 for every run
+	probe     func(ctx context.Context) probeResult
+}
+
+type probeResult struct {
+	statusCode *int64
+	latency    time.Duration
+	err        error
+}
+
+// prober probes service servers and remembers when each was probed last.
+type prober struct {
+	transport *http.Transport
+	last      map[string]time.Time
+}
+
+func newProber() *prober {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = probeTLSConfig
+	return &prober{transport: transport, last: make(map[string]time.Time)}
+}
+
+// probeServices periodically probes the servers of enabled HTTP and TCP
+// services and records the results.
+func (s *Scheduler) probeServices() {
+	interval, ok := s.cfg.SM.Get(s.ctx, settings.KeyServiceHealthInterval)
+	if !ok {
+		slog.Error("failed to get service health interval setting")
+		return
+	}
+
+	ticker := time.NewTicker(settings.AsDuration(interval))
+	defer ticker.Stop()
+
+	p := newProber()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			enabled, ok := s.cfg.SM.Get(s.ctx, settings.KeyServiceHealthEnabled)
+			if !ok || enabled != "true" {
+				continue
+			}
+			p.run(s.ctx, s.cfg.Conn.Q)
+
+			retention, ok := s.cfg.SM.Get(s.ctx, settings.KeyServiceHealthRetention)
+			if !ok {
+				slog.Error("failed to get service health retention setting")
+				continue
+			}
+			maxAge := int64(settings.AsDuration(retention).Seconds())
+			if err := s.cfg.Conn.Q.DeleteOldServiceHealthChecks(s.ctx, maxAge); err != nil {
+				slog.Error("failed to delete old service health checks", "error", err)
+			}
+		}
+	}
+}
+
+func (p *prober) run(ctx context.Context, q *db.Queries) {
+	targets, err := p.targets(ctx, q)
+	if err != nil {
+		slog.Error("failed to list services to probe", "error", err)
+		return
+	}
+
+	now := time.Now()
+	var due []probeTarget
+	for _, t := range targets {
+		key := t.protocol + "/" + t.serviceID + "/" + t.server
+		if last, ok := p.last[key]; ok && now.Sub(last) < t.interval {
+			continue
+		}
+		p.last[key] = now
+		due = append(due, t)
+	}
+
+	results := make([]probeResult, len(due))
+	sem := make(chan struct{}, maxConcurrentProbes)
+	var wg sync.WaitGroup
+	for i, t := range due {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = t.probe(ctx)
+		})
+	}
+	wg.Wait()
+
+	for i, t := range due {
+		result := results[i]
+		params := &db.CreateServiceHealthCheckParams{
+			ProfileID:  t.profileID,
+			ServiceID:  t.serviceID,
+			Protocol:   t.protocol,
+			Server:     t.server,
+			Healthy:    result.err == nil,
+			StatusCode: result.statusCode,
+			LatencyMs:  result.latency.Milliseconds(),
+		}
+		if result.err != nil {
+			msg := result.err.Error()
+			params.Error = &msg
+		}
+		if _, err := q.CreateServiceHealthCheck(ctx, params); err != nil {
+			slog.Error("failed to record service health check", "error", err)
+		}
+	}
+}
+
+// targets lists the servers of all enabled HTTP and TCP load balancers.
+func (p *prober) targets(ctx context.Context, q *db.Queries) ([]probeTarget, error) {
+	profiles, err := q.ListProfiles(ctx, &db.ListProfilesParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []probeTarget
+	for _, profile := range profiles {
+		vars, err := traefik.ProfileVariables(ctx, q, profile.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		httpServices, err := q.ListHttpServices(
+			ctx,
+			&db.ListHttpServicesParams{ProfileID: profile.ID},
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, svc := range httpServices {
+			if !svc.Enabled || svc.Config == nil || svc.Config.Data == nil ||
+				svc.Config.Data.LoadBalancer == nil {
+				continue
+			}
+			lb := traefik.ExpandItem(svc.Config.Data, vars).LoadBalancer
+			hc := lb.HealthCheck
+			var interval time.Duration
+			if hc != nil {
+				interval = time.Duration(hc.Interval)
+			}
+			for _, server := range lb.Servers {
+				targets = append(targets, probeTarget{
+					profileID: profile.ID,
+					serviceID: svc.ID,
+					protocol:  "http",
+					server:    server.URL,
+					interval:  interval,
+					probe: func(ctx context.Context) probeResult {
+						return p.probeHTTP(ctx, server.URL, hc)
+					},
+				})
+			}
+		}
+
+		tcpServices, err := q.ListTcpServices(
+			ctx,
+			&db.ListTcpServicesParams{ProfileID: profile.ID},
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, svc := range tcpServices {
+			if !svc.Enabled || svc.Config == nil || svc.Config.Data == nil ||
+				svc.Config.Data.LoadBalancer == nil {
+				continue
+			}
+			lb := traefik.ExpandItem(svc.Config.Data, vars).LoadBalancer
+			hc := lb.HealthCheck
+			var interval time.Duration
+			if hc != nil {
+				interval = time.Duration(hc.Interval)
+			}
+			for _, server := range lb.Servers {
+				targets = append(targets, probeTarget{
+					profileID: profile.ID,
+					serviceID: svc.ID,
+					protocol:  "tcp",
+					server:    server.Address,
+					interval:  interval,
+					probe: func(ctx context.Context) probeResult {
+						return probeTCP(ctx, server, hc)
+					},
+				})
+			}
+		}
+	}
+	return targets, nil
+}
+
+// probeHTTP requests a server the way Traefik's health check would. Without a
+// health check any response counts as reachable.
+func (p *prober) probeHTTP(
+	ctx context.Context,
+	server string,
+	hc *dynamic.ServerHealthCheck,
+) probeResult {
+	target, err := url.Parse(server)
+	if err != nil {
+		return probeResult{err: err}
+	}
+	method := http.MethodGet
+	timeout := defaultProbeTimeout
+	if hc != nil {
+		if hc.Mode == "grpc" {
+			// The gRPC health protocol is not spoken, only reachability is checked
+			return probeTCP(ctx, dynamic.TCPServer{Address: hostPort(target)}, nil)
+		}
+		if hc.Scheme != "" {
+			target.Scheme = hc.Scheme
+		}
+		if hc.Port != 0 {
+			target.Host = net.JoinHostPort(target.Hostname(), strconv.Itoa(hc.Port))
+		}
+		if hc.Path != "" {
+			path, err := url.Parse(hc.Path)
+			if err != nil {
+				return probeResult{err: err}
+			}
+			target.Path, target.RawQuery = path.Path, path.RawQuery
+		}
+		method = cmp.Or(hc.Method, method)
+		if hc.Timeout > 0 {
+			timeout = time.Duration(hc.Timeout)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), nil)
+	if err != nil {
+		return probeResult{err: err}
+	}
+	client := &http.Client{Transport: p.transport}
+	if hc != nil {
+		if hc.Hostname != "" {
+			req.Host = hc.Hostname
+		}
+		for key, value := range hc.Headers {
+			req.Header.Set(key, value)
+		}
+		if hc.FollowRedirects != nil && !*hc.FollowRedirects {
+			client.CheckRedirect = func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			}
+		}
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	result := probeResult{latency: time.Since(start), err: err}
+	if err != nil {
+		return result
+	}
+	_ = resp.Body.Close()
+
+	code := int64(resp.StatusCode)
+	result.statusCode = &code
+	if hc != nil {
+		// Traefik expects the configured status, or any 2xx and 3xx
+		healthy := resp.StatusCode == hc.Status ||
+			hc.Status == 0 && resp.StatusCode >= 200 && resp.StatusCode < 400
+		if !healthy {
+			result.err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+		}
+	}
+	return result
+}
+
+// probeTCP connects to a server and, with a health check, sends its payload
+// and waits for the expected reply.
+func probeTCP(
+	ctx context.Context,
+	server dynamic.TCPServer,
+	hc *dynamic.TCPServerHealthCheck,
+) probeResult {
+	address := server.Address
+	timeout := defaultProbeTimeout
+	if hc != nil {
+		if hc.Port != 0 {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return probeResult{err: err}
+			}
+			address = net.JoinHostPort(host, strconv.Itoa(hc.Port))
+		}
+		if hc.Timeout > 0 {
+			timeout = time.Duration(hc.Timeout)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	start := time.Now()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return probeResult{latency: time.Since(start), err: err}
+	}
+	defer func() { _ = conn.Close() }()
+	if server.TLS {
+		tlsConn := tls.Client(conn, probeTLSConfig)
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			return probeResult{latency: time.Since(start), err: err}
+		}
+		conn = tlsConn
+	}
+
+	if hc != nil && (hc.Send != "" || hc.Expect != "") {
+		if deadline, ok := ctx.Deadline(); ok {
+			_ = conn.SetDeadline(deadline)
+		}
+		err = exchange(conn, hc.Send, hc.Expect)
+	}
+	return probeResult{latency: time.Since(start), err: err}
+}
+
+func exchange(conn net.Conn, send, expect string) error {
+	if send != "" {
+		if _, err := io.WriteString(conn, send); err != nil {
+			return err
+		}
+	}
+	if expect == "" {
+		return nil
+	}
+	buf := make([]byte, len(expect))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return err
+	}
+	if string(buf) != expect {
+		return errors.New("unexpected response")
+	}
+	return nil
+}
+
+// hostPort returns the address of a URL, with the scheme's default port.
+func hostPort(u *url.URL) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	port := "80"
+	if u.Scheme == "https" {
+		port = "443"
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
@@ -24,6 +24,9 @@ func NewScheduler(ctx context.Context, cfg *config.App) *Scheduler {
 func (s *Scheduler) Start() {
 	go s.syncDNS()
 	go s.cleanupAgents()
+	go s.probeServices()
+	go s.pollTraefikInstances()
+	go s.cleanupSessions()
 }
 
 // syncDNS periodically syncs the DNS records
@@ -49,6 +52,23 @@ func (s *Scheduler) syncDNS() {
 	}
 }
 
+// cleanupSessions periodically deletes expired user sessions
+func (s *Scheduler) cleanupSessions() {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.cfg.Conn.Q.DeleteExpiredSessions(s.ctx, time.Now().UTC()); err != nil {
+				slog.Error("failed to delete expired sessions", "error", err)
+			}
+		}
+	}
+}
+
 func (s *Scheduler) cleanupAgents() {
 	duration, ok := s.cfg.SM.Get(s.ctx, settings.KeyAgentCleanupInterval)
 	if !ok {
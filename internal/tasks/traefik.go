@@ -0,0 +1,101 @@
+package tasks
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/mizuchilabs/mantrae/internal/settings"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/traefik"
+)
+
+// runtimeFetchTimeout bounds all requests to one Traefik instance per poll.
+const runtimeFetchTimeout = 30 * time.Second
+
+// pollTraefikInstances periodically reads the runtime status of the Traefik
+// instances that announced their API URL when fetching their config, and
+// compares what they run with the configuration served to them.
+func (s *Scheduler) pollTraefikInstances() {
+	interval, ok := s.cfg.SM.Get(s.ctx, settings.KeyTraefikSyncInterval)
+	if !ok {
+		slog.Error("failed to get traefik sync interval setting")
+		return
+	}
+
+	ticker := time.NewTicker(settings.AsDuration(interval))
+	defer ticker.Stop()
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = probeTLSConfig
+	client := &http.Client{Transport: transport}
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			enabled, ok := s.cfg.SM.Get(s.ctx, settings.KeyTraefikPollEnabled)
+			if !ok || enabled != "true" {
+				continue
+			}
+			s.pollInstances(client)
+		}
+	}
+}
+
+func (s *Scheduler) pollInstances(client *http.Client) {
+	q := s.cfg.Conn.Q
+	instances, err := q.ListPollableTraefikInstances(s.ctx)
+	if err != nil {
+		slog.Error("failed to list traefik instances", "error", err)
+		return
+	}
+
+	for _, instance := range instances {
+		if err = s.pollInstance(client, instance); err != nil {
+			msg := err.Error()
+			err = q.UpdateTraefikInstanceRuntimeError(
+				s.ctx,
+				&db.UpdateTraefikInstanceRuntimeErrorParams{RuntimeError: &msg, ID: instance.ID},
+			)
+		}
+		if err != nil {
+			slog.Error("failed to update traefik instance", "instance", instance.Name, "error", err)
+		}
+	}
+}
+
+func (s *Scheduler) pollInstance(client *http.Client, instance *db.TraefikInstance) error {
+	q := s.cfg.Conn.Q
+	ctx, cancel := context.WithTimeout(s.ctx, runtimeFetchTimeout)
+	defer cancel()
+
+	items, err := traefik.FetchRuntime(ctx, client, *instance.Url)
+	if err != nil {
+		return err
+	}
+	if err = q.UpdateTraefikInstanceRuntime(s.ctx, &db.UpdateTraefikInstanceRuntimeParams{
+		Runtime: &db.TraefikRuntime{Data: &items},
+		ID:      instance.ID,
+	}); err != nil {
+		return err
+	}
+
+	profile, err := q.GetProfile(s.ctx, instance.ProfileID)
+	if err != nil {
+		return err
+	}
+	cached, err := s.cfg.History.Live(s.ctx, q, *profile)
+	if err != nil {
+		return err
+	}
+	drift, err := traefik.DetectDrift(ctx, client, *instance.Url, cached.Config)
+	if err != nil {
+		return err
+	}
+	return q.UpdateTraefikInstanceDrift(s.ctx, &db.UpdateTraefikInstanceDriftParams{
+		Drift: &db.TraefikDrift{Data: &drift},
+		ID:    instance.ID,
+	})
+}
@@ -0,0 +1,143 @@
+package traefik
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"expvar"
+	"sync"
+
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	cacheHits   = new(expvar.Int)
+	cacheMisses = new(expvar.Int)
+)
+
+func init() {
+	stats := expvar.NewMap("config_cache")
+	stats.Set("hits", cacheHits)
+	stats.Set("misses", cacheMisses)
+	stats.Set("hit_rate", expvar.Func(func() any {
+		hits, misses := cacheHits.Value(), cacheMisses.Value()
+		if hits+misses == 0 {
+			return 0.0
+		}
+		return float64(hits) / float64(hits+misses)
+	}))
+}
+
+// CachedConfig is a built dynamic configuration together with its serialized
+// representations.
+type CachedConfig struct {
+	Revision string
+	Config   *dynamic.Configuration
+	JSON     []byte
+	YAML     []byte
+	TOML     []byte
+}
+
+// ConfigCache keeps the latest built dynamic configuration per profile. An
+// entry is valid as long as the profile revision it was built for is still
+// current, so every Bump on the revisions implicitly invalidates it.
+type ConfigCache struct {
+	mu        sync.Mutex
+	revisions *Revisions
+	entries   map[int64]*CachedConfig
+}
+
+func NewConfigCache(revisions *Revisions) *ConfigCache {
+	return &ConfigCache{
+		revisions: revisions,
+		entries:   make(map[int64]*CachedConfig),
+	}
+}
+
+// Get returns the cached configuration of the profile, building it if the
+// cached entry is missing or outdated.
+func (c *ConfigCache) Get(
+	ctx context.Context,
+	q *db.Queries,
+	profile db.Profile,
+) (*CachedConfig, error) {
+	rev, _ := c.revisions.Current(profile.ID)
+
+	c.mu.Lock()
+	entry, ok := c.entries[profile.ID]
+	c.mu.Unlock()
+	if ok && entry.Revision == rev {
+		cacheHits.Add(1)
+		return entry, nil
+	}
+	cacheMisses.Add(1)
+
+	cfg, err := BuildDynamicConfig(ctx, q, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry, err = EncodeConfig(rev, cfg); err != nil {
+		return nil, err
+	}
+
+	// Only keep the entry if nothing changed while it was being built
+	c.mu.Lock()
+	if current, _ := c.revisions.Current(profile.ID); current == rev {
+		c.entries[profile.ID] = entry
+	}
+	c.mu.Unlock()
+
+	return entry, nil
+}
+
+// EncodeConfig serializes the configuration into all published formats.
+func EncodeConfig(revision string, cfg *dynamic.Configuration) (*CachedConfig, error) {
+	entry := &CachedConfig{Revision: revision, Config: cfg}
+	var buf bytes.Buffer
+	jsonEnc := json.NewEncoder(&buf)
+	jsonEnc.SetIndent("", "  ")
+	if err := jsonEnc.Encode(cfg); err != nil {
+		return nil, err
+	}
+	entry.JSON = bytes.Clone(buf.Bytes())
+
+	buf.Reset()
+	yamlEnc := yaml.NewEncoder(&buf)
+	yamlEnc.SetIndent(2)
+	if err := yamlEnc.Encode(cfg); err != nil {
+		return nil, err
+	}
+	if err := yamlEnc.Close(); err != nil {
+		return nil, err
+	}
+	entry.YAML = bytes.Clone(buf.Bytes())
+
+	var err error
+	if entry.TOML, err = EncodeTOML(cfg); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Encoded returns the configuration in the given format ("json", "yaml" or
+// "toml"), falling back to JSON.
+func (c *CachedConfig) Encoded(format string) []byte {
+	switch format {
+	case "yaml":
+		return c.YAML
+	case "toml":
+		return c.TOML
+	default:
+		return c.JSON
+	}
+}
+
+// Evict drops the cached configuration of a profile.
+func (c *ConfigCache) Evict(profileID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, profileID)
+}
@@ -0,0 +1,332 @@
+package traefik
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/rs/zerolog"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/config/label"
+	"github.com/traefik/traefik/v3/pkg/observability/logs"
+	"github.com/traefik/traefik/v3/pkg/provider"
+	"gopkg.in/yaml.v3"
+)
+
+// ComposeImport holds the dynamic configuration built from the labels of a
+// Docker Compose file, together with the problems Traefik would have logged.
+type ComposeImport struct {
+	Config   *dynamic.Configuration
+	Warnings []string
+}
+
+// FromCompose runs Traefik's label parser over the services of a Docker
+// Compose file and completes the result the way the Docker provider does for
+// running containers (default services, routers and rules).
+//
+// Services are imported if they carry traefik labels and are not disabled via
+// traefik.enable=false. As there are no container IPs, servers point at the
+// compose service name, which resolves on the project's network.
+func FromCompose(data []byte) (*ComposeImport, error) {
+	var file struct {
+		Services map[string]composeService `yaml:"services"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid compose file: %w", err)
+	}
+	if len(file.Services) == 0 {
+		return nil, errors.New("compose file has no services")
+	}
+
+	defaultRule, err := provider.MakeDefaultRuleTemplate("Host(`{{ normalize .Name }}`)", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &logCollector{}
+	logger := zerolog.New(collector).Level(zerolog.InfoLevel)
+	ctx := logger.WithContext(context.Background())
+
+	configurations := make(map[string]*dynamic.Configuration)
+	for _, name := range slices.Sorted(maps.Keys(file.Services)) {
+		svc := file.Services[name]
+		labels := svc.labels()
+		if !svc.enabled(labels) {
+			continue
+		}
+
+		svcLogger := logger.With().Str("composeService", name).Logger()
+		svcCtx := svcLogger.WithContext(ctx)
+		if cfg, err := svc.build(svcCtx, name, labels, defaultRule); err != nil {
+			svcLogger.Error().Err(err).Msg("Skipping service")
+		} else {
+			configurations[name] = cfg
+		}
+	}
+
+	cfg := provider.Merge(ctx, provider.NameSortedConfigurations(configurations), provider.ResourceStrategyMerge)
+	return &ComposeImport{Config: cfg, Warnings: collector.entries}, nil
+}
+
+type composeService struct {
+	Labels composeLabels `yaml:"labels"`
+	Deploy struct {
+		Labels composeLabels `yaml:"labels"`
+	} `yaml:"deploy"`
+	Ports  composePorts `yaml:"ports"`
+	Expose composePorts `yaml:"expose"`
+}
+
+// labels merges the container and (swarm) deploy labels.
+func (s composeService) labels() map[string]string {
+	labels := make(map[string]string, len(s.Labels)+len(s.Deploy.Labels))
+	maps.Copy(labels, s.Labels)
+	maps.Copy(labels, s.Deploy.Labels)
+	return labels
+}
+
+func (s composeService) enabled(labels map[string]string) bool {
+	if enable, ok := labels["traefik.enable"]; ok {
+		enabled, err := strconv.ParseBool(enable)
+		return err == nil && enabled
+	}
+	for key := range labels {
+		if strings.HasPrefix(key, "traefik.") {
+			return true
+		}
+	}
+	return false
+}
+
+// build mirrors the Docker provider's configuration builder for a single
+// container.
+func (s composeService) build(
+	ctx context.Context,
+	name string,
+	labels map[string]string,
+	defaultRule *template.Template,
+) (*dynamic.Configuration, error) {
+	cfg, err := label.DecodeConfiguration(labels)
+	if err != nil {
+		return nil, err
+	}
+	serviceName := provider.Normalize(name)
+
+	var tcpOrUDP bool
+	if len(cfg.TCP.Routers) > 0 || len(cfg.TCP.Services) > 0 {
+		tcpOrUDP = true
+		if len(cfg.TCP.Services) == 0 {
+			cfg.TCP.Services = map[string]*dynamic.TCPService{
+				serviceName: {LoadBalancer: &dynamic.TCPServersLoadBalancer{}},
+			}
+		}
+		for svcName, svc := range cfg.TCP.Services {
+			if svc.LoadBalancer == nil {
+				continue
+			}
+			if len(svc.LoadBalancer.Servers) == 0 {
+				svc.LoadBalancer.Servers = []dynamic.TCPServer{{}}
+			}
+			server := &svc.LoadBalancer.Servers[0]
+			port, err := s.port(server.Port)
+			if err != nil {
+				return nil, fmt.Errorf("tcp service %q: %w", svcName, err)
+			}
+			server.Port = ""
+			server.Address = net.JoinHostPort(name, port)
+		}
+		provider.BuildTCPRouterConfiguration(ctx, cfg.TCP)
+	}
+
+	if len(cfg.UDP.Routers) > 0 || len(cfg.UDP.Services) > 0 {
+		tcpOrUDP = true
+		if len(cfg.UDP.Services) == 0 {
+			cfg.UDP.Services = map[string]*dynamic.UDPService{
+				serviceName: {LoadBalancer: &dynamic.UDPServersLoadBalancer{}},
+			}
+		}
+		for svcName, svc := range cfg.UDP.Services {
+			if svc.LoadBalancer == nil {
+				continue
+			}
+			if len(svc.LoadBalancer.Servers) == 0 {
+				svc.LoadBalancer.Servers = []dynamic.UDPServer{{}}
+			}
+			server := &svc.LoadBalancer.Servers[0]
+			port, err := s.port(server.Port)
+			if err != nil {
+				return nil, fmt.Errorf("udp service %q: %w", svcName, err)
+			}
+			server.Port = ""
+			server.Address = net.JoinHostPort(name, port)
+		}
+		provider.BuildUDPRouterConfiguration(ctx, cfg.UDP)
+	}
+
+	if tcpOrUDP && len(cfg.HTTP.Routers) == 0 &&
+		len(cfg.HTTP.Middlewares) == 0 &&
+		len(cfg.HTTP.Services) == 0 {
+		return cfg, nil
+	}
+
+	if len(cfg.HTTP.Services) == 0 {
+		lb := &dynamic.ServersLoadBalancer{}
+		lb.SetDefaults()
+		cfg.HTTP.Services = map[string]*dynamic.Service{serviceName: {LoadBalancer: lb}}
+	}
+	for svcName, svc := range cfg.HTTP.Services {
+		if svc.LoadBalancer == nil {
+			continue
+		}
+		if len(svc.LoadBalancer.Servers) == 0 {
+			svc.LoadBalancer.Servers = []dynamic.Server{{}}
+		}
+		server := &svc.LoadBalancer.Servers[0]
+		if server.URL != "" {
+			if server.Scheme != "" || server.Port != "" {
+				return nil, fmt.Errorf(
+					"service %q: defining scheme or port is not allowed when URL is defined",
+					svcName,
+				)
+			}
+			continue
+		}
+		port, err := s.port(server.Port)
+		if err != nil {
+			return nil, fmt.Errorf("service %q: %w", svcName, err)
+		}
+		scheme := server.Scheme
+		if scheme == "" {
+			scheme = "http"
+		}
+		server.Port, server.Scheme = "", ""
+		server.URL = fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(name, port))
+	}
+
+	model := struct {
+		Name          string
+		ContainerName string
+		Labels        map[string]string
+	}{
+		Name:          serviceName,
+		ContainerName: name,
+		Labels:        labels,
+	}
+	provider.BuildRouterConfiguration(ctx, cfg.HTTP, serviceName, defaultRule, model)
+	return cfg, nil
+}
+
+// port returns the port from the labels, falling back to the lowest port the
+// service exposes.
+func (s composeService) port(serverPort string) (string, error) {
+	if serverPort != "" {
+		return serverPort, nil
+	}
+	ports := append(slices.Clone(s.Expose), s.Ports...)
+	if len(ports) == 0 {
+		return "", errors.New("port is missing")
+	}
+	return strconv.Itoa(slices.Min(ports)), nil
+}
+
+// composeLabels accepts both the map and the list ("key=value") syntax.
+type composeLabels map[string]string
+
+func (l *composeLabels) UnmarshalYAML(node *yaml.Node) error {
+	labels := make(map[string]string)
+	switch node.Kind {
+	case yaml.MappingNode:
+		if err := node.Decode(&labels); err != nil {
+			return err
+		}
+	case yaml.SequenceNode:
+		var list []string
+		if err := node.Decode(&list); err != nil {
+			return err
+		}
+		for _, item := range list {
+			key, value, _ := strings.Cut(item, "=")
+			labels[key] = value
+		}
+	default:
+		return fmt.Errorf("line %d: invalid labels", node.Line)
+	}
+	*l = labels
+	return nil
+}
+
+// composePorts collects the container ports of the short ("8080:80/tcp") and
+// long ({target: 80}) port syntax.
+type composePorts []int
+
+func (p *composePorts) UnmarshalYAML(node *yaml.Node) error {
+	var items []yaml.Node
+	if err := node.Decode(&items); err != nil {
+		return err
+	}
+	for _, item := range items {
+		var value string
+		if item.Kind == yaml.MappingNode {
+			var long struct {
+				Target string `yaml:"target"`
+			}
+			if err := item.Decode(&long); err != nil {
+				return err
+			}
+			value = long.Target
+		} else if err := item.Decode(&value); err != nil {
+			return err
+		}
+
+		// Only the container side is relevant: [[ip:]published:]target[/proto]
+		value, _, _ = strings.Cut(value, "/")
+		if i := strings.LastIndex(value, ":"); i >= 0 {
+			value = value[i+1:]
+		}
+		value, _, _ = strings.Cut(value, "-")
+		port, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid port %q", item.Line, value)
+		}
+		*p = append(*p, port)
+	}
+	return nil
+}
+
+// logCollector turns the log entries of Traefik's configuration builders into
+// warnings.
+type logCollector struct {
+	entries []string
+}
+
+func (l *logCollector) Write(p []byte) (int, error) {
+	var entry map[string]any
+	if err := json.Unmarshal(p, &entry); err != nil {
+		l.entries = append(l.entries, strings.TrimSpace(string(p)))
+		return len(p), nil
+	}
+
+	var parts []string
+	for _, field := range []struct{ key, label string }{
+		{"composeService", "compose service"},
+		{logs.RouterName, "router"},
+		{logs.ServiceName, "service"},
+	} {
+		if value, ok := entry[field.key].(string); ok {
+			parts = append(parts, fmt.Sprintf("%s %q", field.label, value))
+		}
+	}
+	msg, _ := entry[zerolog.MessageFieldName].(string)
+	if err, ok := entry[zerolog.ErrorFieldName].(string); ok {
+		msg += ": " + err
+	}
+	l.entries = append(l.entries, strings.Join(append(parts, msg), ": "))
+	return len(p), nil
+}
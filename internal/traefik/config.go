@@ -14,7 +14,8 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-// BuildDynamicConfig builds a Traefik configuration from the database
+// BuildDynamicConfig builds a Traefik configuration from the database, with
+// the profile variables expanded
 func BuildDynamicConfig(
 	ctx context.Context,
 	q *db.Queries,
@@ -121,6 +122,12 @@ func BuildDynamicConfig(
 		cfg.TCP.ServersTransports[s.Name] = s.Config.Data
 	}
 
+	vars, err := ProfileVariables(ctx, q, profile.ID)
+	if err != nil {
+		return nil, err
+	}
+	ExpandConfig(cfg, vars)
+
 	// Cleanup empty sections (to avoid Traefik {} block warnings)
 	if len(cfg.HTTP.Routers) == 0 && len(cfg.HTTP.Middlewares) == 0 &&
 		len(cfg.HTTP.Services) == 0 {
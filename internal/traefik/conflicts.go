@@ -0,0 +1,206 @@
+package traefik
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// ConflictKind tells how two routers compete for the same requests.
+type ConflictKind string
+
+const (
+	// ConflictShadowed means a router never receives traffic because a router
+	// with a higher priority matches everything it does.
+	ConflictShadowed ConflictKind = "shadowed"
+	// ConflictAmbiguous means two routers with the same priority may match the
+	// same request, and which one serves it is undefined.
+	ConflictAmbiguous ConflictKind = "ambiguous"
+)
+
+// RouteConflict is a pair of routers of the same protocol competing for
+// requests on shared entry points.
+type RouteConflict struct {
+	Kind          ConflictKind
+	Protocol      string // "http" or "tcp"
+	Router        string // the shadowed router, or the first of an ambiguous pair
+	Other         string // the router taking its traffic, or the second of the pair
+	Priority      int
+	OtherPriority int
+	EntryPoints   []string // shared entry points, empty for all
+}
+
+type routerRule struct {
+	name        string
+	rule        *Rule
+	priority    int
+	entryPoints []string
+	tls         bool
+}
+
+// FindConflicts reports shadowed and ambiguous routers. Routers whose rule
+// does not parse are skipped, see LintRules.
+func FindConflicts(cfg *dynamic.Configuration) []RouteConflict {
+	http, tcp, _ := collectRouters(cfg)
+	return append(findConflicts("http", http), findConflicts("tcp", tcp)...)
+}
+
+// LintRules reports router rules Traefik cannot parse, and shadowed and
+// ambiguous routers.
+func LintRules(cfg *dynamic.Configuration) []Issue {
+	http, tcp, issues := collectRouters(cfg)
+	conflicts := append(findConflicts("http", http), findConflicts("tcp", tcp)...)
+	for _, c := range conflicts {
+		issue := Issue{
+			Severity:  SeverityWarning,
+			Path:      c.Protocol + ".routers." + c.Router,
+			Reference: c.Protocol + ".routers." + c.Other,
+		}
+		switch c.Kind {
+		case ConflictShadowed:
+			issue.Message = fmt.Sprintf(
+				"never matches: router %q matches all its requests at a higher priority (%d > %d)",
+				c.Other, c.OtherPriority, c.Priority,
+			)
+		case ConflictAmbiguous:
+			issue.Message = fmt.Sprintf(
+				"rule overlaps with router %q at the same priority (%d)",
+				c.Other, c.Priority,
+			)
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+func collectRouters(cfg *dynamic.Configuration) (http, tcp []routerRule, issues []Issue) {
+	if cfg == nil {
+		return nil, nil, nil
+	}
+	if cfg.HTTP != nil {
+		for _, name := range slices.Sorted(maps.Keys(cfg.HTTP.Routers)) {
+			r := cfg.HTTP.Routers[name]
+			parsed, issue := lintRule("http.routers."+name, "http", r.Rule, r.RuleSyntax)
+			if issue != nil {
+				issues = append(issues, *issue)
+				continue
+			}
+			http = append(http, routerRule{
+				name:        name,
+				rule:        parsed,
+				priority:    RulePriority("http", r.Rule, r.Priority),
+				entryPoints: r.EntryPoints,
+				tls:         r.TLS != nil,
+			})
+		}
+	}
+	if cfg.TCP != nil {
+		for _, name := range slices.Sorted(maps.Keys(cfg.TCP.Routers)) {
+			r := cfg.TCP.Routers[name]
+			parsed, issue := lintRule("tcp.routers."+name, "tcp", r.Rule, r.RuleSyntax)
+			if issue != nil {
+				issues = append(issues, *issue)
+				continue
+			}
+			tcp = append(tcp, routerRule{
+				name:        name,
+				rule:        parsed,
+				priority:    RulePriority("tcp", r.Rule, r.Priority),
+				entryPoints: r.EntryPoints,
+				tls:         r.TLS != nil,
+			})
+		}
+	}
+	return http, tcp, issues
+}
+
+func lintRule(path, protocol, rule, syntax string) (*Rule, *Issue) {
+	if rule == "" {
+		return nil, &Issue{Severity: SeverityError, Path: path, Message: "no rule set"}
+	}
+	parsed, err := ParseRule(protocol, rule, syntax)
+	if err != nil {
+		return nil, &Issue{
+			Severity: SeverityError,
+			Path:     path,
+			Message:  fmt.Sprintf("invalid rule: %v", err),
+		}
+	}
+	return parsed, nil
+}
+
+func findConflicts(protocol string, routers []routerRule) []RouteConflict {
+	var conflicts []RouteConflict
+	for i, a := range routers {
+		for _, b := range routers[i+1:] {
+			// Traefik routes TLS and plain requests with separate muxers
+			if a.tls != b.tls {
+				continue
+			}
+			shared, ok := sharedEntryPoints(a.entryPoints, b.entryPoints)
+			if !ok {
+				continue
+			}
+
+			high, low := a, b
+			if low.priority > high.priority {
+				high, low = low, high
+			}
+			conflict := RouteConflict{
+				Protocol:      protocol,
+				Router:        low.name,
+				Other:         high.name,
+				Priority:      low.priority,
+				OtherPriority: high.priority,
+				EntryPoints:   shared,
+			}
+			switch {
+			case high.priority == low.priority:
+				if !a.rule.Overlaps(b.rule) {
+					continue
+				}
+				conflict.Kind = ConflictAmbiguous
+				conflict.Router, conflict.Other = a.name, b.name
+			case coversEntryPoints(high.entryPoints, low.entryPoints) &&
+				high.rule.Covers(low.rule):
+				conflict.Kind = ConflictShadowed
+			default:
+				continue
+			}
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	return conflicts
+}
+
+// sharedEntryPoints returns the entry points two routers both listen on.
+// Routers without entry points listen on all of them.
+func sharedEntryPoints(a, b []string) ([]string, bool) {
+	switch {
+	case len(a) == 0:
+		return b, true
+	case len(b) == 0:
+		return a, true
+	}
+	var shared []string
+	for _, ep := range a {
+		if slices.Contains(b, ep) {
+			shared = append(shared, ep)
+		}
+	}
+	return shared, len(shared) > 0
+}
+
+// coversEntryPoints reports whether a router listens on all entry points of
+// another.
+func coversEntryPoints(high, low []string) bool {
+	if len(high) == 0 {
+		return true
+	}
+	if len(low) == 0 {
+		return false
+	}
+	return !slices.ContainsFunc(low, func(ep string) bool { return !slices.Contains(high, ep) })
+}
@@ -0,0 +1,179 @@
+package traefik
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestFindConflicts(t *testing.T) {
+	// Seven alternatives of two headers expand to more branches than the
+	// parser keeps, so the rule is complex
+	var groups []string
+	for _, h := range []string{"A", "B", "C", "D", "E", "F", "G"} {
+		groups = append(groups, "(Header(`X-"+h+"`, `1`) || Header(`X-"+h+"`, `2`))")
+	}
+	complexRule := strings.Join(groups, " && ")
+
+	tests := []struct {
+		name    string
+		routers map[string]*dynamic.Router
+		tcp     map[string]*dynamic.TCPRouter
+		want    []RouteConflict
+	}{
+		{
+			name: "covered",
+			routers: map[string]*dynamic.Router{
+				"all": {Rule: "PathPrefix(`/`)", Priority: 100},
+				"api": {Rule: "Host(`a.com`) && PathPrefix(`/api`)", Priority: 10},
+			},
+			want: []RouteConflict{{
+				Kind:          ConflictShadowed,
+				Protocol:      "http",
+				Router:        "api",
+				Other:         "all",
+				Priority:      10,
+				OtherPriority: 100,
+			}},
+		},
+		{
+			name: "partially covered",
+			routers: map[string]*dynamic.Router{
+				"a":    {Rule: "Host(`a.com`)", Priority: 100},
+				"both": {Rule: "Host(`a.com`) || Host(`b.com`)", Priority: 10},
+			},
+		},
+		{
+			name: "covered by a lower priority",
+			routers: map[string]*dynamic.Router{
+				"all": {Rule: "PathPrefix(`/`)", Priority: 10},
+				"api": {Rule: "PathPrefix(`/api`)", Priority: 100},
+			},
+		},
+		{
+			name: "covered on some entry points",
+			routers: map[string]*dynamic.Router{
+				"all": {Rule: "PathPrefix(`/`)", Priority: 100, EntryPoints: []string{"web"}},
+				"api": {
+					Rule:        "PathPrefix(`/api`)",
+					Priority:    10,
+					EntryPoints: []string{"web", "websecure"},
+				},
+			},
+		},
+		{
+			name: "priority tie",
+			routers: map[string]*dynamic.Router{
+				"b": {Rule: "Host(`a.com`)", Priority: 5, EntryPoints: []string{"web", "websecure"}},
+				"a": {Rule: "PathPrefix(`/api`)", Priority: 5, EntryPoints: []string{"web"}},
+			},
+			want: []RouteConflict{{
+				Kind:          ConflictAmbiguous,
+				Protocol:      "http",
+				Router:        "a",
+				Other:         "b",
+				Priority:      5,
+				OtherPriority: 5,
+				EntryPoints:   []string{"web"},
+			}},
+		},
+		{
+			name: "derived priority tie",
+			routers: map[string]*dynamic.Router{
+				"a": {Rule: "Host(`a.com`)"},
+				"b": {Rule: "Host(`A.com`)"},
+			},
+			want: []RouteConflict{{
+				Kind:          ConflictAmbiguous,
+				Protocol:      "http",
+				Router:        "a",
+				Other:         "b",
+				Priority:      13,
+				OtherPriority: 13,
+			}},
+		},
+		{
+			name: "priority tie with disjoint rules",
+			routers: map[string]*dynamic.Router{
+				"a": {Rule: "Host(`a.com`)", Priority: 5},
+				"b": {Rule: "Host(`b.com`) && PathPrefix(`/`)", Priority: 5},
+			},
+		},
+		{
+			name: "priority tie with a complex rule",
+			routers: map[string]*dynamic.Router{
+				"a": {Rule: "Host(`a.com`)", Priority: 5},
+				"b": {Rule: complexRule, Priority: 5},
+			},
+			want: []RouteConflict{{
+				Kind:          ConflictAmbiguous,
+				Protocol:      "http",
+				Router:        "a",
+				Other:         "b",
+				Priority:      5,
+				OtherPriority: 5,
+			}},
+		},
+		{
+			name: "complex rule is never covered",
+			routers: map[string]*dynamic.Router{
+				"a": {Rule: "PathPrefix(`/`)", Priority: 50},
+				"b": {Rule: complexRule, Priority: 5},
+			},
+		},
+		{
+			name: "disjoint entry points",
+			routers: map[string]*dynamic.Router{
+				"a": {Rule: "PathPrefix(`/`)", Priority: 5, EntryPoints: []string{"web"}},
+				"b": {Rule: "PathPrefix(`/`)", Priority: 5, EntryPoints: []string{"websecure"}},
+			},
+		},
+		{
+			name: "tls and plain",
+			routers: map[string]*dynamic.Router{
+				"a": {Rule: "PathPrefix(`/`)", Priority: 5, TLS: &dynamic.RouterTLSConfig{}},
+				"b": {Rule: "PathPrefix(`/`)", Priority: 5},
+			},
+		},
+		{
+			name: "invalid rule",
+			routers: map[string]*dynamic.Router{
+				"a": {Rule: "PathPrefix(`/`)", Priority: 5},
+				"b": {Rule: "Host(", Priority: 5},
+			},
+		},
+		{
+			name: "tcp",
+			routers: map[string]*dynamic.Router{
+				"web": {Rule: "Host(`a.com`)", Priority: 5},
+			},
+			tcp: map[string]*dynamic.TCPRouter{
+				"any": {Rule: "HostSNI(`*`)", Priority: 10, TLS: &dynamic.RouterTCPTLSConfig{}},
+				"a":   {Rule: "HostSNI(`a.com`)", Priority: 5, TLS: &dynamic.RouterTCPTLSConfig{}},
+			},
+			want: []RouteConflict{{
+				Kind:          ConflictShadowed,
+				Protocol:      "tcp",
+				Router:        "a",
+				Other:         "any",
+				Priority:      5,
+				OtherPriority: 10,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &dynamic.Configuration{
+				HTTP: &dynamic.HTTPConfiguration{Routers: tt.routers},
+				TCP:  &dynamic.TCPConfiguration{Routers: tt.tcp},
+			}
+			got := FindConflicts(cfg)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("conflicts = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
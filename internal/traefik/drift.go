@@ -0,0 +1,231 @@
+package traefik
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// providerName is the name Traefik's HTTP provider qualifies Mantrae's routers,
+// middlewares and services with.
+const providerName = "http"
+
+// driftPageSize is the page size requested from Traefik's list endpoints.
+const driftPageSize = 100
+
+// Drift kinds, see db.DriftItem.
+const (
+	DriftMissing   = "missing"
+	DriftExtra     = "extra"
+	DriftDivergent = "divergent"
+)
+
+// driftSection is a kind of item compared with a Traefik instance.
+type driftSection struct {
+	kind     string // key in /api/rawdata, e.g. "tcpRouters"
+	endpoint string // list endpoint, e.g. "tcp/routers"
+	expected map[string]any
+	decode   func(data []byte) (any, error)
+}
+
+func newDriftSection[T any](kind, endpoint string, items map[string]*T) driftSection {
+	expected := make(map[string]any, len(items))
+	for name, item := range items {
+		expected[name] = item
+	}
+	return driftSection{
+		kind:     kind,
+		endpoint: endpoint,
+		expected: expected,
+		decode: func(data []byte) (any, error) {
+			item := new(T)
+			err := json.Unmarshal(data, item)
+			return item, err
+		},
+	}
+}
+
+func driftSections(cfg *dynamic.Configuration) []driftSection {
+	httpCfg := &dynamic.HTTPConfiguration{}
+	tcpCfg := &dynamic.TCPConfiguration{}
+	udpCfg := &dynamic.UDPConfiguration{}
+	if cfg != nil && cfg.HTTP != nil {
+		httpCfg = cfg.HTTP
+	}
+	if cfg != nil && cfg.TCP != nil {
+		tcpCfg = cfg.TCP
+	}
+	if cfg != nil && cfg.UDP != nil {
+		udpCfg = cfg.UDP
+	}
+	return []driftSection{
+		newDriftSection("routers", "http/routers", httpCfg.Routers),
+		newDriftSection("middlewares", "http/middlewares", httpCfg.Middlewares),
+		newDriftSection("services", "http/services", httpCfg.Services),
+		newDriftSection("tcpRouters", "tcp/routers", tcpCfg.Routers),
+		newDriftSection("tcpMiddlewares", "tcp/middlewares", tcpCfg.Middlewares),
+		newDriftSection("tcpServices", "tcp/services", tcpCfg.Services),
+		newDriftSection("udpRouters", "udp/routers", udpCfg.Routers),
+		newDriftSection("udpServices", "udp/services", udpCfg.Services),
+	}
+}
+
+// DetectDrift compares a configuration with the routers, middlewares and
+// services a Traefik instance runs. Items of the HTTP provider missing from
+// the instance, extra on it, or with fields set to other values are reported.
+// Defaults Traefik fills in and items of other providers are not drift.
+func DetectDrift(
+	ctx context.Context,
+	client *http.Client,
+	apiURL string,
+	cfg *dynamic.Configuration,
+) ([]db.DriftItem, error) {
+	var drift []db.DriftItem
+	for _, section := range driftSections(cfg) {
+		running, err := fetchSection(ctx, client, apiURL, section)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(section.expected)) {
+			item, ok := running[name+"@"+providerName]
+			if !ok {
+				drift = append(drift, db.DriftItem{
+					Kind:    DriftMissing,
+					Section: section.kind,
+					Name:    name,
+				})
+				continue
+			}
+			fields, err := divergentFields(section.expected[name], item)
+			if err != nil {
+				return nil, err
+			}
+			if len(fields) > 0 {
+				drift = append(drift, db.DriftItem{
+					Kind:    DriftDivergent,
+					Section: section.kind,
+					Name:    name,
+					Fields:  fields,
+				})
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(running)) {
+			local, provider, _ := strings.Cut(name, "@")
+			if _, ok := section.expected[local]; provider == providerName && !ok {
+				drift = append(drift, db.DriftItem{
+					Kind:    DriftExtra,
+					Section: section.kind,
+					Name:    local,
+				})
+			}
+		}
+	}
+	return drift, nil
+}
+
+// fetchSection lists the items of a section on a Traefik instance, by their
+// qualified name.
+func fetchSection(
+	ctx context.Context,
+	client *http.Client,
+	apiURL string,
+	section driftSection,
+) (map[string]any, error) {
+	base, err := url.JoinPath(apiURL, "api", section.endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	running := make(map[string]any)
+	for page := 1; ; {
+		var items []json.RawMessage
+		header, err := getJSON(
+			ctx,
+			client,
+			fmt.Sprintf("%s?per_page=%d&page=%d", base, driftPageSize, page),
+			&items,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, data := range items {
+			var meta struct {
+				Name string `json:"name"`
+			}
+			if err = json.Unmarshal(data, &meta); err != nil {
+				return nil, err
+			}
+			if running[meta.Name], err = section.decode(data); err != nil {
+				return nil, fmt.Errorf("%s %q: %w", section.kind, meta.Name, err)
+			}
+		}
+
+		// Traefik points the last page back to the first one
+		next, err := strconv.Atoi(header.Get("X-Next-Page"))
+		if err != nil || next <= page {
+			return running, nil
+		}
+		page = next
+	}
+}
+
+// divergentFields returns the paths of the fields set in the expected item
+// that hold another value in the running one.
+func divergentFields(expected, running any) ([]string, error) {
+	want, err := toJSONValue(expected)
+	if err != nil {
+		return nil, err
+	}
+	got, err := toJSONValue(running)
+	if err != nil {
+		return nil, err
+	}
+	var fields []string
+	diffJSON("", want, got, &fields)
+	return fields, nil
+}
+
+func toJSONValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	err = json.Unmarshal(data, &value)
+	return value, err
+}
+
+func diffJSON(path string, want, got any, fields *[]string) {
+	switch w := want.(type) {
+	case nil:
+		// Unset, Traefik may apply a default
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			*fields = append(*fields, path)
+			return
+		}
+		for _, key := range slices.Sorted(maps.Keys(w)) {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			diffJSON(child, w[key], g[key], fields)
+		}
+	default:
+		if !reflect.DeepEqual(want, got) {
+			*fields = append(*fields, path)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package traefik
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// driftStub serves Traefik's list endpoints. Every endpoint returns its pages
+// in order and points the last page back to the first one, like Traefik does.
+func driftStub(t *testing.T, pages map[string][][]map[string]any) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		endpoint := r.URL.Path[len("/api/"):]
+		list := pages[endpoint]
+		if len(list) == 0 {
+			list = [][]map[string]any{{}}
+		}
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 || page > len(list) {
+			t.Errorf("%s: unexpected page %q", endpoint, r.URL.Query().Get("page"))
+			http.Error(w, "invalid page", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("X-Next-Page", strconv.Itoa(page%len(list)+1))
+		if err := json.NewEncoder(w).Encode(list[page-1]); err != nil {
+			t.Error(err)
+		}
+	}))
+}
+
+func TestDetectDrift(t *testing.T) {
+	service := &dynamic.Service{LoadBalancer: &dynamic.ServersLoadBalancer{
+		Servers: []dynamic.Server{{URL: "http://app:8080"}},
+	}}
+	cfg := &dynamic.Configuration{
+		HTTP: &dynamic.HTTPConfiguration{
+			Routers: map[string]*dynamic.Router{
+				"web": {Rule: "Host(`example.com`)", Service: "app"},
+			},
+			Middlewares: map[string]*dynamic.Middleware{
+				"strip": {StripPrefix: &dynamic.StripPrefix{Prefixes: []string{"/api"}}},
+			},
+			Services: map[string]*dynamic.Service{"app": service},
+		},
+	}
+
+	runningService := map[string]any{
+		"name":     "app@http",
+		"provider": "http",
+		"status":   "enabled",
+		"loadBalancer": map[string]any{
+			"servers":        []any{map[string]any{"url": "http://app:8080"}},
+			"passHostHeader": true,
+		},
+	}
+
+	tests := []struct {
+		name  string
+		pages map[string][][]map[string]any
+		want  []db.DriftItem
+	}{
+		{
+			name: "in sync",
+			pages: map[string][][]map[string]any{
+				"http/routers": {{
+					{"name": "web@http", "rule": "Host(`example.com`)", "service": "app", "priority": 28},
+				}},
+				"http/middlewares": {{
+					{"name": "strip@http", "stripPrefix": map[string]any{"prefixes": []any{"/api"}}},
+				}},
+				"http/services": {{runningService}},
+			},
+		},
+		{
+			name: "missing",
+			pages: map[string][][]map[string]any{
+				"http/services": {{runningService}},
+			},
+			want: []db.DriftItem{
+				{Kind: DriftMissing, Section: "routers", Name: "web"},
+				{Kind: DriftMissing, Section: "middlewares", Name: "strip"},
+			},
+		},
+		{
+			name: "extra and divergent",
+			pages: map[string][][]map[string]any{
+				"http/routers": {{
+					{"name": "old@http", "rule": "Host(`old.example.com`)", "service": "app"},
+					{"name": "web@http", "rule": "Host(`other.com`)", "service": "app"},
+				}},
+				"http/middlewares": {{
+					{"name": "strip@http", "stripPrefix": map[string]any{"prefixes": []any{"/v1"}}},
+				}},
+				"http/services": {{runningService}},
+			},
+			want: []db.DriftItem{
+				{Kind: DriftDivergent, Section: "routers", Name: "web", Fields: []string{"rule"}},
+				{Kind: DriftExtra, Section: "routers", Name: "old"},
+				{Kind: DriftDivergent, Section: "middlewares", Name: "strip", Fields: []string{"stripPrefix.prefixes"}},
+			},
+		},
+		{
+			name: "other providers",
+			pages: map[string][][]map[string]any{
+				"http/routers": {{
+					{"name": "web@docker", "rule": "Host(`other.com`)", "service": "app"},
+					{"name": "dashboard@internal", "rule": "PathPrefix(`/dashboard`)", "service": "api@internal"},
+				}},
+				"http/middlewares": {{
+					{"name": "strip@http", "stripPrefix": map[string]any{"prefixes": []any{"/api"}}},
+				}},
+				"http/services": {{runningService, {"name": "api@internal"}}},
+				"tcp/routers":   {{{"name": "db@file", "rule": "HostSNI(`*`)", "service": "db"}}},
+			},
+			want: []db.DriftItem{
+				{Kind: DriftMissing, Section: "routers", Name: "web"},
+			},
+		},
+		{
+			name: "pages",
+			pages: map[string][][]map[string]any{
+				"http/routers": {
+					{{"name": "a@http", "rule": "Host(`a.com`)", "service": "app"}},
+					{{"name": "web@http", "rule": "Host(`example.com`)", "service": "app"}},
+					{{"name": "z@http", "rule": "Host(`z.com`)", "service": "app"}},
+				},
+				"http/middlewares": {{
+					{"name": "strip@http", "stripPrefix": map[string]any{"prefixes": []any{"/api"}}},
+				}},
+				"http/services": {{}, {runningService}},
+			},
+			want: []db.DriftItem{
+				{Kind: DriftExtra, Section: "routers", Name: "a"},
+				{Kind: DriftExtra, Section: "routers", Name: "z"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := driftStub(t, tt.pages)
+			defer srv.Close()
+
+			got, err := DetectDrift(t.Context(), srv.Client(), srv.URL, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("drift = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
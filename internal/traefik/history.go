@@ -0,0 +1,251 @@
+package traefik
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// keepRevisions is the number of config revisions kept per profile.
+const keepRevisions = 100
+
+// History records a numbered revision of a profile's configuration every time
+// it changes.
+type History struct {
+	mu      sync.Mutex
+	configs *ConfigCache
+
+	// Encoded published revisions of staged profiles
+	publishedMu sync.Mutex
+	published   map[int64]*CachedConfig
+}
+
+func NewHistory(configs *ConfigCache) *History {
+	return &History{
+		configs:   configs,
+		published: make(map[int64]*CachedConfig),
+	}
+}
+
+// Record stores the current configuration of the profile as a new revision,
+// unless it is unchanged since the latest one.
+func (h *History) Record(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+) (*db.ConfigRevision, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	profile, err := q.GetProfile(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	cached, err := h.configs.Get(ctx, q, *profile)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := TakeSnapshot(ctx, q, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := q.GetLatestConfigRevision(ctx, profileID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return nil, err
+	case sameConfig(latest.Config.Data, cached.Config) && sameConfig(latest.Snapshot.Data, snapshot):
+		return latest, nil
+	}
+
+	revision, err := q.CreateConfigRevision(ctx, &db.CreateConfigRevisionParams{
+		ProfileID: profileID,
+		Config:    &db.DynamicConfig{Data: cached.Config},
+		Snapshot:  &db.RevisionSnapshot{Data: snapshot},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = q.DeleteOldConfigRevisions(ctx, &db.DeleteOldConfigRevisionsParams{
+		ProfileID: profileID,
+		Keep:      keepRevisions,
+	}); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// Publish records the current configuration of the profile and promotes it to
+// the one served to Traefik. Until the next Publish, later changes are staged
+// as a draft.
+func (h *History) Publish(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+) (*db.Profile, *db.ConfigRevision, error) {
+	revision, err := h.Record(ctx, q, profileID)
+	if err != nil {
+		return nil, nil, err
+	}
+	profile, err := q.UpdateProfilePublishedRevision(ctx, &db.UpdateProfilePublishedRevisionParams{
+		ID:                profileID,
+		PublishedRevision: &revision.Revision,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return profile, revision, nil
+}
+
+// Live returns the configuration Traefik should receive for the profile: the
+// published revision if its changes are staged, otherwise the latest one.
+func (h *History) Live(
+	ctx context.Context,
+	q *db.Queries,
+	profile db.Profile,
+) (*CachedConfig, error) {
+	if profile.PublishedRevision == nil {
+		return h.configs.Get(ctx, q, profile)
+	}
+
+	tag := fmt.Sprintf("r%d", *profile.PublishedRevision)
+	h.publishedMu.Lock()
+	entry, ok := h.published[profile.ID]
+	h.publishedMu.Unlock()
+	if ok && entry.Revision == tag {
+		return entry, nil
+	}
+
+	revision, err := q.GetConfigRevision(ctx, &db.GetConfigRevisionParams{
+		ProfileID: profile.ID,
+		Revision:  *profile.PublishedRevision,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if entry, err = EncodeConfig(tag, revision.Config.Data); err != nil {
+		return nil, err
+	}
+
+	h.publishedMu.Lock()
+	h.published[profile.ID] = entry
+	h.publishedMu.Unlock()
+	return entry, nil
+}
+
+// Evict drops the cached published configuration of a profile.
+func (h *History) Evict(profileID int64) {
+	h.publishedMu.Lock()
+	defer h.publishedMu.Unlock()
+	delete(h.published, profileID)
+}
+
+// ChangeKind describes how an item differs between two configurations.
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// ConfigChange is a router, service, middleware, etc. that differs between two
+// configurations. Before and After hold its JSON representation.
+type ConfigChange struct {
+	Path   string // e.g. "http.routers.web"
+	Kind   ChangeKind
+	Before json.RawMessage
+	After  json.RawMessage
+}
+
+// DiffConfigs lists the items that changed from one configuration to another,
+// sorted by path.
+func DiffConfigs(from, to *dynamic.Configuration) ([]ConfigChange, error) {
+	a, err := toJSONMap(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := toJSONMap(to)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []ConfigChange
+	// Items live three levels deep (e.g. http > routers > name)
+	if err = diffValues(a, b, "", 3, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func toJSONMap(cfg *dynamic.Configuration) (map[string]any, error) {
+	m := make(map[string]any)
+	if cfg == nil {
+		return m, nil
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return m, json.Unmarshal(data, &m)
+}
+
+func diffValues(a, b any, path string, depth int, changes *[]ConfigChange) error {
+	mapA, okA := a.(map[string]any)
+	mapB, okB := b.(map[string]any)
+	if depth > 0 && (okA || a == nil) && (okB || b == nil) {
+		keys := slices.Collect(maps.Keys(mapA))
+		for key := range mapB {
+			if _, ok := mapA[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			if err := diffValues(mapA[key], mapB[key], child, depth-1, changes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if reflect.DeepEqual(a, b) {
+		return nil
+	}
+	change := ConfigChange{Path: path, Kind: ChangeModified}
+	switch {
+	case a == nil:
+		change.Kind = ChangeAdded
+	case b == nil:
+		change.Kind = ChangeRemoved
+	}
+
+	var err error
+	if a != nil {
+		if change.Before, err = json.Marshal(a); err != nil {
+			return err
+		}
+	}
+	if b != nil {
+		if change.After, err = json.Marshal(b); err != nil {
+			return err
+		}
+	}
+	*changes = append(*changes, change)
+	return nil
+}
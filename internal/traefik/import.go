@@ -0,0 +1,806 @@
+package traefik
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"gopkg.in/yaml.v3"
+)
+
+// Formats accepted by DecodeImport.
+const (
+	FormatDynamic    = "dynamic"
+	FormatKubernetes = "kubernetes"
+	FormatCompose    = "compose"
+)
+
+// DecodeImport parses content of the given format into a dynamic
+// configuration, together with warnings about everything that was dropped.
+func DecodeImport(format string, content []byte) (*dynamic.Configuration, []string, error) {
+	switch format {
+	case FormatDynamic:
+		cfg := &dynamic.Configuration{}
+		if err := yaml.Unmarshal(content, cfg); err != nil {
+			return nil, nil, fmt.Errorf("invalid dynamic config: %w", err)
+		}
+		return cfg, nil, nil
+	case FormatKubernetes:
+		result, err := FromKubernetes(content)
+		if err != nil {
+			return nil, nil, err
+		}
+		var warnings []string
+		for _, field := range result.Unmapped {
+			warnings = append(warnings, field.String())
+		}
+		return result.Config, warnings, nil
+	case FormatCompose:
+		result, err := FromCompose(content)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Config, result.Warnings, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// ImportStatus is the state of an imported item compared to the profile.
+type ImportStatus string
+
+const (
+	// ImportNew items don't exist in the profile yet.
+	ImportNew ImportStatus = "new"
+	// ImportIdentical items exist with the same configuration.
+	ImportIdentical ImportStatus = "identical"
+	// ImportConflict items exist with a different configuration.
+	ImportConflict ImportStatus = "conflict"
+)
+
+// ImportStrategy decides what happens to conflicting items.
+type ImportStrategy int
+
+const (
+	// ImportSkip keeps the existing item.
+	ImportSkip ImportStrategy = iota
+	// ImportOverwrite replaces the configuration of the existing item.
+	ImportOverwrite
+	// ImportRename imports the item under a new name with a numeric suffix.
+	ImportRename
+)
+
+// ImportItem is a router, service or middleware of an import plan.
+type ImportItem struct {
+	Protocol string // "http", "tcp" or "udp"
+	Type     string // "router", "service" or "middleware"
+	Name     string
+	Status   ImportStatus
+	// NewName is set if a conflicting item is imported under another name
+	NewName string
+}
+
+// PlanImport compares the configuration with the items in the profile without
+// changing anything.
+func PlanImport(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	cfg *dynamic.Configuration,
+) ([]ImportItem, error) {
+	var items []ImportItem
+	for _, imp := range importers(cfg) {
+		planned, err := imp.plan(ctx, q, profileID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, planned...)
+	}
+	return items, nil
+}
+
+// ApplyImport writes the configuration into the profile, resolving conflicts
+// with the given strategy, and returns the applied plan. References to renamed
+// items are updated in cfg. Written items with validation errors fail the
+// import with an *ImportError.
+//
+// The first failing write aborts the import, so q should be bound to a
+// transaction that is rolled back on error.
+func ApplyImport(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	cfg *dynamic.Configuration,
+	strategy ImportStrategy,
+) ([]ImportItem, error) {
+	imps := importers(cfg)
+	plans := make([][]ImportItem, len(imps))
+	for i, imp := range imps {
+		planned, err := imp.plan(ctx, q, profileID)
+		if err != nil {
+			return nil, err
+		}
+		plans[i] = planned
+	}
+
+	if strategy == ImportRename {
+		renames := make(map[string]map[string]string)
+		for i, imp := range imps {
+			renames[imp.kind()] = imp.rename(plans[i])
+		}
+		renameReferences(cfg, renames)
+	}
+
+	for i, imp := range imps {
+		if err := imp.apply(ctx, q, profileID, plans[i], strategy); err != nil {
+			return nil, err
+		}
+	}
+	if err := importEntryPoints(ctx, q, profileID, cfg); err != nil {
+		return nil, err
+	}
+
+	items := slices.Concat(plans...)
+	if err := validateImport(ctx, q, profileID, items, strategy); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ImportError is returned by ApplyImport if the imported items don't pass
+// validation.
+type ImportError struct {
+	Issues []Issue
+}
+
+func (e *ImportError) Error() string {
+	issue := e.Issues[0]
+	msg := fmt.Sprintf("invalid configuration: %s: %s", issue.Path, issue.Message)
+	if len(e.Issues) > 1 {
+		msg += fmt.Sprintf(" (and %d more)", len(e.Issues)-1)
+	}
+	return msg
+}
+
+// validateImport validates the profile with the imported items and fails on
+// errors of the items that were written. Issues of untouched items don't
+// block the import.
+func validateImport(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	items []ImportItem,
+	strategy ImportStrategy,
+) error {
+	written := make(map[string]bool)
+	for _, item := range items {
+		name := item.Name
+		switch {
+		case item.Status == ImportNew:
+		case item.Status == ImportConflict && strategy == ImportOverwrite:
+		case item.Status == ImportConflict && strategy == ImportRename:
+			name = item.NewName
+		default:
+			continue
+		}
+		written[item.Protocol+"."+item.Type+"s."+name] = true
+	}
+	if len(written) == 0 {
+		return nil
+	}
+
+	profile, err := q.GetProfile(ctx, profileID)
+	if err != nil {
+		return err
+	}
+	cfg, err := BuildDynamicConfig(ctx, q, *profile)
+	if err != nil {
+		return err
+	}
+	issues, err := ValidateProfile(ctx, q, profileID, cfg)
+	if err != nil {
+		return err
+	}
+
+	var failed []Issue
+	for _, issue := range issues {
+		if issue.Severity == SeverityError && written[issue.Path] {
+			failed = append(failed, issue)
+		}
+	}
+	if len(failed) > 0 {
+		return &ImportError{Issues: failed}
+	}
+	return nil
+}
+
+type importer interface {
+	kind() string
+	plan(ctx context.Context, q *db.Queries, profileID int64) ([]ImportItem, error)
+	rename(items []ImportItem) map[string]string
+	apply(
+		ctx context.Context,
+		q *db.Queries,
+		profileID int64,
+		items []ImportItem,
+		strategy ImportStrategy,
+	) error
+}
+
+type importRow[T any] struct {
+	id        string
+	config    *T
+	enabled   bool
+	isDefault bool
+}
+
+// itemImporter imports one type of item (e.g. http routers).
+type itemImporter[T any] struct {
+	protocol string
+	typ      string
+	incoming map[string]*T
+	existing map[string]importRow[T]
+
+	list   func(ctx context.Context, q *db.Queries, profileID int64) (map[string]importRow[T], error)
+	create func(ctx context.Context, q *db.Queries, profileID int64, name string, cfg *T) error
+	update func(ctx context.Context, q *db.Queries, row importRow[T], name string, cfg *T) error
+}
+
+func (i *itemImporter[T]) kind() string {
+	return i.protocol + " " + i.typ
+}
+
+func (i *itemImporter[T]) plan(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+) ([]ImportItem, error) {
+	existing, err := i.list(ctx, q, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %ss: %w", i.kind(), err)
+	}
+	i.existing = existing
+
+	var items []ImportItem
+	for _, name := range slices.Sorted(maps.Keys(i.incoming)) {
+		if i.incoming[name] == nil {
+			continue
+		}
+		item := ImportItem{Protocol: i.protocol, Type: i.typ, Name: name, Status: ImportNew}
+		if row, ok := existing[name]; ok {
+			item.Status = ImportConflict
+			if sameConfig(row.config, i.incoming[name]) {
+				item.Status = ImportIdentical
+			}
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// rename picks a free name for every conflicting item.
+func (i *itemImporter[T]) rename(items []ImportItem) map[string]string {
+	renames := make(map[string]string)
+	taken := func(name string) bool {
+		_, existing := i.existing[name]
+		_, incoming := i.incoming[name]
+		return existing || incoming || slices.Contains(slices.Collect(maps.Values(renames)), name)
+	}
+	for idx := range items {
+		item := &items[idx]
+		if item.Status != ImportConflict {
+			continue
+		}
+		for n := 2; ; n++ {
+			if name := fmt.Sprintf("%s-%d", item.Name, n); !taken(name) {
+				item.NewName = name
+				renames[item.Name] = name
+				break
+			}
+		}
+	}
+	return renames
+}
+
+func (i *itemImporter[T]) apply(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	items []ImportItem,
+	strategy ImportStrategy,
+) error {
+	for _, item := range items {
+		cfg := i.incoming[item.Name]
+
+		var err error
+		switch {
+		case item.Status == ImportNew:
+			err = i.create(ctx, q, profileID, item.Name, cfg)
+		case item.Status == ImportConflict && strategy == ImportOverwrite:
+			err = i.update(ctx, q, i.existing[item.Name], item.Name, cfg)
+		case item.Status == ImportConflict && strategy == ImportRename:
+			err = i.create(ctx, q, profileID, item.NewName, cfg)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to import %s %q: %w", i.kind(), item.Name, err)
+		}
+	}
+	return nil
+}
+
+func sameConfig(a, b any) bool {
+	dataA, errA := json.Marshal(a)
+	dataB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(dataA, dataB)
+}
+
+func importers(cfg *dynamic.Configuration) []importer {
+	var imps []importer
+	if cfg == nil {
+		return imps
+	}
+	if cfg.HTTP != nil {
+		imps = append(imps, httpRouterImporter(cfg.HTTP.Routers),
+			httpServiceImporter(cfg.HTTP.Services),
+			httpMiddlewareImporter(cfg.HTTP.Middlewares))
+	}
+	if cfg.TCP != nil {
+		imps = append(imps, tcpRouterImporter(cfg.TCP.Routers),
+			tcpServiceImporter(cfg.TCP.Services),
+			tcpMiddlewareImporter(cfg.TCP.Middlewares))
+	}
+	if cfg.UDP != nil {
+		imps = append(imps, udpRouterImporter(cfg.UDP.Routers),
+			udpServiceImporter(cfg.UDP.Services))
+	}
+	return imps
+}
+
+func httpRouterImporter(incoming map[string]*dynamic.Router) importer {
+	return &itemImporter[dynamic.Router]{
+		protocol: "http",
+		typ:      "router",
+		incoming: incoming,
+		list: func(ctx context.Context, q *db.Queries, profileID int64) (map[string]importRow[dynamic.Router], error) {
+			rows, err := q.ListHttpRouters(ctx, &db.ListHttpRoutersParams{ProfileID: profileID})
+			existing := make(map[string]importRow[dynamic.Router], len(rows))
+			for _, r := range rows {
+				existing[r.Name] = importRow[dynamic.Router]{id: r.ID, config: r.Config.Data, enabled: r.Enabled}
+			}
+			return existing, err
+		},
+		create: func(ctx context.Context, q *db.Queries, profileID int64, name string, cfg *dynamic.Router) error {
+			_, err := q.CreateHttpRouter(ctx, &db.CreateHttpRouterParams{
+				ID:        uuid.New().String(),
+				ProfileID: profileID,
+				Name:      name,
+				Config:    &db.RouterConfig{Data: cfg},
+			})
+			return err
+		},
+		update: func(ctx context.Context, q *db.Queries, row importRow[dynamic.Router], name string, cfg *dynamic.Router) error {
+			_, err := q.UpdateHttpRouter(ctx, &db.UpdateHttpRouterParams{
+				ID:      row.id,
+				Name:    name,
+				Config:  &db.RouterConfig{Data: cfg},
+				Enabled: row.enabled,
+			})
+			return err
+		},
+	}
+}
+
+func httpServiceImporter(incoming map[string]*dynamic.Service) importer {
+	return &itemImporter[dynamic.Service]{
+		protocol: "http",
+		typ:      "service",
+		incoming: incoming,
+		list: func(ctx context.Context, q *db.Queries, profileID int64) (map[string]importRow[dynamic.Service], error) {
+			rows, err := q.ListHttpServices(ctx, &db.ListHttpServicesParams{ProfileID: profileID})
+			existing := make(map[string]importRow[dynamic.Service], len(rows))
+			for _, r := range rows {
+				existing[r.Name] = importRow[dynamic.Service]{id: r.ID, config: r.Config.Data, enabled: r.Enabled}
+			}
+			return existing, err
+		},
+		create: func(ctx context.Context, q *db.Queries, profileID int64, name string, cfg *dynamic.Service) error {
+			_, err := q.CreateHttpService(ctx, &db.CreateHttpServiceParams{
+				ID:        uuid.New().String(),
+				ProfileID: profileID,
+				Name:      name,
+				Config:    &db.ServiceConfig{Data: cfg},
+			})
+			return err
+		},
+		update: func(ctx context.Context, q *db.Queries, row importRow[dynamic.Service], name string, cfg *dynamic.Service) error {
+			_, err := q.UpdateHttpService(ctx, &db.UpdateHttpServiceParams{
+				ID:      row.id,
+				Name:    name,
+				Config:  &db.ServiceConfig{Data: cfg},
+				Enabled: row.enabled,
+			})
+			return err
+		},
+	}
+}
+
+func httpMiddlewareImporter(incoming map[string]*dynamic.Middleware) importer {
+	return &itemImporter[dynamic.Middleware]{
+		protocol: "http",
+		typ:      "middleware",
+		incoming: incoming,
+		list: func(ctx context.Context, q *db.Queries, profileID int64) (map[string]importRow[dynamic.Middleware], error) {
+			rows, err := q.ListHttpMiddlewares(ctx, &db.ListHttpMiddlewaresParams{ProfileID: profileID})
+			existing := make(map[string]importRow[dynamic.Middleware], len(rows))
+			for _, r := range rows {
+				existing[r.Name] = importRow[dynamic.Middleware]{
+					id:        r.ID,
+					config:    r.Config.Data,
+					enabled:   r.Enabled,
+					isDefault: r.IsDefault,
+				}
+			}
+			return existing, err
+		},
+		create: func(ctx context.Context, q *db.Queries, profileID int64, name string, cfg *dynamic.Middleware) error {
+			_, err := q.CreateHttpMiddleware(ctx, &db.CreateHttpMiddlewareParams{
+				ID:        uuid.New().String(),
+				ProfileID: profileID,
+				Name:      name,
+				Config:    &db.MiddlewareConfig{Data: cfg},
+			})
+			return err
+		},
+		update: func(ctx context.Context, q *db.Queries, row importRow[dynamic.Middleware], name string, cfg *dynamic.Middleware) error {
+			_, err := q.UpdateHttpMiddleware(ctx, &db.UpdateHttpMiddlewareParams{
+				ID:        row.id,
+				Name:      name,
+				Config:    &db.MiddlewareConfig{Data: cfg},
+				Enabled:   row.enabled,
+				IsDefault: row.isDefault,
+			})
+			return err
+		},
+	}
+}
+
+func tcpRouterImporter(incoming map[string]*dynamic.TCPRouter) importer {
+	return &itemImporter[dynamic.TCPRouter]{
+		protocol: "tcp",
+		typ:      "router",
+		incoming: incoming,
+		list: func(ctx context.Context, q *db.Queries, profileID int64) (map[string]importRow[dynamic.TCPRouter], error) {
+			rows, err := q.ListTcpRouters(ctx, &db.ListTcpRoutersParams{ProfileID: profileID})
+			existing := make(map[string]importRow[dynamic.TCPRouter], len(rows))
+			for _, r := range rows {
+				existing[r.Name] = importRow[dynamic.TCPRouter]{id: r.ID, config: r.Config.Data, enabled: r.Enabled}
+			}
+			return existing, err
+		},
+		create: func(ctx context.Context, q *db.Queries, profileID int64, name string, cfg *dynamic.TCPRouter) error {
+			_, err := q.CreateTcpRouter(ctx, &db.CreateTcpRouterParams{
+				ID:        uuid.New().String(),
+				ProfileID: profileID,
+				Name:      name,
+				Config:    &db.TCPRouterConfig{Data: cfg},
+			})
+			return err
+		},
+		update: func(ctx context.Context, q *db.Queries, row importRow[dynamic.TCPRouter], name string, cfg *dynamic.TCPRouter) error {
+			_, err := q.UpdateTcpRouter(ctx, &db.UpdateTcpRouterParams{
+				ID:      row.id,
+				Name:    name,
+				Config:  &db.TCPRouterConfig{Data: cfg},
+				Enabled: row.enabled,
+			})
+			return err
+		},
+	}
+}
+
+func tcpServiceImporter(incoming map[string]*dynamic.TCPService) importer {
+	return &itemImporter[dynamic.TCPService]{
+		protocol: "tcp",
+		typ:      "service",
+		incoming: incoming,
+		list: func(ctx context.Context, q *db.Queries, profileID int64) (map[string]importRow[dynamic.TCPService], error) {
+			rows, err := q.ListTcpServices(ctx, &db.ListTcpServicesParams{ProfileID: profileID})
+			existing := make(map[string]importRow[dynamic.TCPService], len(rows))
+			for _, r := range rows {
+				existing[r.Name] = importRow[dynamic.TCPService]{id: r.ID, config: r.Config.Data, enabled: r.Enabled}
+			}
+			return existing, err
+		},
+		create: func(ctx context.Context, q *db.Queries, profileID int64, name string, cfg *dynamic.TCPService) error {
+			_, err := q.CreateTcpService(ctx, &db.CreateTcpServiceParams{
+				ID:        uuid.New().String(),
+				ProfileID: profileID,
+				Name:      name,
+				Config:    &db.TCPServiceConfig{Data: cfg},
+			})
+			return err
+		},
+		update: func(ctx context.Context, q *db.Queries, row importRow[dynamic.TCPService], name string, cfg *dynamic.TCPService) error {
+			_, err := q.UpdateTcpService(ctx, &db.UpdateTcpServiceParams{
+				ID:      row.id,
+				Name:    name,
+				Config:  &db.TCPServiceConfig{Data: cfg},
+				Enabled: row.enabled,
+			})
+			return err
+		},
+	}
+}
+
+func tcpMiddlewareImporter(incoming map[string]*dynamic.TCPMiddleware) importer {
+	return &itemImporter[dynamic.TCPMiddleware]{
+		protocol: "tcp",
+		typ:      "middleware",
+		incoming: incoming,
+		list: func(ctx context.Context, q *db.Queries, profileID int64) (map[string]importRow[dynamic.TCPMiddleware], error) {
+			rows, err := q.ListTcpMiddlewares(ctx, &db.ListTcpMiddlewaresParams{ProfileID: profileID})
+			existing := make(map[string]importRow[dynamic.TCPMiddleware], len(rows))
+			for _, r := range rows {
+				existing[r.Name] = importRow[dynamic.TCPMiddleware]{
+					id:        r.ID,
+					config:    r.Config.Data,
+					enabled:   r.Enabled,
+					isDefault: r.IsDefault,
+				}
+			}
+			return existing, err
+		},
+		create: func(ctx context.Context, q *db.Queries, profileID int64, name string, cfg *dynamic.TCPMiddleware) error {
+			_, err := q.CreateTcpMiddleware(ctx, &db.CreateTcpMiddlewareParams{
+				ID:        uuid.New().String(),
+				ProfileID: profileID,
+				Name:      name,
+				Config:    &db.TCPMiddlewareConfig{Data: cfg},
+			})
+			return err
+		},
+		update: func(ctx context.Context, q *db.Queries, row importRow[dynamic.TCPMiddleware], name string, cfg *dynamic.TCPMiddleware) error {
+			_, err := q.UpdateTcpMiddleware(ctx, &db.UpdateTcpMiddlewareParams{
+				ID:        row.id,
+				Name:      name,
+				Config:    &db.TCPMiddlewareConfig{Data: cfg},
+				Enabled:   row.enabled,
+				IsDefault: row.isDefault,
+			})
+			return err
+		},
+	}
+}
+
+func udpRouterImporter(incoming map[string]*dynamic.UDPRouter) importer {
+	return &itemImporter[dynamic.UDPRouter]{
+		protocol: "udp",
+		typ:      "router",
+		incoming: incoming,
+		list: func(ctx context.Context, q *db.Queries, profileID int64) (map[string]importRow[dynamic.UDPRouter], error) {
+			rows, err := q.ListUdpRouters(ctx, &db.ListUdpRoutersParams{ProfileID: profileID})
+			existing := make(map[string]importRow[dynamic.UDPRouter], len(rows))
+			for _, r := range rows {
+				existing[r.Name] = importRow[dynamic.UDPRouter]{id: r.ID, config: r.Config.Data, enabled: r.Enabled}
+			}
+			return existing, err
+		},
+		create: func(ctx context.Context, q *db.Queries, profileID int64, name string, cfg *dynamic.UDPRouter) error {
+			_, err := q.CreateUdpRouter(ctx, &db.CreateUdpRouterParams{
+				ID:        uuid.New().String(),
+				ProfileID: profileID,
+				Name:      name,
+				Config:    &db.UDPRouterConfig{Data: cfg},
+			})
+			return err
+		},
+		update: func(ctx context.Context, q *db.Queries, row importRow[dynamic.UDPRouter], name string, cfg *dynamic.UDPRouter) error {
+			_, err := q.UpdateUdpRouter(ctx, &db.UpdateUdpRouterParams{
+				ID:      row.id,
+				Name:    name,
+				Config:  &db.UDPRouterConfig{Data: cfg},
+				Enabled: row.enabled,
+			})
+			return err
+		},
+	}
+}
+
+func udpServiceImporter(incoming map[string]*dynamic.UDPService) importer {
+	return &itemImporter[dynamic.UDPService]{
+		protocol: "udp",
+		typ:      "service",
+		incoming: incoming,
+		list: func(ctx context.Context, q *db.Queries, profileID int64) (map[string]importRow[dynamic.UDPService], error) {
+			rows, err := q.ListUdpServices(ctx, &db.ListUdpServicesParams{ProfileID: profileID})
+			existing := make(map[string]importRow[dynamic.UDPService], len(rows))
+			for _, r := range rows {
+				existing[r.Name] = importRow[dynamic.UDPService]{id: r.ID, config: r.Config.Data, enabled: r.Enabled}
+			}
+			return existing, err
+		},
+		create: func(ctx context.Context, q *db.Queries, profileID int64, name string, cfg *dynamic.UDPService) error {
+			_, err := q.CreateUdpService(ctx, &db.CreateUdpServiceParams{
+				ID:        uuid.New().String(),
+				ProfileID: profileID,
+				Name:      name,
+				Config:    &db.UDPServiceConfig{Data: cfg},
+			})
+			return err
+		},
+		update: func(ctx context.Context, q *db.Queries, row importRow[dynamic.UDPService], name string, cfg *dynamic.UDPService) error {
+			_, err := q.UpdateUdpService(ctx, &db.UpdateUdpServiceParams{
+				ID:      row.id,
+				Name:    name,
+				Config:  &db.UDPServiceConfig{Data: cfg},
+				Enabled: row.enabled,
+			})
+			return err
+		},
+	}
+}
+
+// renameReferences points all references of the configuration at the new
+// names, keyed by importer kind.
+func renameReferences(cfg *dynamic.Configuration, renames map[string]map[string]string) {
+	ref := func(kind, name string) string {
+		if renamed, ok := renames[kind][name]; ok {
+			return renamed
+		}
+		return name
+	}
+	refs := func(kind string, names []string) {
+		for i, name := range names {
+			names[i] = ref(kind, name)
+		}
+	}
+
+	if cfg.HTTP != nil {
+		for _, router := range cfg.HTTP.Routers {
+			if router == nil {
+				continue
+			}
+			router.Service = ref("http service", router.Service)
+			refs("http middleware", router.Middlewares)
+		}
+		for _, svc := range cfg.HTTP.Services {
+			if svc == nil {
+				continue
+			}
+			refs("http middleware", svc.Middlewares)
+			if svc.Weighted != nil {
+				for i := range svc.Weighted.Services {
+					svc.Weighted.Services[i].Name = ref("http service", svc.Weighted.Services[i].Name)
+				}
+			}
+			if svc.HighestRandomWeight != nil {
+				for i := range svc.HighestRandomWeight.Services {
+					svc.HighestRandomWeight.Services[i].Name = ref(
+						"http service",
+						svc.HighestRandomWeight.Services[i].Name,
+					)
+				}
+			}
+			if svc.Mirroring != nil {
+				svc.Mirroring.Service = ref("http service", svc.Mirroring.Service)
+				for i := range svc.Mirroring.Mirrors {
+					svc.Mirroring.Mirrors[i].Name = ref("http service", svc.Mirroring.Mirrors[i].Name)
+				}
+			}
+			if svc.Failover != nil {
+				svc.Failover.Service = ref("http service", svc.Failover.Service)
+				svc.Failover.Fallback = ref("http service", svc.Failover.Fallback)
+			}
+		}
+		for _, mw := range cfg.HTTP.Middlewares {
+			if mw == nil {
+				continue
+			}
+			if mw.Chain != nil {
+				refs("http middleware", mw.Chain.Middlewares)
+			}
+			if mw.Errors != nil {
+				mw.Errors.Service = ref("http service", mw.Errors.Service)
+			}
+		}
+	}
+
+	if cfg.TCP != nil {
+		for _, router := range cfg.TCP.Routers {
+			if router == nil {
+				continue
+			}
+			router.Service = ref("tcp service", router.Service)
+			refs("tcp middleware", router.Middlewares)
+		}
+		for _, svc := range cfg.TCP.Services {
+			if svc == nil || svc.Weighted == nil {
+				continue
+			}
+			for i := range svc.Weighted.Services {
+				svc.Weighted.Services[i].Name = ref("tcp service", svc.Weighted.Services[i].Name)
+			}
+		}
+	}
+
+	if cfg.UDP != nil {
+		for _, router := range cfg.UDP.Routers {
+			if router == nil {
+				continue
+			}
+			router.Service = ref("udp service", router.Service)
+		}
+		for _, svc := range cfg.UDP.Services {
+			if svc == nil || svc.Weighted == nil {
+				continue
+			}
+			for i := range svc.Weighted.Services {
+				svc.Weighted.Services[i].Name = ref("udp service", svc.Weighted.Services[i].Name)
+			}
+		}
+	}
+}
+
+// importEntryPoints creates the entry points used by the imported routers
+// that don't exist in the profile yet.
+func importEntryPoints(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	cfg *dynamic.Configuration,
+) error {
+	var names []string
+	if cfg.HTTP != nil {
+		for _, router := range cfg.HTTP.Routers {
+			if router != nil {
+				names = append(names, router.EntryPoints...)
+			}
+		}
+	}
+	if cfg.TCP != nil {
+		for _, router := range cfg.TCP.Routers {
+			if router != nil {
+				names = append(names, router.EntryPoints...)
+			}
+		}
+	}
+	if cfg.UDP != nil {
+		for _, router := range cfg.UDP.Routers {
+			if router != nil {
+				names = append(names, router.EntryPoints...)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	existing, err := q.ListEntryPoints(ctx, &db.ListEntryPointsParams{ProfileID: profileID})
+	if err != nil {
+		return fmt.Errorf("failed to list entry points: %w", err)
+	}
+	for _, ep := range existing {
+		names = slices.DeleteFunc(names, func(name string) bool { return name == ep.Name })
+	}
+
+	slices.Sort(names)
+	for _, name := range slices.Compact(names) {
+		if name == "" {
+			continue
+		}
+		if _, err = q.CreateEntryPoint(ctx, &db.CreateEntryPointParams{
+			ID:        uuid.New().String(),
+			ProfileID: profileID,
+			Name:      name,
+		}); err != nil {
+			return fmt.Errorf("failed to import entry point %q: %w", name, err)
+		}
+	}
+	return nil
+}
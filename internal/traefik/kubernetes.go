@@ -0,0 +1,810 @@
+package traefik
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/provider/kubernetes/crd/traefikio/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
+)
+
+// KubernetesExport holds the manifests generated from a dynamic configuration
+// for Traefik's Kubernetes CRD provider, together with everything that could
+// not be expressed as CRDs.
+type KubernetesExport struct {
+	Objects  []any
+	Warnings []string
+}
+
+// ToKubernetes converts a dynamic configuration into traefik.io/v1alpha1
+// resources in the given namespace.
+//
+// CRD routes can only point at Kubernetes services, so every server URL of a
+// load balancer becomes an ExternalName service, named after the protocol and
+// the service. Traefik has to run with allowExternalNameServices enabled for
+// these to be routable.
+//
+// Names that collide once normalized, e.g. "foo_bar" and "foo-bar", get a
+// numeric suffix and a warning.
+func ToKubernetes(cfg *dynamic.Configuration, namespace string) *KubernetesExport {
+	c := &crdConverter{
+		namespace: namespace,
+		export:    &KubernetesExport{},
+		httpRefs:  make(map[string]v1alpha1.LoadBalancerSpec),
+		tcpRefs:   make(map[string][]v1alpha1.ServiceTCP),
+		udpRefs:   make(map[string][]v1alpha1.ServiceUDP),
+		names:     make(map[string]map[string]string),
+		taken:     make(map[string]map[string]bool),
+	}
+	if cfg == nil {
+		return c.export
+	}
+
+	// Name all objects up front, so references resolve to the same names
+	// regardless of the order they are converted in
+	if cfg.HTTP != nil {
+		c.reserve("TraefikService", slices.Collect(maps.Keys(cfg.HTTP.Services)))
+		c.reserve("ServersTransport", slices.Collect(maps.Keys(cfg.HTTP.ServersTransports)))
+		c.reserve("Middleware", slices.Collect(maps.Keys(cfg.HTTP.Middlewares)))
+		c.reserve("IngressRoute", slices.Collect(maps.Keys(cfg.HTTP.Routers)))
+	}
+	if cfg.TCP != nil {
+		c.reserve("ServersTransportTCP", slices.Collect(maps.Keys(cfg.TCP.ServersTransports)))
+		c.reserve("MiddlewareTCP", slices.Collect(maps.Keys(cfg.TCP.Middlewares)))
+		c.reserve("IngressRouteTCP", slices.Collect(maps.Keys(cfg.TCP.Routers)))
+	}
+	if cfg.UDP != nil {
+		c.reserve("IngressRouteUDP", slices.Collect(maps.Keys(cfg.UDP.Routers)))
+	}
+
+	if cfg.HTTP != nil {
+		c.httpServices(cfg.HTTP.Services)
+		c.serversTransports(cfg.HTTP.ServersTransports)
+		c.httpMiddlewares(cfg.HTTP.Middlewares)
+		c.httpRouters(cfg.HTTP.Routers)
+	}
+	if cfg.TCP != nil {
+		c.tcpServices(cfg.TCP.Services)
+		c.tcpServersTransports(cfg.TCP.ServersTransports)
+		c.tcpMiddlewares(cfg.TCP.Middlewares)
+		c.tcpRouters(cfg.TCP.Routers)
+	}
+	if cfg.UDP != nil {
+		c.udpServices(cfg.UDP.Services)
+		c.udpRouters(cfg.UDP.Routers)
+	}
+	return c.export
+}
+
+// YAML renders the export as a multi-document YAML stream, listing the
+// warnings as comments at the top.
+func (e *KubernetesExport) YAML() ([]byte, error) {
+	var buf bytes.Buffer
+	for _, w := range e.Warnings {
+		fmt.Fprintf(&buf, "# WARNING: %s\n", w)
+	}
+	for _, obj := range e.Objects {
+		// Drop the (empty) status of core objects, it is set by the cluster
+		m, err := toMap(obj)
+		if err != nil {
+			return nil, err
+		}
+		delete(m, "status")
+
+		data, err := yaml.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("---\n")
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+type crdConverter struct {
+	namespace string
+	export    *KubernetesExport
+
+	// References to the converted services, keyed by their original name
+	httpRefs map[string]v1alpha1.LoadBalancerSpec
+	tcpRefs  map[string][]v1alpha1.ServiceTCP
+	udpRefs  map[string][]v1alpha1.ServiceUDP
+
+	// Kubernetes names per kind, keyed by the original name, and the names
+	// per kind that are already in use
+	names map[string]map[string]string
+	taken map[string]map[string]bool
+}
+
+func (c *crdConverter) warn(format string, args ...any) {
+	c.export.Warnings = append(c.export.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (c *crdConverter) add(obj any) {
+	c.export.Objects = append(c.export.Objects, obj)
+}
+
+func (c *crdConverter) meta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: c.namespace,
+		Labels:    map[string]string{"app.kubernetes.io/managed-by": "mantrae"},
+	}
+}
+
+// reserve names the objects of a kind in sorted order, so the first object
+// keeps its name when several normalize to the same one.
+func (c *crdConverter) reserve(kind string, names []string) {
+	if c.names[kind] == nil {
+		c.names[kind] = make(map[string]string)
+	}
+	for _, name := range slices.Sorted(slices.Values(names)) {
+		c.names[kind][name] = c.claim(kind, k8sName(name), name)
+	}
+}
+
+// name returns the Kubernetes name of an object. References to other
+// providers (name@provider) are kept as they are.
+func (c *crdConverter) name(kind, name string) string {
+	if name == "" || strings.Contains(name, "@") {
+		return name
+	}
+	if n, ok := c.names[kind][name]; ok {
+		return n
+	}
+	return k8sName(name)
+}
+
+// claim takes a Kubernetes name of a kind, adding a numeric suffix if it is
+// already in use.
+func (c *crdConverter) claim(kind, name, original string) string {
+	if c.taken[kind] == nil {
+		c.taken[kind] = make(map[string]bool)
+	}
+	unique := name
+	for i := 2; c.taken[kind][unique]; i++ {
+		suffix := "-" + strconv.Itoa(i)
+		unique = strings.TrimRight(name[:min(len(name), 63-len(suffix))], "-") + suffix
+	}
+	if unique != name {
+		c.warn("%s %q: name %q is already in use, renamed to %q", kind, original, name, unique)
+	}
+	c.taken[kind][unique] = true
+	return unique
+}
+
+func crdType(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: kind}
+}
+
+// HTTP -----------------------------------------------------------------------
+
+func (c *crdConverter) httpServices(services map[string]*dynamic.Service) {
+	// Resolve references first, composite services may point at each other
+	for _, name := range slices.Sorted(maps.Keys(services)) {
+		svc := services[name]
+		if svc.LoadBalancer != nil && len(svc.LoadBalancer.Servers) == 1 {
+			ref, ok := c.httpServer(name, "http-"+name, svc.LoadBalancer, svc.LoadBalancer.Servers[0])
+			if ok {
+				c.httpRefs[name] = ref
+			}
+			continue
+		}
+		c.httpRefs[name] = v1alpha1.LoadBalancerSpec{
+			Name: c.name("TraefikService", name),
+			Kind: "TraefikService",
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(services)) {
+		svc := services[name]
+		if len(svc.Middlewares) > 0 {
+			c.warn("http service %q: service middlewares are not supported and were dropped", name)
+		}
+
+		spec := v1alpha1.TraefikServiceSpec{}
+		switch {
+		case svc.LoadBalancer != nil:
+			if len(svc.LoadBalancer.Servers) == 1 {
+				continue
+			}
+			if len(svc.LoadBalancer.Servers) == 0 {
+				c.warn("http service %q has no servers", name)
+			}
+			// Multiple servers are balanced through a weighted TraefikService
+			spec.Weighted = &v1alpha1.WeightedRoundRobin{Sticky: svc.LoadBalancer.Sticky}
+			for i, server := range svc.LoadBalancer.Servers {
+				backend := fmt.Sprintf("http-%s-%d", name, i)
+				ref, ok := c.httpServer(name, backend, svc.LoadBalancer, server)
+				if !ok {
+					continue
+				}
+				ref.Weight = server.Weight
+				ref.Sticky = nil
+				spec.Weighted.Services = append(spec.Weighted.Services, v1alpha1.Service{LoadBalancerSpec: ref})
+			}
+		case svc.Weighted != nil:
+			spec.Weighted = &v1alpha1.WeightedRoundRobin{Sticky: svc.Weighted.Sticky}
+			for _, s := range svc.Weighted.Services {
+				ref := c.httpRef(s.Name)
+				ref.Weight = s.Weight
+				spec.Weighted.Services = append(spec.Weighted.Services, v1alpha1.Service{LoadBalancerSpec: ref})
+			}
+		case svc.HighestRandomWeight != nil:
+			spec.HighestRandomWeight = &v1alpha1.HighestRandomWeight{}
+			for _, s := range svc.HighestRandomWeight.Services {
+				ref := c.httpRef(s.Name)
+				ref.Weight = s.Weight
+				spec.HighestRandomWeight.Services = append(
+					spec.HighestRandomWeight.Services,
+					v1alpha1.Service{LoadBalancerSpec: ref},
+				)
+			}
+		case svc.Mirroring != nil:
+			spec.Mirroring = &v1alpha1.Mirroring{
+				LoadBalancerSpec: c.httpRef(svc.Mirroring.Service),
+				MirrorBody:       svc.Mirroring.MirrorBody,
+				MaxBodySize:      svc.Mirroring.MaxBodySize,
+			}
+			for _, m := range svc.Mirroring.Mirrors {
+				spec.Mirroring.Mirrors = append(spec.Mirroring.Mirrors, v1alpha1.MirrorService{
+					LoadBalancerSpec: c.httpRef(m.Name),
+					Percent:          m.Percent,
+				})
+			}
+		case svc.Failover != nil:
+			spec.Failover = &v1alpha1.Failover{
+				Service:  c.httpRef(svc.Failover.Service),
+				Fallback: c.httpRef(svc.Failover.Fallback),
+			}
+			if svc.Failover.Errors != nil {
+				spec.Failover.Errors = v1alpha1.FailoverError{
+					Status:              svc.Failover.Errors.Status,
+					MaxRequestBodyBytes: svc.Failover.Errors.MaxRequestBodyBytes,
+				}
+			}
+		default:
+			c.warn("http service %q has no supported service type", name)
+			continue
+		}
+
+		c.add(&v1alpha1.TraefikService{
+			TypeMeta:   crdType("TraefikService"),
+			ObjectMeta: c.meta(c.name("TraefikService", name)),
+			Spec:       spec,
+		})
+	}
+}
+
+// httpServer creates an ExternalName service for a single load balancer
+// server and returns the reference to it.
+func (c *crdConverter) httpServer(
+	name, backend string,
+	lb *dynamic.ServersLoadBalancer,
+	server dynamic.Server,
+) (v1alpha1.LoadBalancerSpec, bool) {
+	u, err := url.Parse(server.URL)
+	if err != nil || u.Hostname() == "" {
+		c.warn("http service %q: invalid server url %q", name, server.URL)
+		return v1alpha1.LoadBalancerSpec{}, false
+	}
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	if u.Path != "" && u.Path != "/" {
+		c.warn("http service %q: server path %q is not supported and was dropped", name, u.Path)
+	}
+
+	portNum, _ := strconv.Atoi(port)
+
+	ref := v1alpha1.LoadBalancerSpec{
+		Name:             c.externalName(backend, u.Hostname(), portNum, corev1.ProtocolTCP),
+		Port:             intstr.FromInt(portNum),
+		Sticky:           lb.Sticky,
+		Strategy:         lb.Strategy,
+		PassHostHeader:   lb.PassHostHeader,
+		ServersTransport: c.name("ServersTransport", lb.ServersTransport),
+	}
+	if u.Scheme != "http" {
+		ref.Scheme = u.Scheme
+	}
+	if lb.ResponseForwarding != nil {
+		ref.ResponseForwarding = &v1alpha1.ResponseForwarding{
+			FlushInterval: lb.ResponseForwarding.FlushInterval.String(),
+		}
+	}
+	if lb.HealthCheck != nil {
+		ref.HealthCheck = &v1alpha1.ServerHealthCheck{}
+		for _, field := range convertSpec(lb.HealthCheck, ref.HealthCheck) {
+			c.warn("http service %q: healthCheck.%s is not supported and was dropped", name, field)
+		}
+	}
+	if lb.PassiveHealthCheck != nil {
+		ref.PassiveHealthCheck = &v1alpha1.PassiveServerHealthCheck{}
+		for _, field := range convertSpec(lb.PassiveHealthCheck, ref.PassiveHealthCheck) {
+			c.warn("http service %q: passiveHealthCheck.%s is not supported and was dropped", name, field)
+		}
+	}
+	return ref, true
+}
+
+func (c *crdConverter) httpRef(name string) v1alpha1.LoadBalancerSpec {
+	if ref, ok := c.httpRefs[name]; ok {
+		return ref
+	}
+	if !strings.Contains(name, "@") {
+		c.warn("http service %q is referenced but not defined", name)
+	}
+	return v1alpha1.LoadBalancerSpec{Name: c.name("TraefikService", name), Kind: "TraefikService"}
+}
+
+func (c *crdConverter) serversTransports(transports map[string]*dynamic.ServersTransport) {
+	for _, name := range slices.Sorted(maps.Keys(transports)) {
+		transport := transports[name]
+		if len(transport.RootCAs) > 0 || len(transport.Certificates) > 0 {
+			c.warn("servers transport %q: certificates have to be provided as secrets", name)
+		}
+		spec := v1alpha1.ServersTransportSpec{}
+		for _, field := range convertSpec(transport, &spec, "rootCAs", "certificates") {
+			c.warn("servers transport %q: %s is not supported and was dropped", name, field)
+		}
+		c.add(&v1alpha1.ServersTransport{
+			TypeMeta:   crdType("ServersTransport"),
+			ObjectMeta: c.meta(c.name("ServersTransport", name)),
+			Spec:       spec,
+		})
+	}
+}
+
+func (c *crdConverter) httpMiddlewares(middlewares map[string]*dynamic.Middleware) {
+	for _, name := range slices.Sorted(maps.Keys(middlewares)) {
+		mw := middlewares[name]
+		spec := v1alpha1.MiddlewareSpec{}
+		for _, field := range convertSpec(
+			mw, &spec,
+			"chain.middlewares", "errors.service", "basicAuth.users", "digestAuth.users",
+		) {
+			c.warn("http middleware %q: %s is not supported and was dropped", name, field)
+		}
+
+		if mw.Chain != nil {
+			spec.Chain = &v1alpha1.Chain{}
+			for _, m := range mw.Chain.Middlewares {
+				spec.Chain.Middlewares = append(spec.Chain.Middlewares, v1alpha1.MiddlewareRef{
+					Name: c.name("Middleware", m),
+				})
+			}
+		}
+		if mw.Errors != nil && mw.Errors.Service != "" && spec.Errors != nil {
+			spec.Errors.Service = v1alpha1.Service{LoadBalancerSpec: c.httpRef(mw.Errors.Service)}
+		}
+		// Credentials are read from secrets in Kubernetes
+		if mw.BasicAuth != nil && len(mw.BasicAuth.Users) > 0 && spec.BasicAuth != nil {
+			spec.BasicAuth.Secret = c.usersSecret(name, mw.BasicAuth.Users)
+		}
+		if mw.DigestAuth != nil && len(mw.DigestAuth.Users) > 0 && spec.DigestAuth != nil {
+			spec.DigestAuth.Secret = c.usersSecret(name, mw.DigestAuth.Users)
+		}
+
+		c.add(&v1alpha1.Middleware{
+			TypeMeta:   crdType("Middleware"),
+			ObjectMeta: c.meta(c.name("Middleware", name)),
+			Spec:       spec,
+		})
+	}
+}
+
+func (c *crdConverter) usersSecret(middleware string, users dynamic.Users) string {
+	name := c.claim("Secret", k8sName(middleware+"-users"), middleware)
+	c.add(&corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: c.meta(name),
+		Type:       corev1.SecretTypeOpaque,
+		StringData: map[string]string{"users": strings.Join(users, "\n")},
+	})
+	return name
+}
+
+func (c *crdConverter) httpRouters(routers map[string]*dynamic.Router) {
+	for _, name := range slices.Sorted(maps.Keys(routers)) {
+		router := routers[name]
+		route := v1alpha1.Route{
+			Match:    router.Rule,
+			Kind:     "Rule",
+			Priority: router.Priority,
+			Syntax:   router.RuleSyntax,
+		}
+		if router.Service != "" {
+			route.Services = []v1alpha1.Service{{LoadBalancerSpec: c.httpRef(router.Service)}}
+		}
+		for _, m := range router.Middlewares {
+			route.Middlewares = append(route.Middlewares, v1alpha1.MiddlewareRef{
+				Name: c.name("Middleware", m),
+			})
+		}
+		if router.Observability != nil {
+			route.Observability = &v1alpha1.RouterObservabilityConfig{}
+			convertSpec(router.Observability, route.Observability)
+		}
+		if len(router.ParentRefs) > 0 {
+			c.warn("http router %q: parentRefs are not supported and were dropped", name)
+		}
+
+		spec := v1alpha1.IngressRouteSpec{
+			EntryPoints: router.EntryPoints,
+			Routes:      []v1alpha1.Route{route},
+		}
+		if router.TLS != nil {
+			spec.TLS = &v1alpha1.TLS{
+				CertResolver: router.TLS.CertResolver,
+				Domains:      router.TLS.Domains,
+			}
+			if router.TLS.Options != "" {
+				spec.TLS.Options = &v1alpha1.TLSOptionRef{Name: refName(router.TLS.Options)}
+			}
+		}
+
+		c.add(&v1alpha1.IngressRoute{
+			TypeMeta:   crdType("IngressRoute"),
+			ObjectMeta: c.meta(c.name("IngressRoute", name)),
+			Spec:       spec,
+		})
+	}
+}
+
+// TCP ------------------------------------------------------------------------
+
+func (c *crdConverter) tcpServices(services map[string]*dynamic.TCPService) {
+	for _, name := range slices.Sorted(maps.Keys(services)) {
+		lb := services[name].LoadBalancer
+		if lb == nil {
+			continue
+		}
+		for i, server := range lb.Servers {
+			backend := "tcp-" + name
+			if len(lb.Servers) > 1 {
+				backend = fmt.Sprintf("%s-%d", backend, i)
+			}
+			backend, port, ok := c.hostPort(
+				backend, "tcp service", name, server.Address, corev1.ProtocolTCP,
+			)
+			if !ok {
+				continue
+			}
+			c.tcpRefs[name] = append(c.tcpRefs[name], v1alpha1.ServiceTCP{
+				Name:             backend,
+				Port:             intstr.FromInt(port),
+				ProxyProtocol:    lb.ProxyProtocol,
+				TerminationDelay: lb.TerminationDelay,
+				ServersTransport: c.name("ServersTransportTCP", lb.ServersTransport),
+				TLS:              server.TLS,
+			})
+		}
+	}
+
+	// Weighted services are flattened into the route's service list
+	for _, name := range slices.Sorted(maps.Keys(services)) {
+		weighted := services[name].Weighted
+		if weighted == nil {
+			continue
+		}
+		for _, s := range weighted.Services {
+			refs := c.tcpRefs[s.Name]
+			if len(refs) == 0 {
+				c.warn("tcp service %q: weighted service %q cannot be resolved", name, s.Name)
+				continue
+			}
+			if len(refs) > 1 {
+				c.warn("tcp service %q: nested weights of %q are flattened", name, s.Name)
+			}
+			for _, ref := range refs {
+				ref.Weight = s.Weight
+				c.tcpRefs[name] = append(c.tcpRefs[name], ref)
+			}
+		}
+	}
+}
+
+func (c *crdConverter) tcpServersTransports(transports map[string]*dynamic.TCPServersTransport) {
+	for _, name := range slices.Sorted(maps.Keys(transports)) {
+		transport := transports[name]
+		if transport.TLS != nil && (len(transport.TLS.RootCAs) > 0 || len(transport.TLS.Certificates) > 0) {
+			c.warn("tcp servers transport %q: certificates have to be provided as secrets", name)
+		}
+		spec := v1alpha1.ServersTransportTCPSpec{}
+		for _, field := range convertSpec(transport, &spec, "tls.rootCAs", "tls.certificates") {
+			c.warn("tcp servers transport %q: %s is not supported and was dropped", name, field)
+		}
+		c.add(&v1alpha1.ServersTransportTCP{
+			TypeMeta:   crdType("ServersTransportTCP"),
+			ObjectMeta: c.meta(c.name("ServersTransportTCP", name)),
+			Spec:       spec,
+		})
+	}
+}
+
+func (c *crdConverter) tcpMiddlewares(middlewares map[string]*dynamic.TCPMiddleware) {
+	for _, name := range slices.Sorted(maps.Keys(middlewares)) {
+		spec := v1alpha1.MiddlewareTCPSpec{}
+		for _, field := range convertSpec(middlewares[name], &spec) {
+			c.warn("tcp middleware %q: %s is not supported and was dropped", name, field)
+		}
+		c.add(&v1alpha1.MiddlewareTCP{
+			TypeMeta:   crdType("MiddlewareTCP"),
+			ObjectMeta: c.meta(c.name("MiddlewareTCP", name)),
+			Spec:       spec,
+		})
+	}
+}
+
+func (c *crdConverter) tcpRouters(routers map[string]*dynamic.TCPRouter) {
+	for _, name := range slices.Sorted(maps.Keys(routers)) {
+		router := routers[name]
+		route := v1alpha1.RouteTCP{
+			Match:    router.Rule,
+			Priority: router.Priority,
+			Syntax:   router.RuleSyntax,
+			Services: c.tcpRefs[router.Service],
+		}
+		if len(route.Services) == 0 {
+			c.warn("tcp router %q: service %q cannot be resolved", name, router.Service)
+		}
+		for _, m := range router.Middlewares {
+			route.Middlewares = append(route.Middlewares, v1alpha1.ObjectReference{
+				Name: c.name("MiddlewareTCP", m),
+			})
+		}
+
+		spec := v1alpha1.IngressRouteTCPSpec{
+			EntryPoints: router.EntryPoints,
+			Routes:      []v1alpha1.RouteTCP{route},
+		}
+		if router.TLS != nil {
+			spec.TLS = &v1alpha1.TLSTCP{
+				Passthrough:  router.TLS.Passthrough,
+				CertResolver: router.TLS.CertResolver,
+				Domains:      router.TLS.Domains,
+			}
+			if router.TLS.Options != "" {
+				spec.TLS.Options = &v1alpha1.ObjectReference{Name: refName(router.TLS.Options)}
+			}
+		}
+
+		c.add(&v1alpha1.IngressRouteTCP{
+			TypeMeta:   crdType("IngressRouteTCP"),
+			ObjectMeta: c.meta(c.name("IngressRouteTCP", name)),
+			Spec:       spec,
+		})
+	}
+}
+
+// UDP ------------------------------------------------------------------------
+
+func (c *crdConverter) udpServices(services map[string]*dynamic.UDPService) {
+	for _, name := range slices.Sorted(maps.Keys(services)) {
+		lb := services[name].LoadBalancer
+		if lb == nil {
+			continue
+		}
+		for i, server := range lb.Servers {
+			backend := "udp-" + name
+			if len(lb.Servers) > 1 {
+				backend = fmt.Sprintf("%s-%d", backend, i)
+			}
+			backend, port, ok := c.hostPort(
+				backend, "udp service", name, server.Address, corev1.ProtocolUDP,
+			)
+			if !ok {
+				continue
+			}
+			c.udpRefs[name] = append(c.udpRefs[name], v1alpha1.ServiceUDP{
+				Name: backend,
+				Port: intstr.FromInt(port),
+			})
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(services)) {
+		weighted := services[name].Weighted
+		if weighted == nil {
+			continue
+		}
+		for _, s := range weighted.Services {
+			refs := c.udpRefs[s.Name]
+			if len(refs) == 0 {
+				c.warn("udp service %q: weighted service %q cannot be resolved", name, s.Name)
+				continue
+			}
+			if len(refs) > 1 {
+				c.warn("udp service %q: nested weights of %q are flattened", name, s.Name)
+			}
+			for _, ref := range refs {
+				ref.Weight = s.Weight
+				c.udpRefs[name] = append(c.udpRefs[name], ref)
+			}
+		}
+	}
+}
+
+func (c *crdConverter) udpRouters(routers map[string]*dynamic.UDPRouter) {
+	for _, name := range slices.Sorted(maps.Keys(routers)) {
+		router := routers[name]
+		services := c.udpRefs[router.Service]
+		if len(services) == 0 {
+			c.warn("udp router %q: service %q cannot be resolved", name, router.Service)
+		}
+		c.add(&v1alpha1.IngressRouteUDP{
+			TypeMeta:   crdType("IngressRouteUDP"),
+			ObjectMeta: c.meta(c.name("IngressRouteUDP", name)),
+			Spec: v1alpha1.IngressRouteUDPSpec{
+				EntryPoints: router.EntryPoints,
+				Routes:      []v1alpha1.RouteUDP{{Services: services}},
+			},
+		})
+	}
+}
+
+// Helpers --------------------------------------------------------------------
+
+// hostPort creates an ExternalName service for a "host:port" server address
+// and returns its name and the port.
+func (c *crdConverter) hostPort(
+	backend, kind, name, address string,
+	protocol corev1.Protocol,
+) (string, int, bool) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		c.warn("%s %q: invalid server address %q", kind, name, address)
+		return "", 0, false
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		c.warn("%s %q: invalid server port %q", kind, name, portStr)
+		return "", 0, false
+	}
+	return c.externalName(backend, host, port, protocol), port, true
+}
+
+// externalName creates an ExternalName service and returns its name, which is
+// made unique among the generated services.
+func (c *crdConverter) externalName(
+	name, host string,
+	port int,
+	protocol corev1.Protocol,
+) string {
+	name = c.claim("Service", k8sName(name), name)
+	c.add(&corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: c.meta(name),
+		Spec: corev1.ServiceSpec{
+			Type:         corev1.ServiceTypeExternalName,
+			ExternalName: host,
+			Ports: []corev1.ServicePort{{
+				Protocol:   protocol,
+				Port:       int32(port),
+				TargetPort: intstr.FromInt(port),
+			}},
+		},
+	})
+	return name
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// k8sName turns a Traefik object name into a valid Kubernetes resource name
+// (RFC 1035 label).
+func k8sName(name string) string {
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.Trim(name, "-")
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		name = "mantrae-" + name
+	}
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.TrimRight(name, "-")
+}
+
+// refName converts a reference to another object. References to other
+// providers (name@provider) are kept as they are.
+func refName(name string) string {
+	if name == "" || strings.Contains(name, "@") {
+		return name
+	}
+	return k8sName(name)
+}
+
+// convertSpec copies src into dst through their JSON representation, skipping
+// the given (dot separated) fields. It returns the remaining fields of src
+// that have no counterpart in dst.
+func convertSpec(src, dst any, skip ...string) []string {
+	srcMap, err := toMap(src)
+	if err != nil {
+		return []string{fmt.Sprintf("(%v)", err)}
+	}
+	for _, path := range skip {
+		deletePath(srcMap, strings.Split(path, "."))
+	}
+
+	data, err := json.Marshal(srcMap)
+	if err != nil {
+		return []string{fmt.Sprintf("(%v)", err)}
+	}
+	if err = json.Unmarshal(data, dst); err != nil {
+		return []string{fmt.Sprintf("(%v)", err)}
+	}
+
+	dstMap, err := toMap(dst)
+	if err != nil {
+		return []string{fmt.Sprintf("(%v)", err)}
+	}
+	return missingFields(srcMap, dstMap, "")
+}
+
+func toMap(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]any)
+	return m, json.Unmarshal(data, &m)
+}
+
+func deletePath(m map[string]any, path []string) {
+	if len(path) == 1 {
+		delete(m, path[0])
+		return
+	}
+	if child, ok := m[path[0]].(map[string]any); ok {
+		deletePath(child, path[1:])
+	}
+}
+
+func missingFields(src, dst map[string]any, prefix string) []string {
+	var missing []string
+	for _, key := range slices.Sorted(maps.Keys(src)) {
+		value, ok := dst[key]
+		if !ok {
+			missing = append(missing, prefix+key)
+			continue
+		}
+		missing = append(missing, missingValues(src[key], value, prefix+key)...)
+	}
+	return missing
+}
+
+func missingValues(src, dst any, path string) []string {
+	switch srcValue := src.(type) {
+	case map[string]any:
+		if dstValue, ok := dst.(map[string]any); ok {
+			return missingFields(srcValue, dstValue, path+".")
+		}
+	case []any:
+		dstValue, ok := dst.([]any)
+		if !ok {
+			return nil
+		}
+		var missing []string
+		for i := range min(len(srcValue), len(dstValue)) {
+			missing = append(missing, missingValues(srcValue[i], dstValue[i], fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return missing
+	}
+	return nil
+}
@@ -0,0 +1,701 @@
+package traefik
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/provider/kubernetes/crd/traefikio/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// KubernetesImport holds the dynamic configuration translated from Traefik
+// Kubernetes CRD manifests, together with everything that could not be mapped.
+type KubernetesImport struct {
+	Config   *dynamic.Configuration
+	Unmapped []UnmappedField
+}
+
+// UnmappedField is a part of a manifest that has no counterpart in the dynamic
+// configuration and was dropped. An empty field refers to the whole object.
+type UnmappedField struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Field     string `json:"field,omitempty"`
+	Reason    string `json:"reason"`
+}
+
+// FromKubernetes translates a multi-document YAML stream of traefik.io (or
+// legacy traefik.containo.us) resources into a dynamic configuration.
+//
+// Objects keep their names, namespaces are dropped. Routes to Kubernetes
+// services are resolved to a server URL: the external name if the Service
+// manifest is part of the stream and of type ExternalName, the cluster DNS name
+// otherwise. Secrets in the stream are used to resolve auth users.
+func FromKubernetes(data []byte) (*KubernetesImport, error) {
+	manifests, err := parseManifests(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &crdImporter{
+		result: &KubernetesImport{Config: &dynamic.Configuration{
+			HTTP: &dynamic.HTTPConfiguration{
+				Routers:     make(map[string]*dynamic.Router),
+				Middlewares: make(map[string]*dynamic.Middleware),
+				Services:    make(map[string]*dynamic.Service),
+			},
+			TCP: &dynamic.TCPConfiguration{
+				Routers:     make(map[string]*dynamic.TCPRouter),
+				Middlewares: make(map[string]*dynamic.TCPMiddleware),
+				Services:    make(map[string]*dynamic.TCPService),
+			},
+			UDP: &dynamic.UDPConfiguration{
+				Routers:  make(map[string]*dynamic.UDPRouter),
+				Services: make(map[string]*dynamic.UDPService),
+			},
+		}},
+		services:        make(map[string]*corev1.Service),
+		secrets:         make(map[string]*corev1.Secret),
+		traefikServices: make(map[string]bool),
+	}
+
+	// Core objects and TraefikService names have to be known before the
+	// references to them are resolved
+	var crds []*manifest
+	for _, m := range manifests {
+		switch {
+		case m.apiVersion == "v1" && m.kind == "Service":
+			svc := &corev1.Service{}
+			if c.decode(m, svc) {
+				c.services[m.namespace+"/"+m.name] = svc
+			}
+		case m.apiVersion == "v1" && m.kind == "Secret":
+			secret := &corev1.Secret{}
+			if c.decode(m, secret) {
+				c.secrets[m.namespace+"/"+m.name] = secret
+			}
+		case isTraefikAPI(m.apiVersion):
+			if m.kind == "TraefikService" {
+				c.traefikServices[m.name] = true
+			}
+			crds = append(crds, m)
+		default:
+			c.unmapped(m, "", "kind is not supported")
+		}
+	}
+
+	for _, m := range crds {
+		switch m.kind {
+		case "Middleware":
+			c.middleware(m)
+		case "TraefikService":
+			c.traefikService(m)
+		case "IngressRoute":
+			c.ingressRoute(m)
+		case "MiddlewareTCP":
+			c.tcpMiddleware(m)
+		case "IngressRouteTCP":
+			c.ingressRouteTCP(m)
+		case "IngressRouteUDP":
+			c.ingressRouteUDP(m)
+		default:
+			c.unmapped(m, "", "kind is not supported")
+		}
+	}
+	return c.result, nil
+}
+
+type manifest struct {
+	apiVersion string
+	kind       string
+	namespace  string
+	name       string
+	raw        map[string]any
+}
+
+func parseManifests(data []byte) ([]*manifest, error) {
+	var manifests []*manifest
+	reader := k8syaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return manifests, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		raw := make(map[string]any)
+		if err = yaml.Unmarshal(doc, &raw); err != nil {
+			return nil, fmt.Errorf("invalid manifest #%d: %w", len(manifests)+1, err)
+		}
+		if len(raw) > 0 {
+			manifests = appendManifest(manifests, raw)
+		}
+	}
+}
+
+// appendManifest adds an object to the list, expanding lists as returned by
+// `kubectl get -o yaml`.
+func appendManifest(manifests []*manifest, raw map[string]any) []*manifest {
+	kind, _ := raw["kind"].(string)
+	if items, ok := raw["items"].([]any); ok && strings.HasSuffix(kind, "List") {
+		for _, item := range items {
+			if obj, ok := item.(map[string]any); ok {
+				manifests = appendManifest(manifests, obj)
+			}
+		}
+		return manifests
+	}
+
+	m := &manifest{kind: kind, raw: raw}
+	m.apiVersion, _ = raw["apiVersion"].(string)
+	if metadata, ok := raw["metadata"].(map[string]any); ok {
+		m.name, _ = metadata["name"].(string)
+		m.namespace, _ = metadata["namespace"].(string)
+	}
+	if m.namespace == "" {
+		m.namespace = "default"
+	}
+	return append(manifests, m)
+}
+
+func isTraefikAPI(apiVersion string) bool {
+	group, _, _ := strings.Cut(apiVersion, "/")
+	return group == v1alpha1.GroupName || group == "traefik.containo.us"
+}
+
+type crdImporter struct {
+	result *KubernetesImport
+
+	services        map[string]*corev1.Service
+	secrets         map[string]*corev1.Secret
+	traefikServices map[string]bool
+}
+
+func (c *crdImporter) unmapped(m *manifest, field, reason string) {
+	c.result.Unmapped = append(c.result.Unmapped, UnmappedField{
+		Kind:      m.kind,
+		Namespace: m.namespace,
+		Name:      m.name,
+		Field:     field,
+		Reason:    reason,
+	})
+}
+
+// decode converts the manifest into obj and reports the spec fields that are
+// unknown to its type.
+func (c *crdImporter) decode(m *manifest, obj any) bool {
+	if m.name == "" {
+		c.unmapped(m, "metadata.name", "object has no name")
+		return false
+	}
+	data, err := json.Marshal(m.raw)
+	if err == nil {
+		err = json.Unmarshal(data, obj)
+	}
+	if err != nil {
+		c.unmapped(m, "", fmt.Sprintf("invalid manifest: %v", err))
+		return false
+	}
+
+	known, err := toMap(obj)
+	if err != nil {
+		return true
+	}
+	rawSpec, _ := m.raw["spec"].(map[string]any)
+	knownSpec, _ := known["spec"].(map[string]any)
+	for _, field := range missingFields(rawSpec, knownSpec, "spec.") {
+		c.unmapped(m, field, "unknown field")
+	}
+	return true
+}
+
+// HTTP -----------------------------------------------------------------------
+
+func (c *crdImporter) ingressRoute(m *manifest) {
+	var ir v1alpha1.IngressRoute
+	if !c.decode(m, &ir) {
+		return
+	}
+	if ir.Spec.IngressClassName != nil {
+		c.unmapped(m, "spec.ingressClassName", "not supported")
+	}
+	if len(ir.Spec.ParentRefs) > 0 {
+		c.unmapped(m, "spec.parentRefs", "not supported")
+	}
+	if ir.Spec.TLS != nil {
+		c.tlsRefs(m, ir.Spec.TLS.SecretName, ir.Spec.TLS.Store != nil)
+	}
+
+	for i, route := range ir.Spec.Routes {
+		field := fmt.Sprintf("spec.routes[%d]", i)
+		name := routeName(m.name, i, len(ir.Spec.Routes))
+
+		router := &dynamic.Router{
+			EntryPoints: ir.Spec.EntryPoints,
+			Rule:        route.Match,
+			Priority:    route.Priority,
+			RuleSyntax:  route.Syntax,
+		}
+		for _, mw := range route.Middlewares {
+			router.Middlewares = append(router.Middlewares, mw.Name)
+		}
+		if route.Observability != nil {
+			router.Observability = &dynamic.RouterObservabilityConfig{}
+			for _, f := range convertSpec(route.Observability, router.Observability) {
+				c.unmapped(m, field+".observability."+f, "not supported")
+			}
+		}
+		if tls := ir.Spec.TLS; tls != nil {
+			router.TLS = &dynamic.RouterTLSConfig{CertResolver: tls.CertResolver, Domains: tls.Domains}
+			if tls.Options != nil {
+				router.TLS.Options = tls.Options.Name
+			}
+		}
+
+		switch len(route.Services) {
+		case 0:
+			c.unmapped(m, field+".services", "route has no services")
+		case 1:
+			router.Service = c.httpService(m, field+".services[0]", route.Services[0].LoadBalancerSpec)
+		default:
+			weighted := &dynamic.WeightedRoundRobin{}
+			for j, s := range route.Services {
+				svc := c.httpService(m, fmt.Sprintf("%s.services[%d]", field, j), s.LoadBalancerSpec)
+				if svc != "" {
+					weighted.Services = append(weighted.Services, dynamic.WRRService{Name: svc, Weight: s.Weight})
+				}
+			}
+			router.Service = addUnique(
+				c.result.Config.HTTP.Services, c.traefikServices, name,
+				&dynamic.Service{Weighted: weighted},
+			)
+		}
+
+		addUnique(c.result.Config.HTTP.Routers, nil, name, router)
+	}
+}
+
+func (c *crdImporter) middleware(m *manifest) {
+	var mw v1alpha1.Middleware
+	if !c.decode(m, &mw) {
+		return
+	}
+	if _, ok := c.result.Config.HTTP.Middlewares[m.name]; ok {
+		c.unmapped(m, "", "duplicate name")
+		return
+	}
+
+	spec := mw.Spec
+	out := &dynamic.Middleware{}
+	for _, field := range convertSpec(
+		spec, out,
+		"chain", "errors.service", "basicAuth.secret", "digestAuth.secret",
+	) {
+		c.unmapped(m, "spec."+field, "not supported")
+	}
+
+	if spec.Chain != nil {
+		out.Chain = &dynamic.Chain{}
+		for _, ref := range spec.Chain.Middlewares {
+			out.Chain.Middlewares = append(out.Chain.Middlewares, ref.Name)
+		}
+	}
+	if spec.Errors != nil && out.Errors != nil && spec.Errors.Service.Name != "" {
+		out.Errors.Service = c.httpService(m, "spec.errors.service", spec.Errors.Service.LoadBalancerSpec)
+	}
+	if spec.BasicAuth != nil && out.BasicAuth != nil && spec.BasicAuth.Secret != "" {
+		out.BasicAuth.Users = c.users(m, "spec.basicAuth.secret", spec.BasicAuth.Secret)
+	}
+	if spec.DigestAuth != nil && out.DigestAuth != nil && spec.DigestAuth.Secret != "" {
+		out.DigestAuth.Users = c.users(m, "spec.digestAuth.secret", spec.DigestAuth.Secret)
+	}
+
+	c.result.Config.HTTP.Middlewares[m.name] = out
+}
+
+func (c *crdImporter) traefikService(m *manifest) {
+	var ts v1alpha1.TraefikService
+	if !c.decode(m, &ts) {
+		return
+	}
+	if _, ok := c.result.Config.HTTP.Services[m.name]; ok {
+		c.unmapped(m, "", "duplicate name")
+		return
+	}
+
+	spec := ts.Spec
+	svc := &dynamic.Service{}
+	switch {
+	case spec.Weighted != nil:
+		svc.Weighted = &dynamic.WeightedRoundRobin{Sticky: spec.Weighted.Sticky}
+		for i, s := range spec.Weighted.Services {
+			name := c.httpService(m, fmt.Sprintf("spec.weighted.services[%d]", i), s.LoadBalancerSpec)
+			if name != "" {
+				svc.Weighted.Services = append(svc.Weighted.Services, dynamic.WRRService{
+					Name:   name,
+					Weight: s.Weight,
+				})
+			}
+		}
+	case spec.HighestRandomWeight != nil:
+		svc.HighestRandomWeight = &dynamic.HighestRandomWeight{}
+		for i, s := range spec.HighestRandomWeight.Services {
+			field := fmt.Sprintf("spec.highestRandomWeight.services[%d]", i)
+			name := c.httpService(m, field, s.LoadBalancerSpec)
+			if name != "" {
+				svc.HighestRandomWeight.Services = append(svc.HighestRandomWeight.Services, dynamic.HRWService{
+					Name:   name,
+					Weight: s.Weight,
+				})
+			}
+		}
+	case spec.Mirroring != nil:
+		svc.Mirroring = &dynamic.Mirroring{
+			Service:     c.httpService(m, "spec.mirroring", spec.Mirroring.LoadBalancerSpec),
+			MirrorBody:  spec.Mirroring.MirrorBody,
+			MaxBodySize: spec.Mirroring.MaxBodySize,
+		}
+		for i, mirror := range spec.Mirroring.Mirrors {
+			name := c.httpService(m, fmt.Sprintf("spec.mirroring.mirrors[%d]", i), mirror.LoadBalancerSpec)
+			if name != "" {
+				svc.Mirroring.Mirrors = append(svc.Mirroring.Mirrors, dynamic.MirrorService{
+					Name:    name,
+					Percent: mirror.Percent,
+				})
+			}
+		}
+	case spec.Failover != nil:
+		svc.Failover = &dynamic.Failover{
+			Service:  c.httpService(m, "spec.failover.service", spec.Failover.Service),
+			Fallback: c.httpService(m, "spec.failover.fallback", spec.Failover.Fallback),
+		}
+		if len(spec.Failover.Errors.Status) > 0 || spec.Failover.Errors.MaxRequestBodyBytes != nil {
+			svc.Failover.Errors = &dynamic.FailoverError{
+				Status:              spec.Failover.Errors.Status,
+				MaxRequestBodyBytes: spec.Failover.Errors.MaxRequestBodyBytes,
+			}
+		}
+	default:
+		c.unmapped(m, "spec", "service has no supported type")
+		return
+	}
+
+	c.result.Config.HTTP.Services[m.name] = svc
+}
+
+// httpService returns the name of the service a reference points at, creating
+// a load balancer for references to Kubernetes services.
+func (c *crdImporter) httpService(m *manifest, field string, ref v1alpha1.LoadBalancerSpec) string {
+	if ref.Kind == "TraefikService" {
+		return ref.Name
+	}
+
+	host, port, portName, ok := c.resolve(m, field, ref.Name, ref.Namespace, ref.Port)
+	if !ok {
+		return ""
+	}
+	scheme := ref.Scheme
+	if scheme == "" {
+		scheme = "http"
+		if port == 443 || strings.HasPrefix(portName, "https") {
+			scheme = "https"
+		}
+	}
+
+	lb := &dynamic.ServersLoadBalancer{}
+	for _, f := range convertSpec(
+		ref, lb,
+		"name", "kind", "namespace", "port", "scheme", "weight", "middlewares",
+	) {
+		c.unmapped(m, field+"."+f, "not supported")
+	}
+	// Same default as the CRD provider
+	if lb.PassHostHeader == nil {
+		lb.PassHostHeader = new(dynamic.DefaultPassHostHeader)
+	}
+	lb.Servers = []dynamic.Server{{URL: scheme + "://" + net.JoinHostPort(host, strconv.Itoa(port))}}
+
+	svc := &dynamic.Service{LoadBalancer: lb}
+	for _, mw := range ref.Middlewares {
+		svc.Middlewares = append(svc.Middlewares, mw.Name)
+	}
+	return addUnique(c.result.Config.HTTP.Services, c.traefikServices, ref.Name, svc)
+}
+
+// users reads the htpasswd style users of an auth middleware from a secret.
+func (c *crdImporter) users(m *manifest, field, name string) dynamic.Users {
+	secret, ok := c.secrets[m.namespace+"/"+name]
+	if !ok {
+		c.unmapped(m, field, fmt.Sprintf("secret %q is not part of the manifests", name))
+		return nil
+	}
+	content, ok := secret.StringData["users"]
+	if !ok {
+		content = string(secret.Data["users"])
+	}
+
+	var users dynamic.Users
+	for line := range strings.Lines(content) {
+		if line = strings.TrimSpace(line); line != "" {
+			users = append(users, line)
+		}
+	}
+	if len(users) == 0 {
+		c.unmapped(m, field, fmt.Sprintf("secret %q has no users", name))
+	}
+	return users
+}
+
+func (c *crdImporter) tlsRefs(m *manifest, secretName string, store bool) {
+	if secretName != "" {
+		c.unmapped(m, "spec.tls.secretName", "certificates have to be configured in Traefik")
+	}
+	if store {
+		c.unmapped(m, "spec.tls.store", "not supported")
+	}
+}
+
+// TCP ------------------------------------------------------------------------
+
+func (c *crdImporter) tcpMiddleware(m *manifest) {
+	var mw v1alpha1.MiddlewareTCP
+	if !c.decode(m, &mw) {
+		return
+	}
+	if _, ok := c.result.Config.TCP.Middlewares[m.name]; ok {
+		c.unmapped(m, "", "duplicate name")
+		return
+	}
+	out := &dynamic.TCPMiddleware{}
+	for _, field := range convertSpec(mw.Spec, out) {
+		c.unmapped(m, "spec."+field, "not supported")
+	}
+	c.result.Config.TCP.Middlewares[m.name] = out
+}
+
+func (c *crdImporter) ingressRouteTCP(m *manifest) {
+	var ir v1alpha1.IngressRouteTCP
+	if !c.decode(m, &ir) {
+		return
+	}
+	if ir.Spec.IngressClassName != nil {
+		c.unmapped(m, "spec.ingressClassName", "not supported")
+	}
+	if ir.Spec.TLS != nil {
+		c.tlsRefs(m, ir.Spec.TLS.SecretName, ir.Spec.TLS.Store != nil)
+	}
+
+	for i, route := range ir.Spec.Routes {
+		field := fmt.Sprintf("spec.routes[%d]", i)
+		name := routeName(m.name, i, len(ir.Spec.Routes))
+
+		router := &dynamic.TCPRouter{
+			EntryPoints: ir.Spec.EntryPoints,
+			Rule:        route.Match,
+			Priority:    route.Priority,
+			RuleSyntax:  route.Syntax,
+		}
+		for _, mw := range route.Middlewares {
+			router.Middlewares = append(router.Middlewares, mw.Name)
+		}
+		if tls := ir.Spec.TLS; tls != nil {
+			router.TLS = &dynamic.RouterTCPTLSConfig{
+				Passthrough:  tls.Passthrough,
+				CertResolver: tls.CertResolver,
+				Domains:      tls.Domains,
+			}
+			if tls.Options != nil {
+				router.TLS.Options = tls.Options.Name
+			}
+		}
+
+		var services []dynamic.TCPWRRService
+		for j, s := range route.Services {
+			svc := c.tcpService(m, fmt.Sprintf("%s.services[%d]", field, j), s)
+			if svc != "" {
+				services = append(services, dynamic.TCPWRRService{Name: svc, Weight: s.Weight})
+			}
+		}
+		switch len(services) {
+		case 0:
+			c.unmapped(m, field+".services", "route has no services")
+		case 1:
+			router.Service = services[0].Name
+		default:
+			router.Service = addUnique(c.result.Config.TCP.Services, nil, name, &dynamic.TCPService{
+				Weighted: &dynamic.TCPWeightedRoundRobin{Services: services},
+			})
+		}
+
+		addUnique(c.result.Config.TCP.Routers, nil, name, router)
+	}
+}
+
+func (c *crdImporter) tcpService(m *manifest, field string, ref v1alpha1.ServiceTCP) string {
+	host, port, _, ok := c.resolve(m, field, ref.Name, ref.Namespace, ref.Port)
+	if !ok {
+		return ""
+	}
+	c.kubernetesLB(m, field, ref.NativeLB, ref.NodePortLB)
+
+	return addUnique(c.result.Config.TCP.Services, nil, ref.Name, &dynamic.TCPService{
+		LoadBalancer: &dynamic.TCPServersLoadBalancer{
+			Servers: []dynamic.TCPServer{{
+				Address: net.JoinHostPort(host, strconv.Itoa(port)),
+				TLS:     ref.TLS,
+			}},
+			ServersTransport: ref.ServersTransport,
+			ProxyProtocol:    ref.ProxyProtocol,
+			TerminationDelay: ref.TerminationDelay,
+		},
+	})
+}
+
+// UDP ------------------------------------------------------------------------
+
+func (c *crdImporter) ingressRouteUDP(m *manifest) {
+	var ir v1alpha1.IngressRouteUDP
+	if !c.decode(m, &ir) {
+		return
+	}
+	if ir.Spec.IngressClassName != nil {
+		c.unmapped(m, "spec.ingressClassName", "not supported")
+	}
+
+	for i, route := range ir.Spec.Routes {
+		field := fmt.Sprintf("spec.routes[%d]", i)
+		name := routeName(m.name, i, len(ir.Spec.Routes))
+
+		var services []dynamic.UDPWRRService
+		for j, s := range route.Services {
+			svc := c.udpService(m, fmt.Sprintf("%s.services[%d]", field, j), s)
+			if svc != "" {
+				services = append(services, dynamic.UDPWRRService{Name: svc, Weight: s.Weight})
+			}
+		}
+
+		router := &dynamic.UDPRouter{EntryPoints: ir.Spec.EntryPoints}
+		switch len(services) {
+		case 0:
+			c.unmapped(m, field+".services", "route has no services")
+		case 1:
+			router.Service = services[0].Name
+		default:
+			router.Service = addUnique(c.result.Config.UDP.Services, nil, name, &dynamic.UDPService{
+				Weighted: &dynamic.UDPWeightedRoundRobin{Services: services},
+			})
+		}
+
+		addUnique(c.result.Config.UDP.Routers, nil, name, router)
+	}
+}
+
+func (c *crdImporter) udpService(m *manifest, field string, ref v1alpha1.ServiceUDP) string {
+	host, port, _, ok := c.resolve(m, field, ref.Name, ref.Namespace, ref.Port)
+	if !ok {
+		return ""
+	}
+	c.kubernetesLB(m, field, ref.NativeLB, ref.NodePortLB)
+
+	return addUnique(c.result.Config.UDP.Services, nil, ref.Name, &dynamic.UDPService{
+		LoadBalancer: &dynamic.UDPServersLoadBalancer{
+			Servers: []dynamic.UDPServer{{Address: net.JoinHostPort(host, strconv.Itoa(port))}},
+		},
+	})
+}
+
+// Helpers --------------------------------------------------------------------
+
+// resolve returns the address of a Kubernetes service port.
+func (c *crdImporter) resolve(
+	m *manifest,
+	field, name, namespace string,
+	port intstr.IntOrString,
+) (string, int, string, bool) {
+	if namespace == "" {
+		namespace = m.namespace
+	}
+	host := fmt.Sprintf("%s.%s.svc", name, namespace)
+
+	svc, ok := c.services[namespace+"/"+name]
+	if !ok {
+		if port.Type != intstr.Int || port.IntVal == 0 {
+			c.unmapped(m, field+".port", fmt.Sprintf("service %q is not part of the manifests", name))
+			return "", 0, "", false
+		}
+		return host, int(port.IntVal), "", true
+	}
+
+	if svc.Spec.Type == corev1.ServiceTypeExternalName {
+		host = svc.Spec.ExternalName
+	}
+	for _, p := range svc.Spec.Ports {
+		switch {
+		case port.Type == intstr.String && p.Name == port.StrVal,
+			port.Type == intstr.Int && p.Port == port.IntVal,
+			port.Type == intstr.Int && port.IntVal == 0 && len(svc.Spec.Ports) == 1:
+			return host, int(p.Port), p.Name, true
+		}
+	}
+	if port.Type == intstr.Int && port.IntVal != 0 {
+		return host, int(port.IntVal), "", true
+	}
+	c.unmapped(m, field+".port", fmt.Sprintf("service %q has no port %q", name, port.String()))
+	return "", 0, "", false
+}
+
+func (c *crdImporter) kubernetesLB(m *manifest, field string, nativeLB *bool, nodePortLB bool) {
+	if nativeLB != nil {
+		c.unmapped(m, field+".nativeLB", "not supported")
+	}
+	if nodePortLB {
+		c.unmapped(m, field+".nodePortLB", "not supported")
+	}
+}
+
+func routeName(name string, i, routes int) string {
+	if routes == 1 {
+		return name
+	}
+	return fmt.Sprintf("%s-%d", name, i)
+}
+
+// addUnique stores the object under the preferred name, reusing an identical
+// object or picking a free name on conflicts. It returns the name used.
+func addUnique[T any](objects map[string]T, reserved map[string]bool, preferred string, obj T) string {
+	name := preferred
+	for i := 2; ; i++ {
+		existing, ok := objects[name]
+		if !ok && !reserved[name] {
+			objects[name] = obj
+			return name
+		}
+		if ok && reflect.DeepEqual(existing, obj) {
+			return name
+		}
+		name = fmt.Sprintf("%s-%d", preferred, i)
+	}
+}
+
+func (f UnmappedField) String() string {
+	s := fmt.Sprintf("%s %s/%s", f.Kind, f.Namespace, f.Name)
+	if f.Field != "" {
+		s += " " + f.Field
+	}
+	return s + ": " + f.Reason
+}
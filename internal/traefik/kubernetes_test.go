@@ -0,0 +1,95 @@
+package traefik
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/provider/kubernetes/crd/traefikio/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestToKubernetesNames(t *testing.T) {
+	cfg := &dynamic.Configuration{
+		HTTP: &dynamic.HTTPConfiguration{
+			Routers: map[string]*dynamic.Router{
+				"foo-bar": {Rule: "Host(`a.example.com`)", Service: "app", Middlewares: []string{"foo_bar"}},
+				"foo_bar": {Rule: "Host(`b.example.com`)", Service: "app", Middlewares: []string{"foo-bar"}},
+			},
+			Middlewares: map[string]*dynamic.Middleware{
+				"foo-bar": {Headers: &dynamic.Headers{}},
+				"foo_bar": {Headers: &dynamic.Headers{}},
+			},
+			Services: map[string]*dynamic.Service{
+				"app": {LoadBalancer: &dynamic.ServersLoadBalancer{
+					Servers: []dynamic.Server{{URL: "http://app:8080"}},
+				}},
+			},
+		},
+		TCP: &dynamic.TCPConfiguration{
+			Routers: map[string]*dynamic.TCPRouter{
+				"app": {Rule: "HostSNI(`*`)", Service: "app"},
+			},
+			Services: map[string]*dynamic.TCPService{
+				"app": {LoadBalancer: &dynamic.TCPServersLoadBalancer{
+					Servers: []dynamic.TCPServer{{Address: "app:5432"}},
+				}},
+			},
+		},
+	}
+
+	export := ToKubernetes(cfg, "default")
+
+	names := make(map[string][]string)
+	routes := make(map[string]*v1alpha1.IngressRoute)
+	for _, obj := range export.Objects {
+		switch o := obj.(type) {
+		case *corev1.Service:
+			names["Service"] = append(names["Service"], o.Name)
+		case *v1alpha1.Middleware:
+			names["Middleware"] = append(names["Middleware"], o.Name)
+		case *v1alpha1.IngressRoute:
+			names["IngressRoute"] = append(names["IngressRoute"], o.Name)
+			routes[o.Name] = o
+		}
+	}
+	for kind, list := range names {
+		slices.Sort(list)
+		if len(slices.Compact(slices.Clone(list))) != len(list) {
+			t.Errorf("duplicate %s names: %v", kind, list)
+		}
+	}
+
+	tests := []struct {
+		kind string
+		want []string
+	}{
+		{"Service", []string{"http-app", "tcp-app"}},
+		{"Middleware", []string{"foo-bar", "foo-bar-2"}},
+		{"IngressRoute", []string{"foo-bar", "foo-bar-2"}},
+	}
+	for _, tt := range tests {
+		if !slices.Equal(names[tt.kind], tt.want) {
+			t.Errorf("%s names = %v, want %v", tt.kind, names[tt.kind], tt.want)
+		}
+	}
+
+	// References follow the renamed objects
+	if got := routes["foo-bar"].Spec.Routes[0].Middlewares[0].Name; got != "foo-bar-2" {
+		t.Errorf("router foo-bar references middleware %q, want foo-bar-2", got)
+	}
+	if got := routes["foo-bar-2"].Spec.Routes[0].Middlewares[0].Name; got != "foo-bar" {
+		t.Errorf("router foo_bar references middleware %q, want foo-bar", got)
+	}
+
+	var renamed int
+	for _, w := range export.Warnings {
+		if strings.Contains(w, "already in use") {
+			renamed++
+		}
+	}
+	if renamed != 2 {
+		t.Errorf("got %d rename warnings, want 2: %v", renamed, export.Warnings)
+	}
+}
@@ -0,0 +1,419 @@
+package traefik
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// ErrUnknownResource is returned for resources missing from the source profile.
+var ErrUnknownResource = errors.New("unknown resource")
+
+// Resource names a router, service or middleware of a profile.
+type Resource struct {
+	Protocol string // "http", "tcp" or "udp"
+	Type     string // "router", "service" or "middleware"
+	Name     string
+}
+
+func (r Resource) String() string {
+	return r.Protocol + " " + r.Type + " " + r.Name
+}
+
+// CloneProfile copies the entry points, variables and the user managed
+// routers, services, middlewares and servers transports of a profile into
+// another, empty profile, together with the DNS providers of the routers.
+//
+// q should be bound to a transaction, so a failure doesn't leave the profile
+// half cloned.
+func CloneProfile(ctx context.Context, q *db.Queries, fromID, toID int64) error {
+	if err := copyEntryPoints(ctx, q, fromID, toID, nil); err != nil {
+		return err
+	}
+	vars, err := q.ListProfileVariables(ctx, fromID)
+	if err != nil {
+		return fmt.Errorf("failed to list variables: %w", err)
+	}
+	for _, v := range vars {
+		if _, err = q.CreateProfileVariable(ctx, &db.CreateProfileVariableParams{
+			ID:          uuid.New().String(),
+			ProfileID:   toID,
+			Name:        v.Name,
+			Value:       v.Value,
+			Description: v.Description,
+		}); err != nil {
+			return fmt.Errorf("failed to copy variable %q: %w", v.Name, err)
+		}
+	}
+
+	snapshot, err := TakeSnapshot(ctx, q, fromID)
+	if err != nil {
+		return err
+	}
+	return RestoreSnapshot(ctx, q, toID, snapshot)
+}
+
+// PlanPromotion compares the resources of a profile with the items of the
+// target profile without changing anything. The changes show how the target
+// would look if conflicting items were overwritten.
+func PlanPromotion(
+	ctx context.Context,
+	q *db.Queries,
+	fromID, toID int64,
+	resources []Resource,
+) ([]ImportItem, []ConfigChange, error) {
+	incoming, err := selectResources(ctx, q, fromID, resources, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	current, err := selectResources(ctx, q, toID, resources, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, err := PlanImport(ctx, q, toID, incoming)
+	if err != nil {
+		return nil, nil, err
+	}
+	changes, err := DiffConfigs(current, incoming)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, changes, nil
+}
+
+// Promote copies the resources of a profile into the target profile,
+// resolving conflicts with the given strategy, and returns the applied plan.
+// Entry points and DNS providers of the promoted routers come along.
+//
+// The first failing write aborts the promotion, so q should be bound to a
+// transaction that is rolled back on error.
+func Promote(
+	ctx context.Context,
+	q *db.Queries,
+	fromID, toID int64,
+	resources []Resource,
+	strategy ImportStrategy,
+) ([]ImportItem, error) {
+	incoming, err := selectResources(ctx, q, fromID, resources, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var entryPoints []string
+	for _, router := range incoming.HTTP.Routers {
+		entryPoints = append(entryPoints, router.EntryPoints...)
+	}
+	for _, router := range incoming.TCP.Routers {
+		entryPoints = append(entryPoints, router.EntryPoints...)
+	}
+	for _, router := range incoming.UDP.Routers {
+		entryPoints = append(entryPoints, router.EntryPoints...)
+	}
+	if err = copyEntryPoints(ctx, q, fromID, toID, entryPoints); err != nil {
+		return nil, err
+	}
+
+	items, err := ApplyImport(ctx, q, toID, incoming, strategy)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.Type != "router" || item.Status == ImportIdentical ||
+			item.Status == ImportConflict && strategy == ImportSkip {
+			continue
+		}
+		if err = copyDNSProviders(
+			ctx,
+			q,
+			fromID,
+			toID,
+			item.Protocol,
+			item.Name,
+			cmp.Or(item.NewName, item.Name),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// selectResources builds a configuration of the named items of a profile,
+// enabled or not. If strict is set, a missing item is an error, otherwise it
+// is left out.
+func selectResources(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	resources []Resource,
+	strict bool,
+) (*dynamic.Configuration, error) {
+	all, err := profileItems(ctx, q, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &dynamic.Configuration{
+		HTTP: &dynamic.HTTPConfiguration{},
+		TCP:  &dynamic.TCPConfiguration{},
+		UDP:  &dynamic.UDPConfiguration{},
+	}
+	for _, r := range resources {
+		var found bool
+		switch r.Protocol + " " + r.Type {
+		case "http router":
+			found = pickItem(&cfg.HTTP.Routers, all.HTTP.Routers, r.Name)
+		case "http service":
+			found = pickItem(&cfg.HTTP.Services, all.HTTP.Services, r.Name)
+		case "http middleware":
+			found = pickItem(&cfg.HTTP.Middlewares, all.HTTP.Middlewares, r.Name)
+		case "tcp router":
+			found = pickItem(&cfg.TCP.Routers, all.TCP.Routers, r.Name)
+		case "tcp service":
+			found = pickItem(&cfg.TCP.Services, all.TCP.Services, r.Name)
+		case "tcp middleware":
+			found = pickItem(&cfg.TCP.Middlewares, all.TCP.Middlewares, r.Name)
+		case "udp router":
+			found = pickItem(&cfg.UDP.Routers, all.UDP.Routers, r.Name)
+		case "udp service":
+			found = pickItem(&cfg.UDP.Services, all.UDP.Services, r.Name)
+		default:
+			return nil, fmt.Errorf("%w type %q", ErrUnknownResource, r.Protocol+" "+r.Type)
+		}
+		if !found && strict {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownResource, r)
+		}
+	}
+	return cfg, nil
+}
+
+func pickItem[T any](dst *map[string]*T, src map[string]*T, name string) bool {
+	item, ok := src[name]
+	if !ok || item == nil {
+		return false
+	}
+	if *dst == nil {
+		*dst = make(map[string]*T)
+	}
+	(*dst)[name] = item
+	return true
+}
+
+// profileItems returns all routers, services and middlewares of a profile,
+// keyed by name.
+func profileItems(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+) (*dynamic.Configuration, error) {
+	cfg := &dynamic.Configuration{
+		HTTP: &dynamic.HTTPConfiguration{
+			Routers:     make(map[string]*dynamic.Router),
+			Services:    make(map[string]*dynamic.Service),
+			Middlewares: make(map[string]*dynamic.Middleware),
+		},
+		TCP: &dynamic.TCPConfiguration{
+			Routers:     make(map[string]*dynamic.TCPRouter),
+			Services:    make(map[string]*dynamic.TCPService),
+			Middlewares: make(map[string]*dynamic.TCPMiddleware),
+		},
+		UDP: &dynamic.UDPConfiguration{
+			Routers:  make(map[string]*dynamic.UDPRouter),
+			Services: make(map[string]*dynamic.UDPService),
+		},
+	}
+
+	httpRouters, err := q.ListHttpRouters(ctx, &db.ListHttpRoutersParams{ProfileID: profileID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list http routers: %w", err)
+	}
+	for _, r := range httpRouters {
+		if r.Config != nil {
+			cfg.HTTP.Routers[r.Name] = r.Config.Data
+		}
+	}
+	httpServices, err := q.ListHttpServices(ctx, &db.ListHttpServicesParams{ProfileID: profileID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list http services: %w", err)
+	}
+	for _, s := range httpServices {
+		if s.Config != nil {
+			cfg.HTTP.Services[s.Name] = s.Config.Data
+		}
+	}
+	httpMiddlewares, err := q.ListHttpMiddlewares(
+		ctx,
+		&db.ListHttpMiddlewaresParams{ProfileID: profileID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list http middlewares: %w", err)
+	}
+	for _, m := range httpMiddlewares {
+		if m.Config != nil {
+			cfg.HTTP.Middlewares[m.Name] = m.Config.Data
+		}
+	}
+
+	tcpRouters, err := q.ListTcpRouters(ctx, &db.ListTcpRoutersParams{ProfileID: profileID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tcp routers: %w", err)
+	}
+	for _, r := range tcpRouters {
+		if r.Config != nil {
+			cfg.TCP.Routers[r.Name] = r.Config.Data
+		}
+	}
+	tcpServices, err := q.ListTcpServices(ctx, &db.ListTcpServicesParams{ProfileID: profileID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tcp services: %w", err)
+	}
+	for _, s := range tcpServices {
+		if s.Config != nil {
+			cfg.TCP.Services[s.Name] = s.Config.Data
+		}
+	}
+	tcpMiddlewares, err := q.ListTcpMiddlewares(
+		ctx,
+		&db.ListTcpMiddlewaresParams{ProfileID: profileID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tcp middlewares: %w", err)
+	}
+	for _, m := range tcpMiddlewares {
+		if m.Config != nil {
+			cfg.TCP.Middlewares[m.Name] = m.Config.Data
+		}
+	}
+
+	udpRouters, err := q.ListUdpRouters(ctx, &db.ListUdpRoutersParams{ProfileID: profileID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list udp routers: %w", err)
+	}
+	for _, r := range udpRouters {
+		if r.Config != nil {
+			cfg.UDP.Routers[r.Name] = r.Config.Data
+		}
+	}
+	udpServices, err := q.ListUdpServices(ctx, &db.ListUdpServicesParams{ProfileID: profileID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list udp services: %w", err)
+	}
+	for _, s := range udpServices {
+		if s.Config != nil {
+			cfg.UDP.Services[s.Name] = s.Config.Data
+		}
+	}
+	return cfg, nil
+}
+
+// copyEntryPoints creates the named entry points of a profile, or all of them
+// if names is nil, in the target profile unless they exist there already.
+// The default entry point is only carried over into a profile without one.
+func copyEntryPoints(
+	ctx context.Context,
+	q *db.Queries,
+	fromID, toID int64,
+	names []string,
+) error {
+	source, err := q.ListEntryPoints(ctx, &db.ListEntryPointsParams{ProfileID: fromID})
+	if err != nil {
+		return fmt.Errorf("failed to list entry points: %w", err)
+	}
+	existing, err := q.ListEntryPoints(ctx, &db.ListEntryPointsParams{ProfileID: toID})
+	if err != nil {
+		return fmt.Errorf("failed to list entry points: %w", err)
+	}
+	hasDefault := slices.ContainsFunc(existing, func(ep *db.EntryPoint) bool {
+		return ep.IsDefault
+	})
+
+	for _, ep := range source {
+		if names != nil && !slices.Contains(names, ep.Name) ||
+			slices.ContainsFunc(existing, func(e *db.EntryPoint) bool { return e.Name == ep.Name }) {
+			continue
+		}
+		if _, err = q.CreateEntryPoint(ctx, &db.CreateEntryPointParams{
+			ID:        uuid.New().String(),
+			ProfileID: toID,
+			Name:      ep.Name,
+			Address:   ep.Address,
+			IsDefault: ep.IsDefault && !hasDefault,
+		}); err != nil {
+			return fmt.Errorf("failed to copy entry point %q: %w", ep.Name, err)
+		}
+	}
+	return nil
+}
+
+// copyDNSProviders links a router of the target profile to the DNS providers
+// of a router of the source profile.
+func copyDNSProviders(
+	ctx context.Context,
+	q *db.Queries,
+	fromID, toID int64,
+	protocol, from, to string,
+) error {
+	switch protocol {
+	case "http":
+		source, err := q.GetHttpRouterByName(ctx, &db.GetHttpRouterByNameParams{
+			ProfileID: fromID,
+			Name:      from,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get http router %q: %w", from, err)
+		}
+		target, err := q.GetHttpRouterByName(ctx, &db.GetHttpRouterByNameParams{
+			ProfileID: toID,
+			Name:      to,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get http router %q: %w", to, err)
+		}
+		providers, err := q.GetDnsProvidersByHttpRouter(ctx, source.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list dns providers of %q: %w", from, err)
+		}
+		for _, provider := range providers {
+			if err = q.CreateHttpRouterDNSProvider(ctx, &db.CreateHttpRouterDNSProviderParams{
+				HttpRouterID:  target.ID,
+				DnsProviderID: provider.ID,
+			}); err != nil {
+				return fmt.Errorf("failed to link dns provider to %q: %w", to, err)
+			}
+		}
+	case "tcp":
+		source, err := q.GetTcpRouterByName(ctx, &db.GetTcpRouterByNameParams{
+			ProfileID: fromID,
+			Name:      from,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get tcp router %q: %w", from, err)
+		}
+		target, err := q.GetTcpRouterByName(ctx, &db.GetTcpRouterByNameParams{
+			ProfileID: toID,
+			Name:      to,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get tcp router %q: %w", to, err)
+		}
+		providers, err := q.GetDnsProvidersByTcpRouter(ctx, source.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list dns providers of %q: %w", from, err)
+		}
+		for _, provider := range providers {
+			if err = q.CreateTcpRouterDNSProvider(ctx, &db.CreateTcpRouterDNSProviderParams{
+				TcpRouterID:   target.ID,
+				DnsProviderID: provider.ID,
+			}); err != nil {
+				return fmt.Errorf("failed to link dns provider to %q: %w", to, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package traefik
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mizuchilabs/mantrae/internal/store"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func promoteService(url string) *dynamic.Service {
+	return &dynamic.Service{LoadBalancer: &dynamic.ServersLoadBalancer{
+		Servers: []dynamic.Server{{URL: url}},
+	}}
+}
+
+func TestPromote(t *testing.T) {
+	source := &dynamic.Configuration{
+		HTTP: &dynamic.HTTPConfiguration{
+			Routers: map[string]*dynamic.Router{
+				"web": {
+					Rule:        "Host(`example.com`)",
+					Middlewares: []string{"strip"},
+					Service:     "app",
+				},
+			},
+			Services: map[string]*dynamic.Service{
+				"app": promoteService("http://dev:8080"),
+			},
+			Middlewares: map[string]*dynamic.Middleware{
+				"strip": {StripPrefix: &dynamic.StripPrefix{Prefixes: []string{"/api"}}},
+			},
+		},
+	}
+	resources := []Resource{
+		{Protocol: "http", Type: "router", Name: "web"},
+		{Protocol: "http", Type: "service", Name: "app"},
+		{Protocol: "http", Type: "middleware", Name: "strip"},
+	}
+
+	tests := []struct {
+		name      string
+		target    map[string]*dynamic.Service // existing services of the target
+		strategy  ImportStrategy
+		resources []Resource // defaults to all source items
+		items     []ImportItem
+		services  map[string]string // service name to server URL in the target
+		reference string            // service of the promoted router
+		err       error
+	}{
+		{
+			name:     "new",
+			strategy: ImportSkip,
+			items: []ImportItem{
+				{Protocol: "http", Type: "router", Name: "web", Status: ImportNew},
+				{Protocol: "http", Type: "service", Name: "app", Status: ImportNew},
+				{Protocol: "http", Type: "middleware", Name: "strip", Status: ImportNew},
+			},
+			services:  map[string]string{"app": "http://dev:8080"},
+			reference: "app",
+		},
+		{
+			name:     "skip",
+			target:   map[string]*dynamic.Service{"app": promoteService("http://prod:8080")},
+			strategy: ImportSkip,
+			items: []ImportItem{
+				{Protocol: "http", Type: "router", Name: "web", Status: ImportNew},
+				{Protocol: "http", Type: "service", Name: "app", Status: ImportConflict},
+				{Protocol: "http", Type: "middleware", Name: "strip", Status: ImportNew},
+			},
+			services:  map[string]string{"app": "http://prod:8080"},
+			reference: "app",
+		},
+		{
+			name:     "overwrite",
+			target:   map[string]*dynamic.Service{"app": promoteService("http://prod:8080")},
+			strategy: ImportOverwrite,
+			items: []ImportItem{
+				{Protocol: "http", Type: "router", Name: "web", Status: ImportNew},
+				{Protocol: "http", Type: "service", Name: "app", Status: ImportConflict},
+				{Protocol: "http", Type: "middleware", Name: "strip", Status: ImportNew},
+			},
+			services:  map[string]string{"app": "http://dev:8080"},
+			reference: "app",
+		},
+		{
+			name:     "rename",
+			target:   map[string]*dynamic.Service{"app": promoteService("http://prod:8080")},
+			strategy: ImportRename,
+			items: []ImportItem{
+				{Protocol: "http", Type: "router", Name: "web", Status: ImportNew},
+				{
+					Protocol: "http",
+					Type:     "service",
+					Name:     "app",
+					Status:   ImportConflict,
+					NewName:  "app-2",
+				},
+				{Protocol: "http", Type: "middleware", Name: "strip", Status: ImportNew},
+			},
+			services: map[string]string{
+				"app":   "http://prod:8080",
+				"app-2": "http://dev:8080",
+			},
+			reference: "app-2",
+		},
+		{
+			name: "rename to a free name",
+			target: map[string]*dynamic.Service{
+				"app":   promoteService("http://prod:8080"),
+				"app-2": promoteService("http://prod:8082"),
+			},
+			strategy: ImportRename,
+			items: []ImportItem{
+				{Protocol: "http", Type: "router", Name: "web", Status: ImportNew},
+				{
+					Protocol: "http",
+					Type:     "service",
+					Name:     "app",
+					Status:   ImportConflict,
+					NewName:  "app-3",
+				},
+				{Protocol: "http", Type: "middleware", Name: "strip", Status: ImportNew},
+			},
+			services: map[string]string{
+				"app":   "http://prod:8080",
+				"app-2": "http://prod:8082",
+				"app-3": "http://dev:8080",
+			},
+			reference: "app-3",
+		},
+		{
+			name:     "identical",
+			target:   map[string]*dynamic.Service{"app": promoteService("http://dev:8080")},
+			strategy: ImportRename,
+			items: []ImportItem{
+				{Protocol: "http", Type: "router", Name: "web", Status: ImportNew},
+				{Protocol: "http", Type: "service", Name: "app", Status: ImportIdentical},
+				{Protocol: "http", Type: "middleware", Name: "strip", Status: ImportNew},
+			},
+			services:  map[string]string{"app": "http://dev:8080"},
+			reference: "app",
+		},
+		{
+			name:      "unknown resource",
+			strategy:  ImportSkip,
+			resources: []Resource{{Protocol: "http", Type: "router", Name: "missing"}},
+			err:       ErrUnknownResource,
+		},
+	}
+
+	for _, tt := range tests {
+		if tt.resources == nil {
+			tt.resources = resources
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			conn := store.NewConnection(ctx, "file:"+filepath.Join(t.TempDir(), "mantrae.db"))
+			from, err := conn.Q.CreateProfile(ctx, &db.CreateProfileParams{Name: "dev", Token: "dev"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			to, err := conn.Q.CreateProfile(ctx, &db.CreateProfileParams{Name: "prod", Token: "prod"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = ApplyImport(ctx, conn.Q, from.ID, source, ImportSkip); err != nil {
+				t.Fatal(err)
+			}
+			target := &dynamic.Configuration{HTTP: &dynamic.HTTPConfiguration{Services: tt.target}}
+			if _, err = ApplyImport(ctx, conn.Q, to.ID, target, ImportSkip); err != nil {
+				t.Fatal(err)
+			}
+
+			var items []ImportItem
+			err = conn.WithTx(ctx, func(q *db.Queries) error {
+				var err error
+				items, err = Promote(ctx, q, from.ID, to.ID, tt.resources, tt.strategy)
+				return err
+			})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if !reflect.DeepEqual(items, tt.items) {
+				t.Errorf("items = %+v, want %+v", items, tt.items)
+			}
+
+			got, err := profileItems(ctx, conn.Q, to.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			services := make(map[string]string)
+			for name, svc := range got.HTTP.Services {
+				services[name] = svc.LoadBalancer.Servers[0].URL
+			}
+			if !reflect.DeepEqual(services, tt.services) {
+				t.Errorf("services = %v, want %v", services, tt.services)
+			}
+			if router := got.HTTP.Routers["web"]; router == nil || router.Service != tt.reference {
+				t.Errorf("router = %+v, want service %q", router, tt.reference)
+			}
+
+			// The source profile keeps its own references
+			kept, err := profileItems(ctx, conn.Q, from.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if router := kept.HTTP.Routers["web"]; router == nil || router.Service != "app" {
+				t.Errorf("source router = %+v, want service %q", router, "app")
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package traefik
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Revisions tracks a change counter per profile, so consumers of the
+// published dynamic configuration can detect (or wait for) changes without
+// rebuilding the whole config on every poll.
+type Revisions struct {
+	mu      sync.Mutex
+	epoch   string
+	revs    map[int64]uint64
+	changed map[int64]chan struct{}
+	hooks   []func(profileID int64)
+}
+
+func NewRevisions() *Revisions {
+	return &Revisions{
+		epoch:   strconv.FormatInt(time.Now().UnixNano(), 36),
+		revs:    make(map[int64]uint64),
+		changed: make(map[int64]chan struct{}),
+	}
+}
+
+// Current returns the current revision tag of a profile together with a
+// channel that is closed as soon as the profile changes.
+func (r *Revisions) Current(profileID int64) (string, <-chan struct{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ch, ok := r.changed[profileID]
+	if !ok {
+		ch = make(chan struct{})
+		r.changed[profileID] = ch
+	}
+	return fmt.Sprintf("%s.%d", r.epoch, r.revs[profileID]), ch
+}
+
+// OnBump registers a function that is called after every Bump. Hooks run
+// synchronously on the caller's goroutine.
+func (r *Revisions) OnBump(hook func(profileID int64)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hooks = append(r.hooks, hook)
+}
+
+// Bump marks the profile as changed and wakes up all waiters.
+func (r *Revisions) Bump(profileID int64) {
+	r.mu.Lock()
+	r.revs[profileID]++
+	if ch, ok := r.changed[profileID]; ok {
+		close(ch)
+		delete(r.changed, profileID)
+	}
+	hooks := r.hooks
+	r.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook(profileID)
+	}
+}
+
+// BumpAll marks every profile as changed, e.g. after restoring a database
+// backup, and runs the hooks for each of the given profiles.
+func (r *Revisions) BumpAll(profileIDs ...int64) {
+	r.mu.Lock()
+	r.epoch = strconv.FormatInt(time.Now().UnixNano(), 36)
+	for id, ch := range r.changed {
+		close(ch)
+		delete(r.changed, id)
+	}
+	hooks := r.hooks
+	r.mu.Unlock()
+
+	for _, profileID := range profileIDs {
+		for _, hook := range hooks {
+			hook(profileID)
+		}
+	}
+}
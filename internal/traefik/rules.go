@@ -0,0 +1,457 @@
+package traefik
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	httpmuxer "github.com/traefik/traefik/v3/pkg/muxer/http"
+	tcpmuxer "github.com/traefik/traefik/v3/pkg/muxer/tcp"
+)
+
+// maxRuleBranches caps the expansion of a rule into branches, which grows
+// exponentially with negated conjunctions.
+const maxRuleBranches = 64
+
+var (
+	httpMatchers = map[string][]string{
+		"v3": {
+			"ClientIP", "Method", "Host", "HostRegexp", "Path", "PathRegexp", "PathPrefix",
+			"Header", "HeaderRegexp", "Query", "QueryRegexp",
+		},
+		"v2": {
+			"Host", "HostHeader", "HostRegexp", "ClientIP", "Path", "PathPrefix", "Method",
+			"Headers", "HeadersRegexp", "Query",
+		},
+	}
+	tcpMatchers = map[string][]string{
+		"v3": {"ALPN", "ClientIP", "HostSNI", "HostSNIRegexp"},
+		"v2": {"ALPN", "ClientIP", "HostSNI", "HostSNIRegexp"},
+	}
+
+	httpRuleParser = sync.OnceValues(func() (httpmuxer.SyntaxParser, error) {
+		return httpmuxer.NewSyntaxParser()
+	})
+)
+
+// RuleError is a problem at a position of a router rule.
+type RuleError struct {
+	Line    int // 1-based
+	Column  int // 1-based, in bytes
+	Message string
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message)
+}
+
+// Matcher is a single matcher of a rule, e.g. Host(`example.com`).
+type Matcher struct {
+	Name   string // canonical name, e.g. "PathPrefix"
+	Values []string
+	Not    bool
+}
+
+// Rule is a parsed router rule in disjunctive normal form: it matches if all
+// matchers of any of its branches match.
+type Rule struct {
+	Branches [][]Matcher
+	Complex  bool // too many branches to expand, Branches is empty
+}
+
+// ParseRule parses a HTTP or TCP router rule and checks it with Traefik's own
+// matchers for the given syntax ("v3" unless "v2").
+func ParseRule(protocol, rule, syntax string) (*Rule, error) {
+	syntax = strings.ToLower(syntax)
+	if syntax != "v2" {
+		syntax = "v3"
+	}
+	known := httpMatchers[syntax]
+	if protocol == "tcp" {
+		known = tcpMatchers[syntax]
+	}
+
+	expr, err := parser.ParseExpr(rule)
+	if err != nil {
+		var list scanner.ErrorList
+		if errors.As(err, &list) && len(list) > 0 {
+			return nil, &RuleError{
+				Line:    list[0].Pos.Line,
+				Column:  list[0].Pos.Column,
+				Message: list[0].Msg,
+			}
+		}
+		return nil, &RuleError{Line: 1, Column: 1, Message: err.Error()}
+	}
+
+	p := &ruleParser{protocol: protocol, rule: rule, syntax: syntax, known: known}
+	branches, err := p.walk(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Anything the walk let through is still checked by Traefik as a whole
+	if err = addRoute(protocol, rule, syntax); err != nil {
+		return nil, &RuleError{Line: 1, Column: 1, Message: routeErrorMessage(err, rule)}
+	}
+	if branches == nil {
+		return &Rule{Complex: true}, nil
+	}
+	return &Rule{Branches: branches}, nil
+}
+
+type ruleParser struct {
+	protocol string
+	rule     string
+	syntax   string
+	known    []string
+}
+
+// walk expands an expression into branches. A nil result without error means
+// the expression is too complex to expand.
+func (p *ruleParser) walk(node ast.Expr) ([][]Matcher, error) {
+	switch n := node.(type) {
+	case *ast.ParenExpr:
+		return p.walk(n.X)
+
+	case *ast.UnaryExpr:
+		if n.Op != token.NOT {
+			return nil, p.errorAt(n.OpPos, "operator %s is not supported", n.Op)
+		}
+		branches, err := p.walk(n.X)
+		if err != nil || branches == nil {
+			return nil, err
+		}
+		return negateBranches(branches), nil
+
+	case *ast.BinaryExpr:
+		if n.Op != token.LAND && n.Op != token.LOR {
+			return nil, p.errorAt(n.OpPos, "operator %s is not supported", n.Op)
+		}
+		left, err := p.walk(n.X)
+		if err != nil {
+			return nil, err
+		}
+		right, err := p.walk(n.Y)
+		if err != nil || left == nil || right == nil {
+			return nil, err
+		}
+		if n.Op == token.LOR {
+			return capBranches(append(left, right...)), nil
+		}
+		return andBranches(left, right), nil
+
+	case *ast.CallExpr:
+		return p.matcher(n)
+
+	default:
+		return nil, p.errorAt(node.Pos(), "expected a matcher")
+	}
+}
+
+func (p *ruleParser) matcher(call *ast.CallExpr) ([][]Matcher, error) {
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return nil, p.errorAt(call.Pos(), "expected a matcher name")
+	}
+	idx := slices.IndexFunc(p.known, func(name string) bool {
+		return strings.EqualFold(name, ident.Name)
+	})
+	if idx < 0 {
+		return nil, p.errorAt(ident.Pos(), "unknown matcher %s", ident.Name)
+	}
+
+	m := Matcher{Name: p.known[idx]}
+	for _, arg := range call.Args {
+		lit, ok := arg.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return nil, p.errorAt(arg.Pos(), "%s arguments must be strings", m.Name)
+		}
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return nil, p.errorAt(arg.Pos(), "invalid string: %v", err)
+		}
+		m.Values = append(m.Values, value)
+	}
+
+	// Let Traefik check the arguments (count, regexps, IPs, ...)
+	source := p.rule[call.Pos()-1 : call.End()-1]
+	if err := addRoute(p.protocol, source, p.syntax); err != nil {
+		return nil, p.errorAt(call.Pos(), "%s", routeErrorMessage(err, source))
+	}
+	return [][]Matcher{{m}}, nil
+}
+
+func (p *ruleParser) errorAt(pos token.Pos, format string, args ...any) *RuleError {
+	offset := int(pos) - 1
+	line := 1 + strings.Count(p.rule[:offset], "\n")
+	column := offset - strings.LastIndexByte(p.rule[:offset], '\n')
+	return &RuleError{Line: line, Column: column, Message: fmt.Sprintf(format, args...)}
+}
+
+func andBranches(left, right [][]Matcher) [][]Matcher {
+	if len(left)*len(right) > maxRuleBranches {
+		return nil
+	}
+	result := make([][]Matcher, 0, len(left)*len(right))
+	for _, l := range left {
+		for _, r := range right {
+			result = append(result, append(slices.Clone(l), r...))
+		}
+	}
+	return result
+}
+
+// negateBranches applies De Morgan's laws: the negation of the branches is the
+// conjunction of the negations of each branch.
+func negateBranches(branches [][]Matcher) [][]Matcher {
+	result := [][]Matcher{{}}
+	for _, branch := range branches {
+		negated := make([][]Matcher, 0, len(branch))
+		for _, m := range branch {
+			m.Not = !m.Not
+			negated = append(negated, []Matcher{m})
+		}
+		if result = andBranches(result, negated); result == nil {
+			return nil
+		}
+	}
+	return result
+}
+
+func capBranches(branches [][]Matcher) [][]Matcher {
+	if len(branches) > maxRuleBranches {
+		return nil
+	}
+	return branches
+}
+
+func addRoute(protocol, rule, syntax string) error {
+	if protocol == "tcp" {
+		muxer, err := tcpmuxer.NewMuxer(nil)
+		if err != nil {
+			return err
+		}
+		return muxer.AddRoute(rule, syntax, 0, "http", nil)
+	}
+
+	syntaxParser, err := httpRuleParser()
+	if err != nil {
+		return err
+	}
+	muxer := httpmuxer.NewMuxer(syntaxParser, nil)
+	return muxer.AddRoute(rule, syntax, 0, "http", http.NotFoundHandler())
+}
+
+// routeErrorMessage strips the rule Traefik repeats in its errors.
+func routeErrorMessage(err error, rule string) string {
+	msg := err.Error()
+	if i := strings.LastIndex(msg, rule+": "); i >= 0 {
+		msg = msg[i+len(rule)+2:]
+	}
+	if rest, ok := strings.CutPrefix(msg, "error while adding rule "); ok {
+		if _, after, found := strings.Cut(rest, ": "); found {
+			msg = after
+		}
+	}
+	return msg
+}
+
+// RulePriority returns the priority Traefik gives a router: its configured
+// priority, or one derived from its rule.
+func RulePriority(protocol, rule string, priority int) int {
+	switch {
+	case priority != 0:
+		return priority
+	case protocol == "tcp":
+		return tcpmuxer.GetRulePriority(rule)
+	default:
+		return httpmuxer.GetRulePriority(rule)
+	}
+}
+
+// Overlaps reports whether a request could match both rules. It errs on the
+// side of overlapping for matchers it cannot compare, like regexps, and for
+// rules too complex to expand.
+func (r *Rule) Overlaps(other *Rule) bool {
+	if r.Complex || other.Complex {
+		return true
+	}
+	for _, a := range r.Branches {
+		for _, b := range other.Branches {
+			if !branchesDisjoint(a, b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Covers reports whether every request matching the other rule also matches
+// this one. It errs on the side of not covering for matchers it cannot
+// compare.
+func (r *Rule) Covers(other *Rule) bool {
+	if r.Complex || other.Complex {
+		return false
+	}
+	for _, b := range other.Branches {
+		if branchesDisjoint(b, nil) {
+			continue // never matches
+		}
+		if !slices.ContainsFunc(r.Branches, func(a []Matcher) bool {
+			return branchImplies(b, a)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// branchImplies reports whether matching all of b means matching all of a.
+func branchImplies(b, a []Matcher) bool {
+	for _, ma := range a {
+		if !slices.ContainsFunc(b, func(mb Matcher) bool { return matcherImplies(mb, ma) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// matcherImplies reports whether matching b means matching a.
+func matcherImplies(b, a Matcher) bool {
+	if b.Name == a.Name && b.Not == a.Not && slices.Equal(b.Values, a.Values) {
+		return true
+	}
+	switch {
+	case a.Not && b.Not:
+		a.Not, b.Not = false, false
+		return matcherImplies(a, b)
+	case a.Not:
+		a.Not = false
+		return matchersDisjoint(b, a)
+	case b.Not:
+		return false
+	}
+
+	switch {
+	case isHostMatcher(a) && isHostMatcher(b), a.Name == "Method" && b.Name == "Method":
+		return subsetFold(b.Values, a.Values)
+	case a.Name == "HostSNI" && b.Name == "HostSNI":
+		return slices.Contains(a.Values, "*") || subsetFold(b.Values, a.Values)
+	case isPathMatcher(a) && isPathMatcher(b):
+		for _, vb := range b.Values {
+			if !slices.ContainsFunc(a.Values, func(va string) bool {
+				return pathImplies(b.Name, vb, a.Name, va)
+			}) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func pathImplies(nameB, b, nameA, a string) bool {
+	if strings.Contains(a, "{") || strings.Contains(b, "{") {
+		return false
+	}
+	if nameA == "Path" {
+		return nameB == "Path" && a == b
+	}
+	return strings.HasPrefix(b, a)
+}
+
+func branchesDisjoint(a, b []Matcher) bool {
+	all := append(slices.Clone(a), b...)
+	for i := range all {
+		for j := i + 1; j < len(all); j++ {
+			if matchersDisjoint(all[i], all[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchersDisjoint reports whether no request can match both matchers.
+func matchersDisjoint(a, b Matcher) bool {
+	if a.Not || b.Not {
+		// Only a matcher and its own negation are known to exclude each other
+		return a.Not != b.Not && a.Name == b.Name && slices.Equal(a.Values, b.Values)
+	}
+
+	switch {
+	case isHostMatcher(a) && isHostMatcher(b):
+		return !intersectFold(a.Values, b.Values)
+	case a.Name == "Method" && b.Name == "Method":
+		return !intersectFold(a.Values, b.Values)
+	case a.Name == "HostSNI" && b.Name == "HostSNI":
+		if slices.Contains(a.Values, "*") || slices.Contains(b.Values, "*") {
+			return false
+		}
+		return !intersectFold(a.Values, b.Values)
+	case isPathMatcher(a) && isPathMatcher(b):
+		for _, va := range a.Values {
+			for _, vb := range b.Values {
+				if !pathsDisjoint(a.Name, va, b.Name, vb) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func isHostMatcher(m Matcher) bool {
+	return m.Name == "Host" || m.Name == "HostHeader"
+}
+
+func isPathMatcher(m Matcher) bool {
+	return m.Name == "Path" || m.Name == "PathPrefix"
+}
+
+func pathsDisjoint(nameA, a, nameB, b string) bool {
+	// v2 paths may contain {name:regexp} templates
+	if strings.Contains(a, "{") || strings.Contains(b, "{") {
+		return false
+	}
+	switch {
+	case nameA == "Path" && nameB == "Path":
+		return a != b
+	case nameA == "Path":
+		return !strings.HasPrefix(a, b)
+	case nameB == "Path":
+		return !strings.HasPrefix(b, a)
+	default:
+		return !strings.HasPrefix(a, b) && !strings.HasPrefix(b, a)
+	}
+}
+
+func subsetFold(sub, set []string) bool {
+	for _, v := range sub {
+		if !slices.ContainsFunc(set, func(s string) bool { return strings.EqualFold(s, v) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func intersectFold(a, b []string) bool {
+	for _, va := range a {
+		for _, vb := range b {
+			if strings.EqualFold(va, vb) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package traefik
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+)
+
+// maxRuntimeSize caps the /api/rawdata response read from a Traefik instance.
+const maxRuntimeSize = 32 << 20
+
+// runtimeKinds are the sections of Traefik's /api/rawdata response.
+var runtimeKinds = []string{
+	"routers",
+	"middlewares",
+	"services",
+	"tcpRouters",
+	"tcpMiddlewares",
+	"tcpServices",
+	"udpRouters",
+	"udpServices",
+}
+
+type runtimeInfo struct {
+	Status string   `json:"status"`
+	Errors []string `json:"error"`
+}
+
+// FetchRuntime reads the routers, middlewares and services a Traefik instance
+// loaded from its API, with the errors it reported for them. Credentials in
+// the URL are sent as basic auth.
+func FetchRuntime(
+	ctx context.Context,
+	client *http.Client,
+	apiURL string,
+) ([]db.RuntimeItem, error) {
+	endpoint, err := url.JoinPath(apiURL, "api", "rawdata")
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if _, err = getJSON(ctx, client, endpoint, &raw); err != nil {
+		return nil, err
+	}
+
+	var items []db.RuntimeItem
+	for _, kind := range runtimeKinds {
+		var section map[string]runtimeInfo
+		if data, ok := raw[kind]; ok {
+			if err = json.Unmarshal(data, &section); err != nil {
+				return nil, fmt.Errorf("invalid rawdata %s: %w", kind, err)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(section)) {
+			info := section[name]
+			items = append(items, db.RuntimeItem{
+				Kind:   kind,
+				Name:   name,
+				Status: info.Status,
+				Errors: info.Errors,
+			})
+		}
+	}
+	return items, nil
+}
+
+// getJSON decodes the response of a Traefik API endpoint.
+func getJSON(
+	ctx context.Context,
+	client *http.Client,
+	endpoint string,
+	v any,
+) (http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected status %d", req.URL.Path, resp.StatusCode)
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxRuntimeSize)).Decode(v); err != nil {
+		return nil, fmt.Errorf("%s: invalid response: %w", req.URL.Path, err)
+	}
+	return resp.Header, nil
+}
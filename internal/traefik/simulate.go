@@ -0,0 +1,270 @@
+package traefik
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/middlewares/requestdecorator"
+	httpmuxer "github.com/traefik/traefik/v3/pkg/muxer/http"
+)
+
+// maxServiceDepth guards the service resolution against reference cycles.
+const maxServiceDepth = 16
+
+// SimulatedRequest is a HTTP request to route through a configuration.
+type SimulatedRequest struct {
+	EntryPoint string // empty for all entry points
+	TLS        bool
+	Method     string
+	Host       string
+	Path       string // may contain a query
+	Headers    map[string]string
+	ClientIP   string
+}
+
+// RouteCandidate is a router listening for the request, in the order Traefik
+// tries them.
+type RouteCandidate struct {
+	Router   string
+	Rule     string
+	Priority int
+	Matches  bool
+	Error    string // rule Traefik would reject, the router is not loaded
+}
+
+// SimulatedMiddleware is a middleware the request passes through.
+type SimulatedMiddleware struct {
+	Name    string
+	Type    string // e.g. "stripPrefix", empty if defined by another provider
+	Service string // service applying it, empty for router middlewares
+}
+
+// SimulatedService is a service the request may be forwarded to.
+type SimulatedService struct {
+	Name    string
+	Type    string // e.g. "loadBalancer", empty if defined by another provider
+	Servers []string
+}
+
+// Simulation is the outcome of routing a request.
+type Simulation struct {
+	Router      string // empty if no router matches
+	Candidates  []RouteCandidate
+	Middlewares []SimulatedMiddleware
+	Services    []SimulatedService
+}
+
+// SimulateRequest finds the HTTP router that would handle a request with
+// Traefik's own muxer, and resolves its middleware chain and services.
+func SimulateRequest(
+	ctx context.Context,
+	cfg *dynamic.Configuration,
+	sr SimulatedRequest,
+) (*Simulation, error) {
+	req, err := sr.build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &Simulation{}
+	if cfg == nil || cfg.HTTP == nil {
+		return result, nil
+	}
+
+	syntaxParser, err := httpRuleParser()
+	if err != nil {
+		return nil, err
+	}
+	muxer := httpmuxer.NewMuxer(syntaxParser, nil)
+	matched := ""
+	for _, name := range slices.Sorted(maps.Keys(cfg.HTTP.Routers)) {
+		r := cfg.HTTP.Routers[name]
+		if (r.TLS != nil) != sr.TLS {
+			continue
+		}
+		if sr.EntryPoint != "" && len(r.EntryPoints) > 0 &&
+			!slices.Contains(r.EntryPoints, sr.EntryPoint) {
+			continue
+		}
+
+		candidate := RouteCandidate{
+			Router:   name,
+			Rule:     r.Rule,
+			Priority: RulePriority("http", r.Rule, r.Priority),
+		}
+		if _, err := ParseRule("http", r.Rule, r.RuleSyntax); err != nil {
+			candidate.Error = err.Error()
+			result.Candidates = append(result.Candidates, candidate)
+			continue
+		}
+
+		// Each router is also tried alone to tell all the matching ones
+		single := httpmuxer.NewMuxer(syntaxParser, nil)
+		if err := single.AddRoute(r.Rule, r.RuleSyntax, candidate.Priority, "http",
+			http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+				candidate.Matches = true
+			})); err != nil {
+			return nil, err
+		}
+		serve(single, req)
+
+		if err := muxer.AddRoute(r.Rule, r.RuleSyntax, candidate.Priority, "http",
+			http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+				matched = name
+			})); err != nil {
+			return nil, err
+		}
+		result.Candidates = append(result.Candidates, candidate)
+	}
+	serve(muxer, req)
+
+	slices.SortStableFunc(result.Candidates, func(a, b RouteCandidate) int {
+		return cmp.Compare(b.Priority, a.Priority)
+	})
+	if matched == "" {
+		return result, nil
+	}
+
+	router := cfg.HTTP.Routers[matched]
+	result.Router = matched
+	result.Middlewares = resolveMiddlewares(cfg.HTTP, router.Middlewares, "", nil)
+	resolveService(cfg.HTTP, router.Service, result, 0)
+	return result, nil
+}
+
+func (sr SimulatedRequest) build(ctx context.Context) (*http.Request, error) {
+	scheme := "http"
+	if sr.TLS {
+		scheme = "https"
+	}
+	path := cmp.Or(sr.Path, "/")
+	if path[0] != '/' {
+		return nil, fmt.Errorf("path %q must start with /", path)
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		cmp.Or(sr.Method, http.MethodGet),
+		scheme+"://"+sr.Host+path,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Host = sr.Host
+	for key, value := range sr.Headers {
+		req.Header.Set(key, value)
+	}
+	if sr.TLS {
+		req.TLS = &tls.ConnectionState{ServerName: sr.Host}
+	}
+	if sr.ClientIP != "" {
+		if net.ParseIP(sr.ClientIP) == nil {
+			return nil, fmt.Errorf("invalid client IP %q", sr.ClientIP)
+		}
+		req.RemoteAddr = net.JoinHostPort(sr.ClientIP, "0")
+	}
+	return req, nil
+}
+
+// serve routes a request the way an entry point does, with the canonical host
+// the matchers rely on.
+func serve(muxer *httpmuxer.Muxer, req *http.Request) {
+	requestdecorator.New(nil).ServeHTTP(httptest.NewRecorder(), req, muxer.ServeHTTP)
+}
+
+func resolveMiddlewares(
+	cfg *dynamic.HTTPConfiguration,
+	names []string,
+	service string,
+	seen map[string]bool,
+) []SimulatedMiddleware {
+	var result []SimulatedMiddleware
+	for _, name := range names {
+		local, ok := localName(name)
+		m := cfg.Middlewares[local]
+		if !ok || m == nil {
+			result = append(result, SimulatedMiddleware{Name: name, Service: service})
+			continue
+		}
+		if m.Chain != nil && !seen[local] {
+			seen = maps.Clone(seen)
+			if seen == nil {
+				seen = make(map[string]bool)
+			}
+			seen[local] = true
+			result = append(result, resolveMiddlewares(cfg, m.Chain.Middlewares, service, seen)...)
+			continue
+		}
+		result = append(result, SimulatedMiddleware{
+			Name:    name,
+			Type:    configType(m),
+			Service: service,
+		})
+	}
+	return result
+}
+
+func resolveService(cfg *dynamic.HTTPConfiguration, name string, result *Simulation, depth int) {
+	local, ok := localName(name)
+	s := cfg.Services[local]
+	if !ok || s == nil || depth > maxServiceDepth {
+		result.Services = append(result.Services, SimulatedService{Name: name})
+		return
+	}
+
+	service := SimulatedService{Name: name, Type: configType(s)}
+	if s.LoadBalancer != nil {
+		for _, server := range s.LoadBalancer.Servers {
+			service.Servers = append(service.Servers, server.URL)
+		}
+	}
+	result.Services = append(result.Services, service)
+	result.Middlewares = append(
+		result.Middlewares,
+		resolveMiddlewares(cfg, s.Middlewares, name, nil)...,
+	)
+
+	switch {
+	case s.Weighted != nil:
+		for _, child := range s.Weighted.Services {
+			resolveService(cfg, child.Name, result, depth+1)
+		}
+	case s.HighestRandomWeight != nil:
+		for _, child := range s.HighestRandomWeight.Services {
+			resolveService(cfg, child.Name, result, depth+1)
+		}
+	case s.Mirroring != nil:
+		// Mirrors get copies, the response comes from the main service
+		resolveService(cfg, s.Mirroring.Service, result, depth+1)
+	case s.Failover != nil:
+		resolveService(cfg, s.Failover.Service, result, depth+1)
+		resolveService(cfg, s.Failover.Fallback, result, depth+1)
+	}
+}
+
+// configType returns the JSON key of the type set in a middleware or service,
+// e.g. "stripPrefix".
+func configType(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return ""
+	}
+	for key := range fields {
+		if key != "middlewares" {
+			return key
+		}
+	}
+	return ""
+}
@@ -0,0 +1,194 @@
+package traefik
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestSimulateRequest(t *testing.T) {
+	cfg := &dynamic.Configuration{
+		HTTP: &dynamic.HTTPConfiguration{
+			Routers: map[string]*dynamic.Router{
+				"web": {
+					Rule:        "Host(`example.com`)",
+					EntryPoints: []string{"web"},
+					Middlewares: []string{"secure"},
+					Service:     "app",
+				},
+				"api": {
+					Rule:        "Host(`example.com`) && PathPrefix(`/api`)",
+					Middlewares: []string{"strip@http"},
+					Service:     "split",
+				},
+				"admin": {
+					Rule:        "Method(`POST`) && Header(`X-Admin`, `1`)",
+					Priority:    1000,
+					Middlewares: []string{"auth@docker"},
+					Service:     "app",
+				},
+				"internal": {
+					Rule:     "ClientIP(`10.0.0.0/8`)",
+					Priority: 500,
+					Service:  "app",
+				},
+				"secure": {
+					Rule:    "Host(`example.com`)",
+					TLS:     &dynamic.RouterTLSConfig{},
+					Service: "app",
+				},
+				"broken": {Rule: "Host(", Service: "app"},
+			},
+			Middlewares: map[string]*dynamic.Middleware{
+				"secure": {Chain: &dynamic.Chain{Middlewares: []string{"headers", "strip"}}},
+				"headers": {Headers: &dynamic.Headers{
+					CustomRequestHeaders: map[string]string{"X-Forwarded-Proto": "https"},
+				}},
+				"strip": {StripPrefix: &dynamic.StripPrefix{Prefixes: []string{"/api"}}},
+			},
+			Services: map[string]*dynamic.Service{
+				"app": {LoadBalancer: &dynamic.ServersLoadBalancer{
+					Servers: []dynamic.Server{{URL: "http://app:8080"}},
+				}},
+				"split": {Weighted: &dynamic.WeightedRoundRobin{
+					Services: []dynamic.WRRService{{Name: "app"}, {Name: "v2@docker"}},
+				}},
+			},
+		},
+	}
+
+	app := SimulatedService{Name: "app", Type: "loadBalancer", Servers: []string{"http://app:8080"}}
+
+	tests := []struct {
+		name        string
+		req         SimulatedRequest
+		router      string
+		matches     []string // matching candidates, by descending priority
+		middlewares []SimulatedMiddleware
+		services    []SimulatedService
+	}{
+		{
+			name:    "host",
+			req:     SimulatedRequest{Host: "example.com"},
+			router:  "web",
+			matches: []string{"web"},
+			middlewares: []SimulatedMiddleware{
+				{Name: "headers", Type: "headers"},
+				{Name: "strip", Type: "stripPrefix"},
+			},
+			services: []SimulatedService{app},
+		},
+		{
+			name:        "host with port and case",
+			req:         SimulatedRequest{Host: "Example.com:8080", Path: "/api/users?page=2"},
+			router:      "api",
+			matches:     []string{"api", "web"},
+			middlewares: []SimulatedMiddleware{{Name: "strip@http", Type: "stripPrefix"}},
+			services: []SimulatedService{
+				{Name: "split", Type: "weighted"},
+				app,
+				{Name: "v2@docker"},
+			},
+		},
+		{
+			name: "method and header",
+			req: SimulatedRequest{
+				Method:  "POST",
+				Host:    "example.com",
+				Path:    "/api",
+				Headers: map[string]string{"X-Admin": "1"},
+			},
+			router:      "admin",
+			matches:     []string{"admin", "api", "web"},
+			middlewares: []SimulatedMiddleware{{Name: "auth@docker"}},
+			services:    []SimulatedService{app},
+		},
+		{
+			name: "header mismatch",
+			req: SimulatedRequest{
+				Method:  "POST",
+				Host:    "example.com",
+				Headers: map[string]string{"X-Admin": "2"},
+			},
+			router:  "web",
+			matches: []string{"web"},
+			middlewares: []SimulatedMiddleware{
+				{Name: "headers", Type: "headers"},
+				{Name: "strip", Type: "stripPrefix"},
+			},
+			services: []SimulatedService{app},
+		},
+		{
+			name:     "client ip",
+			req:      SimulatedRequest{Host: "example.com", ClientIP: "10.1.2.3"},
+			router:   "internal",
+			matches:  []string{"internal", "web"},
+			services: []SimulatedService{app},
+		},
+		{
+			name:     "tls",
+			req:      SimulatedRequest{Host: "example.com", TLS: true},
+			router:   "secure",
+			matches:  []string{"secure"},
+			services: []SimulatedService{app},
+		},
+		{
+			name: "entry point",
+			req:  SimulatedRequest{EntryPoint: "websecure", Host: "example.com"},
+		},
+		{
+			name: "no match",
+			req:  SimulatedRequest{Host: "other.com", Path: "/api"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SimulateRequest(t.Context(), cfg, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Router != tt.router {
+				t.Errorf("router = %q, want %q", got.Router, tt.router)
+			}
+
+			var matches []string
+			for _, c := range got.Candidates {
+				if c.Matches {
+					matches = append(matches, c.Router)
+				}
+				if (c.Error != "") != (c.Router == "broken") {
+					t.Errorf("candidate %q: error = %q", c.Router, c.Error)
+				}
+			}
+			if !reflect.DeepEqual(matches, tt.matches) {
+				t.Errorf("matches = %v, want %v", matches, tt.matches)
+			}
+			if !reflect.DeepEqual(got.Middlewares, tt.middlewares) {
+				t.Errorf("middlewares = %+v, want %+v", got.Middlewares, tt.middlewares)
+			}
+			if !reflect.DeepEqual(got.Services, tt.services) {
+				t.Errorf("services = %+v, want %+v", got.Services, tt.services)
+			}
+		})
+	}
+}
+
+func TestSimulateRequestInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		req  SimulatedRequest
+	}{
+		{name: "relative path", req: SimulatedRequest{Host: "example.com", Path: "api"}},
+		{name: "client ip", req: SimulatedRequest{Host: "example.com", ClientIP: "10.0.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SimulateRequest(t.Context(), nil, tt.req); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
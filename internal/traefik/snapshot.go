@@ -0,0 +1,718 @@
+package traefik
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// TakeSnapshot collects the user managed routers, services, middlewares and
+// servers transports of a profile, and the DNS providers of its routers.
+func TakeSnapshot(ctx context.Context, q *db.Queries, profileID int64) (*db.Snapshot, error) {
+	snapshot := &db.Snapshot{DNSProviders: make(map[string][]string)}
+	for _, table := range snapshotTables() {
+		if err := table.take(ctx, q, profileID, snapshot); err != nil {
+			return nil, err
+		}
+	}
+	for _, links := range dnsLinkTables() {
+		routers, err := links.list(ctx, q, profileID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s routers: %w", links.protocol, err)
+		}
+		for name, id := range routers {
+			providers, err := links.providers(ctx, q, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list dns providers of %q: %w", name, err)
+			}
+			if len(providers) > 0 {
+				slices.Sort(providers)
+				snapshot.DNSProviders[links.protocol+"."+name] = providers
+			}
+		}
+	}
+	return snapshot, nil
+}
+
+// RestoreSnapshot brings the user managed rows of a profile back to the state
+// of the snapshot: rows are updated in place (keeping their IDs and DNS
+// providers), recreated if they were deleted since, and deleted if they were
+// added since. Routers are linked to the DNS providers of the snapshot again,
+// unless it predates the links. Rows owned by agents are left untouched.
+//
+// q should be bound to a transaction, so a failure doesn't leave the profile
+// half restored.
+func RestoreSnapshot(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	snapshot *db.Snapshot,
+) error {
+	for _, table := range snapshotTables() {
+		if err := table.restore(ctx, q, profileID, snapshot); err != nil {
+			return err
+		}
+	}
+	if snapshot.DNSProviders == nil {
+		return nil
+	}
+
+	providers, err := q.ListDnsProviders(ctx, &db.ListDnsProvidersParams{})
+	if err != nil {
+		return fmt.Errorf("failed to list dns providers: %w", err)
+	}
+	exists := make(map[string]bool, len(providers))
+	for _, p := range providers {
+		exists[p.ID] = true
+	}
+	for _, links := range dnsLinkTables() {
+		routers, err := links.list(ctx, q, profileID)
+		if err != nil {
+			return fmt.Errorf("failed to list %s routers: %w", links.protocol, err)
+		}
+		for name, id := range routers {
+			current, err := links.providers(ctx, q, id)
+			if err != nil {
+				return fmt.Errorf("failed to list dns providers of %q: %w", name, err)
+			}
+			wanted := snapshot.DNSProviders[links.protocol+"."+name]
+			for _, providerID := range current {
+				if !slices.Contains(wanted, providerID) {
+					if err = links.unlink(ctx, q, id, providerID); err != nil {
+						return fmt.Errorf("failed to unlink dns provider from %q: %w", name, err)
+					}
+				}
+			}
+			for _, providerID := range wanted {
+				// Providers deleted since the snapshot can't be linked again
+				if exists[providerID] && !slices.Contains(current, providerID) {
+					if err = links.link(ctx, q, id, providerID); err != nil {
+						return fmt.Errorf("failed to link dns provider to %q: %w", name, err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// DNSLink is a router linked to a DNS provider.
+type DNSLink struct {
+	Router     string // e.g. "http.web"
+	ProviderID string
+	Rule       string
+}
+
+// StaleDNSLinks returns the DNS links of a snapshot whose records the other
+// snapshot no longer needs: the router or its link is gone, or its rule
+// changed. Nothing is stale if the first snapshot didn't record its links.
+func StaleDNSLinks(before, after *db.Snapshot) []DNSLink {
+	if before == nil || before.DNSProviders == nil {
+		return nil
+	}
+	rules := func(s *db.Snapshot) map[string]string {
+		result := make(map[string]string)
+		if s == nil {
+			return result
+		}
+		for _, row := range s.HTTPRouters {
+			if row.Config != nil {
+				result["http."+row.Name] = row.Config.Rule
+			}
+		}
+		for _, row := range s.TCPRouters {
+			if row.Config != nil {
+				result["tcp."+row.Name] = row.Config.Rule
+			}
+		}
+		return result
+	}
+	oldRules, newRules := rules(before), rules(after)
+
+	var stale []DNSLink
+	for _, router := range slices.Sorted(maps.Keys(before.DNSProviders)) {
+		rule, ok := oldRules[router]
+		if !ok {
+			continue
+		}
+		for _, providerID := range before.DNSProviders[router] {
+			newRule, kept := newRules[router]
+			if kept && newRule == rule && after.DNSProviders != nil &&
+				slices.Contains(after.DNSProviders[router], providerID) {
+				continue
+			}
+			stale = append(stale, DNSLink{Router: router, ProviderID: providerID, Rule: rule})
+		}
+	}
+	return stale
+}
+
+// dnsLinkTable reads and writes the DNS provider links of one router table.
+type dnsLinkTable struct {
+	protocol  string
+	list      func(ctx context.Context, q *db.Queries, profileID int64) (map[string]string, error)
+	providers func(ctx context.Context, q *db.Queries, routerID string) ([]string, error)
+	link      func(ctx context.Context, q *db.Queries, routerID, providerID string) error
+	unlink    func(ctx context.Context, q *db.Queries, routerID, providerID string) error
+}
+
+func dnsLinkTables() []dnsLinkTable {
+	providerIDs := func(providers []*db.DnsProvider, err error) ([]string, error) {
+		ids := make([]string, 0, len(providers))
+		for _, p := range providers {
+			ids = append(ids, p.ID)
+		}
+		return ids, err
+	}
+	return []dnsLinkTable{
+		{
+			protocol: "http",
+			list: func(ctx context.Context, q *db.Queries, profileID int64) (map[string]string, error) {
+				rows, err := q.ListHttpRouters(ctx, &db.ListHttpRoutersParams{ProfileID: profileID})
+				routers := make(map[string]string, len(rows))
+				for _, r := range rows {
+					if r.AgentID == nil {
+						routers[r.Name] = r.ID
+					}
+				}
+				return routers, err
+			},
+			providers: func(ctx context.Context, q *db.Queries, routerID string) ([]string, error) {
+				return providerIDs(q.GetDnsProvidersByHttpRouter(ctx, routerID))
+			},
+			link: func(ctx context.Context, q *db.Queries, routerID, providerID string) error {
+				return q.CreateHttpRouterDNSProvider(ctx, &db.CreateHttpRouterDNSProviderParams{
+					HttpRouterID:  routerID,
+					DnsProviderID: providerID,
+				})
+			},
+			unlink: func(ctx context.Context, q *db.Queries, routerID, providerID string) error {
+				return q.DeleteHttpRouterDNSProvider(ctx, &db.DeleteHttpRouterDNSProviderParams{
+					HttpRouterID:  routerID,
+					DnsProviderID: providerID,
+				})
+			},
+		},
+		{
+			protocol: "tcp",
+			list: func(ctx context.Context, q *db.Queries, profileID int64) (map[string]string, error) {
+				rows, err := q.ListTcpRouters(ctx, &db.ListTcpRoutersParams{ProfileID: profileID})
+				routers := make(map[string]string, len(rows))
+				for _, r := range rows {
+					if r.AgentID == nil {
+						routers[r.Name] = r.ID
+					}
+				}
+				return routers, err
+			},
+			providers: func(ctx context.Context, q *db.Queries, routerID string) ([]string, error) {
+				return providerIDs(q.GetDnsProvidersByTcpRouter(ctx, routerID))
+			},
+			link: func(ctx context.Context, q *db.Queries, routerID, providerID string) error {
+				return q.CreateTcpRouterDNSProvider(ctx, &db.CreateTcpRouterDNSProviderParams{
+					TcpRouterID:   routerID,
+					DnsProviderID: providerID,
+				})
+			},
+			unlink: func(ctx context.Context, q *db.Queries, routerID, providerID string) error {
+				return q.DeleteTcpRouterDNSProvider(ctx, &db.DeleteTcpRouterDNSProviderParams{
+					TcpRouterID:   routerID,
+					DnsProviderID: providerID,
+				})
+			},
+		},
+	}
+}
+
+type snapshotter interface {
+	take(ctx context.Context, q *db.Queries, profileID int64, snapshot *db.Snapshot) error
+	restore(ctx context.Context, q *db.Queries, profileID int64, snapshot *db.Snapshot) error
+}
+
+type snapshotRecord[T any] struct {
+	id      string
+	agentID *string
+	row     db.SnapshotRow[T]
+}
+
+// snapshotTable snapshots one table (e.g. http routers).
+type snapshotTable[T any] struct {
+	name string
+	rows func(snapshot *db.Snapshot) *[]db.SnapshotRow[T]
+
+	list   func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[T], error)
+	create func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[T]) (string, error)
+	update func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[T]) error
+	remove func(q *db.Queries, ctx context.Context, id string) error // method expression
+}
+
+func (t *snapshotTable[T]) take(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	snapshot *db.Snapshot,
+) error {
+	records, err := t.list(ctx, q, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to list %s: %w", t.name, err)
+	}
+	rows := t.rows(snapshot)
+	for _, record := range records {
+		if record.agentID == nil {
+			*rows = append(*rows, record.row)
+		}
+	}
+	return nil
+}
+
+func (t *snapshotTable[T]) restore(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	snapshot *db.Snapshot,
+) error {
+	records, err := t.list(ctx, q, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to list %s: %w", t.name, err)
+	}
+	current := make(map[string]snapshotRecord[T], len(records))
+	for _, record := range records {
+		current[record.row.Name] = record
+	}
+
+	wanted := make(map[string]bool)
+	for _, row := range *t.rows(snapshot) {
+		wanted[row.Name] = true
+
+		record, ok := current[row.Name]
+		switch {
+		case ok && record.agentID != nil:
+			// The name has been taken over by an agent in the meantime
+			continue
+		case ok:
+			err = t.update(ctx, q, record.id, row)
+		default:
+			var id string
+			if id, err = t.create(ctx, q, profileID, row); err == nil && !row.Enabled {
+				err = t.update(ctx, q, id, row)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to restore %s %q: %w", t.name, row.Name, err)
+		}
+	}
+
+	for name, record := range current {
+		if record.agentID != nil || wanted[name] {
+			continue
+		}
+		if err = t.remove(q, ctx, record.id); err != nil {
+			return fmt.Errorf("failed to delete %s %q: %w", t.name, name, err)
+		}
+	}
+	return nil
+}
+
+func snapshotTables() []snapshotter {
+	return []snapshotter{
+		&snapshotTable[dynamic.Router]{
+			name: "http routers",
+			rows: func(s *db.Snapshot) *[]db.SnapshotRow[dynamic.Router] { return &s.HTTPRouters },
+			list: func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[dynamic.Router], error) {
+				rows, err := q.ListHttpRouters(ctx, &db.ListHttpRoutersParams{ProfileID: profileID})
+				records := make([]snapshotRecord[dynamic.Router], 0, len(rows))
+				for _, r := range rows {
+					records = append(records, snapshotRecord[dynamic.Router]{
+						id:      r.ID,
+						agentID: r.AgentID,
+						row:     db.SnapshotRow[dynamic.Router]{Name: r.Name, Enabled: r.Enabled, Config: r.Config.Data},
+					})
+				}
+				return records, err
+			},
+			create: func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[dynamic.Router]) (string, error) {
+				r, err := q.CreateHttpRouter(ctx, &db.CreateHttpRouterParams{
+					ID:        uuid.New().String(),
+					ProfileID: profileID,
+					Name:      row.Name,
+					Config:    &db.RouterConfig{Data: row.Config},
+				})
+				if err != nil {
+					return "", err
+				}
+				return r.ID, nil
+			},
+			update: func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[dynamic.Router]) error {
+				_, err := q.UpdateHttpRouter(ctx, &db.UpdateHttpRouterParams{
+					ID:      id,
+					Name:    row.Name,
+					Config:  &db.RouterConfig{Data: row.Config},
+					Enabled: row.Enabled,
+				})
+				return err
+			},
+			remove: (*db.Queries).DeleteHttpRouter,
+		},
+		&snapshotTable[dynamic.Service]{
+			name: "http services",
+			rows: func(s *db.Snapshot) *[]db.SnapshotRow[dynamic.Service] { return &s.HTTPServices },
+			list: func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[dynamic.Service], error) {
+				rows, err := q.ListHttpServices(ctx, &db.ListHttpServicesParams{ProfileID: profileID})
+				records := make([]snapshotRecord[dynamic.Service], 0, len(rows))
+				for _, r := range rows {
+					records = append(records, snapshotRecord[dynamic.Service]{
+						id:      r.ID,
+						agentID: r.AgentID,
+						row:     db.SnapshotRow[dynamic.Service]{Name: r.Name, Enabled: r.Enabled, Config: r.Config.Data},
+					})
+				}
+				return records, err
+			},
+			create: func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[dynamic.Service]) (string, error) {
+				r, err := q.CreateHttpService(ctx, &db.CreateHttpServiceParams{
+					ID:        uuid.New().String(),
+					ProfileID: profileID,
+					Name:      row.Name,
+					Config:    &db.ServiceConfig{Data: row.Config},
+				})
+				if err != nil {
+					return "", err
+				}
+				return r.ID, nil
+			},
+			update: func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[dynamic.Service]) error {
+				_, err := q.UpdateHttpService(ctx, &db.UpdateHttpServiceParams{
+					ID:      id,
+					Name:    row.Name,
+					Config:  &db.ServiceConfig{Data: row.Config},
+					Enabled: row.Enabled,
+				})
+				return err
+			},
+			remove: (*db.Queries).DeleteHttpService,
+		},
+		&snapshotTable[dynamic.Middleware]{
+			name: "http middlewares",
+			rows: func(s *db.Snapshot) *[]db.SnapshotRow[dynamic.Middleware] { return &s.HTTPMiddlewares },
+			list: func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[dynamic.Middleware], error) {
+				rows, err := q.ListHttpMiddlewares(ctx, &db.ListHttpMiddlewaresParams{ProfileID: profileID})
+				records := make([]snapshotRecord[dynamic.Middleware], 0, len(rows))
+				for _, r := range rows {
+					records = append(records, snapshotRecord[dynamic.Middleware]{
+						id:      r.ID,
+						agentID: r.AgentID,
+						row: db.SnapshotRow[dynamic.Middleware]{
+							Name:      r.Name,
+							Enabled:   r.Enabled,
+							IsDefault: r.IsDefault,
+							Config:    r.Config.Data,
+						},
+					})
+				}
+				return records, err
+			},
+			create: func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[dynamic.Middleware]) (string, error) {
+				r, err := q.CreateHttpMiddleware(ctx, &db.CreateHttpMiddlewareParams{
+					ID:        uuid.New().String(),
+					ProfileID: profileID,
+					Name:      row.Name,
+					Config:    &db.MiddlewareConfig{Data: row.Config},
+					IsDefault: row.IsDefault,
+				})
+				if err != nil {
+					return "", err
+				}
+				return r.ID, nil
+			},
+			update: func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[dynamic.Middleware]) error {
+				_, err := q.UpdateHttpMiddleware(ctx, &db.UpdateHttpMiddlewareParams{
+					ID:        id,
+					Name:      row.Name,
+					Config:    &db.MiddlewareConfig{Data: row.Config},
+					Enabled:   row.Enabled,
+					IsDefault: row.IsDefault,
+				})
+				return err
+			},
+			remove: (*db.Queries).DeleteHttpMiddleware,
+		},
+		&snapshotTable[dynamic.ServersTransport]{
+			name: "http servers transports",
+			rows: func(s *db.Snapshot) *[]db.SnapshotRow[dynamic.ServersTransport] { return &s.HTTPServersTransports },
+			list: func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[dynamic.ServersTransport], error) {
+				rows, err := q.ListHttpServersTransports(ctx, &db.ListHttpServersTransportsParams{ProfileID: profileID})
+				records := make([]snapshotRecord[dynamic.ServersTransport], 0, len(rows))
+				for _, r := range rows {
+					records = append(records, snapshotRecord[dynamic.ServersTransport]{
+						id:      r.ID,
+						agentID: r.AgentID,
+						row:     db.SnapshotRow[dynamic.ServersTransport]{Name: r.Name, Enabled: r.Enabled, Config: r.Config.Data},
+					})
+				}
+				return records, err
+			},
+			create: func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[dynamic.ServersTransport]) (string, error) {
+				r, err := q.CreateHttpServersTransport(ctx, &db.CreateHttpServersTransportParams{
+					ID:        uuid.New().String(),
+					ProfileID: profileID,
+					Name:      row.Name,
+					Config:    &db.ServersTransportConfig{Data: row.Config},
+				})
+				if err != nil {
+					return "", err
+				}
+				return r.ID, nil
+			},
+			update: func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[dynamic.ServersTransport]) error {
+				_, err := q.UpdateHttpServersTransport(ctx, &db.UpdateHttpServersTransportParams{
+					ID:      id,
+					Name:    row.Name,
+					Config:  &db.ServersTransportConfig{Data: row.Config},
+					Enabled: row.Enabled,
+				})
+				return err
+			},
+			remove: (*db.Queries).DeleteHttpServersTransport,
+		},
+		&snapshotTable[dynamic.TCPRouter]{
+			name: "tcp routers",
+			rows: func(s *db.Snapshot) *[]db.SnapshotRow[dynamic.TCPRouter] { return &s.TCPRouters },
+			list: func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[dynamic.TCPRouter], error) {
+				rows, err := q.ListTcpRouters(ctx, &db.ListTcpRoutersParams{ProfileID: profileID})
+				records := make([]snapshotRecord[dynamic.TCPRouter], 0, len(rows))
+				for _, r := range rows {
+					records = append(records, snapshotRecord[dynamic.TCPRouter]{
+						id:      r.ID,
+						agentID: r.AgentID,
+						row:     db.SnapshotRow[dynamic.TCPRouter]{Name: r.Name, Enabled: r.Enabled, Config: r.Config.Data},
+					})
+				}
+				return records, err
+			},
+			create: func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[dynamic.TCPRouter]) (string, error) {
+				r, err := q.CreateTcpRouter(ctx, &db.CreateTcpRouterParams{
+					ID:        uuid.New().String(),
+					ProfileID: profileID,
+					Name:      row.Name,
+					Config:    &db.TCPRouterConfig{Data: row.Config},
+				})
+				if err != nil {
+					return "", err
+				}
+				return r.ID, nil
+			},
+			update: func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[dynamic.TCPRouter]) error {
+				_, err := q.UpdateTcpRouter(ctx, &db.UpdateTcpRouterParams{
+					ID:      id,
+					Name:    row.Name,
+					Config:  &db.TCPRouterConfig{Data: row.Config},
+					Enabled: row.Enabled,
+				})
+				return err
+			},
+			remove: (*db.Queries).DeleteTcpRouter,
+		},
+		&snapshotTable[dynamic.TCPService]{
+			name: "tcp services",
+			rows: func(s *db.Snapshot) *[]db.SnapshotRow[dynamic.TCPService] { return &s.TCPServices },
+			list: func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[dynamic.TCPService], error) {
+				rows, err := q.ListTcpServices(ctx, &db.ListTcpServicesParams{ProfileID: profileID})
+				records := make([]snapshotRecord[dynamic.TCPService], 0, len(rows))
+				for _, r := range rows {
+					records = append(records, snapshotRecord[dynamic.TCPService]{
+						id:      r.ID,
+						agentID: r.AgentID,
+						row:     db.SnapshotRow[dynamic.TCPService]{Name: r.Name, Enabled: r.Enabled, Config: r.Config.Data},
+					})
+				}
+				return records, err
+			},
+			create: func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[dynamic.TCPService]) (string, error) {
+				r, err := q.CreateTcpService(ctx, &db.CreateTcpServiceParams{
+					ID:        uuid.New().String(),
+					ProfileID: profileID,
+					Name:      row.Name,
+					Config:    &db.TCPServiceConfig{Data: row.Config},
+				})
+				if err != nil {
+					return "", err
+				}
+				return r.ID, nil
+			},
+			update: func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[dynamic.TCPService]) error {
+				_, err := q.UpdateTcpService(ctx, &db.UpdateTcpServiceParams{
+					ID:      id,
+					Name:    row.Name,
+					Config:  &db.TCPServiceConfig{Data: row.Config},
+					Enabled: row.Enabled,
+				})
+				return err
+			},
+			remove: (*db.Queries).DeleteTcpService,
+		},
+		&snapshotTable[dynamic.TCPMiddleware]{
+			name: "tcp middlewares",
+			rows: func(s *db.Snapshot) *[]db.SnapshotRow[dynamic.TCPMiddleware] { return &s.TCPMiddlewares },
+			list: func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[dynamic.TCPMiddleware], error) {
+				rows, err := q.ListTcpMiddlewares(ctx, &db.ListTcpMiddlewaresParams{ProfileID: profileID})
+				records := make([]snapshotRecord[dynamic.TCPMiddleware], 0, len(rows))
+				for _, r := range rows {
+					records = append(records, snapshotRecord[dynamic.TCPMiddleware]{
+						id:      r.ID,
+						agentID: r.AgentID,
+						row: db.SnapshotRow[dynamic.TCPMiddleware]{
+							Name:      r.Name,
+							Enabled:   r.Enabled,
+							IsDefault: r.IsDefault,
+							Config:    r.Config.Data,
+						},
+					})
+				}
+				return records, err
+			},
+			create: func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[dynamic.TCPMiddleware]) (string, error) {
+				r, err := q.CreateTcpMiddleware(ctx, &db.CreateTcpMiddlewareParams{
+					ID:        uuid.New().String(),
+					ProfileID: profileID,
+					Name:      row.Name,
+					Config:    &db.TCPMiddlewareConfig{Data: row.Config},
+					IsDefault: row.IsDefault,
+				})
+				if err != nil {
+					return "", err
+				}
+				return r.ID, nil
+			},
+			update: func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[dynamic.TCPMiddleware]) error {
+				_, err := q.UpdateTcpMiddleware(ctx, &db.UpdateTcpMiddlewareParams{
+					ID:        id,
+					Name:      row.Name,
+					Config:    &db.TCPMiddlewareConfig{Data: row.Config},
+					Enabled:   row.Enabled,
+					IsDefault: row.IsDefault,
+				})
+				return err
+			},
+			remove: (*db.Queries).DeleteTcpMiddleware,
+		},
+		&snapshotTable[dynamic.TCPServersTransport]{
+			name: "tcp servers transports",
+			rows: func(s *db.Snapshot) *[]db.SnapshotRow[dynamic.TCPServersTransport] { return &s.TCPServersTransports },
+			list: func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[dynamic.TCPServersTransport], error) {
+				rows, err := q.ListTcpServersTransports(ctx, &db.ListTcpServersTransportsParams{ProfileID: profileID})
+				records := make([]snapshotRecord[dynamic.TCPServersTransport], 0, len(rows))
+				for _, r := range rows {
+					records = append(records, snapshotRecord[dynamic.TCPServersTransport]{
+						id:      r.ID,
+						agentID: r.AgentID,
+						row:     db.SnapshotRow[dynamic.TCPServersTransport]{Name: r.Name, Enabled: r.Enabled, Config: r.Config.Data},
+					})
+				}
+				return records, err
+			},
+			create: func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[dynamic.TCPServersTransport]) (string, error) {
+				r, err := q.CreateTcpServersTransport(ctx, &db.CreateTcpServersTransportParams{
+					ID:        uuid.New().String(),
+					ProfileID: profileID,
+					Name:      row.Name,
+					Config:    &db.TCPServersTransportConfig{Data: row.Config},
+				})
+				if err != nil {
+					return "", err
+				}
+				return r.ID, nil
+			},
+			update: func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[dynamic.TCPServersTransport]) error {
+				_, err := q.UpdateTcpServersTransport(ctx, &db.UpdateTcpServersTransportParams{
+					ID:      id,
+					Name:    row.Name,
+					Config:  &db.TCPServersTransportConfig{Data: row.Config},
+					Enabled: row.Enabled,
+				})
+				return err
+			},
+			remove: (*db.Queries).DeleteTcpServersTransport,
+		},
+		&snapshotTable[dynamic.UDPRouter]{
+			name: "udp routers",
+			rows: func(s *db.Snapshot) *[]db.SnapshotRow[dynamic.UDPRouter] { return &s.UDPRouters },
+			list: func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[dynamic.UDPRouter], error) {
+				rows, err := q.ListUdpRouters(ctx, &db.ListUdpRoutersParams{ProfileID: profileID})
+				records := make([]snapshotRecord[dynamic.UDPRouter], 0, len(rows))
+				for _, r := range rows {
+					records = append(records, snapshotRecord[dynamic.UDPRouter]{
+						id:      r.ID,
+						agentID: r.AgentID,
+						row:     db.SnapshotRow[dynamic.UDPRouter]{Name: r.Name, Enabled: r.Enabled, Config: r.Config.Data},
+					})
+				}
+				return records, err
+			},
+			create: func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[dynamic.UDPRouter]) (string, error) {
+				r, err := q.CreateUdpRouter(ctx, &db.CreateUdpRouterParams{
+					ID:        uuid.New().String(),
+					ProfileID: profileID,
+					Name:      row.Name,
+					Config:    &db.UDPRouterConfig{Data: row.Config},
+				})
+				if err != nil {
+					return "", err
+				}
+				return r.ID, nil
+			},
+			update: func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[dynamic.UDPRouter]) error {
+				_, err := q.UpdateUdpRouter(ctx, &db.UpdateUdpRouterParams{
+					ID:      id,
+					Name:    row.Name,
+					Config:  &db.UDPRouterConfig{Data: row.Config},
+					Enabled: row.Enabled,
+				})
+				return err
+			},
+			remove: (*db.Queries).DeleteUdpRouter,
+		},
+		&snapshotTable[dynamic.UDPService]{
+			name: "udp services",
+			rows: func(s *db.Snapshot) *[]db.SnapshotRow[dynamic.UDPService] { return &s.UDPServices },
+			list: func(ctx context.Context, q *db.Queries, profileID int64) ([]snapshotRecord[dynamic.UDPService], error) {
+				rows, err := q.ListUdpServices(ctx, &db.ListUdpServicesParams{ProfileID: profileID})
+				records := make([]snapshotRecord[dynamic.UDPService], 0, len(rows))
+				for _, r := range rows {
+					records = append(records, snapshotRecord[dynamic.UDPService]{
+						id:      r.ID,
+						agentID: r.AgentID,
+						row:     db.SnapshotRow[dynamic.UDPService]{Name: r.Name, Enabled: r.Enabled, Config: r.Config.Data},
+					})
+				}
+				return records, err
+			},
+			create: func(ctx context.Context, q *db.Queries, profileID int64, row db.SnapshotRow[dynamic.UDPService]) (string, error) {
+				r, err := q.CreateUdpService(ctx, &db.CreateUdpServiceParams{
+					ID:        uuid.New().String(),
+					ProfileID: profileID,
+					Name:      row.Name,
+					Config:    &db.UDPServiceConfig{Data: row.Config},
+				})
+				if err != nil {
+					return "", err
+				}
+				return r.ID, nil
+			},
+			update: func(ctx context.Context, q *db.Queries, id string, row db.SnapshotRow[dynamic.UDPService]) error {
+				_, err := q.UpdateUdpService(ctx, &db.UpdateUdpServiceParams{
+					ID:      id,
+					Name:    row.Name,
+					Config:  &db.UDPServiceConfig{Data: row.Config},
+					Enabled: row.Enabled,
+				})
+				return err
+			},
+			remove: (*db.Queries).DeleteUdpService,
+		},
+	}
+}
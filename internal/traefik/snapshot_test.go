@@ -0,0 +1,170 @@
+package traefik
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	mantraev1 "github.com/mizuchilabs/mantrae/internal/gen/mantrae/v1"
+	"github.com/mizuchilabs/mantrae/internal/store"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestStaleDNSLinks(t *testing.T) {
+	snapshot := func(rules map[string]string, links map[string][]string) *db.Snapshot {
+		s := &db.Snapshot{DNSProviders: links}
+		for name, rule := range rules {
+			s.HTTPRouters = append(s.HTTPRouters, db.SnapshotRow[dynamic.Router]{
+				Name:   name,
+				Config: &dynamic.Router{Rule: rule},
+			})
+		}
+		return s
+	}
+	web := "Host(`example.com`)"
+	published := snapshot(
+		map[string]string{"web": web},
+		map[string][]string{"http.web": {"cloudflare", "powerdns"}},
+	)
+
+	tests := []struct {
+		name   string
+		before *db.Snapshot
+		after  *db.Snapshot
+		want   []DNSLink
+	}{
+		{
+			name:   "unchanged",
+			before: published,
+			after: snapshot(
+				map[string]string{"web": web},
+				map[string][]string{"http.web": {"cloudflare", "powerdns"}},
+			),
+		},
+		{
+			name:   "router deleted",
+			before: published,
+			after:  snapshot(nil, map[string][]string{}),
+			want: []DNSLink{
+				{Router: "http.web", ProviderID: "cloudflare", Rule: web},
+				{Router: "http.web", ProviderID: "powerdns", Rule: web},
+			},
+		},
+		{
+			name:   "provider unlinked",
+			before: published,
+			after: snapshot(
+				map[string]string{"web": web},
+				map[string][]string{"http.web": {"powerdns"}},
+			),
+			want: []DNSLink{{Router: "http.web", ProviderID: "cloudflare", Rule: web}},
+		},
+		{
+			name:   "rule changed",
+			before: published,
+			after: snapshot(
+				map[string]string{"web": "Host(`example.org`)"},
+				map[string][]string{"http.web": {"cloudflare", "powerdns"}},
+			),
+			want: []DNSLink{
+				{Router: "http.web", ProviderID: "cloudflare", Rule: web},
+				{Router: "http.web", ProviderID: "powerdns", Rule: web},
+			},
+		},
+		{
+			name:   "links not recorded",
+			before: snapshot(map[string]string{"web": web}, nil),
+			after:  snapshot(nil, map[string][]string{}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StaleDNSLinks(tt.before, tt.after); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("stale = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestoreSnapshotDNSLinks(t *testing.T) {
+	ctx := t.Context()
+	conn := store.NewConnection(ctx, "file:"+filepath.Join(t.TempDir(), "mantrae.db"))
+	profile, err := conn.Q.CreateProfile(ctx, &db.CreateProfileParams{Name: "p", Token: "p"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"cloudflare", "powerdns"} {
+		if _, err = conn.Q.CreateDnsProvider(ctx, &db.CreateDnsProviderParams{
+			ID:     id,
+			Name:   id,
+			Config: &db.DNSProviderConfig{Data: &mantraev1.DNSProviderConfig{}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	router, err := conn.Q.CreateHttpRouter(ctx, &db.CreateHttpRouterParams{
+		ID:        "web",
+		ProfileID: profile.ID,
+		Name:      "web",
+		Config:    &db.RouterConfig{Data: &dynamic.Router{Rule: "Host(`example.com`)"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	link := func(t *testing.T, routerID, providerID string) {
+		t.Helper()
+		if err := conn.Q.CreateHttpRouterDNSProvider(ctx, &db.CreateHttpRouterDNSProviderParams{
+			HttpRouterID:  routerID,
+			DnsProviderID: providerID,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	link(t, router.ID, "cloudflare")
+
+	snapshot, err := TakeSnapshot(ctx, conn.Q, profile.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{"http.web": {"cloudflare"}}
+	if !reflect.DeepEqual(snapshot.DNSProviders, want) {
+		t.Fatalf("links = %v, want %v", snapshot.DNSProviders, want)
+	}
+
+	tests := []struct {
+		name   string
+		change func(t *testing.T)
+	}{
+		{name: "link added", change: func(t *testing.T) { link(t, router.ID, "powerdns") }},
+		{name: "router deleted", change: func(t *testing.T) {
+			if err := conn.Q.DeleteHttpRouter(ctx, router.ID); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.change(t)
+			if err := RestoreSnapshot(ctx, conn.Q, profile.ID, snapshot); err != nil {
+				t.Fatal(err)
+			}
+			restored, err := TakeSnapshot(ctx, conn.Q, profile.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(restored.DNSProviders, want) {
+				t.Errorf("links = %v, want %v", restored.DNSProviders, want)
+			}
+			router, err = conn.Q.GetHttpRouterByName(ctx, &db.GetHttpRouterByNameParams{
+				ProfileID: profile.ID,
+				Name:      "web",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package traefik
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/traefik/templates"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"gopkg.in/yaml.v3"
+)
+
+// Template is a parameterized fragment of a dynamic configuration: a Go
+// text/template rendering to YAML, with its variables as data.
+type Template struct {
+	Name        string
+	Description string
+	Variables   []db.TemplateVariable
+	Content     string
+	Builtin     bool `yaml:"-"`
+}
+
+var variableName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// templateFuncs are the functions available to templates besides the builtins
+// of text/template.
+var templateFuncs = template.FuncMap{
+	// quote renders a value as a double-quoted YAML string
+	"quote":    strconv.Quote,
+	"hostPort": net.JoinHostPort,
+}
+
+// BuiltinTemplates returns the templates shipped with Mantrae, sorted by name.
+var BuiltinTemplates = sync.OnceValues(func() ([]Template, error) {
+	files, err := fs.Glob(templates.TemplateFS, "*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Template, 0, len(files))
+	for _, file := range files {
+		data, err := templates.TemplateFS.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		t := Template{Builtin: true}
+		if err = yaml.Unmarshal(data, &t); err != nil {
+			return nil, fmt.Errorf("invalid template %s: %w", file, err)
+		}
+		if err = t.Check(); err != nil {
+			return nil, fmt.Errorf("invalid template %s: %w", file, err)
+		}
+		result = append(result, t)
+	}
+	slices.SortFunc(result, func(a, b Template) int { return strings.Compare(a.Name, b.Name) })
+	return result, nil
+})
+
+// BuiltinTemplate returns the built-in template with the given name.
+func BuiltinTemplate(name string) (*Template, bool) {
+	builtins, err := BuiltinTemplates()
+	if err != nil {
+		return nil, false
+	}
+	for _, t := range builtins {
+		if t.Name == name {
+			return &t, true
+		}
+	}
+	return nil, false
+}
+
+// Check reports invalid variables and template syntax errors.
+func (t *Template) Check() error {
+	seen := make(map[string]bool, len(t.Variables))
+	for _, v := range t.Variables {
+		if !variableName.MatchString(v.Name) {
+			return fmt.Errorf("invalid variable name %q", v.Name)
+		}
+		if seen[v.Name] {
+			return fmt.Errorf("duplicate variable %q", v.Name)
+		}
+		seen[v.Name] = true
+	}
+	_, err := t.parse()
+	return err
+}
+
+func (t *Template) parse() (*template.Template, error) {
+	return template.New(t.Name).
+		Option("missingkey=error").
+		Funcs(templateFuncs).
+		Parse(t.Content)
+}
+
+// Render executes the template with the given values, falling back to the
+// defaults of the variables, and decodes the result. Rules Traefik would
+// reject fail the rendering.
+func (t *Template) Render(values map[string]string) (string, *dynamic.Configuration, error) {
+	data := make(map[string]string, len(t.Variables))
+	for _, v := range t.Variables {
+		value, ok := values[v.Name]
+		if !ok || value == "" {
+			value = v.Default
+		}
+		if v.Required && value == "" {
+			return "", nil, fmt.Errorf("variable %q is required", v.Name)
+		}
+		// A line break would let the value change the structure of the YAML
+		if strings.ContainsAny(value, "\r\n") {
+			return "", nil, fmt.Errorf("variable %q must be a single line", v.Name)
+		}
+		data[v.Name] = value
+	}
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		if _, ok := data[name]; !ok {
+			return "", nil, fmt.Errorf("unknown variable %q", name)
+		}
+	}
+
+	tmpl, err := t.parse()
+	if err != nil {
+		return "", nil, err
+	}
+	var out bytes.Buffer
+	if err = tmpl.Execute(&out, data); err != nil {
+		return "", nil, err
+	}
+	cfg, _, err := DecodeImport(FormatDynamic, out.Bytes())
+	if err != nil {
+		return "", nil, err
+	}
+
+	var errs []error
+	for _, issue := range LintRules(cfg) {
+		if issue.Severity == SeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", issue.Path, issue.Message))
+		}
+	}
+	if err = errors.Join(errs...); err != nil {
+		return "", nil, err
+	}
+	return out.String(), cfg, nil
+}
@@ -0,0 +1,37 @@
+package traefik
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/BurntSushi/toml"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// EncodeTOML serializes the dynamic configuration as TOML.
+//
+// The TOML encoder's omitempty doesn't cover numbers and durations, so encoding
+// the Traefik structs directly would write out zero values (e.g. `timeout =
+// "0s"`) that override Traefik's defaults. Going through the JSON
+// representation keeps exactly the fields the JSON/YAML output contains.
+func EncodeTOML(cfg *dynamic.Configuration) ([]byte, error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var tree map[string]any
+	if err = dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := toml.NewEncoder(&buf)
+	enc.Indent = "  "
+	if err = enc.Encode(tree); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
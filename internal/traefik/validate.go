@@ -0,0 +1,339 @@
+package traefik
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// Severity tells whether an issue breaks the configuration or is only suspect.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a problem found while validating a configuration.
+type Issue struct {
+	Severity  Severity
+	Path      string // item with the problem, e.g. "http.routers.web"
+	Reference string // item it refers to, e.g. "http.services.api"
+	Message   string
+}
+
+// References holds what a configuration may refer to besides its own items.
+type References struct {
+	EntryPoints []string
+	Disabled    map[string]bool // e.g. "http.services.api"
+}
+
+// ValidateProfile checks the references of the profile's built configuration
+// against its entry points, disabled items and variables, and lints its router
+// rules.
+func ValidateProfile(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+	cfg *dynamic.Configuration,
+) ([]Issue, error) {
+	refs := References{Disabled: make(map[string]bool)}
+
+	entryPoints, err := q.ListEntryPoints(ctx, &db.ListEntryPointsParams{ProfileID: profileID})
+	if err != nil {
+		return nil, err
+	}
+	for _, ep := range entryPoints {
+		refs.EntryPoints = append(refs.EntryPoints, ep.Name)
+	}
+
+	httpServices, err := q.ListHttpServices(
+		ctx,
+		&db.ListHttpServicesParams{ProfileID: profileID},
+	)
+	if err != nil {
+		return nil, err
+	}
+	addDisabled(refs.Disabled, "http.services", httpServices,
+		func(r *db.HttpService) (string, bool) { return r.Name, r.Enabled })
+	tcpServices, err := q.ListTcpServices(ctx, &db.ListTcpServicesParams{ProfileID: profileID})
+	if err != nil {
+		return nil, err
+	}
+	addDisabled(refs.Disabled, "tcp.services", tcpServices,
+		func(r *db.TcpService) (string, bool) { return r.Name, r.Enabled })
+	udpServices, err := q.ListUdpServices(ctx, &db.ListUdpServicesParams{ProfileID: profileID})
+	if err != nil {
+		return nil, err
+	}
+	addDisabled(refs.Disabled, "udp.services", udpServices,
+		func(r *db.UdpService) (string, bool) { return r.Name, r.Enabled })
+
+	httpMiddlewares, err := q.ListHttpMiddlewares(
+		ctx,
+		&db.ListHttpMiddlewaresParams{ProfileID: profileID},
+	)
+	if err != nil {
+		return nil, err
+	}
+	addDisabled(refs.Disabled, "http.middlewares", httpMiddlewares,
+		func(r *db.HttpMiddleware) (string, bool) { return r.Name, r.Enabled })
+	tcpMiddlewares, err := q.ListTcpMiddlewares(
+		ctx,
+		&db.ListTcpMiddlewaresParams{ProfileID: profileID},
+	)
+	if err != nil {
+		return nil, err
+	}
+	addDisabled(refs.Disabled, "tcp.middlewares", tcpMiddlewares,
+		func(r *db.TcpMiddleware) (string, bool) { return r.Name, r.Enabled })
+
+	httpTransports, err := q.ListHttpServersTransports(
+		ctx,
+		&db.ListHttpServersTransportsParams{ProfileID: profileID},
+	)
+	if err != nil {
+		return nil, err
+	}
+	addDisabled(refs.Disabled, "http.serversTransports", httpTransports,
+		func(r *db.HttpServersTransport) (string, bool) { return r.Name, r.Enabled })
+	tcpTransports, err := q.ListTcpServersTransports(
+		ctx,
+		&db.ListTcpServersTransportsParams{ProfileID: profileID},
+	)
+	if err != nil {
+		return nil, err
+	}
+	addDisabled(refs.Disabled, "tcp.serversTransports", tcpTransports,
+		func(r *db.TcpServersTransport) (string, bool) { return r.Name, r.Enabled })
+
+	return slices.Concat(ValidateConfig(cfg, refs), LintRules(cfg), CheckVariables(cfg)), nil
+}
+
+func addDisabled[T any](
+	disabled map[string]bool,
+	kind string,
+	rows []T,
+	get func(T) (string, bool),
+) {
+	for _, row := range rows {
+		if name, enabled := get(row); !enabled {
+			disabled[kind+"."+name] = true
+		}
+	}
+}
+
+// ValidateConfig checks that routers, services and middlewares only refer to
+// items that exist. References to other providers (e.g. "api@internal") are
+// not checked.
+func ValidateConfig(cfg *dynamic.Configuration, refs References) []Issue {
+	if cfg == nil {
+		return nil
+	}
+	v := &validator{refs: refs, defined: make(map[string]bool)}
+
+	if cfg.HTTP != nil {
+		define(v, "http.services", cfg.HTTP.Services)
+		define(v, "http.middlewares", cfg.HTTP.Middlewares)
+		define(v, "http.serversTransports", cfg.HTTP.ServersTransports)
+	}
+	if cfg.TCP != nil {
+		define(v, "tcp.services", cfg.TCP.Services)
+		define(v, "tcp.middlewares", cfg.TCP.Middlewares)
+		define(v, "tcp.serversTransports", cfg.TCP.ServersTransports)
+	}
+	if cfg.UDP != nil {
+		define(v, "udp.services", cfg.UDP.Services)
+	}
+	if cfg.TLS != nil {
+		define(v, "tls.options", cfg.TLS.Options)
+	}
+
+	if cfg.HTTP != nil {
+		for _, name := range slices.Sorted(maps.Keys(cfg.HTTP.Routers)) {
+			r := cfg.HTTP.Routers[name]
+			path := "http.routers." + name
+			v.service(path, "http.services", r.Service)
+			v.refList(path, "http.middlewares", r.Middlewares)
+			v.entryPoints(path, r.EntryPoints)
+			if r.TLS != nil {
+				v.tlsOptions(path, r.TLS.Options)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(cfg.HTTP.Services)) {
+			s := cfg.HTTP.Services[name]
+			path := "http.services." + name
+			if s.LoadBalancer != nil && s.LoadBalancer.ServersTransport != "" {
+				v.ref(path, "http.serversTransports", s.LoadBalancer.ServersTransport)
+			}
+			if s.Weighted != nil {
+				for _, child := range s.Weighted.Services {
+					v.ref(path, "http.services", child.Name)
+				}
+			}
+			if s.Mirroring != nil {
+				v.ref(path, "http.services", s.Mirroring.Service)
+				for _, mirror := range s.Mirroring.Mirrors {
+					v.ref(path, "http.services", mirror.Name)
+				}
+			}
+			if s.Failover != nil {
+				v.ref(path, "http.services", s.Failover.Service)
+				v.ref(path, "http.services", s.Failover.Fallback)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(cfg.HTTP.Middlewares)) {
+			m := cfg.HTTP.Middlewares[name]
+			path := "http.middlewares." + name
+			if m.Chain != nil {
+				v.refList(path, "http.middlewares", m.Chain.Middlewares)
+			}
+			if m.Errors != nil {
+				v.ref(path, "http.services", m.Errors.Service)
+			}
+		}
+	}
+
+	if cfg.TCP != nil {
+		for _, name := range slices.Sorted(maps.Keys(cfg.TCP.Routers)) {
+			r := cfg.TCP.Routers[name]
+			path := "tcp.routers." + name
+			v.service(path, "tcp.services", r.Service)
+			v.refList(path, "tcp.middlewares", r.Middlewares)
+			v.entryPoints(path, r.EntryPoints)
+			if r.TLS != nil {
+				v.tlsOptions(path, r.TLS.Options)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(cfg.TCP.Services)) {
+			s := cfg.TCP.Services[name]
+			path := "tcp.services." + name
+			if s.LoadBalancer != nil && s.LoadBalancer.ServersTransport != "" {
+				v.ref(path, "tcp.serversTransports", s.LoadBalancer.ServersTransport)
+			}
+			if s.Weighted != nil {
+				for _, child := range s.Weighted.Services {
+					v.ref(path, "tcp.services", child.Name)
+				}
+			}
+		}
+	}
+
+	if cfg.UDP != nil {
+		for _, name := range slices.Sorted(maps.Keys(cfg.UDP.Routers)) {
+			r := cfg.UDP.Routers[name]
+			path := "udp.routers." + name
+			v.service(path, "udp.services", r.Service)
+			v.entryPoints(path, r.EntryPoints)
+		}
+		for _, name := range slices.Sorted(maps.Keys(cfg.UDP.Services)) {
+			s := cfg.UDP.Services[name]
+			if s.Weighted != nil {
+				for _, child := range s.Weighted.Services {
+					v.ref("udp.services."+name, "udp.services", child.Name)
+				}
+			}
+		}
+	}
+
+	return v.issues
+}
+
+type validator struct {
+	refs    References
+	defined map[string]bool // e.g. "http.services.api"
+	issues  []Issue
+}
+
+func define[T any](v *validator, kind string, items map[string]T) {
+	for name := range items {
+		v.defined[kind+"."+name] = true
+	}
+}
+
+func (v *validator) add(severity Severity, path, reference, format string, args ...any) {
+	v.issues = append(v.issues, Issue{
+		Severity:  severity,
+		Path:      path,
+		Reference: reference,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+// service checks the service of a router, which is mandatory.
+func (v *validator) service(path, kind, name string) {
+	if name == "" {
+		v.add(SeverityError, path, "", "no service set")
+		return
+	}
+	v.ref(path, kind, name)
+}
+
+func (v *validator) refList(path, kind string, names []string) {
+	for _, name := range names {
+		v.ref(path, kind, name)
+	}
+}
+
+func (v *validator) ref(path, kind, name string) {
+	local, ok := localName(name)
+	if !ok {
+		return
+	}
+	target := kind + "." + local
+	switch {
+	case local == "":
+		v.add(SeverityError, path, "", "empty %s reference", describeKind(kind))
+	case v.defined[target]:
+	case v.refs.Disabled[target]:
+		v.add(SeverityError, path, target, "%s %q is disabled", describeKind(kind), local)
+	default:
+		v.add(SeverityError, path, target, "%s %q does not exist", describeKind(kind), local)
+	}
+}
+
+func (v *validator) entryPoints(path string, names []string) {
+	for _, name := range names {
+		if !slices.Contains(v.refs.EntryPoints, name) {
+			v.add(SeverityWarning, path, "", "entry point %q is not defined in this profile", name)
+		}
+	}
+}
+
+func (v *validator) tlsOptions(path, name string) {
+	local, ok := localName(name)
+	if !ok || local == "" || local == "default" {
+		return
+	}
+	if !v.defined["tls.options."+local] {
+		v.add(SeverityError, path, "", "TLS options %q do not exist", local)
+	}
+}
+
+// localName strips the provider from a reference. It reports false for
+// references to another provider than the HTTP provider serving this config.
+func localName(name string) (string, bool) {
+	base, provider, found := strings.Cut(name, "@")
+	if found && provider != "http" {
+		return "", false
+	}
+	return base, true
+}
+
+func describeKind(kind string) string {
+	switch kind[strings.IndexByte(kind, '.')+1:] {
+	case "services":
+		return "service"
+	case "middlewares":
+		return "middleware"
+	case "serversTransports":
+		return "servers transport"
+	default:
+		return kind
+	}
+}
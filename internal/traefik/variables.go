@@ -0,0 +1,134 @@
+package traefik
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/mizuchilabs/mantrae/internal/util"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// ProfileVariables returns the variables of a profile by name.
+func ProfileVariables(
+	ctx context.Context,
+	q *db.Queries,
+	profileID int64,
+) (map[string]string, error) {
+	rows, err := q.ListProfileVariables(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	vars := make(map[string]string, len(rows))
+	for _, row := range rows {
+		vars[row.Name] = row.Value
+	}
+	return vars, nil
+}
+
+// ExpandConfig replaces ${NAME} references in the string fields of routers,
+// services and middlewares (e.g. rules, server URLs and header values) with
+// the profile variables. Unknown references are left in place, see
+// CheckVariables.
+func ExpandConfig(cfg *dynamic.Configuration, vars map[string]string) {
+	if cfg == nil || len(vars) == 0 {
+		return
+	}
+	if cfg.HTTP != nil {
+		expandItems(cfg.HTTP.Routers, vars)
+		expandItems(cfg.HTTP.Services, vars)
+		expandItems(cfg.HTTP.Middlewares, vars)
+	}
+	if cfg.TCP != nil {
+		expandItems(cfg.TCP.Routers, vars)
+		expandItems(cfg.TCP.Services, vars)
+		expandItems(cfg.TCP.Middlewares, vars)
+	}
+	if cfg.UDP != nil {
+		expandItems(cfg.UDP.Routers, vars)
+		expandItems(cfg.UDP.Services, vars)
+	}
+}
+
+func expandItems[T any](items map[string]*T, vars map[string]string) {
+	for name, item := range items {
+		items[name] = ExpandItem(item, vars)
+	}
+}
+
+// ExpandItem returns a router, service or middleware with the references in
+// its string fields expanded. It works on the JSON form of the item, so every
+// field is covered without walking the Traefik types.
+func ExpandItem[T any](item *T, vars map[string]string) *T {
+	if item == nil || len(vars) == 0 {
+		return item
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return item
+	}
+	expanded := util.ExpandVariables(string(data), jsonEscaped(vars))
+	if expanded == string(data) {
+		return item
+	}
+	result := new(T)
+	if err = json.Unmarshal([]byte(expanded), result); err != nil {
+		return item
+	}
+	return result
+}
+
+// jsonEscaped escapes the values to be placed inside JSON strings.
+func jsonEscaped(vars map[string]string) map[string]string {
+	escaped := make(map[string]string, len(vars))
+	for name, value := range vars {
+		quoted, _ := json.Marshal(value)
+		escaped[name] = string(quoted[1 : len(quoted)-1])
+	}
+	return escaped
+}
+
+// CheckVariables reports references to undefined variables left in an
+// expanded configuration.
+func CheckVariables(cfg *dynamic.Configuration) []Issue {
+	if cfg == nil {
+		return nil
+	}
+	var issues []Issue
+	if cfg.HTTP != nil {
+		issues = append(issues, checkItems("http.routers", cfg.HTTP.Routers)...)
+		issues = append(issues, checkItems("http.services", cfg.HTTP.Services)...)
+		issues = append(issues, checkItems("http.middlewares", cfg.HTTP.Middlewares)...)
+	}
+	if cfg.TCP != nil {
+		issues = append(issues, checkItems("tcp.routers", cfg.TCP.Routers)...)
+		issues = append(issues, checkItems("tcp.services", cfg.TCP.Services)...)
+		issues = append(issues, checkItems("tcp.middlewares", cfg.TCP.Middlewares)...)
+	}
+	if cfg.UDP != nil {
+		issues = append(issues, checkItems("udp.routers", cfg.UDP.Routers)...)
+		issues = append(issues, checkItems("udp.services", cfg.UDP.Services)...)
+	}
+	return issues
+}
+
+func checkItems[T any](kind string, items map[string]*T) []Issue {
+	var issues []Issue
+	for _, name := range slices.Sorted(maps.Keys(items)) {
+		data, err := json.Marshal(items[name])
+		if err != nil {
+			continue
+		}
+		for _, ref := range util.VariableRefs(string(data)) {
+			issues = append(issues, Issue{
+				Severity: SeverityError,
+				Path:     kind + "." + name,
+				Message:  fmt.Sprintf("variable %q is not defined in this profile", ref),
+			})
+		}
+	}
+	return issues
+}
@@ -0,0 +1,132 @@
+package traefik
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mizuchilabs/mantrae/internal/store"
+	"github.com/mizuchilabs/mantrae/internal/store/db"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestBuildDynamicConfigVariables(t *testing.T) {
+	undefined := func(name string) Issue {
+		return Issue{
+			Severity: SeverityError,
+			Path:     "http.routers.web",
+			Message:  `variable "` + name + `" is not defined in this profile`,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		vars   map[string]string
+		rule   string
+		header string // value of a request header set by a middleware
+		want   string // expanded rule
+		wantH  string // expanded header
+		issues []Issue
+	}{
+		{
+			name:   "expanded",
+			vars:   map[string]string{"HOST": "example.com", "TOKEN": "abc"},
+			rule:   "Host(`${HOST}`) || Host(`www.${HOST}`)",
+			header: "Bearer ${TOKEN}",
+			want:   "Host(`example.com`) || Host(`www.example.com`)",
+			wantH:  "Bearer abc",
+		},
+		{
+			name:   "escaped values",
+			vars:   map[string]string{"HOST": "example.com", "TOKEN": `a"b\c` + "\n<&>"},
+			rule:   "Host(`${HOST}`)",
+			header: "${TOKEN}",
+			want:   "Host(`example.com`)",
+			wantH:  `a"b\c` + "\n<&>",
+		},
+		{
+			name:   "not references",
+			vars:   map[string]string{"HOST": "example.com"},
+			rule:   "Host(`$HOST`) || Host(`${1HOST}`) || Host(`${HOST`)",
+			header: "$${HOST",
+			want:   "Host(`$HOST`) || Host(`${1HOST}`) || Host(`${HOST`)",
+			wantH:  "$${HOST",
+		},
+		{
+			name:   "undefined",
+			vars:   map[string]string{"HOST": "example.com"},
+			rule:   "Host(`${HOST}`) || Host(`${ALIAS}`) || Host(`${ALIAS}.${DOMAIN}`)",
+			header: "plain",
+			want:   "Host(`example.com`) || Host(`${ALIAS}`) || Host(`${ALIAS}.${DOMAIN}`)",
+			wantH:  "plain",
+			issues: []Issue{undefined("ALIAS"), undefined("DOMAIN")},
+		},
+		{
+			name:   "no variables",
+			rule:   "Host(`${HOST}`)",
+			header: "plain",
+			want:   "Host(`${HOST}`)",
+			wantH:  "plain",
+			issues: []Issue{undefined("HOST")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			conn := store.NewConnection(ctx, "file:"+filepath.Join(t.TempDir(), "mantrae.db"))
+			profile, err := conn.Q.CreateProfile(ctx, &db.CreateProfileParams{Name: "p", Token: "p"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, value := range tt.vars {
+				if _, err = conn.Q.CreateProfileVariable(ctx, &db.CreateProfileVariableParams{
+					ID:        name,
+					ProfileID: profile.ID,
+					Name:      name,
+					Value:     value,
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err = conn.Q.CreateHttpRouter(ctx, &db.CreateHttpRouterParams{
+				ID:        "web",
+				ProfileID: profile.ID,
+				Name:      "web",
+				Config: &db.RouterConfig{Data: &dynamic.Router{
+					Rule:        tt.rule,
+					Middlewares: []string{"auth"},
+				}},
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err = conn.Q.CreateHttpMiddleware(ctx, &db.CreateHttpMiddlewareParams{
+				ID:        "auth",
+				ProfileID: profile.ID,
+				Name:      "auth",
+				Config: &db.MiddlewareConfig{Data: &dynamic.Middleware{
+					Headers: &dynamic.Headers{
+						CustomRequestHeaders: map[string]string{"Authorization": tt.header},
+					},
+				}},
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := BuildDynamicConfig(ctx, conn.Q, *profile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := cfg.HTTP.Routers["web"].Rule; got != tt.want {
+				t.Errorf("rule = %q, want %q", got, tt.want)
+			}
+			header := cfg.HTTP.Middlewares["auth"].Headers.CustomRequestHeaders["Authorization"]
+			if header != tt.wantH {
+				t.Errorf("header = %q, want %q", header, tt.wantH)
+			}
+			if issues := CheckVariables(cfg); !reflect.DeepEqual(issues, tt.issues) {
+				t.Errorf("issues = %+v, want %+v", issues, tt.issues)
+			}
+		})
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base32"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"math/big"
@@ -65,6 +67,13 @@ func GenerateToken(length int) string {
 	return strings.ToLower(strings.TrimRight(token, "="))
 }
 
+// HashToken hashes a random, high entropy token for lookups. Unlike passwords
+// these tokens don't need a slow hash.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 func GenerateAgentToken(profileID, agentID string) string {
 	return fmt.Sprintf("%s.%s.%s", profileID, agentID, GenerateToken(8))
 }
@@ -229,6 +229,19 @@ func OriginOnly(urlStr string) string {
 	return strings.TrimSuffix(u.String(), "/")
 }
 
+// ClientIP returns the address of the client sending a request, preferring
+// the first address in X-Forwarded-For when behind a proxy.
+func ClientIP(header http.Header, remoteAddr string) string {
+	if forwarded := header.Get("X-Forwarded-For"); forwarded != "" {
+		ip, _, _ := strings.Cut(forwarded, ",")
+		return strings.TrimSpace(ip)
+	}
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+	return remoteAddr
+}
+
 func IsValidIPv4(ip string) bool {
 	parsedIP := net.ParseIP(ip)
 	if parsedIP == nil {
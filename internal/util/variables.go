@@ -0,0 +1,37 @@
+package util
+
+import (
+	"regexp"
+)
+
+var (
+	// VariableName matches the name of a profile variable
+	VariableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	variableRef = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+)
+
+// ExpandVariables replaces ${NAME} references with the values of the
+// variables. References to unknown variables are left in place.
+func ExpandVariables(s string, vars map[string]string) string {
+	return variableRef.ReplaceAllStringFunc(s, func(ref string) string {
+		if value, ok := vars[ref[2:len(ref)-1]]; ok {
+			return value
+		}
+		return ref
+	})
+}
+
+// VariableRefs returns the names of the variables referenced in s, in order
+// of appearance and without duplicates.
+func VariableRefs(s string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, match := range variableRef.FindAllStringSubmatch(s, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
@@ -67,6 +67,41 @@ to specify a different username.`,
 					return nil
 				},
 			},
+			{
+				Name:  "export",
+				Usage: "Export a profile as Kubernetes CRDs",
+				Description: `Convert the dynamic configuration of a profile into Traefik
+Kubernetes CRD manifests (IngressRoute, Middleware, TraefikService, ...).
+Servers are exported as ExternalName services, so the Traefik CRD provider
+needs allowExternalNameServices enabled.`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "profile",
+						Usage: "Name of the profile to export",
+						Value: "default",
+					},
+					&cli.StringFlag{
+						Name:    "namespace",
+						Aliases: []string{"n"},
+						Usage:   "Kubernetes namespace of the generated resources",
+						Value:   "default",
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "File to write the manifests to (default: stdout)",
+					},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					app, err := config.New(ctx, cmd)
+					if err != nil {
+						slog.Error("Setup failed", "error", err)
+						return err
+					}
+
+					return app.ExportKubernetes(ctx, cmd)
+				},
+			},
 		},
 		Flags: []cli.Flag{
 			&cli.BoolFlag{
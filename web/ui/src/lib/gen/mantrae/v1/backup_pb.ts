@@ -2,18 +2,20 @@
 // @generated from file mantrae/v1/backup.proto (package mantrae.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { ProtocolType } from "./protocol_pb";
+import { file_mantrae_v1_protocol } from "./protocol_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file mantrae/v1/backup.proto.
  */
 export const file_mantrae_v1_backup: GenFile = /*@__PURE__*/
-  fileDesc("ChdtYW50cmFlL3YxL2JhY2t1cC5wcm90bxIKbWFudHJhZS52MSJUCgZCYWNrdXASDAoEbmFtZRgBIAEoCRIMCgRzaXplGAIgASgDEi4KCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIhUKE0NyZWF0ZUJhY2t1cFJlcXVlc3QiFgoUQ3JlYXRlQmFja3VwUmVzcG9uc2UiQQoUUmVzdG9yZUJhY2t1cFJlcXVlc3QSFQoEbmFtZRgBIAEoCUIHukgEcgIQARISCgpwcm9maWxlX2lkGAIgASgDIhcKFVJlc3RvcmVCYWNrdXBSZXNwb25zZSIUChJMaXN0QmFja3Vwc1JlcXVlc3QiOgoTTGlzdEJhY2t1cHNSZXNwb25zZRIjCgdiYWNrdXBzGAEgAygLMhIubWFudHJhZS52MS5CYWNrdXAiLAoTRGVsZXRlQmFja3VwUmVxdWVzdBIVCgRuYW1lGAEgASgJQge6SARyAhABIhYKFERlbGV0ZUJhY2t1cFJlc3BvbnNlMuACCg1CYWNrdXBTZXJ2aWNlElEKDENyZWF0ZUJhY2t1cBIfLm1hbnRyYWUudjEuQ3JlYXRlQmFja3VwUmVxdWVzdBogLm1hbnRyYWUudjEuQ3JlYXRlQmFja3VwUmVzcG9uc2USVAoNUmVzdG9yZUJhY2t1cBIgLm1hbnRyYWUudjEuUmVzdG9yZUJhY2t1cFJlcXVlc3QaIS5tYW50cmFlLnYxLlJlc3RvcmVCYWNrdXBSZXNwb25zZRJTCgtMaXN0QmFja3VwcxIeLm1hbnRyYWUudjEuTGlzdEJhY2t1cHNSZXF1ZXN0Gh8ubWFudHJhZS52MS5MaXN0QmFja3Vwc1Jlc3BvbnNlIgOQAgESUQoMRGVsZXRlQmFja3VwEh8ubWFudHJhZS52MS5EZWxldGVCYWNrdXBSZXF1ZXN0GiAubWFudHJhZS52MS5EZWxldGVCYWNrdXBSZXNwb25zZUKoAQoOY29tLm1hbnRyYWUudjFCC0JhY2t1cFByb3RvUAFaQGdpdGh1Yi5jb20vbWl6dWNoaWxhYnMvbWFudHJhZS9pbnRlcm5hbC9nZW4vbWFudHJhZS92MTttYW50cmFldjGiAgNNWFiqAgpNYW50cmFlLlYxygIKTWFudHJhZVxWMeICFk1hbnRyYWVcVjFcR1BCTWV0YWRhdGHqAgtNYW50cmFlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+  fileDesc("ChdtYW50cmFlL3YxL2JhY2t1cC5wcm90bxIKbWFudHJhZS52MSJUCgZCYWNrdXASDAoEbmFtZRgBIAEoCRIMCgRzaXplGAIgASgDEi4KCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIhUKE0NyZWF0ZUJhY2t1cFJlcXVlc3QiFgoUQ3JlYXRlQmFja3VwUmVzcG9uc2UibwoUUmVzdG9yZUJhY2t1cFJlcXVlc3QSFQoEbmFtZRgBIAEoCUIHukgEcgIQARISCgpwcm9maWxlX2lkGAIgASgDEiwKCHN0cmF0ZWd5GAMgASgOMhoubWFudHJhZS52MS5JbXBvcnRTdHJhdGVneSI+ChVSZXN0b3JlQmFja3VwUmVzcG9uc2USJQoFaXRlbXMYASADKAsyFi5tYW50cmFlLnYxLkltcG9ydEl0ZW0iFAoSTGlzdEJhY2t1cHNSZXF1ZXN0IjoKE0xpc3RCYWNrdXBzUmVzcG9uc2USIwoHYmFja3VwcxgBIAMoCzISLm1hbnRyYWUudjEuQmFja3VwIiwKE0RlbGV0ZUJhY2t1cFJlcXVlc3QSFQoEbmFtZRgBIAEoCUIHukgEcgIQASIWChREZWxldGVCYWNrdXBSZXNwb25zZSJ7ChRJbXBvcnRDb21wb3NlUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAEhgKB2NvbnRlbnQYAiABKAlCB7pIBHICEAESLAoIc3RyYXRlZ3kYAyABKA4yGi5tYW50cmFlLnYxLkltcG9ydFN0cmF0ZWd5IlAKFUltcG9ydENvbXBvc2VSZXNwb25zZRIQCgh3YXJuaW5ncxgBIAMoCRIlCgVpdGVtcxgCIAMoCzIWLm1hbnRyYWUudjEuSW1wb3J0SXRlbSKQAQoKSW1wb3J0SXRlbRIqCghwcm90b2NvbBgBIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlEgwKBHR5cGUYAiABKAkSDAoEbmFtZRgDIAEoCRIoCgZzdGF0dXMYBCABKA4yGC5tYW50cmFlLnYxLkltcG9ydFN0YXR1cxIQCghuZXdfbmFtZRgFIAEoCSJ0ChFQbGFuSW1wb3J0UmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAEhgKB2NvbnRlbnQYAiABKAlCB7pIBHICEAESKAoGZm9ybWF0GAMgASgOMhgubWFudHJhZS52MS5JbXBvcnRGb3JtYXQiTQoSUGxhbkltcG9ydFJlc3BvbnNlEiUKBWl0ZW1zGAEgAygLMhYubWFudHJhZS52MS5JbXBvcnRJdGVtEhAKCHdhcm5pbmdzGAIgAygJIqMBChJBcHBseUltcG9ydFJlcXVlc3QSGwoKcHJvZmlsZV9pZBgBIAEoA0IHukgEIgIgABIYCgdjb250ZW50GAIgASgJQge6SARyAhABEigKBmZvcm1hdBgDIAEoDjIYLm1hbnRyYWUudjEuSW1wb3J0Rm9ybWF0EiwKCHN0cmF0ZWd5GAQgASgOMhoubWFudHJhZS52MS5JbXBvcnRTdHJhdGVneSJOChNBcHBseUltcG9ydFJlc3BvbnNlEiUKBWl0ZW1zGAEgAygLMhYubWFudHJhZS52MS5JbXBvcnRJdGVtEhAKCHdhcm5pbmdzGAIgAygJKoEBCgxJbXBvcnRGb3JtYXQSHQoZSU1QT1JUX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhkKFUlNUE9SVF9GT1JNQVRfRFlOQU1JQxABEhwKGElNUE9SVF9GT1JNQVRfS1VCRVJORVRFUxACEhkKFUlNUE9SVF9GT1JNQVRfQ09NUE9TRRADKoYBCg5JbXBvcnRTdHJhdGVneRIfChtJTVBPUlRfU1RSQVRFR1lfVU5TUEVDSUZJRUQQABIYChRJTVBPUlRfU1RSQVRFR1lfU0tJUBABEh0KGUlNUE9SVF9TVFJBVEVHWV9PVkVSV1JJVEUQAhIaChZJTVBPUlRfU1RSQVRFR1lfUkVOQU1FEAMqfQoMSW1wb3J0U3RhdHVzEh0KGUlNUE9SVF9TVEFUVVNfVU5TUEVDSUZJRUQQABIVChFJTVBPUlRfU1RBVFVTX05FVxABEhsKF0lNUE9SVF9TVEFUVVNfSURFTlRJQ0FMEAISGgoWSU1QT1JUX1NUQVRVU19DT05GTElDVBADMtMECg1CYWNrdXBTZXJ2aWNlElEKDENyZWF0ZUJhY2t1cBIfLm1hbnRyYWUudjEuQ3JlYXRlQmFja3VwUmVxdWVzdBogLm1hbnRyYWUudjEuQ3JlYXRlQmFja3VwUmVzcG9uc2USVAoNUmVzdG9yZUJhY2t1cBIgLm1hbnRyYWUudjEuUmVzdG9yZUJhY2t1cFJlcXVlc3QaIS5tYW50cmFlLnYxLlJlc3RvcmVCYWNrdXBSZXNwb25zZRJTCgtMaXN0QmFja3VwcxIeLm1hbnRyYWUudjEuTGlzdEJhY2t1cHNSZXF1ZXN0Gh8ubWFudHJhZS52MS5MaXN0QmFja3Vwc1Jlc3BvbnNlIgOQAgESUQoMRGVsZXRlQmFja3VwEh8ubWFudHJhZS52MS5EZWxldGVCYWNrdXBSZXF1ZXN0GiAubWFudHJhZS52MS5EZWxldGVCYWNrdXBSZXNwb25zZRJUCg1JbXBvcnRDb21wb3NlEiAubWFudHJhZS52MS5JbXBvcnRDb21wb3NlUmVxdWVzdBohLm1hbnRyYWUudjEuSW1wb3J0Q29tcG9zZVJlc3BvbnNlEksKClBsYW5JbXBvcnQSHS5tYW50cmFlLnYxLlBsYW5JbXBvcnRSZXF1ZXN0Gh4ubWFudHJhZS52MS5QbGFuSW1wb3J0UmVzcG9uc2USTgoLQXBwbHlJbXBvcnQSHi5tYW50cmFlLnYxLkFwcGx5SW1wb3J0UmVxdWVzdBofLm1hbnRyYWUudjEuQXBwbHlJbXBvcnRSZXNwb25zZUKoAQoOY29tLm1hbnRyYWUudjFCC0JhY2t1cFByb3RvUAFaQGdpdGh1Yi5jb20vbWl6dWNoaWxhYnMvbWFudHJhZS9pbnRlcm5hbC9nZW4vbWFudHJhZS92MTttYW50cmFldjGiAgNNWFiqAgpNYW50cmFlLlYxygIKTWFudHJhZVxWMeICFk1hbnRyYWVcVjFcR1BCTWV0YWRhdGHqAgtNYW50cmFlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp, file_mantrae_v1_protocol]);
 
 /**
  * @generated from message mantrae.v1.Backup
@@ -81,6 +83,11 @@ export type RestoreBackupRequest = Message<"mantrae.v1.RestoreBackupRequest"> &
    * @generated from field: int64 profile_id = 2;
    */
   profileId: bigint;
+
+  /**
+   * @generated from field: mantrae.v1.ImportStrategy strategy = 3;
+   */
+  strategy: ImportStrategy;
 };
 
 /**
@@ -94,6 +101,10 @@ export const RestoreBackupRequestSchema: GenMessage<RestoreBackupRequest> = /*@_
  * @generated from message mantrae.v1.RestoreBackupResponse
  */
 export type RestoreBackupResponse = Message<"mantrae.v1.RestoreBackupResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ImportItem items = 1;
+   */
+  items: ImportItem[];
 };
 
 /**
@@ -163,6 +174,288 @@ export type DeleteBackupResponse = Message<"mantrae.v1.DeleteBackupResponse"> &
 export const DeleteBackupResponseSchema: GenMessage<DeleteBackupResponse> = /*@__PURE__*/
   messageDesc(file_mantrae_v1_backup, 8);
 
+/**
+ * @generated from message mantrae.v1.ImportComposeRequest
+ */
+export type ImportComposeRequest = Message<"mantrae.v1.ImportComposeRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * @generated from field: mantrae.v1.ImportStrategy strategy = 3;
+   */
+  strategy: ImportStrategy;
+};
+
+/**
+ * Describes the message mantrae.v1.ImportComposeRequest.
+ * Use `create(ImportComposeRequestSchema)` to create a new message.
+ */
+export const ImportComposeRequestSchema: GenMessage<ImportComposeRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_backup, 9);
+
+/**
+ * @generated from message mantrae.v1.ImportComposeResponse
+ */
+export type ImportComposeResponse = Message<"mantrae.v1.ImportComposeResponse"> & {
+  /**
+   * @generated from field: repeated string warnings = 1;
+   */
+  warnings: string[];
+
+  /**
+   * @generated from field: repeated mantrae.v1.ImportItem items = 2;
+   */
+  items: ImportItem[];
+};
+
+/**
+ * Describes the message mantrae.v1.ImportComposeResponse.
+ * Use `create(ImportComposeResponseSchema)` to create a new message.
+ */
+export const ImportComposeResponseSchema: GenMessage<ImportComposeResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_backup, 10);
+
+/**
+ * @generated from message mantrae.v1.ImportItem
+ */
+export type ImportItem = Message<"mantrae.v1.ImportItem"> & {
+  /**
+   * @generated from field: mantrae.v1.ProtocolType protocol = 1;
+   */
+  protocol: ProtocolType;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: mantrae.v1.ImportStatus status = 4;
+   */
+  status: ImportStatus;
+
+  /**
+   * @generated from field: string new_name = 5;
+   */
+  newName: string;
+};
+
+/**
+ * Describes the message mantrae.v1.ImportItem.
+ * Use `create(ImportItemSchema)` to create a new message.
+ */
+export const ImportItemSchema: GenMessage<ImportItem> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_backup, 11);
+
+/**
+ * @generated from message mantrae.v1.PlanImportRequest
+ */
+export type PlanImportRequest = Message<"mantrae.v1.PlanImportRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * @generated from field: mantrae.v1.ImportFormat format = 3;
+   */
+  format: ImportFormat;
+};
+
+/**
+ * Describes the message mantrae.v1.PlanImportRequest.
+ * Use `create(PlanImportRequestSchema)` to create a new message.
+ */
+export const PlanImportRequestSchema: GenMessage<PlanImportRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_backup, 12);
+
+/**
+ * @generated from message mantrae.v1.PlanImportResponse
+ */
+export type PlanImportResponse = Message<"mantrae.v1.PlanImportResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ImportItem items = 1;
+   */
+  items: ImportItem[];
+
+  /**
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[];
+};
+
+/**
+ * Describes the message mantrae.v1.PlanImportResponse.
+ * Use `create(PlanImportResponseSchema)` to create a new message.
+ */
+export const PlanImportResponseSchema: GenMessage<PlanImportResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_backup, 13);
+
+/**
+ * @generated from message mantrae.v1.ApplyImportRequest
+ */
+export type ApplyImportRequest = Message<"mantrae.v1.ApplyImportRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * @generated from field: mantrae.v1.ImportFormat format = 3;
+   */
+  format: ImportFormat;
+
+  /**
+   * @generated from field: mantrae.v1.ImportStrategy strategy = 4;
+   */
+  strategy: ImportStrategy;
+};
+
+/**
+ * Describes the message mantrae.v1.ApplyImportRequest.
+ * Use `create(ApplyImportRequestSchema)` to create a new message.
+ */
+export const ApplyImportRequestSchema: GenMessage<ApplyImportRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_backup, 14);
+
+/**
+ * @generated from message mantrae.v1.ApplyImportResponse
+ */
+export type ApplyImportResponse = Message<"mantrae.v1.ApplyImportResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ImportItem items = 1;
+   */
+  items: ImportItem[];
+
+  /**
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[];
+};
+
+/**
+ * Describes the message mantrae.v1.ApplyImportResponse.
+ * Use `create(ApplyImportResponseSchema)` to create a new message.
+ */
+export const ApplyImportResponseSchema: GenMessage<ApplyImportResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_backup, 15);
+
+/**
+ * @generated from enum mantrae.v1.ImportFormat
+ */
+export enum ImportFormat {
+  /**
+   * @generated from enum value: IMPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IMPORT_FORMAT_DYNAMIC = 1;
+   */
+  DYNAMIC = 1,
+
+  /**
+   * @generated from enum value: IMPORT_FORMAT_KUBERNETES = 2;
+   */
+  KUBERNETES = 2,
+
+  /**
+   * @generated from enum value: IMPORT_FORMAT_COMPOSE = 3;
+   */
+  COMPOSE = 3,
+}
+
+/**
+ * Describes the enum mantrae.v1.ImportFormat.
+ */
+export const ImportFormatSchema: GenEnum<ImportFormat> = /*@__PURE__*/
+  enumDesc(file_mantrae_v1_backup, 0);
+
+/**
+ * @generated from enum mantrae.v1.ImportStrategy
+ */
+export enum ImportStrategy {
+  /**
+   * @generated from enum value: IMPORT_STRATEGY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IMPORT_STRATEGY_SKIP = 1;
+   */
+  SKIP = 1,
+
+  /**
+   * @generated from enum value: IMPORT_STRATEGY_OVERWRITE = 2;
+   */
+  OVERWRITE = 2,
+
+  /**
+   * @generated from enum value: IMPORT_STRATEGY_RENAME = 3;
+   */
+  RENAME = 3,
+}
+
+/**
+ * Describes the enum mantrae.v1.ImportStrategy.
+ */
+export const ImportStrategySchema: GenEnum<ImportStrategy> = /*@__PURE__*/
+  enumDesc(file_mantrae_v1_backup, 1);
+
+/**
+ * @generated from enum mantrae.v1.ImportStatus
+ */
+export enum ImportStatus {
+  /**
+   * @generated from enum value: IMPORT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IMPORT_STATUS_NEW = 1;
+   */
+  NEW = 1,
+
+  /**
+   * @generated from enum value: IMPORT_STATUS_IDENTICAL = 2;
+   */
+  IDENTICAL = 2,
+
+  /**
+   * @generated from enum value: IMPORT_STATUS_CONFLICT = 3;
+   */
+  CONFLICT = 3,
+}
+
+/**
+ * Describes the enum mantrae.v1.ImportStatus.
+ */
+export const ImportStatusSchema: GenEnum<ImportStatus> = /*@__PURE__*/
+  enumDesc(file_mantrae_v1_backup, 2);
+
 /**
  * @generated from service mantrae.v1.BackupService
  */
@@ -202,6 +495,30 @@ export const BackupService: GenService<{
     input: typeof DeleteBackupRequestSchema;
     output: typeof DeleteBackupResponseSchema;
   },
+  /**
+   * @generated from rpc mantrae.v1.BackupService.ImportCompose
+   */
+  importCompose: {
+    methodKind: "unary";
+    input: typeof ImportComposeRequestSchema;
+    output: typeof ImportComposeResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.BackupService.PlanImport
+   */
+  planImport: {
+    methodKind: "unary";
+    input: typeof PlanImportRequestSchema;
+    output: typeof PlanImportResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.BackupService.ApplyImport
+   */
+  applyImport: {
+    methodKind: "unary";
+    input: typeof ApplyImportRequestSchema;
+    output: typeof ApplyImportResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mantrae_v1_backup, 0);
 
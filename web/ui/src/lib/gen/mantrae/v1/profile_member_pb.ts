@@ -0,0 +1,252 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file mantrae/v1/profile_member.proto (package mantrae.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Role } from "./user_pb";
+import { file_mantrae_v1_user } from "./user_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mantrae/v1/profile_member.proto.
+ */
+export const file_mantrae_v1_profile_member: GenFile = /*@__PURE__*/
+  fileDesc("Ch9tYW50cmFlL3YxL3Byb2ZpbGVfbWVtYmVyLnByb3RvEgptYW50cmFlLnYxIrQBCg1Qcm9maWxlTWVtYmVyEhIKCnByb2ZpbGVfaWQYASABKAMSDwoHdXNlcl9pZBgCIAEoCRIeCgRyb2xlGAMgASgOMhAubWFudHJhZS52MS5Sb2xlEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIn0KGkNyZWF0ZVByb2ZpbGVNZW1iZXJSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASGAoHdXNlcl9pZBgCIAEoCUIHukgEcgIQARIoCgRyb2xlGAMgASgOMhAubWFudHJhZS52MS5Sb2xlQgi6SAWCAQIQASJIChtDcmVhdGVQcm9maWxlTWVtYmVyUmVzcG9uc2USKQoGbWVtYmVyGAEgASgLMhkubWFudHJhZS52MS5Qcm9maWxlTWVtYmVyIn0KGlVwZGF0ZVByb2ZpbGVNZW1iZXJSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASGAoHdXNlcl9pZBgCIAEoCUIHukgEcgIQARIoCgRyb2xlGAMgASgOMhAubWFudHJhZS52MS5Sb2xlQgi6SAWCAQIQASJIChtVcGRhdGVQcm9maWxlTWVtYmVyUmVzcG9uc2USKQoGbWVtYmVyGAEgASgLMhkubWFudHJhZS52MS5Qcm9maWxlTWVtYmVyIlMKGkRlbGV0ZVByb2ZpbGVNZW1iZXJSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASGAoHdXNlcl9pZBgCIAEoCUIHukgEcgIQASIdChtEZWxldGVQcm9maWxlTWVtYmVyUmVzcG9uc2UiOAoZTGlzdFByb2ZpbGVNZW1iZXJzUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAIkgKGkxpc3RQcm9maWxlTWVtYmVyc1Jlc3BvbnNlEioKB21lbWJlcnMYASADKAsyGS5tYW50cmFlLnYxLlByb2ZpbGVNZW1iZXIyuAMKFFByb2ZpbGVNZW1iZXJTZXJ2aWNlEmYKE0NyZWF0ZVByb2ZpbGVNZW1iZXISJi5tYW50cmFlLnYxLkNyZWF0ZVByb2ZpbGVNZW1iZXJSZXF1ZXN0GicubWFudHJhZS52MS5DcmVhdGVQcm9maWxlTWVtYmVyUmVzcG9uc2USZgoTVXBkYXRlUHJvZmlsZU1lbWJlchImLm1hbnRyYWUudjEuVXBkYXRlUHJvZmlsZU1lbWJlclJlcXVlc3QaJy5tYW50cmFlLnYxLlVwZGF0ZVByb2ZpbGVNZW1iZXJSZXNwb25zZRJmChNEZWxldGVQcm9maWxlTWVtYmVyEiYubWFudHJhZS52MS5EZWxldGVQcm9maWxlTWVtYmVyUmVxdWVzdBonLm1hbnRyYWUudjEuRGVsZXRlUHJvZmlsZU1lbWJlclJlc3BvbnNlEmgKEkxpc3RQcm9maWxlTWVtYmVycxIlLm1hbnRyYWUudjEuTGlzdFByb2ZpbGVNZW1iZXJzUmVxdWVzdBomLm1hbnRyYWUudjEuTGlzdFByb2ZpbGVNZW1iZXJzUmVzcG9uc2UiA5ACAUKvAQoOY29tLm1hbnRyYWUudjFCElByb2ZpbGVNZW1iZXJQcm90b1ABWkBnaXRodWIuY29tL21penVjaGlsYWJzL21hbnRyYWUvaW50ZXJuYWwvZ2VuL21hbnRyYWUvdjE7bWFudHJhZXYxogIDTVhYqgIKTWFudHJhZS5WMcoCCk1hbnRyYWVcVjHiAhZNYW50cmFlXFYxXEdQQk1ldGFkYXRh6gILTWFudHJhZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp, file_mantrae_v1_user]);
+
+/**
+ * @generated from message mantrae.v1.ProfileMember
+ */
+export type ProfileMember = Message<"mantrae.v1.ProfileMember"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: mantrae.v1.Role role = 3;
+   */
+  role: Role;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mantrae.v1.ProfileMember.
+ * Use `create(ProfileMemberSchema)` to create a new message.
+ */
+export const ProfileMemberSchema: GenMessage<ProfileMember> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_member, 0);
+
+/**
+ * @generated from message mantrae.v1.CreateProfileMemberRequest
+ */
+export type CreateProfileMemberRequest = Message<"mantrae.v1.CreateProfileMemberRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: mantrae.v1.Role role = 3;
+   */
+  role: Role;
+};
+
+/**
+ * Describes the message mantrae.v1.CreateProfileMemberRequest.
+ * Use `create(CreateProfileMemberRequestSchema)` to create a new message.
+ */
+export const CreateProfileMemberRequestSchema: GenMessage<CreateProfileMemberRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_member, 1);
+
+/**
+ * @generated from message mantrae.v1.CreateProfileMemberResponse
+ */
+export type CreateProfileMemberResponse = Message<"mantrae.v1.CreateProfileMemberResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.ProfileMember member = 1;
+   */
+  member?: ProfileMember;
+};
+
+/**
+ * Describes the message mantrae.v1.CreateProfileMemberResponse.
+ * Use `create(CreateProfileMemberResponseSchema)` to create a new message.
+ */
+export const CreateProfileMemberResponseSchema: GenMessage<CreateProfileMemberResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_member, 2);
+
+/**
+ * @generated from message mantrae.v1.UpdateProfileMemberRequest
+ */
+export type UpdateProfileMemberRequest = Message<"mantrae.v1.UpdateProfileMemberRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: mantrae.v1.Role role = 3;
+   */
+  role: Role;
+};
+
+/**
+ * Describes the message mantrae.v1.UpdateProfileMemberRequest.
+ * Use `create(UpdateProfileMemberRequestSchema)` to create a new message.
+ */
+export const UpdateProfileMemberRequestSchema: GenMessage<UpdateProfileMemberRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_member, 3);
+
+/**
+ * @generated from message mantrae.v1.UpdateProfileMemberResponse
+ */
+export type UpdateProfileMemberResponse = Message<"mantrae.v1.UpdateProfileMemberResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.ProfileMember member = 1;
+   */
+  member?: ProfileMember;
+};
+
+/**
+ * Describes the message mantrae.v1.UpdateProfileMemberResponse.
+ * Use `create(UpdateProfileMemberResponseSchema)` to create a new message.
+ */
+export const UpdateProfileMemberResponseSchema: GenMessage<UpdateProfileMemberResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_member, 4);
+
+/**
+ * @generated from message mantrae.v1.DeleteProfileMemberRequest
+ */
+export type DeleteProfileMemberRequest = Message<"mantrae.v1.DeleteProfileMemberRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message mantrae.v1.DeleteProfileMemberRequest.
+ * Use `create(DeleteProfileMemberRequestSchema)` to create a new message.
+ */
+export const DeleteProfileMemberRequestSchema: GenMessage<DeleteProfileMemberRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_member, 5);
+
+/**
+ * @generated from message mantrae.v1.DeleteProfileMemberResponse
+ */
+export type DeleteProfileMemberResponse = Message<"mantrae.v1.DeleteProfileMemberResponse"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.DeleteProfileMemberResponse.
+ * Use `create(DeleteProfileMemberResponseSchema)` to create a new message.
+ */
+export const DeleteProfileMemberResponseSchema: GenMessage<DeleteProfileMemberResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_member, 6);
+
+/**
+ * @generated from message mantrae.v1.ListProfileMembersRequest
+ */
+export type ListProfileMembersRequest = Message<"mantrae.v1.ListProfileMembersRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.ListProfileMembersRequest.
+ * Use `create(ListProfileMembersRequestSchema)` to create a new message.
+ */
+export const ListProfileMembersRequestSchema: GenMessage<ListProfileMembersRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_member, 7);
+
+/**
+ * @generated from message mantrae.v1.ListProfileMembersResponse
+ */
+export type ListProfileMembersResponse = Message<"mantrae.v1.ListProfileMembersResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ProfileMember members = 1;
+   */
+  members: ProfileMember[];
+};
+
+/**
+ * Describes the message mantrae.v1.ListProfileMembersResponse.
+ * Use `create(ListProfileMembersResponseSchema)` to create a new message.
+ */
+export const ListProfileMembersResponseSchema: GenMessage<ListProfileMembersResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_member, 8);
+
+/**
+ * @generated from service mantrae.v1.ProfileMemberService
+ */
+export const ProfileMemberService: GenService<{
+  /**
+   * @generated from rpc mantrae.v1.ProfileMemberService.CreateProfileMember
+   */
+  createProfileMember: {
+    methodKind: "unary";
+    input: typeof CreateProfileMemberRequestSchema;
+    output: typeof CreateProfileMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileMemberService.UpdateProfileMember
+   */
+  updateProfileMember: {
+    methodKind: "unary";
+    input: typeof UpdateProfileMemberRequestSchema;
+    output: typeof UpdateProfileMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileMemberService.DeleteProfileMember
+   */
+  deleteProfileMember: {
+    methodKind: "unary";
+    input: typeof DeleteProfileMemberRequestSchema;
+    output: typeof DeleteProfileMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileMemberService.ListProfileMembers
+   */
+  listProfileMembers: {
+    methodKind: "unary";
+    input: typeof ListProfileMembersRequestSchema;
+    output: typeof ListProfileMembersResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_mantrae_v1_profile_member, 0);
+
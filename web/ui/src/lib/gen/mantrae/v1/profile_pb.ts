@@ -7,13 +7,21 @@ import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { ImportItem, ImportStrategy } from "./backup_pb";
+import { file_mantrae_v1_backup } from "./backup_pb";
+import type { ProtocolType } from "./protocol_pb";
+import { file_mantrae_v1_protocol } from "./protocol_pb";
+import type { ConfigChange, Revision } from "./revision_pb";
+import { file_mantrae_v1_revision } from "./revision_pb";
+import type { ValidationIssue } from "./validation_pb";
+import { file_mantrae_v1_validation } from "./validation_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file mantrae/v1/profile.proto.
  */
 export const file_mantrae_v1_profile: GenFile = /*@__PURE__*/
-  fileDesc("ChhtYW50cmFlL3YxL3Byb2ZpbGUucHJvdG8SCm1hbnRyYWUudjEipwEKB1Byb2ZpbGUSCgoCaWQYASABKAMSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRINCgV0b2tlbhgEIAEoCRIuCgpjcmVhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIoChFHZXRQcm9maWxlUmVxdWVzdBITCgJpZBgBIAEoA0IHukgEIgIgACI6ChJHZXRQcm9maWxlUmVzcG9uc2USJAoHcHJvZmlsZRgBIAEoCzITLm1hbnRyYWUudjEuUHJvZmlsZSJXChRDcmVhdGVQcm9maWxlUmVxdWVzdBIVCgRuYW1lGAEgASgJQge6SARyAhABEhgKC2Rlc2NyaXB0aW9uGAIgASgJSACIAQFCDgoMX2Rlc2NyaXB0aW9uIj0KFUNyZWF0ZVByb2ZpbGVSZXNwb25zZRIkCgdwcm9maWxlGAEgASgLMhMubWFudHJhZS52MS5Qcm9maWxlIqABChRVcGRhdGVQcm9maWxlUmVxdWVzdBITCgJpZBgBIAEoA0IHukgEIgIgABIVCgRuYW1lGAIgASgJQge6SARyAhABEhgKC2Rlc2NyaXB0aW9uGAMgASgJSACIAQESHQoQcmVnZW5lcmF0ZV90b2tlbhgEIAEoCEgBiAEBQg4KDF9kZXNjcmlwdGlvbkITChFfcmVnZW5lcmF0ZV90b2tlbiI9ChVVcGRhdGVQcm9maWxlUmVzcG9uc2USJAoHcHJvZmlsZRgBIAEoCzITLm1hbnRyYWUudjEuUHJvZmlsZSIrChREZWxldGVQcm9maWxlUmVxdWVzdBITCgJpZBgBIAEoA0IHukgEIgIgACIXChVEZWxldGVQcm9maWxlUmVzcG9uc2UitAEKE0xpc3RQcm9maWxlc1JlcXVlc3QSagoFbGltaXQYASABKANCVrpIU7oBUAoLbGltaXQudmFsaWQSKWxpbWl0IG11c3QgYmUgZWl0aGVyIC0xIG9yIGdyZWF0ZXIgdGhhbiAwGhZ0aGlzID09IC0xIHx8IHRoaXMgPiAwSACIAQESHAoGb2Zmc2V0GAIgASgDQge6SAQiAigASAGIAQFCCAoGX2xpbWl0QgkKB19vZmZzZXQiUgoUTGlzdFByb2ZpbGVzUmVzcG9uc2USJQoIcHJvZmlsZXMYASADKAsyEy5tYW50cmFlLnYxLlByb2ZpbGUSEwoLdG90YWxfY291bnQYAiABKAMyvAMKDlByb2ZpbGVTZXJ2aWNlElAKCkdldFByb2ZpbGUSHS5tYW50cmFlLnYxLkdldFByb2ZpbGVSZXF1ZXN0Gh4ubWFudHJhZS52MS5HZXRQcm9maWxlUmVzcG9uc2UiA5ACARJUCg1DcmVhdGVQcm9maWxlEiAubWFudHJhZS52MS5DcmVhdGVQcm9maWxlUmVxdWVzdBohLm1hbnRyYWUudjEuQ3JlYXRlUHJvZmlsZVJlc3BvbnNlElQKDVVwZGF0ZVByb2ZpbGUSIC5tYW50cmFlLnYxLlVwZGF0ZVByb2ZpbGVSZXF1ZXN0GiEubWFudHJhZS52MS5VcGRhdGVQcm9maWxlUmVzcG9uc2USVAoNRGVsZXRlUHJvZmlsZRIgLm1hbnRyYWUudjEuRGVsZXRlUHJvZmlsZVJlcXVlc3QaIS5tYW50cmFlLnYxLkRlbGV0ZVByb2ZpbGVSZXNwb25zZRJWCgxMaXN0UHJvZmlsZXMSHy5tYW50cmFlLnYxLkxpc3RQcm9maWxlc1JlcXVlc3QaIC5tYW50cmFlLnYxLkxpc3RQcm9maWxlc1Jlc3BvbnNlIgOQAgFCqQEKDmNvbS5tYW50cmFlLnYxQgxQcm9maWxlUHJvdG9QAVpAZ2l0aHViLmNvbS9taXp1Y2hpbGFicy9tYW50cmFlL2ludGVybmFsL2dlbi9tYW50cmFlL3YxO21hbnRyYWV2MaICA01YWKoCCk1hbnRyYWUuVjHKAgpNYW50cmFlXFYx4gIWTWFudHJhZVxWMVxHUEJNZXRhZGF0YeoCC01hbnRyYWU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+  fileDesc("ChhtYW50cmFlL3YxL3Byb2ZpbGUucHJvdG8SCm1hbnRyYWUudjEi3wEKB1Byb2ZpbGUSCgoCaWQYASABKAMSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRINCgV0b2tlbhgEIAEoCRIuCgpjcmVhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIfChJwdWJsaXNoZWRfcmV2aXNpb24YByABKANIAIgBAUIVChNfcHVibGlzaGVkX3JldmlzaW9uIigKEUdldFByb2ZpbGVSZXF1ZXN0EhMKAmlkGAEgASgDQge6SAQiAiAAIjoKEkdldFByb2ZpbGVSZXNwb25zZRIkCgdwcm9maWxlGAEgASgLMhMubWFudHJhZS52MS5Qcm9maWxlIlcKFENyZWF0ZVByb2ZpbGVSZXF1ZXN0EhUKBG5hbWUYASABKAlCB7pIBHICEAESGAoLZGVzY3JpcHRpb24YAiABKAlIAIgBAUIOCgxfZGVzY3JpcHRpb24iPQoVQ3JlYXRlUHJvZmlsZVJlc3BvbnNlEiQKB3Byb2ZpbGUYASABKAsyEy5tYW50cmFlLnYxLlByb2ZpbGUiwAEKFFVwZGF0ZVByb2ZpbGVSZXF1ZXN0EhMKAmlkGAEgASgDQge6SAQiAiAAEhUKBG5hbWUYAiABKAlCB7pIBHICEAESGAoLZGVzY3JpcHRpb24YAyABKAlIAIgBARIdChByZWdlbmVyYXRlX3Rva2VuGAQgASgISAGIAQESEwoGc3RhZ2VkGAUgASgISAKIAQFCDgoMX2Rlc2NyaXB0aW9uQhMKEV9yZWdlbmVyYXRlX3Rva2VuQgkKB19zdGFnZWQiPQoVVXBkYXRlUHJvZmlsZVJlc3BvbnNlEiQKB3Byb2ZpbGUYASABKAsyEy5tYW50cmFlLnYxLlByb2ZpbGUiKwoURGVsZXRlUHJvZmlsZVJlcXVlc3QSEwoCaWQYASABKANCB7pIBCICIAAiFwoVRGVsZXRlUHJvZmlsZVJlc3BvbnNlIrQBChNMaXN0UHJvZmlsZXNSZXF1ZXN0EmoKBWxpbWl0GAEgASgDQla6SFO6AVAKC2xpbWl0LnZhbGlkEilsaW1pdCBtdXN0IGJlIGVpdGhlciAtMSBvciBncmVhdGVyIHRoYW4gMBoWdGhpcyA9PSAtMSB8fCB0aGlzID4gMEgAiAEBEhwKBm9mZnNldBgCIAEoA0IHukgEIgIoAEgBiAEBQggKBl9saW1pdEIJCgdfb2Zmc2V0IlIKFExpc3RQcm9maWxlc1Jlc3BvbnNlEiUKCHByb2ZpbGVzGAEgAygLMhMubWFudHJhZS52MS5Qcm9maWxlEhMKC3RvdGFsX2NvdW50GAIgASgDIiwKFVB1Ymxpc2hQcm9maWxlUmVxdWVzdBITCgJpZBgBIAEoA0IHukgEIgIgACJmChZQdWJsaXNoUHJvZmlsZVJlc3BvbnNlEiQKB3Byb2ZpbGUYASABKAsyEy5tYW50cmFlLnYxLlByb2ZpbGUSJgoIcmV2aXNpb24YAiABKAsyFC5tYW50cmFlLnYxLlJldmlzaW9uIioKE0Rpc2NhcmREcmFmdFJlcXVlc3QSEwoCaWQYASABKANCB7pIBCICIAAiFgoURGlzY2FyZERyYWZ0UmVzcG9uc2UiKgoTR2V0RHJhZnREaWZmUmVxdWVzdBITCgJpZBgBIAEoA0IHukgEIgIgACJBChRHZXREcmFmdERpZmZSZXNwb25zZRIpCgdjaGFuZ2VzGAEgAygLMhgubWFudHJhZS52MS5Db25maWdDaGFuZ2UiLQoWVmFsaWRhdGVQcm9maWxlUmVxdWVzdBITCgJpZBgBIAEoA0IHukgEIgIgACJGChdWYWxpZGF0ZVByb2ZpbGVSZXNwb25zZRIrCgZpc3N1ZXMYASADKAsyGy5tYW50cmFlLnYxLlZhbGlkYXRpb25Jc3N1ZSJrChNDbG9uZVByb2ZpbGVSZXF1ZXN0EhMKAmlkGAEgASgDQge6SAQiAiAAEhUKBG5hbWUYAiABKAlCB7pIBHICEAESGAoLZGVzY3JpcHRpb24YAyABKAlIAIgBAUIOCgxfZGVzY3JpcHRpb24iPAoUQ2xvbmVQcm9maWxlUmVzcG9uc2USJAoHcHJvZmlsZRgBIAEoCzITLm1hbnRyYWUudjEuUHJvZmlsZSJ1Cg9Qcm9maWxlUmVzb3VyY2USNAoIcHJvdG9jb2wYASABKA4yGC5tYW50cmFlLnYxLlByb3RvY29sVHlwZUIIukgFggECEAESFQoEdHlwZRgCIAEoCUIHukgEcgIQARIVCgRuYW1lGAMgASgJQge6SARyAhABIpgBChRQbGFuUHJvbW90aW9uUmVxdWVzdBIiChFzb3VyY2VfcHJvZmlsZV9pZBgBIAEoA0IHukgEIgIgABIiChF0YXJnZXRfcHJvZmlsZV9pZBgCIAEoA0IHukgEIgIgABI4CglyZXNvdXJjZXMYAyADKAsyGy5tYW50cmFlLnYxLlByb2ZpbGVSZXNvdXJjZUIIukgFkgECCAEiaQoVUGxhblByb21vdGlvblJlc3BvbnNlEiUKBWl0ZW1zGAEgAygLMhYubWFudHJhZS52MS5JbXBvcnRJdGVtEikKB2NoYW5nZXMYAiADKAsyGC5tYW50cmFlLnYxLkNvbmZpZ0NoYW5nZSLTAQoXUHJvbW90ZVJlc291cmNlc1JlcXVlc3QSIgoRc291cmNlX3Byb2ZpbGVfaWQYASABKANCB7pIBCICIAASIgoRdGFyZ2V0X3Byb2ZpbGVfaWQYAiABKANCB7pIBCICIAASOAoJcmVzb3VyY2VzGAMgAygLMhsubWFudHJhZS52MS5Qcm9maWxlUmVzb3VyY2VCCLpIBZIBAggBEjYKCHN0cmF0ZWd5GAQgASgOMhoubWFudHJhZS52MS5JbXBvcnRTdHJhdGVneUIIukgFggECEAEiQQoYUHJvbW90ZVJlc291cmNlc1Jlc3BvbnNlEiUKBWl0ZW1zGAEgAygLMhYubWFudHJhZS52MS5JbXBvcnRJdGVtMqkICg5Qcm9maWxlU2VydmljZRJQCgpHZXRQcm9maWxlEh0ubWFudHJhZS52MS5HZXRQcm9maWxlUmVxdWVzdBoeLm1hbnRyYWUudjEuR2V0UHJvZmlsZVJlc3BvbnNlIgOQAgESVAoNQ3JlYXRlUHJvZmlsZRIgLm1hbnRyYWUudjEuQ3JlYXRlUHJvZmlsZVJlcXVlc3QaIS5tYW50cmFlLnYxLkNyZWF0ZVByb2ZpbGVSZXNwb25zZRJUCg1VcGRhdGVQcm9maWxlEiAubWFudHJhZS52MS5VcGRhdGVQcm9maWxlUmVxdWVzdBohLm1hbnRyYWUudjEuVXBkYXRlUHJvZmlsZVJlc3BvbnNlElQKDURlbGV0ZVByb2ZpbGUSIC5tYW50cmFlLnYxLkRlbGV0ZVByb2ZpbGVSZXF1ZXN0GiEubWFudHJhZS52MS5EZWxldGVQcm9maWxlUmVzcG9uc2USVgoMTGlzdFByb2ZpbGVzEh8ubWFudHJhZS52MS5MaXN0UHJvZmlsZXNSZXF1ZXN0GiAubWFudHJhZS52MS5MaXN0UHJvZmlsZXNSZXNwb25zZSIDkAIBElcKDlB1Ymxpc2hQcm9maWxlEiEubWFudHJhZS52MS5QdWJsaXNoUHJvZmlsZVJlcXVlc3QaIi5tYW50cmFlLnYxLlB1Ymxpc2hQcm9maWxlUmVzcG9uc2USUQoMRGlzY2FyZERyYWZ0Eh8ubWFudHJhZS52MS5EaXNjYXJkRHJhZnRSZXF1ZXN0GiAubWFudHJhZS52MS5EaXNjYXJkRHJhZnRSZXNwb25zZRJWCgxHZXREcmFmdERpZmYSHy5tYW50cmFlLnYxLkdldERyYWZ0RGlmZlJlcXVlc3QaIC5tYW50cmFlLnYxLkdldERyYWZ0RGlmZlJlc3BvbnNlIgOQAgESXwoPVmFsaWRhdGVQcm9maWxlEiIubWFudHJhZS52MS5WYWxpZGF0ZVByb2ZpbGVSZXF1ZXN0GiMubWFudHJhZS52MS5WYWxpZGF0ZVByb2ZpbGVSZXNwb25zZSIDkAIBElEKDENsb25lUHJvZmlsZRIfLm1hbnRyYWUudjEuQ2xvbmVQcm9maWxlUmVxdWVzdBogLm1hbnRyYWUudjEuQ2xvbmVQcm9maWxlUmVzcG9uc2USVAoNUGxhblByb21vdGlvbhIgLm1hbnRyYWUudjEuUGxhblByb21vdGlvblJlcXVlc3QaIS5tYW50cmFlLnYxLlBsYW5Qcm9tb3Rpb25SZXNwb25zZRJdChBQcm9tb3RlUmVzb3VyY2VzEiMubWFudHJhZS52MS5Qcm9tb3RlUmVzb3VyY2VzUmVxdWVzdBokLm1hbnRyYWUudjEuUHJvbW90ZVJlc291cmNlc1Jlc3BvbnNlQqkBCg5jb20ubWFudHJhZS52MUIMUHJvZmlsZVByb3RvUAFaQGdpdGh1Yi5jb20vbWl6dWNoaWxhYnMvbWFudHJhZS9pbnRlcm5hbC9nZW4vbWFudHJhZS92MTttYW50cmFldjGiAgNNWFiqAgpNYW50cmFlLlYxygIKTWFudHJhZVxWMeICFk1hbnRyYWVcVjFcR1BCTWV0YWRhdGHqAgtNYW50cmFlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp, file_mantrae_v1_backup, file_mantrae_v1_protocol, file_mantrae_v1_revision, file_mantrae_v1_validation]);
 
 /**
  * @generated from message mantrae.v1.Profile
@@ -48,6 +56,11 @@ export type Profile = Message<"mantrae.v1.Profile"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 6;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional int64 published_revision = 7;
+   */
+  publishedRevision?: bigint;
 };
 
 /**
@@ -153,6 +166,11 @@ export type UpdateProfileRequest = Message<"mantrae.v1.UpdateProfileRequest"> &
    * @generated from field: optional bool regenerate_token = 4;
    */
   regenerateToken?: boolean;
+
+  /**
+   * @generated from field: optional bool staged = 5;
+   */
+  staged?: boolean;
 };
 
 /**
@@ -253,6 +271,312 @@ export type ListProfilesResponse = Message<"mantrae.v1.ListProfilesResponse"> &
 export const ListProfilesResponseSchema: GenMessage<ListProfilesResponse> = /*@__PURE__*/
   messageDesc(file_mantrae_v1_profile, 10);
 
+/**
+ * @generated from message mantrae.v1.PublishProfileRequest
+ */
+export type PublishProfileRequest = Message<"mantrae.v1.PublishProfileRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.PublishProfileRequest.
+ * Use `create(PublishProfileRequestSchema)` to create a new message.
+ */
+export const PublishProfileRequestSchema: GenMessage<PublishProfileRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 11);
+
+/**
+ * @generated from message mantrae.v1.PublishProfileResponse
+ */
+export type PublishProfileResponse = Message<"mantrae.v1.PublishProfileResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+
+  /**
+   * @generated from field: mantrae.v1.Revision revision = 2;
+   */
+  revision?: Revision;
+};
+
+/**
+ * Describes the message mantrae.v1.PublishProfileResponse.
+ * Use `create(PublishProfileResponseSchema)` to create a new message.
+ */
+export const PublishProfileResponseSchema: GenMessage<PublishProfileResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 12);
+
+/**
+ * @generated from message mantrae.v1.DiscardDraftRequest
+ */
+export type DiscardDraftRequest = Message<"mantrae.v1.DiscardDraftRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.DiscardDraftRequest.
+ * Use `create(DiscardDraftRequestSchema)` to create a new message.
+ */
+export const DiscardDraftRequestSchema: GenMessage<DiscardDraftRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 13);
+
+/**
+ * @generated from message mantrae.v1.DiscardDraftResponse
+ */
+export type DiscardDraftResponse = Message<"mantrae.v1.DiscardDraftResponse"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.DiscardDraftResponse.
+ * Use `create(DiscardDraftResponseSchema)` to create a new message.
+ */
+export const DiscardDraftResponseSchema: GenMessage<DiscardDraftResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 14);
+
+/**
+ * @generated from message mantrae.v1.GetDraftDiffRequest
+ */
+export type GetDraftDiffRequest = Message<"mantrae.v1.GetDraftDiffRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.GetDraftDiffRequest.
+ * Use `create(GetDraftDiffRequestSchema)` to create a new message.
+ */
+export const GetDraftDiffRequestSchema: GenMessage<GetDraftDiffRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 15);
+
+/**
+ * @generated from message mantrae.v1.GetDraftDiffResponse
+ */
+export type GetDraftDiffResponse = Message<"mantrae.v1.GetDraftDiffResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ConfigChange changes = 1;
+   */
+  changes: ConfigChange[];
+};
+
+/**
+ * Describes the message mantrae.v1.GetDraftDiffResponse.
+ * Use `create(GetDraftDiffResponseSchema)` to create a new message.
+ */
+export const GetDraftDiffResponseSchema: GenMessage<GetDraftDiffResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 16);
+
+/**
+ * @generated from message mantrae.v1.ValidateProfileRequest
+ */
+export type ValidateProfileRequest = Message<"mantrae.v1.ValidateProfileRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.ValidateProfileRequest.
+ * Use `create(ValidateProfileRequestSchema)` to create a new message.
+ */
+export const ValidateProfileRequestSchema: GenMessage<ValidateProfileRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 17);
+
+/**
+ * @generated from message mantrae.v1.ValidateProfileResponse
+ */
+export type ValidateProfileResponse = Message<"mantrae.v1.ValidateProfileResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ValidationIssue issues = 1;
+   */
+  issues: ValidationIssue[];
+};
+
+/**
+ * Describes the message mantrae.v1.ValidateProfileResponse.
+ * Use `create(ValidateProfileResponseSchema)` to create a new message.
+ */
+export const ValidateProfileResponseSchema: GenMessage<ValidateProfileResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 18);
+
+/**
+ * @generated from message mantrae.v1.CloneProfileRequest
+ */
+export type CloneProfileRequest = Message<"mantrae.v1.CloneProfileRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: optional string description = 3;
+   */
+  description?: string;
+};
+
+/**
+ * Describes the message mantrae.v1.CloneProfileRequest.
+ * Use `create(CloneProfileRequestSchema)` to create a new message.
+ */
+export const CloneProfileRequestSchema: GenMessage<CloneProfileRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 19);
+
+/**
+ * @generated from message mantrae.v1.CloneProfileResponse
+ */
+export type CloneProfileResponse = Message<"mantrae.v1.CloneProfileResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+};
+
+/**
+ * Describes the message mantrae.v1.CloneProfileResponse.
+ * Use `create(CloneProfileResponseSchema)` to create a new message.
+ */
+export const CloneProfileResponseSchema: GenMessage<CloneProfileResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 20);
+
+/**
+ * @generated from message mantrae.v1.ProfileResource
+ */
+export type ProfileResource = Message<"mantrae.v1.ProfileResource"> & {
+  /**
+   * @generated from field: mantrae.v1.ProtocolType protocol = 1;
+   */
+  protocol: ProtocolType;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message mantrae.v1.ProfileResource.
+ * Use `create(ProfileResourceSchema)` to create a new message.
+ */
+export const ProfileResourceSchema: GenMessage<ProfileResource> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 21);
+
+/**
+ * @generated from message mantrae.v1.PlanPromotionRequest
+ */
+export type PlanPromotionRequest = Message<"mantrae.v1.PlanPromotionRequest"> & {
+  /**
+   * @generated from field: int64 source_profile_id = 1;
+   */
+  sourceProfileId: bigint;
+
+  /**
+   * @generated from field: int64 target_profile_id = 2;
+   */
+  targetProfileId: bigint;
+
+  /**
+   * @generated from field: repeated mantrae.v1.ProfileResource resources = 3;
+   */
+  resources: ProfileResource[];
+};
+
+/**
+ * Describes the message mantrae.v1.PlanPromotionRequest.
+ * Use `create(PlanPromotionRequestSchema)` to create a new message.
+ */
+export const PlanPromotionRequestSchema: GenMessage<PlanPromotionRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 22);
+
+/**
+ * @generated from message mantrae.v1.PlanPromotionResponse
+ */
+export type PlanPromotionResponse = Message<"mantrae.v1.PlanPromotionResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ImportItem items = 1;
+   */
+  items: ImportItem[];
+
+  /**
+   * @generated from field: repeated mantrae.v1.ConfigChange changes = 2;
+   */
+  changes: ConfigChange[];
+};
+
+/**
+ * Describes the message mantrae.v1.PlanPromotionResponse.
+ * Use `create(PlanPromotionResponseSchema)` to create a new message.
+ */
+export const PlanPromotionResponseSchema: GenMessage<PlanPromotionResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 23);
+
+/**
+ * @generated from message mantrae.v1.PromoteResourcesRequest
+ */
+export type PromoteResourcesRequest = Message<"mantrae.v1.PromoteResourcesRequest"> & {
+  /**
+   * @generated from field: int64 source_profile_id = 1;
+   */
+  sourceProfileId: bigint;
+
+  /**
+   * @generated from field: int64 target_profile_id = 2;
+   */
+  targetProfileId: bigint;
+
+  /**
+   * @generated from field: repeated mantrae.v1.ProfileResource resources = 3;
+   */
+  resources: ProfileResource[];
+
+  /**
+   * @generated from field: mantrae.v1.ImportStrategy strategy = 4;
+   */
+  strategy: ImportStrategy;
+};
+
+/**
+ * Describes the message mantrae.v1.PromoteResourcesRequest.
+ * Use `create(PromoteResourcesRequestSchema)` to create a new message.
+ */
+export const PromoteResourcesRequestSchema: GenMessage<PromoteResourcesRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 24);
+
+/**
+ * @generated from message mantrae.v1.PromoteResourcesResponse
+ */
+export type PromoteResourcesResponse = Message<"mantrae.v1.PromoteResourcesResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ImportItem items = 1;
+   */
+  items: ImportItem[];
+};
+
+/**
+ * Describes the message mantrae.v1.PromoteResourcesResponse.
+ * Use `create(PromoteResourcesResponseSchema)` to create a new message.
+ */
+export const PromoteResourcesResponseSchema: GenMessage<PromoteResourcesResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile, 25);
+
 /**
  * @generated from service mantrae.v1.ProfileService
  */
@@ -297,6 +621,62 @@ export const ProfileService: GenService<{
     input: typeof ListProfilesRequestSchema;
     output: typeof ListProfilesResponseSchema;
   },
+  /**
+   * @generated from rpc mantrae.v1.ProfileService.PublishProfile
+   */
+  publishProfile: {
+    methodKind: "unary";
+    input: typeof PublishProfileRequestSchema;
+    output: typeof PublishProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileService.DiscardDraft
+   */
+  discardDraft: {
+    methodKind: "unary";
+    input: typeof DiscardDraftRequestSchema;
+    output: typeof DiscardDraftResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileService.GetDraftDiff
+   */
+  getDraftDiff: {
+    methodKind: "unary";
+    input: typeof GetDraftDiffRequestSchema;
+    output: typeof GetDraftDiffResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileService.ValidateProfile
+   */
+  validateProfile: {
+    methodKind: "unary";
+    input: typeof ValidateProfileRequestSchema;
+    output: typeof ValidateProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileService.CloneProfile
+   */
+  cloneProfile: {
+    methodKind: "unary";
+    input: typeof CloneProfileRequestSchema;
+    output: typeof CloneProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileService.PlanPromotion
+   */
+  planPromotion: {
+    methodKind: "unary";
+    input: typeof PlanPromotionRequestSchema;
+    output: typeof PlanPromotionResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileService.PromoteResources
+   */
+  promoteResources: {
+    methodKind: "unary";
+    input: typeof PromoteResourcesRequestSchema;
+    output: typeof PromoteResourcesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mantrae_v1_profile, 0);
 
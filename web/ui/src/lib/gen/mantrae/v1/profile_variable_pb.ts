@@ -0,0 +1,307 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file mantrae/v1/profile_variable.proto (package mantrae.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mantrae/v1/profile_variable.proto.
+ */
+export const file_mantrae_v1_profile_variable: GenFile = /*@__PURE__*/
+  fileDesc("CiFtYW50cmFlL3YxL3Byb2ZpbGVfdmFyaWFibGUucHJvdG8SCm1hbnRyYWUudjEi2AEKD1Byb2ZpbGVWYXJpYWJsZRIKCgJpZBgBIAEoCRISCgpwcm9maWxlX2lkGAIgASgDEgwKBG5hbWUYAyABKAkSDQoFdmFsdWUYBCABKAkSGAoLZGVzY3JpcHRpb24YBSABKAlIAIgBARIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIOCgxfZGVzY3JpcHRpb24iMAoZR2V0UHJvZmlsZVZhcmlhYmxlUmVxdWVzdBITCgJpZBgBIAEoCUIHukgEcgIQASJLChpHZXRQcm9maWxlVmFyaWFibGVSZXNwb25zZRItCgh2YXJpYWJsZRgBIAEoCzIbLm1hbnRyYWUudjEuUHJvZmlsZVZhcmlhYmxlIqMBChxDcmVhdGVQcm9maWxlVmFyaWFibGVSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASLQoEbmFtZRgCIAEoCUIfukgcchoyGF5bQS1aYS16X11bQS1aYS16MC05X10qJBINCgV2YWx1ZRgDIAEoCRIYCgtkZXNjcmlwdGlvbhgEIAEoCUgAiAEBQg4KDF9kZXNjcmlwdGlvbiJOCh1DcmVhdGVQcm9maWxlVmFyaWFibGVSZXNwb25zZRItCgh2YXJpYWJsZRgBIAEoCzIbLm1hbnRyYWUudjEuUHJvZmlsZVZhcmlhYmxlIpsBChxVcGRhdGVQcm9maWxlVmFyaWFibGVSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABEi0KBG5hbWUYAiABKAlCH7pIHHIaMhheW0EtWmEtel9dW0EtWmEtejAtOV9dKiQSDQoFdmFsdWUYAyABKAkSGAoLZGVzY3JpcHRpb24YBCABKAlIAIgBAUIOCgxfZGVzY3JpcHRpb24iTgodVXBkYXRlUHJvZmlsZVZhcmlhYmxlUmVzcG9uc2USLQoIdmFyaWFibGUYASABKAsyGy5tYW50cmFlLnYxLlByb2ZpbGVWYXJpYWJsZSIzChxEZWxldGVQcm9maWxlVmFyaWFibGVSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABIh8KHURlbGV0ZVByb2ZpbGVWYXJpYWJsZVJlc3BvbnNlIjoKG0xpc3RQcm9maWxlVmFyaWFibGVzUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAIk4KHExpc3RQcm9maWxlVmFyaWFibGVzUmVzcG9uc2USLgoJdmFyaWFibGVzGAEgAygLMhsubWFudHJhZS52MS5Qcm9maWxlVmFyaWFibGUyvAQKFlByb2ZpbGVWYXJpYWJsZVNlcnZpY2USaAoSR2V0UHJvZmlsZVZhcmlhYmxlEiUubWFudHJhZS52MS5HZXRQcm9maWxlVmFyaWFibGVSZXF1ZXN0GiYubWFudHJhZS52MS5HZXRQcm9maWxlVmFyaWFibGVSZXNwb25zZSIDkAIBEmwKFUNyZWF0ZVByb2ZpbGVWYXJpYWJsZRIoLm1hbnRyYWUudjEuQ3JlYXRlUHJvZmlsZVZhcmlhYmxlUmVxdWVzdBopLm1hbnRyYWUudjEuQ3JlYXRlUHJvZmlsZVZhcmlhYmxlUmVzcG9uc2USbAoVVXBkYXRlUHJvZmlsZVZhcmlhYmxlEigubWFudHJhZS52MS5VcGRhdGVQcm9maWxlVmFyaWFibGVSZXF1ZXN0GikubWFudHJhZS52MS5VcGRhdGVQcm9maWxlVmFyaWFibGVSZXNwb25zZRJsChVEZWxldGVQcm9maWxlVmFyaWFibGUSKC5tYW50cmFlLnYxLkRlbGV0ZVByb2ZpbGVWYXJpYWJsZVJlcXVlc3QaKS5tYW50cmFlLnYxLkRlbGV0ZVByb2ZpbGVWYXJpYWJsZVJlc3BvbnNlEm4KFExpc3RQcm9maWxlVmFyaWFibGVzEicubWFudHJhZS52MS5MaXN0UHJvZmlsZVZhcmlhYmxlc1JlcXVlc3QaKC5tYW50cmFlLnYxLkxpc3RQcm9maWxlVmFyaWFibGVzUmVzcG9uc2UiA5ACAUKxAQoOY29tLm1hbnRyYWUudjFCFFByb2ZpbGVWYXJpYWJsZVByb3RvUAFaQGdpdGh1Yi5jb20vbWl6dWNoaWxhYnMvbWFudHJhZS9pbnRlcm5hbC9nZW4vbWFudHJhZS92MTttYW50cmFldjGiAgNNWFiqAgpNYW50cmFlLlYxygIKTWFudHJhZVxWMeICFk1hbnRyYWVcVjFcR1BCTWV0YWRhdGHqAgtNYW50cmFlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message mantrae.v1.ProfileVariable
+ */
+export type ProfileVariable = Message<"mantrae.v1.ProfileVariable"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: int64 profile_id = 2;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string value = 4;
+   */
+  value: string;
+
+  /**
+   * @generated from field: optional string description = 5;
+   */
+  description?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mantrae.v1.ProfileVariable.
+ * Use `create(ProfileVariableSchema)` to create a new message.
+ */
+export const ProfileVariableSchema: GenMessage<ProfileVariable> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 0);
+
+/**
+ * @generated from message mantrae.v1.GetProfileVariableRequest
+ */
+export type GetProfileVariableRequest = Message<"mantrae.v1.GetProfileVariableRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message mantrae.v1.GetProfileVariableRequest.
+ * Use `create(GetProfileVariableRequestSchema)` to create a new message.
+ */
+export const GetProfileVariableRequestSchema: GenMessage<GetProfileVariableRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 1);
+
+/**
+ * @generated from message mantrae.v1.GetProfileVariableResponse
+ */
+export type GetProfileVariableResponse = Message<"mantrae.v1.GetProfileVariableResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.ProfileVariable variable = 1;
+   */
+  variable?: ProfileVariable;
+};
+
+/**
+ * Describes the message mantrae.v1.GetProfileVariableResponse.
+ * Use `create(GetProfileVariableResponseSchema)` to create a new message.
+ */
+export const GetProfileVariableResponseSchema: GenMessage<GetProfileVariableResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 2);
+
+/**
+ * @generated from message mantrae.v1.CreateProfileVariableRequest
+ */
+export type CreateProfileVariableRequest = Message<"mantrae.v1.CreateProfileVariableRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value: string;
+
+  /**
+   * @generated from field: optional string description = 4;
+   */
+  description?: string;
+};
+
+/**
+ * Describes the message mantrae.v1.CreateProfileVariableRequest.
+ * Use `create(CreateProfileVariableRequestSchema)` to create a new message.
+ */
+export const CreateProfileVariableRequestSchema: GenMessage<CreateProfileVariableRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 3);
+
+/**
+ * @generated from message mantrae.v1.CreateProfileVariableResponse
+ */
+export type CreateProfileVariableResponse = Message<"mantrae.v1.CreateProfileVariableResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.ProfileVariable variable = 1;
+   */
+  variable?: ProfileVariable;
+};
+
+/**
+ * Describes the message mantrae.v1.CreateProfileVariableResponse.
+ * Use `create(CreateProfileVariableResponseSchema)` to create a new message.
+ */
+export const CreateProfileVariableResponseSchema: GenMessage<CreateProfileVariableResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 4);
+
+/**
+ * @generated from message mantrae.v1.UpdateProfileVariableRequest
+ */
+export type UpdateProfileVariableRequest = Message<"mantrae.v1.UpdateProfileVariableRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value: string;
+
+  /**
+   * @generated from field: optional string description = 4;
+   */
+  description?: string;
+};
+
+/**
+ * Describes the message mantrae.v1.UpdateProfileVariableRequest.
+ * Use `create(UpdateProfileVariableRequestSchema)` to create a new message.
+ */
+export const UpdateProfileVariableRequestSchema: GenMessage<UpdateProfileVariableRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 5);
+
+/**
+ * @generated from message mantrae.v1.UpdateProfileVariableResponse
+ */
+export type UpdateProfileVariableResponse = Message<"mantrae.v1.UpdateProfileVariableResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.ProfileVariable variable = 1;
+   */
+  variable?: ProfileVariable;
+};
+
+/**
+ * Describes the message mantrae.v1.UpdateProfileVariableResponse.
+ * Use `create(UpdateProfileVariableResponseSchema)` to create a new message.
+ */
+export const UpdateProfileVariableResponseSchema: GenMessage<UpdateProfileVariableResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 6);
+
+/**
+ * @generated from message mantrae.v1.DeleteProfileVariableRequest
+ */
+export type DeleteProfileVariableRequest = Message<"mantrae.v1.DeleteProfileVariableRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message mantrae.v1.DeleteProfileVariableRequest.
+ * Use `create(DeleteProfileVariableRequestSchema)` to create a new message.
+ */
+export const DeleteProfileVariableRequestSchema: GenMessage<DeleteProfileVariableRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 7);
+
+/**
+ * @generated from message mantrae.v1.DeleteProfileVariableResponse
+ */
+export type DeleteProfileVariableResponse = Message<"mantrae.v1.DeleteProfileVariableResponse"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.DeleteProfileVariableResponse.
+ * Use `create(DeleteProfileVariableResponseSchema)` to create a new message.
+ */
+export const DeleteProfileVariableResponseSchema: GenMessage<DeleteProfileVariableResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 8);
+
+/**
+ * @generated from message mantrae.v1.ListProfileVariablesRequest
+ */
+export type ListProfileVariablesRequest = Message<"mantrae.v1.ListProfileVariablesRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.ListProfileVariablesRequest.
+ * Use `create(ListProfileVariablesRequestSchema)` to create a new message.
+ */
+export const ListProfileVariablesRequestSchema: GenMessage<ListProfileVariablesRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 9);
+
+/**
+ * @generated from message mantrae.v1.ListProfileVariablesResponse
+ */
+export type ListProfileVariablesResponse = Message<"mantrae.v1.ListProfileVariablesResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ProfileVariable variables = 1;
+   */
+  variables: ProfileVariable[];
+};
+
+/**
+ * Describes the message mantrae.v1.ListProfileVariablesResponse.
+ * Use `create(ListProfileVariablesResponseSchema)` to create a new message.
+ */
+export const ListProfileVariablesResponseSchema: GenMessage<ListProfileVariablesResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_profile_variable, 10);
+
+/**
+ * @generated from service mantrae.v1.ProfileVariableService
+ */
+export const ProfileVariableService: GenService<{
+  /**
+   * @generated from rpc mantrae.v1.ProfileVariableService.GetProfileVariable
+   */
+  getProfileVariable: {
+    methodKind: "unary";
+    input: typeof GetProfileVariableRequestSchema;
+    output: typeof GetProfileVariableResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileVariableService.CreateProfileVariable
+   */
+  createProfileVariable: {
+    methodKind: "unary";
+    input: typeof CreateProfileVariableRequestSchema;
+    output: typeof CreateProfileVariableResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileVariableService.UpdateProfileVariable
+   */
+  updateProfileVariable: {
+    methodKind: "unary";
+    input: typeof UpdateProfileVariableRequestSchema;
+    output: typeof UpdateProfileVariableResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileVariableService.DeleteProfileVariable
+   */
+  deleteProfileVariable: {
+    methodKind: "unary";
+    input: typeof DeleteProfileVariableRequestSchema;
+    output: typeof DeleteProfileVariableResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ProfileVariableService.ListProfileVariables
+   */
+  listProfileVariables: {
+    methodKind: "unary";
+    input: typeof ListProfileVariablesRequestSchema;
+    output: typeof ListProfileVariablesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_mantrae_v1_profile_variable, 0);
+
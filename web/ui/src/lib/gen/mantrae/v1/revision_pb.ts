@@ -0,0 +1,327 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file mantrae/v1/revision.proto (package mantrae.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mantrae/v1/revision.proto.
+ */
+export const file_mantrae_v1_revision: GenFile = /*@__PURE__*/
+  fileDesc("ChltYW50cmFlL3YxL3JldmlzaW9uLnByb3RvEgptYW50cmFlLnYxImAKCFJldmlzaW9uEhIKCnByb2ZpbGVfaWQYASABKAMSEAoIcmV2aXNpb24YAiABKAMSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiYQoMQ29uZmlnQ2hhbmdlEgwKBHBhdGgYASABKAkSJAoEa2luZBgCIAEoDjIWLm1hbnRyYWUudjEuQ2hhbmdlS2luZBIOCgZiZWZvcmUYAyABKAkSDQoFYWZ0ZXIYBCABKAki0gEKFExpc3RSZXZpc2lvbnNSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASagoFbGltaXQYAiABKANCVrpIU7oBUAoLbGltaXQudmFsaWQSKWxpbWl0IG11c3QgYmUgZWl0aGVyIC0xIG9yIGdyZWF0ZXIgdGhhbiAwGhZ0aGlzID09IC0xIHx8IHRoaXMgPiAwSACIAQESHAoGb2Zmc2V0GAMgASgDQge6SAQiAigASAGIAQFCCAoGX2xpbWl0QgkKB19vZmZzZXQiVQoVTGlzdFJldmlzaW9uc1Jlc3BvbnNlEicKCXJldmlzaW9ucxgBIAMoCzIULm1hbnRyYWUudjEuUmV2aXNpb24SEwoLdG90YWxfY291bnQYAiABKAMiXAoSR2V0UmV2aXNpb25SZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASGQoIcmV2aXNpb24YAiABKANCB7pIBCICIAASDgoGZm9ybWF0GAMgASgJIk0KE0dldFJldmlzaW9uUmVzcG9uc2USJgoIcmV2aXNpb24YASABKAsyFC5tYW50cmFlLnYxLlJldmlzaW9uEg4KBmNvbmZpZxgCIAEoCSJxChREaWZmUmV2aXNpb25zUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAEh4KDWZyb21fcmV2aXNpb24YAiABKANCB7pIBCICIAASHAoLdG9fcmV2aXNpb24YAyABKANCB7pIBCICIAAiQgoVRGlmZlJldmlzaW9uc1Jlc3BvbnNlEikKB2NoYW5nZXMYASADKAsyGC5tYW50cmFlLnYxLkNvbmZpZ0NoYW5nZSJRChdSb2xsYmFja1JldmlzaW9uUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAEhkKCHJldmlzaW9uGAIgASgDQge6SAQiAiAAIkIKGFJvbGxiYWNrUmV2aXNpb25SZXNwb25zZRImCghyZXZpc2lvbhgBIAEoCzIULm1hbnRyYWUudjEuUmV2aXNpb24qcwoKQ2hhbmdlS2luZBIbChdDSEFOR0VfS0lORF9VTlNQRUNJRklFRBAAEhUKEUNIQU5HRV9LSU5EX0FEREVEEAESFwoTQ0hBTkdFX0tJTkRfUkVNT1ZFRBACEhgKFENIQU5HRV9LSU5EX01PRElGSUVEEAMy+wIKD1JldmlzaW9uU2VydmljZRJZCg1MaXN0UmV2aXNpb25zEiAubWFudHJhZS52MS5MaXN0UmV2aXNpb25zUmVxdWVzdBohLm1hbnRyYWUudjEuTGlzdFJldmlzaW9uc1Jlc3BvbnNlIgOQAgESUwoLR2V0UmV2aXNpb24SHi5tYW50cmFlLnYxLkdldFJldmlzaW9uUmVxdWVzdBofLm1hbnRyYWUudjEuR2V0UmV2aXNpb25SZXNwb25zZSIDkAIBElkKDURpZmZSZXZpc2lvbnMSIC5tYW50cmFlLnYxLkRpZmZSZXZpc2lvbnNSZXF1ZXN0GiEubWFudHJhZS52MS5EaWZmUmV2aXNpb25zUmVzcG9uc2UiA5ACARJdChBSb2xsYmFja1JldmlzaW9uEiMubWFudHJhZS52MS5Sb2xsYmFja1JldmlzaW9uUmVxdWVzdBokLm1hbnRyYWUudjEuUm9sbGJhY2tSZXZpc2lvblJlc3BvbnNlQqoBCg5jb20ubWFudHJhZS52MUINUmV2aXNpb25Qcm90b1ABWkBnaXRodWIuY29tL21penVjaGlsYWJzL21hbnRyYWUvaW50ZXJuYWwvZ2VuL21hbnRyYWUvdjE7bWFudHJhZXYxogIDTVhYqgIKTWFudHJhZS5WMcoCCk1hbnRyYWVcVjHiAhZNYW50cmFlXFYxXEdQQk1ldGFkYXRh6gILTWFudHJhZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message mantrae.v1.Revision
+ */
+export type Revision = Message<"mantrae.v1.Revision"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: int64 revision = 2;
+   */
+  revision: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message mantrae.v1.Revision.
+ * Use `create(RevisionSchema)` to create a new message.
+ */
+export const RevisionSchema: GenMessage<Revision> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_revision, 0);
+
+/**
+ * @generated from message mantrae.v1.ConfigChange
+ */
+export type ConfigChange = Message<"mantrae.v1.ConfigChange"> & {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path: string;
+
+  /**
+   * @generated from field: mantrae.v1.ChangeKind kind = 2;
+   */
+  kind: ChangeKind;
+
+  /**
+   * @generated from field: string before = 3;
+   */
+  before: string;
+
+  /**
+   * @generated from field: string after = 4;
+   */
+  after: string;
+};
+
+/**
+ * Describes the message mantrae.v1.ConfigChange.
+ * Use `create(ConfigChangeSchema)` to create a new message.
+ */
+export const ConfigChangeSchema: GenMessage<ConfigChange> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_revision, 1);
+
+/**
+ * @generated from message mantrae.v1.ListRevisionsRequest
+ */
+export type ListRevisionsRequest = Message<"mantrae.v1.ListRevisionsRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: optional int64 limit = 2;
+   */
+  limit?: bigint;
+
+  /**
+   * @generated from field: optional int64 offset = 3;
+   */
+  offset?: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.ListRevisionsRequest.
+ * Use `create(ListRevisionsRequestSchema)` to create a new message.
+ */
+export const ListRevisionsRequestSchema: GenMessage<ListRevisionsRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_revision, 2);
+
+/**
+ * @generated from message mantrae.v1.ListRevisionsResponse
+ */
+export type ListRevisionsResponse = Message<"mantrae.v1.ListRevisionsResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.Revision revisions = 1;
+   */
+  revisions: Revision[];
+
+  /**
+   * @generated from field: int64 total_count = 2;
+   */
+  totalCount: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.ListRevisionsResponse.
+ * Use `create(ListRevisionsResponseSchema)` to create a new message.
+ */
+export const ListRevisionsResponseSchema: GenMessage<ListRevisionsResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_revision, 3);
+
+/**
+ * @generated from message mantrae.v1.GetRevisionRequest
+ */
+export type GetRevisionRequest = Message<"mantrae.v1.GetRevisionRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: int64 revision = 2;
+   */
+  revision: bigint;
+
+  /**
+   * @generated from field: string format = 3;
+   */
+  format: string;
+};
+
+/**
+ * Describes the message mantrae.v1.GetRevisionRequest.
+ * Use `create(GetRevisionRequestSchema)` to create a new message.
+ */
+export const GetRevisionRequestSchema: GenMessage<GetRevisionRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_revision, 4);
+
+/**
+ * @generated from message mantrae.v1.GetRevisionResponse
+ */
+export type GetRevisionResponse = Message<"mantrae.v1.GetRevisionResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.Revision revision = 1;
+   */
+  revision?: Revision;
+
+  /**
+   * @generated from field: string config = 2;
+   */
+  config: string;
+};
+
+/**
+ * Describes the message mantrae.v1.GetRevisionResponse.
+ * Use `create(GetRevisionResponseSchema)` to create a new message.
+ */
+export const GetRevisionResponseSchema: GenMessage<GetRevisionResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_revision, 5);
+
+/**
+ * @generated from message mantrae.v1.DiffRevisionsRequest
+ */
+export type DiffRevisionsRequest = Message<"mantrae.v1.DiffRevisionsRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: int64 from_revision = 2;
+   */
+  fromRevision: bigint;
+
+  /**
+   * @generated from field: int64 to_revision = 3;
+   */
+  toRevision: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.DiffRevisionsRequest.
+ * Use `create(DiffRevisionsRequestSchema)` to create a new message.
+ */
+export const DiffRevisionsRequestSchema: GenMessage<DiffRevisionsRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_revision, 6);
+
+/**
+ * @generated from message mantrae.v1.DiffRevisionsResponse
+ */
+export type DiffRevisionsResponse = Message<"mantrae.v1.DiffRevisionsResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ConfigChange changes = 1;
+   */
+  changes: ConfigChange[];
+};
+
+/**
+ * Describes the message mantrae.v1.DiffRevisionsResponse.
+ * Use `create(DiffRevisionsResponseSchema)` to create a new message.
+ */
+export const DiffRevisionsResponseSchema: GenMessage<DiffRevisionsResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_revision, 7);
+
+/**
+ * @generated from message mantrae.v1.RollbackRevisionRequest
+ */
+export type RollbackRevisionRequest = Message<"mantrae.v1.RollbackRevisionRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: int64 revision = 2;
+   */
+  revision: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.RollbackRevisionRequest.
+ * Use `create(RollbackRevisionRequestSchema)` to create a new message.
+ */
+export const RollbackRevisionRequestSchema: GenMessage<RollbackRevisionRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_revision, 8);
+
+/**
+ * @generated from message mantrae.v1.RollbackRevisionResponse
+ */
+export type RollbackRevisionResponse = Message<"mantrae.v1.RollbackRevisionResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.Revision revision = 1;
+   */
+  revision?: Revision;
+};
+
+/**
+ * Describes the message mantrae.v1.RollbackRevisionResponse.
+ * Use `create(RollbackRevisionResponseSchema)` to create a new message.
+ */
+export const RollbackRevisionResponseSchema: GenMessage<RollbackRevisionResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_revision, 9);
+
+/**
+ * @generated from enum mantrae.v1.ChangeKind
+ */
+export enum ChangeKind {
+  /**
+   * @generated from enum value: CHANGE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHANGE_KIND_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * @generated from enum value: CHANGE_KIND_REMOVED = 2;
+   */
+  REMOVED = 2,
+
+  /**
+   * @generated from enum value: CHANGE_KIND_MODIFIED = 3;
+   */
+  MODIFIED = 3,
+}
+
+/**
+ * Describes the enum mantrae.v1.ChangeKind.
+ */
+export const ChangeKindSchema: GenEnum<ChangeKind> = /*@__PURE__*/
+  enumDesc(file_mantrae_v1_revision, 0);
+
+/**
+ * @generated from service mantrae.v1.RevisionService
+ */
+export const RevisionService: GenService<{
+  /**
+   * @generated from rpc mantrae.v1.RevisionService.ListRevisions
+   */
+  listRevisions: {
+    methodKind: "unary";
+    input: typeof ListRevisionsRequestSchema;
+    output: typeof ListRevisionsResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.RevisionService.GetRevision
+   */
+  getRevision: {
+    methodKind: "unary";
+    input: typeof GetRevisionRequestSchema;
+    output: typeof GetRevisionResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.RevisionService.DiffRevisions
+   */
+  diffRevisions: {
+    methodKind: "unary";
+    input: typeof DiffRevisionsRequestSchema;
+    output: typeof DiffRevisionsResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.RevisionService.RollbackRevision
+   */
+  rollbackRevision: {
+    methodKind: "unary";
+    input: typeof RollbackRevisionRequestSchema;
+    output: typeof RollbackRevisionResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_mantrae_v1_revision, 0);
+
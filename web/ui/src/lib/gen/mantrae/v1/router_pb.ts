@@ -2,8 +2,8 @@
 // @generated from file mantrae/v1/router.proto (package mantrae.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_struct, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
@@ -11,13 +11,15 @@ import type { DNSProvider } from "./dns_provider_pb";
 import { file_mantrae_v1_dns_provider } from "./dns_provider_pb";
 import type { ProtocolType } from "./protocol_pb";
 import { file_mantrae_v1_protocol } from "./protocol_pb";
+import type { ValidationIssue } from "./validation_pb";
+import { file_mantrae_v1_validation } from "./validation_pb";
 import type { JsonObject, Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file mantrae/v1/router.proto.
  */
 export const file_mantrae_v1_router: GenFile = /*@__PURE__*/
-  fileDesc("ChdtYW50cmFlL3YxL3JvdXRlci5wcm90bxIKbWFudHJhZS52MSK6AgoGUm91dGVyEgoKAmlkGAEgASgJEhIKCnByb2ZpbGVfaWQYAiABKAMSEAoIYWdlbnRfaWQYAyABKAkSDAoEbmFtZRgEIAEoCRInCgZjb25maWcYBSABKAsyFy5nb29nbGUucHJvdG9idWYuU3RydWN0Eg8KB2VuYWJsZWQYBiABKAgSJgoEdHlwZRgHIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlEi4KDWRuc19wcm92aWRlcnMYCCADKAsyFy5tYW50cmFlLnYxLkROU1Byb3ZpZGVyEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlkKEEdldFJvdXRlclJlcXVlc3QSEwoCaWQYASABKAlCB7pIBHICEAESMAoEdHlwZRgCIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlQgi6SAWCAQIQASI3ChFHZXRSb3V0ZXJSZXNwb25zZRIiCgZyb3V0ZXIYASABKAsyEi5tYW50cmFlLnYxLlJvdXRlciLZAQoTQ3JlYXRlUm91dGVyUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAEhUKCGFnZW50X2lkGAIgASgJSACIAQESFQoEbmFtZRgDIAEoCUIHukgEcgIQARInCgZjb25maWcYBCABKAsyFy5nb29nbGUucHJvdG9idWYuU3RydWN0Eg8KB2VuYWJsZWQYBSABKAgSMAoEdHlwZRgGIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlQgi6SAWCAQIQAUILCglfYWdlbnRfaWQiOgoUQ3JlYXRlUm91dGVyUmVzcG9uc2USIgoGcm91dGVyGAEgASgLMhIubWFudHJhZS52MS5Sb3V0ZXIi3QEKE1VwZGF0ZVJvdXRlclJlcXVlc3QSEwoCaWQYASABKAlCB7pIBHICEAESFQoEbmFtZRgCIAEoCUIHukgEcgIQARIwCgR0eXBlGAMgASgOMhgubWFudHJhZS52MS5Qcm90b2NvbFR5cGVCCLpIBYIBAhABEicKBmNvbmZpZxgEIAEoCzIXLmdvb2dsZS5wcm90b2J1Zi5TdHJ1Y3QSDwoHZW5hYmxlZBgFIAEoCBIuCg1kbnNfcHJvdmlkZXJzGAYgAygLMhcubWFudHJhZS52MS5ETlNQcm92aWRlciI6ChRVcGRhdGVSb3V0ZXJSZXNwb25zZRIiCgZyb3V0ZXIYASABKAsyEi5tYW50cmFlLnYxLlJvdXRlciJcChNEZWxldGVSb3V0ZXJSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABEjAKBHR5cGUYAiABKA4yGC5tYW50cmFlLnYxLlByb3RvY29sVHlwZUIIukgFggECEAEiFgoURGVsZXRlUm91dGVyUmVzcG9uc2UiswIKEkxpc3RSb3V0ZXJzUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAEh4KCGFnZW50X2lkGAIgASgJQge6SARyAhABSACIAQESKwoEdHlwZRgDIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlSAGIAQESagoFbGltaXQYBCABKANCVrpIU7oBUAoLbGltaXQudmFsaWQSKWxpbWl0IG11c3QgYmUgZWl0aGVyIC0xIG9yIGdyZWF0ZXIgdGhhbiAwGhZ0aGlzID09IC0xIHx8IHRoaXMgPiAwSAKIAQESHAoGb2Zmc2V0GAUgASgDQge6SAQiAigASAOIAQFCCwoJX2FnZW50X2lkQgcKBV90eXBlQggKBl9saW1pdEIJCgdfb2Zmc2V0Ik8KE0xpc3RSb3V0ZXJzUmVzcG9uc2USIwoHcm91dGVycxgBIAMoCzISLm1hbnRyYWUudjEuUm91dGVyEhMKC3RvdGFsX2NvdW50GAIgASgDMqwDCg1Sb3V0ZXJTZXJ2aWNlEk0KCUdldFJvdXRlchIcLm1hbnRyYWUudjEuR2V0Um91dGVyUmVxdWVzdBodLm1hbnRyYWUudjEuR2V0Um91dGVyUmVzcG9uc2UiA5ACARJRCgxDcmVhdGVSb3V0ZXISHy5tYW50cmFlLnYxLkNyZWF0ZVJvdXRlclJlcXVlc3QaIC5tYW50cmFlLnYxLkNyZWF0ZVJvdXRlclJlc3BvbnNlElEKDFVwZGF0ZVJvdXRlchIfLm1hbnRyYWUudjEuVXBkYXRlUm91dGVyUmVxdWVzdBogLm1hbnRyYWUudjEuVXBkYXRlUm91dGVyUmVzcG9uc2USUQoMRGVsZXRlUm91dGVyEh8ubWFudHJhZS52MS5EZWxldGVSb3V0ZXJSZXF1ZXN0GiAubWFudHJhZS52MS5EZWxldGVSb3V0ZXJSZXNwb25zZRJTCgtMaXN0Um91dGVycxIeLm1hbnRyYWUudjEuTGlzdFJvdXRlcnNSZXF1ZXN0Gh8ubWFudHJhZS52MS5MaXN0Um91dGVyc1Jlc3BvbnNlIgOQAgFCqAEKDmNvbS5tYW50cmFlLnYxQgtSb3V0ZXJQcm90b1ABWkBnaXRodWIuY29tL21penVjaGlsYWJzL21hbnRyYWUvaW50ZXJuYWwvZ2VuL21hbnRyYWUvdjE7bWFudHJhZXYxogIDTVhYqgIKTWFudHJhZS5WMcoCCk1hbnRyYWVcVjHiAhZNYW50cmFlXFYxXEdQQk1ldGFkYXRh6gILTWFudHJhZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_struct, file_google_protobuf_timestamp, file_mantrae_v1_dns_provider, file_mantrae_v1_protocol]);
+  fileDesc("ChdtYW50cmFlL3YxL3JvdXRlci5wcm90bxIKbWFudHJhZS52MSK6AgoGUm91dGVyEgoKAmlkGAEgASgJEhIKCnByb2ZpbGVfaWQYAiABKAMSEAoIYWdlbnRfaWQYAyABKAkSDAoEbmFtZRgEIAEoCRInCgZjb25maWcYBSABKAsyFy5nb29nbGUucHJvdG9idWYuU3RydWN0Eg8KB2VuYWJsZWQYBiABKAgSJgoEdHlwZRgHIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlEi4KDWRuc19wcm92aWRlcnMYCCADKAsyFy5tYW50cmFlLnYxLkROU1Byb3ZpZGVyEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlkKEEdldFJvdXRlclJlcXVlc3QSEwoCaWQYASABKAlCB7pIBHICEAESMAoEdHlwZRgCIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlQgi6SAWCAQIQASI3ChFHZXRSb3V0ZXJSZXNwb25zZRIiCgZyb3V0ZXIYASABKAsyEi5tYW50cmFlLnYxLlJvdXRlciLZAQoTQ3JlYXRlUm91dGVyUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAEhUKCGFnZW50X2lkGAIgASgJSACIAQESFQoEbmFtZRgDIAEoCUIHukgEcgIQARInCgZjb25maWcYBCABKAsyFy5nb29nbGUucHJvdG9idWYuU3RydWN0Eg8KB2VuYWJsZWQYBSABKAgSMAoEdHlwZRgGIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlQgi6SAWCAQIQAUILCglfYWdlbnRfaWQiZwoUQ3JlYXRlUm91dGVyUmVzcG9uc2USIgoGcm91dGVyGAEgASgLMhIubWFudHJhZS52MS5Sb3V0ZXISKwoGaXNzdWVzGAIgAygLMhsubWFudHJhZS52MS5WYWxpZGF0aW9uSXNzdWUi3QEKE1VwZGF0ZVJvdXRlclJlcXVlc3QSEwoCaWQYASABKAlCB7pIBHICEAESFQoEbmFtZRgCIAEoCUIHukgEcgIQARIwCgR0eXBlGAMgASgOMhgubWFudHJhZS52MS5Qcm90b2NvbFR5cGVCCLpIBYIBAhABEicKBmNvbmZpZxgEIAEoCzIXLmdvb2dsZS5wcm90b2J1Zi5TdHJ1Y3QSDwoHZW5hYmxlZBgFIAEoCBIuCg1kbnNfcHJvdmlkZXJzGAYgAygLMhcubWFudHJhZS52MS5ETlNQcm92aWRlciJnChRVcGRhdGVSb3V0ZXJSZXNwb25zZRIiCgZyb3V0ZXIYASABKAsyEi5tYW50cmFlLnYxLlJvdXRlchIrCgZpc3N1ZXMYAiADKAsyGy5tYW50cmFlLnYxLlZhbGlkYXRpb25Jc3N1ZSJcChNEZWxldGVSb3V0ZXJSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABEjAKBHR5cGUYAiABKA4yGC5tYW50cmFlLnYxLlByb3RvY29sVHlwZUIIukgFggECEAEiFgoURGVsZXRlUm91dGVyUmVzcG9uc2UiswIKEkxpc3RSb3V0ZXJzUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAEh4KCGFnZW50X2lkGAIgASgJQge6SARyAhABSACIAQESKwoEdHlwZRgDIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlSAGIAQESagoFbGltaXQYBCABKANCVrpIU7oBUAoLbGltaXQudmFsaWQSKWxpbWl0IG11c3QgYmUgZWl0aGVyIC0xIG9yIGdyZWF0ZXIgdGhhbiAwGhZ0aGlzID09IC0xIHx8IHRoaXMgPiAwSAKIAQESHAoGb2Zmc2V0GAUgASgDQge6SAQiAigASAOIAQFCCwoJX2FnZW50X2lkQgcKBV90eXBlQggKBl9saW1pdEIJCgdfb2Zmc2V0Ik8KE0xpc3RSb3V0ZXJzUmVzcG9uc2USIwoHcm91dGVycxgBIAMoCzISLm1hbnRyYWUudjEuUm91dGVyEhMKC3RvdGFsX2NvdW50GAIgASgDIr4BCg1Sb3V0ZUNvbmZsaWN0EiYKBGtpbmQYASABKA4yGC5tYW50cmFlLnYxLkNvbmZsaWN0S2luZBImCgR0eXBlGAIgASgOMhgubWFudHJhZS52MS5Qcm90b2NvbFR5cGUSDgoGcm91dGVyGAMgASgJEg0KBW90aGVyGAQgASgJEhAKCHByaW9yaXR5GAUgASgFEhYKDm90aGVyX3ByaW9yaXR5GAYgASgFEhQKDGVudHJ5X3BvaW50cxgHIAMoCSI4ChlMaXN0Um91dGVDb25mbGljdHNSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAAiSgoaTGlzdFJvdXRlQ29uZmxpY3RzUmVzcG9uc2USLAoJY29uZmxpY3RzGAEgAygLMhkubWFudHJhZS52MS5Sb3V0ZUNvbmZsaWN0IoQCChRTaW11bGF0ZVJvdXRlUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAEhMKC2VudHJ5X3BvaW50GAIgASgJEgsKA3RscxgDIAEoCBIOCgZtZXRob2QYBCABKAkSDAoEaG9zdBgFIAEoCRIMCgRwYXRoGAYgASgJEj4KB2hlYWRlcnMYByADKAsyLS5tYW50cmFlLnYxLlNpbXVsYXRlUm91dGVSZXF1ZXN0LkhlYWRlcnNFbnRyeRIRCgljbGllbnRfaXAYCCABKAkaLgoMSGVhZGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiYAoOUm91dGVDYW5kaWRhdGUSDgoGcm91dGVyGAEgASgJEgwKBHJ1bGUYAiABKAkSEAoIcHJpb3JpdHkYAyABKAUSDwoHbWF0Y2hlcxgEIAEoCBINCgVlcnJvchgFIAEoCSJCChNTaW11bGF0ZWRNaWRkbGV3YXJlEgwKBG5hbWUYASABKAkSDAoEdHlwZRgCIAEoCRIPCgdzZXJ2aWNlGAMgASgJIj8KEFNpbXVsYXRlZFNlcnZpY2USDAoEbmFtZRgBIAEoCRIMCgR0eXBlGAIgASgJEg8KB3NlcnZlcnMYAyADKAkivQEKFVNpbXVsYXRlUm91dGVSZXNwb25zZRIOCgZyb3V0ZXIYASABKAkSLgoKY2FuZGlkYXRlcxgCIAMoCzIaLm1hbnRyYWUudjEuUm91dGVDYW5kaWRhdGUSNAoLbWlkZGxld2FyZXMYAyADKAsyHy5tYW50cmFlLnYxLlNpbXVsYXRlZE1pZGRsZXdhcmUSLgoIc2VydmljZXMYBCADKAsyHC5tYW50cmFlLnYxLlNpbXVsYXRlZFNlcnZpY2UqZgoMQ29uZmxpY3RLaW5kEh0KGUNPTkZMSUNUX0tJTkRfVU5TUEVDSUZJRUQQABIaChZDT05GTElDVF9LSU5EX1NIQURPV0VEEAESGwoXQ09ORkxJQ1RfS0lORF9BTUJJR1VPVVMQAjLxBAoNUm91dGVyU2VydmljZRJNCglHZXRSb3V0ZXISHC5tYW50cmFlLnYxLkdldFJvdXRlclJlcXVlc3QaHS5tYW50cmFlLnYxLkdldFJvdXRlclJlc3BvbnNlIgOQAgESUQoMQ3JlYXRlUm91dGVyEh8ubWFudHJhZS52MS5DcmVhdGVSb3V0ZXJSZXF1ZXN0GiAubWFudHJhZS52MS5DcmVhdGVSb3V0ZXJSZXNwb25zZRJRCgxVcGRhdGVSb3V0ZXISHy5tYW50cmFlLnYxLlVwZGF0ZVJvdXRlclJlcXVlc3QaIC5tYW50cmFlLnYxLlVwZGF0ZVJvdXRlclJlc3BvbnNlElEKDERlbGV0ZVJvdXRlchIfLm1hbnRyYWUudjEuRGVsZXRlUm91dGVyUmVxdWVzdBogLm1hbnRyYWUudjEuRGVsZXRlUm91dGVyUmVzcG9uc2USUwoLTGlzdFJvdXRlcnMSHi5tYW50cmFlLnYxLkxpc3RSb3V0ZXJzUmVxdWVzdBofLm1hbnRyYWUudjEuTGlzdFJvdXRlcnNSZXNwb25zZSIDkAIBEmgKEkxpc3RSb3V0ZUNvbmZsaWN0cxIlLm1hbnRyYWUudjEuTGlzdFJvdXRlQ29uZmxpY3RzUmVxdWVzdBomLm1hbnRyYWUudjEuTGlzdFJvdXRlQ29uZmxpY3RzUmVzcG9uc2UiA5ACARJZCg1TaW11bGF0ZVJvdXRlEiAubWFudHJhZS52MS5TaW11bGF0ZVJvdXRlUmVxdWVzdBohLm1hbnRyYWUudjEuU2ltdWxhdGVSb3V0ZVJlc3BvbnNlIgOQAgFCqAEKDmNvbS5tYW50cmFlLnYxQgtSb3V0ZXJQcm90b1ABWkBnaXRodWIuY29tL21penVjaGlsYWJzL21hbnRyYWUvaW50ZXJuYWwvZ2VuL21hbnRyYWUvdjE7bWFudHJhZXYxogIDTVhYqgIKTWFudHJhZS5WMcoCCk1hbnRyYWVcVjHiAhZNYW50cmFlXFYxXEdQQk1ldGFkYXRh6gILTWFudHJhZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_struct, file_google_protobuf_timestamp, file_mantrae_v1_dns_provider, file_mantrae_v1_protocol, file_mantrae_v1_validation]);
 
 /**
  * @generated from message mantrae.v1.Router
@@ -170,6 +172,11 @@ export type CreateRouterResponse = Message<"mantrae.v1.CreateRouterResponse"> &
    * @generated from field: mantrae.v1.Router router = 1;
    */
   router?: Router;
+
+  /**
+   * @generated from field: repeated mantrae.v1.ValidationIssue issues = 2;
+   */
+  issues: ValidationIssue[];
 };
 
 /**
@@ -229,6 +236,11 @@ export type UpdateRouterResponse = Message<"mantrae.v1.UpdateRouterResponse"> &
    * @generated from field: mantrae.v1.Router router = 1;
    */
   router?: Router;
+
+  /**
+   * @generated from field: repeated mantrae.v1.ValidationIssue issues = 2;
+   */
+  issues: ValidationIssue[];
 };
 
 /**
@@ -332,6 +344,288 @@ export type ListRoutersResponse = Message<"mantrae.v1.ListRoutersResponse"> & {
 export const ListRoutersResponseSchema: GenMessage<ListRoutersResponse> = /*@__PURE__*/
   messageDesc(file_mantrae_v1_router, 10);
 
+/**
+ * @generated from message mantrae.v1.RouteConflict
+ */
+export type RouteConflict = Message<"mantrae.v1.RouteConflict"> & {
+  /**
+   * @generated from field: mantrae.v1.ConflictKind kind = 1;
+   */
+  kind: ConflictKind;
+
+  /**
+   * @generated from field: mantrae.v1.ProtocolType type = 2;
+   */
+  type: ProtocolType;
+
+  /**
+   * @generated from field: string router = 3;
+   */
+  router: string;
+
+  /**
+   * @generated from field: string other = 4;
+   */
+  other: string;
+
+  /**
+   * @generated from field: int32 priority = 5;
+   */
+  priority: number;
+
+  /**
+   * @generated from field: int32 other_priority = 6;
+   */
+  otherPriority: number;
+
+  /**
+   * @generated from field: repeated string entry_points = 7;
+   */
+  entryPoints: string[];
+};
+
+/**
+ * Describes the message mantrae.v1.RouteConflict.
+ * Use `create(RouteConflictSchema)` to create a new message.
+ */
+export const RouteConflictSchema: GenMessage<RouteConflict> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_router, 11);
+
+/**
+ * @generated from message mantrae.v1.ListRouteConflictsRequest
+ */
+export type ListRouteConflictsRequest = Message<"mantrae.v1.ListRouteConflictsRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.ListRouteConflictsRequest.
+ * Use `create(ListRouteConflictsRequestSchema)` to create a new message.
+ */
+export const ListRouteConflictsRequestSchema: GenMessage<ListRouteConflictsRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_router, 12);
+
+/**
+ * @generated from message mantrae.v1.ListRouteConflictsResponse
+ */
+export type ListRouteConflictsResponse = Message<"mantrae.v1.ListRouteConflictsResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.RouteConflict conflicts = 1;
+   */
+  conflicts: RouteConflict[];
+};
+
+/**
+ * Describes the message mantrae.v1.ListRouteConflictsResponse.
+ * Use `create(ListRouteConflictsResponseSchema)` to create a new message.
+ */
+export const ListRouteConflictsResponseSchema: GenMessage<ListRouteConflictsResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_router, 13);
+
+/**
+ * @generated from message mantrae.v1.SimulateRouteRequest
+ */
+export type SimulateRouteRequest = Message<"mantrae.v1.SimulateRouteRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string entry_point = 2;
+   */
+  entryPoint: string;
+
+  /**
+   * @generated from field: bool tls = 3;
+   */
+  tls: boolean;
+
+  /**
+   * @generated from field: string method = 4;
+   */
+  method: string;
+
+  /**
+   * @generated from field: string host = 5;
+   */
+  host: string;
+
+  /**
+   * @generated from field: string path = 6;
+   */
+  path: string;
+
+  /**
+   * @generated from field: map<string, string> headers = 7;
+   */
+  headers: { [key: string]: string };
+
+  /**
+   * @generated from field: string client_ip = 8;
+   */
+  clientIp: string;
+};
+
+/**
+ * Describes the message mantrae.v1.SimulateRouteRequest.
+ * Use `create(SimulateRouteRequestSchema)` to create a new message.
+ */
+export const SimulateRouteRequestSchema: GenMessage<SimulateRouteRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_router, 14);
+
+/**
+ * @generated from message mantrae.v1.RouteCandidate
+ */
+export type RouteCandidate = Message<"mantrae.v1.RouteCandidate"> & {
+  /**
+   * @generated from field: string router = 1;
+   */
+  router: string;
+
+  /**
+   * @generated from field: string rule = 2;
+   */
+  rule: string;
+
+  /**
+   * @generated from field: int32 priority = 3;
+   */
+  priority: number;
+
+  /**
+   * @generated from field: bool matches = 4;
+   */
+  matches: boolean;
+
+  /**
+   * @generated from field: string error = 5;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message mantrae.v1.RouteCandidate.
+ * Use `create(RouteCandidateSchema)` to create a new message.
+ */
+export const RouteCandidateSchema: GenMessage<RouteCandidate> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_router, 15);
+
+/**
+ * @generated from message mantrae.v1.SimulatedMiddleware
+ */
+export type SimulatedMiddleware = Message<"mantrae.v1.SimulatedMiddleware"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string service = 3;
+   */
+  service: string;
+};
+
+/**
+ * Describes the message mantrae.v1.SimulatedMiddleware.
+ * Use `create(SimulatedMiddlewareSchema)` to create a new message.
+ */
+export const SimulatedMiddlewareSchema: GenMessage<SimulatedMiddleware> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_router, 16);
+
+/**
+ * @generated from message mantrae.v1.SimulatedService
+ */
+export type SimulatedService = Message<"mantrae.v1.SimulatedService"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * @generated from field: repeated string servers = 3;
+   */
+  servers: string[];
+};
+
+/**
+ * Describes the message mantrae.v1.SimulatedService.
+ * Use `create(SimulatedServiceSchema)` to create a new message.
+ */
+export const SimulatedServiceSchema: GenMessage<SimulatedService> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_router, 17);
+
+/**
+ * @generated from message mantrae.v1.SimulateRouteResponse
+ */
+export type SimulateRouteResponse = Message<"mantrae.v1.SimulateRouteResponse"> & {
+  /**
+   * @generated from field: string router = 1;
+   */
+  router: string;
+
+  /**
+   * @generated from field: repeated mantrae.v1.RouteCandidate candidates = 2;
+   */
+  candidates: RouteCandidate[];
+
+  /**
+   * @generated from field: repeated mantrae.v1.SimulatedMiddleware middlewares = 3;
+   */
+  middlewares: SimulatedMiddleware[];
+
+  /**
+   * @generated from field: repeated mantrae.v1.SimulatedService services = 4;
+   */
+  services: SimulatedService[];
+};
+
+/**
+ * Describes the message mantrae.v1.SimulateRouteResponse.
+ * Use `create(SimulateRouteResponseSchema)` to create a new message.
+ */
+export const SimulateRouteResponseSchema: GenMessage<SimulateRouteResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_router, 18);
+
+/**
+ * @generated from enum mantrae.v1.ConflictKind
+ */
+export enum ConflictKind {
+  /**
+   * @generated from enum value: CONFLICT_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CONFLICT_KIND_SHADOWED = 1;
+   */
+  SHADOWED = 1,
+
+  /**
+   * @generated from enum value: CONFLICT_KIND_AMBIGUOUS = 2;
+   */
+  AMBIGUOUS = 2,
+}
+
+/**
+ * Describes the enum mantrae.v1.ConflictKind.
+ */
+export const ConflictKindSchema: GenEnum<ConflictKind> = /*@__PURE__*/
+  enumDesc(file_mantrae_v1_router, 0);
+
 /**
  * @generated from service mantrae.v1.RouterService
  */
@@ -376,6 +670,22 @@ export const RouterService: GenService<{
     input: typeof ListRoutersRequestSchema;
     output: typeof ListRoutersResponseSchema;
   },
+  /**
+   * @generated from rpc mantrae.v1.RouterService.ListRouteConflicts
+   */
+  listRouteConflicts: {
+    methodKind: "unary";
+    input: typeof ListRouteConflictsRequestSchema;
+    output: typeof ListRouteConflictsResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.RouterService.SimulateRoute
+   */
+  simulateRoute: {
+    methodKind: "unary";
+    input: typeof SimulateRouteRequestSchema;
+    output: typeof SimulateRouteResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mantrae_v1_router, 0);
 
@@ -9,13 +9,15 @@ import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_struct, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { ProtocolType } from "./protocol_pb";
 import { file_mantrae_v1_protocol } from "./protocol_pb";
+import type { ValidationIssue } from "./validation_pb";
+import { file_mantrae_v1_validation } from "./validation_pb";
 import type { JsonObject, Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file mantrae/v1/service.proto.
  */
 export const file_mantrae_v1_service: GenFile = /*@__PURE__*/
-  fileDesc("ChhtYW50cmFlL3YxL3NlcnZpY2UucHJvdG8SCm1hbnRyYWUudjEiiwIKB1NlcnZpY2USCgoCaWQYASABKAkSEgoKcHJvZmlsZV9pZBgCIAEoAxIQCghhZ2VudF9pZBgDIAEoCRIMCgRuYW1lGAQgASgJEg8KB2VuYWJsZWQYBSABKAgSJwoGY29uZmlnGAYgASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdBImCgR0eXBlGAcgASgOMhgubWFudHJhZS52MS5Qcm90b2NvbFR5cGUSLgoKY3JlYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAipwEKEUdldFNlcnZpY2VSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASMAoEdHlwZRgCIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlQgi6SAWCAQIQARIVCgJpZBgDIAEoCUIHukgEcgIQAUgAEhcKBG5hbWUYBCABKAlCB7pIBHICEAFIAEITCgppZGVudGlmaWVyEgW6SAIIASI6ChJHZXRTZXJ2aWNlUmVzcG9uc2USJAoHc2VydmljZRgBIAEoCzITLm1hbnRyYWUudjEuU2VydmljZSLaAQoUQ3JlYXRlU2VydmljZVJlcXVlc3QSGwoKcHJvZmlsZV9pZBgBIAEoA0IHukgEIgIgABIVCghhZ2VudF9pZBgCIAEoCUgAiAEBEhUKBG5hbWUYAyABKAlCB7pIBHICEAESJwoGY29uZmlnGAQgASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdBIPCgdlbmFibGVkGAUgASgIEjAKBHR5cGUYBiABKA4yGC5tYW50cmFlLnYxLlByb3RvY29sVHlwZUIIukgFggECEAFCCwoJX2FnZW50X2lkIj0KFUNyZWF0ZVNlcnZpY2VSZXNwb25zZRIkCgdzZXJ2aWNlGAEgASgLMhMubWFudHJhZS52MS5TZXJ2aWNlIq4BChRVcGRhdGVTZXJ2aWNlUmVxdWVzdBITCgJpZBgBIAEoCUIHukgEcgIQARIVCgRuYW1lGAIgASgJQge6SARyAhABEg8KB2VuYWJsZWQYAyABKAgSJwoGY29uZmlnGAQgASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdBIwCgR0eXBlGAUgASgOMhgubWFudHJhZS52MS5Qcm90b2NvbFR5cGVCCLpIBYIBAhABIj0KFVVwZGF0ZVNlcnZpY2VSZXNwb25zZRIkCgdzZXJ2aWNlGAEgASgLMhMubWFudHJhZS52MS5TZXJ2aWNlIl0KFERlbGV0ZVNlcnZpY2VSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABEjAKBHR5cGUYAiABKA4yGC5tYW50cmFlLnYxLlByb3RvY29sVHlwZUIIukgFggECEAEiFwoVRGVsZXRlU2VydmljZVJlc3BvbnNlIrQCChNMaXN0U2VydmljZXNSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASHgoIYWdlbnRfaWQYAiABKAlCB7pIBHICEAFIAIgBARIrCgR0eXBlGAMgASgOMhgubWFudHJhZS52MS5Qcm90b2NvbFR5cGVIAYgBARJqCgVsaW1pdBgEIAEoA0JWukhTugFQCgtsaW1pdC52YWxpZBIpbGltaXQgbXVzdCBiZSBlaXRoZXIgLTEgb3IgZ3JlYXRlciB0aGFuIDAaFnRoaXMgPT0gLTEgfHwgdGhpcyA+IDBIAogBARIcCgZvZmZzZXQYBSABKANCB7pIBCICKABIA4gBAUILCglfYWdlbnRfaWRCBwoFX3R5cGVCCAoGX2xpbWl0QgkKB19vZmZzZXQiUgoUTGlzdFNlcnZpY2VzUmVzcG9uc2USJQoIc2VydmljZXMYASADKAsyEy5tYW50cmFlLnYxLlNlcnZpY2USEwoLdG90YWxfY291bnQYAiABKAMyvAMKDlNlcnZpY2VTZXJ2aWNlElAKCkdldFNlcnZpY2USHS5tYW50cmFlLnYxLkdldFNlcnZpY2VSZXF1ZXN0Gh4ubWFudHJhZS52MS5HZXRTZXJ2aWNlUmVzcG9uc2UiA5ACARJUCg1DcmVhdGVTZXJ2aWNlEiAubWFudHJhZS52MS5DcmVhdGVTZXJ2aWNlUmVxdWVzdBohLm1hbnRyYWUudjEuQ3JlYXRlU2VydmljZVJlc3BvbnNlElQKDVVwZGF0ZVNlcnZpY2USIC5tYW50cmFlLnYxLlVwZGF0ZVNlcnZpY2VSZXF1ZXN0GiEubWFudHJhZS52MS5VcGRhdGVTZXJ2aWNlUmVzcG9uc2USVAoNRGVsZXRlU2VydmljZRIgLm1hbnRyYWUudjEuRGVsZXRlU2VydmljZVJlcXVlc3QaIS5tYW50cmFlLnYxLkRlbGV0ZVNlcnZpY2VSZXNwb25zZRJWCgxMaXN0U2VydmljZXMSHy5tYW50cmFlLnYxLkxpc3RTZXJ2aWNlc1JlcXVlc3QaIC5tYW50cmFlLnYxLkxpc3RTZXJ2aWNlc1Jlc3BvbnNlIgOQAgFCqQEKDmNvbS5tYW50cmFlLnYxQgxTZXJ2aWNlUHJvdG9QAVpAZ2l0aHViLmNvbS9taXp1Y2hpbGFicy9tYW50cmFlL2ludGVybmFsL2dlbi9tYW50cmFlL3YxO21hbnRyYWV2MaICA01YWKoCCk1hbnRyYWUuVjHKAgpNYW50cmFlXFYx4gIWTWFudHJhZVxWMVxHUEJNZXRhZGF0YeoCC01hbnRyYWU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_struct, file_google_protobuf_timestamp, file_mantrae_v1_protocol]);
+  fileDesc("ChhtYW50cmFlL3YxL3NlcnZpY2UucHJvdG8SCm1hbnRyYWUudjEiiwIKB1NlcnZpY2USCgoCaWQYASABKAkSEgoKcHJvZmlsZV9pZBgCIAEoAxIQCghhZ2VudF9pZBgDIAEoCRIMCgRuYW1lGAQgASgJEg8KB2VuYWJsZWQYBSABKAgSJwoGY29uZmlnGAYgASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdBImCgR0eXBlGAcgASgOMhgubWFudHJhZS52MS5Qcm90b2NvbFR5cGUSLgoKY3JlYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAipwEKEUdldFNlcnZpY2VSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASMAoEdHlwZRgCIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlQgi6SAWCAQIQARIVCgJpZBgDIAEoCUIHukgEcgIQAUgAEhcKBG5hbWUYBCABKAlCB7pIBHICEAFIAEITCgppZGVudGlmaWVyEgW6SAIIASI6ChJHZXRTZXJ2aWNlUmVzcG9uc2USJAoHc2VydmljZRgBIAEoCzITLm1hbnRyYWUudjEuU2VydmljZSLaAQoUQ3JlYXRlU2VydmljZVJlcXVlc3QSGwoKcHJvZmlsZV9pZBgBIAEoA0IHukgEIgIgABIVCghhZ2VudF9pZBgCIAEoCUgAiAEBEhUKBG5hbWUYAyABKAlCB7pIBHICEAESJwoGY29uZmlnGAQgASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdBIPCgdlbmFibGVkGAUgASgIEjAKBHR5cGUYBiABKA4yGC5tYW50cmFlLnYxLlByb3RvY29sVHlwZUIIukgFggECEAFCCwoJX2FnZW50X2lkImoKFUNyZWF0ZVNlcnZpY2VSZXNwb25zZRIkCgdzZXJ2aWNlGAEgASgLMhMubWFudHJhZS52MS5TZXJ2aWNlEisKBmlzc3VlcxgCIAMoCzIbLm1hbnRyYWUudjEuVmFsaWRhdGlvbklzc3VlIq4BChRVcGRhdGVTZXJ2aWNlUmVxdWVzdBITCgJpZBgBIAEoCUIHukgEcgIQARIVCgRuYW1lGAIgASgJQge6SARyAhABEg8KB2VuYWJsZWQYAyABKAgSJwoGY29uZmlnGAQgASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdBIwCgR0eXBlGAUgASgOMhgubWFudHJhZS52MS5Qcm90b2NvbFR5cGVCCLpIBYIBAhABImoKFVVwZGF0ZVNlcnZpY2VSZXNwb25zZRIkCgdzZXJ2aWNlGAEgASgLMhMubWFudHJhZS52MS5TZXJ2aWNlEisKBmlzc3VlcxgCIAMoCzIbLm1hbnRyYWUudjEuVmFsaWRhdGlvbklzc3VlIl0KFERlbGV0ZVNlcnZpY2VSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABEjAKBHR5cGUYAiABKA4yGC5tYW50cmFlLnYxLlByb3RvY29sVHlwZUIIukgFggECEAEiFwoVRGVsZXRlU2VydmljZVJlc3BvbnNlIrQCChNMaXN0U2VydmljZXNSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASHgoIYWdlbnRfaWQYAiABKAlCB7pIBHICEAFIAIgBARIrCgR0eXBlGAMgASgOMhgubWFudHJhZS52MS5Qcm90b2NvbFR5cGVIAYgBARJqCgVsaW1pdBgEIAEoA0JWukhTugFQCgtsaW1pdC52YWxpZBIpbGltaXQgbXVzdCBiZSBlaXRoZXIgLTEgb3IgZ3JlYXRlciB0aGFuIDAaFnRoaXMgPT0gLTEgfHwgdGhpcyA+IDBIAogBARIcCgZvZmZzZXQYBSABKANCB7pIBCICKABIA4gBAUILCglfYWdlbnRfaWRCBwoFX3R5cGVCCAoGX2xpbWl0QgkKB19vZmZzZXQiUgoUTGlzdFNlcnZpY2VzUmVzcG9uc2USJQoIc2VydmljZXMYASADKAsyEy5tYW50cmFlLnYxLlNlcnZpY2USEwoLdG90YWxfY291bnQYAiABKAMigwIKDFNlcnZlckhlYWx0aBIKCgJpZBgBIAEoAxISCgpzZXJ2aWNlX2lkGAIgASgJEiYKBHR5cGUYAyABKA4yGC5tYW50cmFlLnYxLlByb3RvY29sVHlwZRIOCgZzZXJ2ZXIYBCABKAkSDwoHaGVhbHRoeRgFIAEoCBIYCgtzdGF0dXNfY29kZRgGIAEoBUgAiAEBEhIKCmxhdGVuY3lfbXMYByABKAMSEgoFZXJyb3IYCCABKAlIAYgBARIuCgpjaGVja2VkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIOCgxfc3RhdHVzX2NvZGVCCAoGX2Vycm9yIjcKGExpc3RTZXJ2aWNlSGVhbHRoUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAIkYKGUxpc3RTZXJ2aWNlSGVhbHRoUmVzcG9uc2USKQoHc2VydmVycxgBIAMoCzIYLm1hbnRyYWUudjEuU2VydmVySGVhbHRoIoUCCh9MaXN0U2VydmljZUhlYWx0aEhpc3RvcnlSZXF1ZXN0EhsKCnNlcnZpY2VfaWQYASABKAlCB7pIBHICEAESJgoEdHlwZRgCIAEoDjIYLm1hbnRyYWUudjEuUHJvdG9jb2xUeXBlEmoKBWxpbWl0GAMgASgDQla6SFO6AVAKC2xpbWl0LnZhbGlkEilsaW1pdCBtdXN0IGJlIGVpdGhlciAtMSBvciBncmVhdGVyIHRoYW4gMBoWdGhpcyA9PSAtMSB8fCB0aGlzID4gMEgAiAEBEhwKBm9mZnNldBgEIAEoA0IHukgEIgIoAEgBiAEBQggKBl9saW1pdEIJCgdfb2Zmc2V0IkwKIExpc3RTZXJ2aWNlSGVhbHRoSGlzdG9yeVJlc3BvbnNlEigKBmNoZWNrcxgBIAMoCzIYLm1hbnRyYWUudjEuU2VydmVySGVhbHRoMp8FCg5TZXJ2aWNlU2VydmljZRJQCgpHZXRTZXJ2aWNlEh0ubWFudHJhZS52MS5HZXRTZXJ2aWNlUmVxdWVzdBoeLm1hbnRyYWUudjEuR2V0U2VydmljZVJlc3BvbnNlIgOQAgESVAoNQ3JlYXRlU2VydmljZRIgLm1hbnRyYWUudjEuQ3JlYXRlU2VydmljZVJlcXVlc3QaIS5tYW50cmFlLnYxLkNyZWF0ZVNlcnZpY2VSZXNwb25zZRJUCg1VcGRhdGVTZXJ2aWNlEiAubWFudHJhZS52MS5VcGRhdGVTZXJ2aWNlUmVxdWVzdBohLm1hbnRyYWUudjEuVXBkYXRlU2VydmljZVJlc3BvbnNlElQKDURlbGV0ZVNlcnZpY2USIC5tYW50cmFlLnYxLkRlbGV0ZVNlcnZpY2VSZXF1ZXN0GiEubWFudHJhZS52MS5EZWxldGVTZXJ2aWNlUmVzcG9uc2USVgoMTGlzdFNlcnZpY2VzEh8ubWFudHJhZS52MS5MaXN0U2VydmljZXNSZXF1ZXN0GiAubWFudHJhZS52MS5MaXN0U2VydmljZXNSZXNwb25zZSIDkAIBEmUKEUxpc3RTZXJ2aWNlSGVhbHRoEiQubWFudHJhZS52MS5MaXN0U2VydmljZUhlYWx0aFJlcXVlc3QaJS5tYW50cmFlLnYxLkxpc3RTZXJ2aWNlSGVhbHRoUmVzcG9uc2UiA5ACARJ6ChhMaXN0U2VydmljZUhlYWx0aEhpc3RvcnkSKy5tYW50cmFlLnYxLkxpc3RTZXJ2aWNlSGVhbHRoSGlzdG9yeVJlcXVlc3QaLC5tYW50cmFlLnYxLkxpc3RTZXJ2aWNlSGVhbHRoSGlzdG9yeVJlc3BvbnNlIgOQAgFCqQEKDmNvbS5tYW50cmFlLnYxQgxTZXJ2aWNlUHJvdG9QAVpAZ2l0aHViLmNvbS9taXp1Y2hpbGFicy9tYW50cmFlL2ludGVybmFsL2dlbi9tYW50cmFlL3YxO21hbnRyYWV2MaICA01YWKoCCk1hbnRyYWUuVjHKAgpNYW50cmFlXFYx4gIWTWFudHJhZVxWMVxHUEJNZXRhZGF0YeoCC01hbnRyYWU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_struct, file_google_protobuf_timestamp, file_mantrae_v1_protocol, file_mantrae_v1_validation]);
 
 /**
  * @generated from message mantrae.v1.Service
@@ -180,6 +182,11 @@ export type CreateServiceResponse = Message<"mantrae.v1.CreateServiceResponse">
    * @generated from field: mantrae.v1.Service service = 1;
    */
   service?: Service;
+
+  /**
+   * @generated from field: repeated mantrae.v1.ValidationIssue issues = 2;
+   */
+  issues: ValidationIssue[];
 };
 
 /**
@@ -234,6 +241,11 @@ export type UpdateServiceResponse = Message<"mantrae.v1.UpdateServiceResponse">
    * @generated from field: mantrae.v1.Service service = 1;
    */
   service?: Service;
+
+  /**
+   * @generated from field: repeated mantrae.v1.ValidationIssue issues = 2;
+   */
+  issues: ValidationIssue[];
 };
 
 /**
@@ -337,6 +349,146 @@ export type ListServicesResponse = Message<"mantrae.v1.ListServicesResponse"> &
 export const ListServicesResponseSchema: GenMessage<ListServicesResponse> = /*@__PURE__*/
   messageDesc(file_mantrae_v1_service, 10);
 
+/**
+ * @generated from message mantrae.v1.ServerHealth
+ */
+export type ServerHealth = Message<"mantrae.v1.ServerHealth"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string service_id = 2;
+   */
+  serviceId: string;
+
+  /**
+   * @generated from field: mantrae.v1.ProtocolType type = 3;
+   */
+  type: ProtocolType;
+
+  /**
+   * @generated from field: string server = 4;
+   */
+  server: string;
+
+  /**
+   * @generated from field: bool healthy = 5;
+   */
+  healthy: boolean;
+
+  /**
+   * @generated from field: optional int32 status_code = 6;
+   */
+  statusCode?: number;
+
+  /**
+   * @generated from field: int64 latency_ms = 7;
+   */
+  latencyMs: bigint;
+
+  /**
+   * @generated from field: optional string error = 8;
+   */
+  error?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_at = 9;
+   */
+  checkedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mantrae.v1.ServerHealth.
+ * Use `create(ServerHealthSchema)` to create a new message.
+ */
+export const ServerHealthSchema: GenMessage<ServerHealth> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_service, 11);
+
+/**
+ * @generated from message mantrae.v1.ListServiceHealthRequest
+ */
+export type ListServiceHealthRequest = Message<"mantrae.v1.ListServiceHealthRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.ListServiceHealthRequest.
+ * Use `create(ListServiceHealthRequestSchema)` to create a new message.
+ */
+export const ListServiceHealthRequestSchema: GenMessage<ListServiceHealthRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_service, 12);
+
+/**
+ * @generated from message mantrae.v1.ListServiceHealthResponse
+ */
+export type ListServiceHealthResponse = Message<"mantrae.v1.ListServiceHealthResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ServerHealth servers = 1;
+   */
+  servers: ServerHealth[];
+};
+
+/**
+ * Describes the message mantrae.v1.ListServiceHealthResponse.
+ * Use `create(ListServiceHealthResponseSchema)` to create a new message.
+ */
+export const ListServiceHealthResponseSchema: GenMessage<ListServiceHealthResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_service, 13);
+
+/**
+ * @generated from message mantrae.v1.ListServiceHealthHistoryRequest
+ */
+export type ListServiceHealthHistoryRequest = Message<"mantrae.v1.ListServiceHealthHistoryRequest"> & {
+  /**
+   * @generated from field: string service_id = 1;
+   */
+  serviceId: string;
+
+  /**
+   * @generated from field: mantrae.v1.ProtocolType type = 2;
+   */
+  type: ProtocolType;
+
+  /**
+   * @generated from field: optional int64 limit = 3;
+   */
+  limit?: bigint;
+
+  /**
+   * @generated from field: optional int64 offset = 4;
+   */
+  offset?: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.ListServiceHealthHistoryRequest.
+ * Use `create(ListServiceHealthHistoryRequestSchema)` to create a new message.
+ */
+export const ListServiceHealthHistoryRequestSchema: GenMessage<ListServiceHealthHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_service, 14);
+
+/**
+ * @generated from message mantrae.v1.ListServiceHealthHistoryResponse
+ */
+export type ListServiceHealthHistoryResponse = Message<"mantrae.v1.ListServiceHealthHistoryResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ServerHealth checks = 1;
+   */
+  checks: ServerHealth[];
+};
+
+/**
+ * Describes the message mantrae.v1.ListServiceHealthHistoryResponse.
+ * Use `create(ListServiceHealthHistoryResponseSchema)` to create a new message.
+ */
+export const ListServiceHealthHistoryResponseSchema: GenMessage<ListServiceHealthHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_service, 15);
+
 /**
  * @generated from service mantrae.v1.ServiceService
  */
@@ -381,6 +533,22 @@ export const ServiceService: GenService<{
     input: typeof ListServicesRequestSchema;
     output: typeof ListServicesResponseSchema;
   },
+  /**
+   * @generated from rpc mantrae.v1.ServiceService.ListServiceHealth
+   */
+  listServiceHealth: {
+    methodKind: "unary";
+    input: typeof ListServiceHealthRequestSchema;
+    output: typeof ListServiceHealthResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.ServiceService.ListServiceHealthHistory
+   */
+  listServiceHealthHistory: {
+    methodKind: "unary";
+    input: typeof ListServiceHealthHistoryRequestSchema;
+    output: typeof ListServiceHealthHistoryResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mantrae_v1_service, 0);
 
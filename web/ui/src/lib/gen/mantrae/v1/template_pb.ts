@@ -0,0 +1,466 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file mantrae/v1/template.proto (package mantrae.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { ImportItem, ImportStrategy } from "./backup_pb";
+import { file_mantrae_v1_backup } from "./backup_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mantrae/v1/template.proto.
+ */
+export const file_mantrae_v1_template: GenFile = /*@__PURE__*/
+  fileDesc("ChltYW50cmFlL3YxL3RlbXBsYXRlLnByb3RvEgptYW50cmFlLnYxIvIBCg5Db25maWdUZW1wbGF0ZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEi8KCXZhcmlhYmxlcxgEIAMoCzIcLm1hbnRyYWUudjEuVGVtcGxhdGVWYXJpYWJsZRIPCgdjb250ZW50GAUgASgJEg8KB2J1aWx0aW4YBiABKAgSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAieAoQVGVtcGxhdGVWYXJpYWJsZRImCgRuYW1lGAEgASgJQhi6SBVyEzIRXlthLXpdW2EtejAtOV9dKiQSEwoLZGVzY3JpcHRpb24YAiABKAkSFQoNZGVmYXVsdF92YWx1ZRgDIAEoCRIQCghyZXF1aXJlZBgEIAEoCCIWChRMaXN0VGVtcGxhdGVzUmVxdWVzdCJGChVMaXN0VGVtcGxhdGVzUmVzcG9uc2USLQoJdGVtcGxhdGVzGAEgAygLMhoubWFudHJhZS52MS5Db25maWdUZW1wbGF0ZSIrChJHZXRUZW1wbGF0ZVJlcXVlc3QSFQoEbmFtZRgBIAEoCUIHukgEcgIQASJDChNHZXRUZW1wbGF0ZVJlc3BvbnNlEiwKCHRlbXBsYXRlGAEgASgLMhoubWFudHJhZS52MS5Db25maWdUZW1wbGF0ZSKRAQoVQ3JlYXRlVGVtcGxhdGVSZXF1ZXN0EhgKBG5hbWUYASABKAlCCrpIB3IFEAEY/wESEwoLZGVzY3JpcHRpb24YAiABKAkSLwoJdmFyaWFibGVzGAMgAygLMhwubWFudHJhZS52MS5UZW1wbGF0ZVZhcmlhYmxlEhgKB2NvbnRlbnQYBCABKAlCB7pIBHICEAEiRgoWQ3JlYXRlVGVtcGxhdGVSZXNwb25zZRIsCgh0ZW1wbGF0ZRgBIAEoCzIaLm1hbnRyYWUudjEuQ29uZmlnVGVtcGxhdGUipgEKFVVwZGF0ZVRlbXBsYXRlUmVxdWVzdBITCgJpZBgBIAEoCUIHukgEcgIQARIYCgRuYW1lGAIgASgJQgq6SAdyBRABGP8BEhMKC2Rlc2NyaXB0aW9uGAMgASgJEi8KCXZhcmlhYmxlcxgEIAMoCzIcLm1hbnRyYWUudjEuVGVtcGxhdGVWYXJpYWJsZRIYCgdjb250ZW50GAUgASgJQge6SARyAhABIkYKFlVwZGF0ZVRlbXBsYXRlUmVzcG9uc2USLAoIdGVtcGxhdGUYASABKAsyGi5tYW50cmFlLnYxLkNvbmZpZ1RlbXBsYXRlIiwKFURlbGV0ZVRlbXBsYXRlUmVxdWVzdBITCgJpZBgBIAEoCUIHukgEcgIQASIYChZEZWxldGVUZW1wbGF0ZVJlc3BvbnNlIsQBChZQcmV2aWV3VGVtcGxhdGVSZXF1ZXN0EhsKCnByb2ZpbGVfaWQYASABKANCB7pIBCICIAASFQoEbmFtZRgCIAEoCUIHukgEcgIQARJECgl2YXJpYWJsZXMYAyADKAsyMS5tYW50cmFlLnYxLlByZXZpZXdUZW1wbGF0ZVJlcXVlc3QuVmFyaWFibGVzRW50cnkaMAoOVmFyaWFibGVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJRChdQcmV2aWV3VGVtcGxhdGVSZXNwb25zZRIPCgdjb250ZW50GAEgASgJEiUKBWl0ZW1zGAIgAygLMhYubWFudHJhZS52MS5JbXBvcnRJdGVtIpQCChpJbnN0YW50aWF0ZVRlbXBsYXRlUmVxdWVzdBIbCgpwcm9maWxlX2lkGAEgASgDQge6SAQiAiAAEhUKBG5hbWUYAiABKAlCB7pIBHICEAESSAoJdmFyaWFibGVzGAMgAygLMjUubWFudHJhZS52MS5JbnN0YW50aWF0ZVRlbXBsYXRlUmVxdWVzdC5WYXJpYWJsZXNFbnRyeRIsCghzdHJhdGVneRgEIAEoDjIaLm1hbnRyYWUudjEuSW1wb3J0U3RyYXRlZ3kSGAoQZG5zX3Byb3ZpZGVyX2lkcxgFIAMoCRowCg5WYXJpYWJsZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkQKG0luc3RhbnRpYXRlVGVtcGxhdGVSZXNwb25zZRIlCgVpdGVtcxgBIAMoCzIWLm1hbnRyYWUudjEuSW1wb3J0SXRlbTKVBQoPVGVtcGxhdGVTZXJ2aWNlElkKDUxpc3RUZW1wbGF0ZXMSIC5tYW50cmFlLnYxLkxpc3RUZW1wbGF0ZXNSZXF1ZXN0GiEubWFudHJhZS52MS5MaXN0VGVtcGxhdGVzUmVzcG9uc2UiA5ACARJTCgtHZXRUZW1wbGF0ZRIeLm1hbnRyYWUudjEuR2V0VGVtcGxhdGVSZXF1ZXN0Gh8ubWFudHJhZS52MS5HZXRUZW1wbGF0ZVJlc3BvbnNlIgOQAgESVwoOQ3JlYXRlVGVtcGxhdGUSIS5tYW50cmFlLnYxLkNyZWF0ZVRlbXBsYXRlUmVxdWVzdBoiLm1hbnRyYWUudjEuQ3JlYXRlVGVtcGxhdGVSZXNwb25zZRJXCg5VcGRhdGVUZW1wbGF0ZRIhLm1hbnRyYWUudjEuVXBkYXRlVGVtcGxhdGVSZXF1ZXN0GiIubWFudHJhZS52MS5VcGRhdGVUZW1wbGF0ZVJlc3BvbnNlElcKDkRlbGV0ZVRlbXBsYXRlEiEubWFudHJhZS52MS5EZWxldGVUZW1wbGF0ZVJlcXVlc3QaIi5tYW50cmFlLnYxLkRlbGV0ZVRlbXBsYXRlUmVzcG9uc2USXwoPUHJldmlld1RlbXBsYXRlEiIubWFudHJhZS52MS5QcmV2aWV3VGVtcGxhdGVSZXF1ZXN0GiMubWFudHJhZS52MS5QcmV2aWV3VGVtcGxhdGVSZXNwb25zZSIDkAIBEmYKE0luc3RhbnRpYXRlVGVtcGxhdGUSJi5tYW50cmFlLnYxLkluc3RhbnRpYXRlVGVtcGxhdGVSZXF1ZXN0GicubWFudHJhZS52MS5JbnN0YW50aWF0ZVRlbXBsYXRlUmVzcG9uc2VCqgEKDmNvbS5tYW50cmFlLnYxQg1UZW1wbGF0ZVByb3RvUAFaQGdpdGh1Yi5jb20vbWl6dWNoaWxhYnMvbWFudHJhZS9pbnRlcm5hbC9nZW4vbWFudHJhZS92MTttYW50cmFldjGiAgNNWFiqAgpNYW50cmFlLlYxygIKTWFudHJhZVxWMeICFk1hbnRyYWVcVjFcR1BCTWV0YWRhdGHqAgtNYW50cmFlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp, file_mantrae_v1_backup]);
+
+/**
+ * @generated from message mantrae.v1.ConfigTemplate
+ */
+export type ConfigTemplate = Message<"mantrae.v1.ConfigTemplate"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated mantrae.v1.TemplateVariable variables = 4;
+   */
+  variables: TemplateVariable[];
+
+  /**
+   * @generated from field: string content = 5;
+   */
+  content: string;
+
+  /**
+   * @generated from field: bool builtin = 6;
+   */
+  builtin: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mantrae.v1.ConfigTemplate.
+ * Use `create(ConfigTemplateSchema)` to create a new message.
+ */
+export const ConfigTemplateSchema: GenMessage<ConfigTemplate> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 0);
+
+/**
+ * @generated from message mantrae.v1.TemplateVariable
+ */
+export type TemplateVariable = Message<"mantrae.v1.TemplateVariable"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: string default_value = 3;
+   */
+  defaultValue: string;
+
+  /**
+   * @generated from field: bool required = 4;
+   */
+  required: boolean;
+};
+
+/**
+ * Describes the message mantrae.v1.TemplateVariable.
+ * Use `create(TemplateVariableSchema)` to create a new message.
+ */
+export const TemplateVariableSchema: GenMessage<TemplateVariable> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 1);
+
+/**
+ * @generated from message mantrae.v1.ListTemplatesRequest
+ */
+export type ListTemplatesRequest = Message<"mantrae.v1.ListTemplatesRequest"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.ListTemplatesRequest.
+ * Use `create(ListTemplatesRequestSchema)` to create a new message.
+ */
+export const ListTemplatesRequestSchema: GenMessage<ListTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 2);
+
+/**
+ * @generated from message mantrae.v1.ListTemplatesResponse
+ */
+export type ListTemplatesResponse = Message<"mantrae.v1.ListTemplatesResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ConfigTemplate templates = 1;
+   */
+  templates: ConfigTemplate[];
+};
+
+/**
+ * Describes the message mantrae.v1.ListTemplatesResponse.
+ * Use `create(ListTemplatesResponseSchema)` to create a new message.
+ */
+export const ListTemplatesResponseSchema: GenMessage<ListTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 3);
+
+/**
+ * @generated from message mantrae.v1.GetTemplateRequest
+ */
+export type GetTemplateRequest = Message<"mantrae.v1.GetTemplateRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message mantrae.v1.GetTemplateRequest.
+ * Use `create(GetTemplateRequestSchema)` to create a new message.
+ */
+export const GetTemplateRequestSchema: GenMessage<GetTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 4);
+
+/**
+ * @generated from message mantrae.v1.GetTemplateResponse
+ */
+export type GetTemplateResponse = Message<"mantrae.v1.GetTemplateResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.ConfigTemplate template = 1;
+   */
+  template?: ConfigTemplate;
+};
+
+/**
+ * Describes the message mantrae.v1.GetTemplateResponse.
+ * Use `create(GetTemplateResponseSchema)` to create a new message.
+ */
+export const GetTemplateResponseSchema: GenMessage<GetTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 5);
+
+/**
+ * @generated from message mantrae.v1.CreateTemplateRequest
+ */
+export type CreateTemplateRequest = Message<"mantrae.v1.CreateTemplateRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated mantrae.v1.TemplateVariable variables = 3;
+   */
+  variables: TemplateVariable[];
+
+  /**
+   * @generated from field: string content = 4;
+   */
+  content: string;
+};
+
+/**
+ * Describes the message mantrae.v1.CreateTemplateRequest.
+ * Use `create(CreateTemplateRequestSchema)` to create a new message.
+ */
+export const CreateTemplateRequestSchema: GenMessage<CreateTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 6);
+
+/**
+ * @generated from message mantrae.v1.CreateTemplateResponse
+ */
+export type CreateTemplateResponse = Message<"mantrae.v1.CreateTemplateResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.ConfigTemplate template = 1;
+   */
+  template?: ConfigTemplate;
+};
+
+/**
+ * Describes the message mantrae.v1.CreateTemplateResponse.
+ * Use `create(CreateTemplateResponseSchema)` to create a new message.
+ */
+export const CreateTemplateResponseSchema: GenMessage<CreateTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 7);
+
+/**
+ * @generated from message mantrae.v1.UpdateTemplateRequest
+ */
+export type UpdateTemplateRequest = Message<"mantrae.v1.UpdateTemplateRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated mantrae.v1.TemplateVariable variables = 4;
+   */
+  variables: TemplateVariable[];
+
+  /**
+   * @generated from field: string content = 5;
+   */
+  content: string;
+};
+
+/**
+ * Describes the message mantrae.v1.UpdateTemplateRequest.
+ * Use `create(UpdateTemplateRequestSchema)` to create a new message.
+ */
+export const UpdateTemplateRequestSchema: GenMessage<UpdateTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 8);
+
+/**
+ * @generated from message mantrae.v1.UpdateTemplateResponse
+ */
+export type UpdateTemplateResponse = Message<"mantrae.v1.UpdateTemplateResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.ConfigTemplate template = 1;
+   */
+  template?: ConfigTemplate;
+};
+
+/**
+ * Describes the message mantrae.v1.UpdateTemplateResponse.
+ * Use `create(UpdateTemplateResponseSchema)` to create a new message.
+ */
+export const UpdateTemplateResponseSchema: GenMessage<UpdateTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 9);
+
+/**
+ * @generated from message mantrae.v1.DeleteTemplateRequest
+ */
+export type DeleteTemplateRequest = Message<"mantrae.v1.DeleteTemplateRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message mantrae.v1.DeleteTemplateRequest.
+ * Use `create(DeleteTemplateRequestSchema)` to create a new message.
+ */
+export const DeleteTemplateRequestSchema: GenMessage<DeleteTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 10);
+
+/**
+ * @generated from message mantrae.v1.DeleteTemplateResponse
+ */
+export type DeleteTemplateResponse = Message<"mantrae.v1.DeleteTemplateResponse"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.DeleteTemplateResponse.
+ * Use `create(DeleteTemplateResponseSchema)` to create a new message.
+ */
+export const DeleteTemplateResponseSchema: GenMessage<DeleteTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 11);
+
+/**
+ * @generated from message mantrae.v1.PreviewTemplateRequest
+ */
+export type PreviewTemplateRequest = Message<"mantrae.v1.PreviewTemplateRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: map<string, string> variables = 3;
+   */
+  variables: { [key: string]: string };
+};
+
+/**
+ * Describes the message mantrae.v1.PreviewTemplateRequest.
+ * Use `create(PreviewTemplateRequestSchema)` to create a new message.
+ */
+export const PreviewTemplateRequestSchema: GenMessage<PreviewTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 12);
+
+/**
+ * @generated from message mantrae.v1.PreviewTemplateResponse
+ */
+export type PreviewTemplateResponse = Message<"mantrae.v1.PreviewTemplateResponse"> & {
+  /**
+   * @generated from field: string content = 1;
+   */
+  content: string;
+
+  /**
+   * @generated from field: repeated mantrae.v1.ImportItem items = 2;
+   */
+  items: ImportItem[];
+};
+
+/**
+ * Describes the message mantrae.v1.PreviewTemplateResponse.
+ * Use `create(PreviewTemplateResponseSchema)` to create a new message.
+ */
+export const PreviewTemplateResponseSchema: GenMessage<PreviewTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 13);
+
+/**
+ * @generated from message mantrae.v1.InstantiateTemplateRequest
+ */
+export type InstantiateTemplateRequest = Message<"mantrae.v1.InstantiateTemplateRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: map<string, string> variables = 3;
+   */
+  variables: { [key: string]: string };
+
+  /**
+   * @generated from field: mantrae.v1.ImportStrategy strategy = 4;
+   */
+  strategy: ImportStrategy;
+
+  /**
+   * @generated from field: repeated string dns_provider_ids = 5;
+   */
+  dnsProviderIds: string[];
+};
+
+/**
+ * Describes the message mantrae.v1.InstantiateTemplateRequest.
+ * Use `create(InstantiateTemplateRequestSchema)` to create a new message.
+ */
+export const InstantiateTemplateRequestSchema: GenMessage<InstantiateTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 14);
+
+/**
+ * @generated from message mantrae.v1.InstantiateTemplateResponse
+ */
+export type InstantiateTemplateResponse = Message<"mantrae.v1.InstantiateTemplateResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.ImportItem items = 1;
+   */
+  items: ImportItem[];
+};
+
+/**
+ * Describes the message mantrae.v1.InstantiateTemplateResponse.
+ * Use `create(InstantiateTemplateResponseSchema)` to create a new message.
+ */
+export const InstantiateTemplateResponseSchema: GenMessage<InstantiateTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_template, 15);
+
+/**
+ * @generated from service mantrae.v1.TemplateService
+ */
+export const TemplateService: GenService<{
+  /**
+   * @generated from rpc mantrae.v1.TemplateService.ListTemplates
+   */
+  listTemplates: {
+    methodKind: "unary";
+    input: typeof ListTemplatesRequestSchema;
+    output: typeof ListTemplatesResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.TemplateService.GetTemplate
+   */
+  getTemplate: {
+    methodKind: "unary";
+    input: typeof GetTemplateRequestSchema;
+    output: typeof GetTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.TemplateService.CreateTemplate
+   */
+  createTemplate: {
+    methodKind: "unary";
+    input: typeof CreateTemplateRequestSchema;
+    output: typeof CreateTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.TemplateService.UpdateTemplate
+   */
+  updateTemplate: {
+    methodKind: "unary";
+    input: typeof UpdateTemplateRequestSchema;
+    output: typeof UpdateTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.TemplateService.DeleteTemplate
+   */
+  deleteTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteTemplateRequestSchema;
+    output: typeof DeleteTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.TemplateService.PreviewTemplate
+   */
+  previewTemplate: {
+    methodKind: "unary";
+    input: typeof PreviewTemplateRequestSchema;
+    output: typeof PreviewTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.TemplateService.InstantiateTemplate
+   */
+  instantiateTemplate: {
+    methodKind: "unary";
+    input: typeof InstantiateTemplateRequestSchema;
+    output: typeof InstantiateTemplateResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_mantrae_v1_template, 0);
+
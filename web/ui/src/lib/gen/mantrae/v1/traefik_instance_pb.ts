@@ -0,0 +1,271 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file mantrae/v1/traefik_instance.proto (package mantrae.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mantrae/v1/traefik_instance.proto.
+ */
+export const file_mantrae_v1_traefik_instance: GenFile = /*@__PURE__*/
+  fileDesc("CiFtYW50cmFlL3YxL3RyYWVmaWtfaW5zdGFuY2UucHJvdG8SCm1hbnRyYWUudjEirAMKD1RyYWVmaWtJbnN0YW5jZRIKCgJpZBgBIAEoAxISCgpwcm9maWxlX2lkGAIgASgDEgwKBG5hbWUYAyABKAkSCwoDdXJsGAQgASgJEhUKCHJldmlzaW9uGAUgASgDSACIAQESLgoKbGFzdF9mZXRjaBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKAoHcnVudGltZRgHIAMoCzIXLm1hbnRyYWUudjEuUnVudGltZUl0ZW0SGgoNcnVudGltZV9lcnJvchgIIAEoCUgBiAEBEi4KCnJ1bnRpbWVfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiQKBWRyaWZ0GAsgAygLMhUubWFudHJhZS52MS5EcmlmdEl0ZW0SLAoIZHJpZnRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgsKCV9yZXZpc2lvbkIQCg5fcnVudGltZV9lcnJvciJJCgtSdW50aW1lSXRlbRIMCgRraW5kGAEgASgJEgwKBG5hbWUYAiABKAkSDgoGc3RhdHVzGAMgASgJEg4KBmVycm9ycxgEIAMoCSI6ChtMaXN0VHJhZWZpa0luc3RhbmNlc1JlcXVlc3QSGwoKcHJvZmlsZV9pZBgBIAEoA0IHukgEIgIgACJOChxMaXN0VHJhZWZpa0luc3RhbmNlc1Jlc3BvbnNlEi4KCWluc3RhbmNlcxgBIAMoCzIbLm1hbnRyYWUudjEuVHJhZWZpa0luc3RhbmNlIjMKHERlbGV0ZVRyYWVmaWtJbnN0YW5jZVJlcXVlc3QSEwoCaWQYASABKANCB7pIBCICIAAiHwodRGVsZXRlVHJhZWZpa0luc3RhbmNlUmVzcG9uc2UiXwoJRHJpZnRJdGVtEiMKBGtpbmQYASABKA4yFS5tYW50cmFlLnYxLkRyaWZ0S2luZBIPCgdzZWN0aW9uGAIgASgJEgwKBG5hbWUYAyABKAkSDgoGZmllbGRzGAQgAygJKm8KCURyaWZ0S2luZBIaChZEUklGVF9LSU5EX1VOU1BFQ0lGSUVEEAASFgoSRFJJRlRfS0lORF9NSVNTSU5HEAESFAoQRFJJRlRfS0lORF9FWFRSQRACEhgKFERSSUZUX0tJTkRfRElWRVJHRU5UEAMy9gEKFlRyYWVmaWtJbnN0YW5jZVNlcnZpY2USbgoUTGlzdFRyYWVmaWtJbnN0YW5jZXMSJy5tYW50cmFlLnYxLkxpc3RUcmFlZmlrSW5zdGFuY2VzUmVxdWVzdBooLm1hbnRyYWUudjEuTGlzdFRyYWVmaWtJbnN0YW5jZXNSZXNwb25zZSIDkAIBEmwKFURlbGV0ZVRyYWVmaWtJbnN0YW5jZRIoLm1hbnRyYWUudjEuRGVsZXRlVHJhZWZpa0luc3RhbmNlUmVxdWVzdBopLm1hbnRyYWUudjEuRGVsZXRlVHJhZWZpa0luc3RhbmNlUmVzcG9uc2VCsQEKDmNvbS5tYW50cmFlLnYxQhRUcmFlZmlrSW5zdGFuY2VQcm90b1ABWkBnaXRodWIuY29tL21penVjaGlsYWJzL21hbnRyYWUvaW50ZXJuYWwvZ2VuL21hbnRyYWUvdjE7bWFudHJhZXYxogIDTVhYqgIKTWFudHJhZS5WMcoCCk1hbnRyYWVcVjHiAhZNYW50cmFlXFYxXEdQQk1ldGFkYXRh6gILTWFudHJhZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message mantrae.v1.TraefikInstance
+ */
+export type TraefikInstance = Message<"mantrae.v1.TraefikInstance"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: int64 profile_id = 2;
+   */
+  profileId: bigint;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string url = 4;
+   */
+  url: string;
+
+  /**
+   * @generated from field: optional int64 revision = 5;
+   */
+  revision?: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_fetch = 6;
+   */
+  lastFetch?: Timestamp;
+
+  /**
+   * @generated from field: repeated mantrae.v1.RuntimeItem runtime = 7;
+   */
+  runtime: RuntimeItem[];
+
+  /**
+   * @generated from field: optional string runtime_error = 8;
+   */
+  runtimeError?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp runtime_at = 9;
+   */
+  runtimeAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated mantrae.v1.DriftItem drift = 11;
+   */
+  drift: DriftItem[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp drift_at = 12;
+   */
+  driftAt?: Timestamp;
+};
+
+/**
+ * Describes the message mantrae.v1.TraefikInstance.
+ * Use `create(TraefikInstanceSchema)` to create a new message.
+ */
+export const TraefikInstanceSchema: GenMessage<TraefikInstance> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_traefik_instance, 0);
+
+/**
+ * @generated from message mantrae.v1.RuntimeItem
+ */
+export type RuntimeItem = Message<"mantrae.v1.RuntimeItem"> & {
+  /**
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string status = 3;
+   */
+  status: string;
+
+  /**
+   * @generated from field: repeated string errors = 4;
+   */
+  errors: string[];
+};
+
+/**
+ * Describes the message mantrae.v1.RuntimeItem.
+ * Use `create(RuntimeItemSchema)` to create a new message.
+ */
+export const RuntimeItemSchema: GenMessage<RuntimeItem> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_traefik_instance, 1);
+
+/**
+ * @generated from message mantrae.v1.ListTraefikInstancesRequest
+ */
+export type ListTraefikInstancesRequest = Message<"mantrae.v1.ListTraefikInstancesRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.ListTraefikInstancesRequest.
+ * Use `create(ListTraefikInstancesRequestSchema)` to create a new message.
+ */
+export const ListTraefikInstancesRequestSchema: GenMessage<ListTraefikInstancesRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_traefik_instance, 2);
+
+/**
+ * @generated from message mantrae.v1.ListTraefikInstancesResponse
+ */
+export type ListTraefikInstancesResponse = Message<"mantrae.v1.ListTraefikInstancesResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.TraefikInstance instances = 1;
+   */
+  instances: TraefikInstance[];
+};
+
+/**
+ * Describes the message mantrae.v1.ListTraefikInstancesResponse.
+ * Use `create(ListTraefikInstancesResponseSchema)` to create a new message.
+ */
+export const ListTraefikInstancesResponseSchema: GenMessage<ListTraefikInstancesResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_traefik_instance, 3);
+
+/**
+ * @generated from message mantrae.v1.DeleteTraefikInstanceRequest
+ */
+export type DeleteTraefikInstanceRequest = Message<"mantrae.v1.DeleteTraefikInstanceRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message mantrae.v1.DeleteTraefikInstanceRequest.
+ * Use `create(DeleteTraefikInstanceRequestSchema)` to create a new message.
+ */
+export const DeleteTraefikInstanceRequestSchema: GenMessage<DeleteTraefikInstanceRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_traefik_instance, 4);
+
+/**
+ * @generated from message mantrae.v1.DeleteTraefikInstanceResponse
+ */
+export type DeleteTraefikInstanceResponse = Message<"mantrae.v1.DeleteTraefikInstanceResponse"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.DeleteTraefikInstanceResponse.
+ * Use `create(DeleteTraefikInstanceResponseSchema)` to create a new message.
+ */
+export const DeleteTraefikInstanceResponseSchema: GenMessage<DeleteTraefikInstanceResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_traefik_instance, 5);
+
+/**
+ * @generated from message mantrae.v1.DriftItem
+ */
+export type DriftItem = Message<"mantrae.v1.DriftItem"> & {
+  /**
+   * @generated from field: mantrae.v1.DriftKind kind = 1;
+   */
+  kind: DriftKind;
+
+  /**
+   * @generated from field: string section = 2;
+   */
+  section: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string fields = 4;
+   */
+  fields: string[];
+};
+
+/**
+ * Describes the message mantrae.v1.DriftItem.
+ * Use `create(DriftItemSchema)` to create a new message.
+ */
+export const DriftItemSchema: GenMessage<DriftItem> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_traefik_instance, 6);
+
+/**
+ * @generated from enum mantrae.v1.DriftKind
+ */
+export enum DriftKind {
+  /**
+   * @generated from enum value: DRIFT_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DRIFT_KIND_MISSING = 1;
+   */
+  MISSING = 1,
+
+  /**
+   * @generated from enum value: DRIFT_KIND_EXTRA = 2;
+   */
+  EXTRA = 2,
+
+  /**
+   * @generated from enum value: DRIFT_KIND_DIVERGENT = 3;
+   */
+  DIVERGENT = 3,
+}
+
+/**
+ * Describes the enum mantrae.v1.DriftKind.
+ */
+export const DriftKindSchema: GenEnum<DriftKind> = /*@__PURE__*/
+  enumDesc(file_mantrae_v1_traefik_instance, 0);
+
+/**
+ * @generated from service mantrae.v1.TraefikInstanceService
+ */
+export const TraefikInstanceService: GenService<{
+  /**
+   * @generated from rpc mantrae.v1.TraefikInstanceService.ListTraefikInstances
+   */
+  listTraefikInstances: {
+    methodKind: "unary";
+    input: typeof ListTraefikInstancesRequestSchema;
+    output: typeof ListTraefikInstancesResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.TraefikInstanceService.DeleteTraefikInstance
+   */
+  deleteTraefikInstance: {
+    methodKind: "unary";
+    input: typeof DeleteTraefikInstanceRequestSchema;
+    output: typeof DeleteTraefikInstanceResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_mantrae_v1_traefik_instance, 0);
+
@@ -2,8 +2,8 @@
 // @generated from file mantrae/v1/user.proto (package mantrae.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mantrae/v1/user.proto.
  */
 export const file_mantrae_v1_user: GenFile = /*@__PURE__*/
-  fileDesc("ChVtYW50cmFlL3YxL3VzZXIucHJvdG8SCm1hbnRyYWUudjEiwwEKBFVzZXISCgoCaWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSDQoFZW1haWwYAyABKAkSLgoKbGFzdF9sb2dpbhgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAifAoQTG9naW5Vc2VyUmVxdWVzdBIbCgh1c2VybmFtZRgBIAEoCUIHukgEcgIQA0gAEhgKBWVtYWlsGAIgASgJQge6SARyAmABSAASHAoIcGFzc3dvcmQYAyABKAlCCrpIB8gBAXICEAhCEwoKaWRlbnRpZmllchIFukgCCAEiIgoRTG9naW5Vc2VyUmVzcG9uc2USDQoFdG9rZW4YASABKAkiEwoRTG9nb3V0VXNlclJlcXVlc3QiFAoSTG9nb3V0VXNlclJlc3BvbnNlImwKDkdldFVzZXJSZXF1ZXN0EhUKAmlkGAEgASgJQge6SARyAhABSAASGwoIdXNlcm5hbWUYAiABKAlCB7pIBHICEANIABIYCgVlbWFpbBgDIAEoCUIHukgEcgJgAUgAQgwKCmlkZW50aWZpZXIiMQoPR2V0VXNlclJlc3BvbnNlEh4KBHVzZXIYASABKAsyEC5tYW50cmFlLnYxLlVzZXIicwoRQ3JlYXRlVXNlclJlcXVlc3QSGQoIdXNlcm5hbWUYASABKAlCB7pIBHICEAMSGQoIcGFzc3dvcmQYAiABKAlCB7pIBHICEAgSHgoFZW1haWwYAyABKAlCCrpIB9gBAHICYAFIAIgBAUIICgZfZW1haWwiNAoSQ3JlYXRlVXNlclJlc3BvbnNlEh4KBHVzZXIYASABKAsyEC5tYW50cmFlLnYxLlVzZXIinQEKEVVwZGF0ZVVzZXJSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABEhkKCHVzZXJuYW1lGAIgASgJQge6SARyAhADEh4KBWVtYWlsGAMgASgJQgq6SAfYAQByAmABSACIAQESIQoIcGFzc3dvcmQYBCABKAlCCrpIB9gBAHICEAhIAYgBAUIICgZfZW1haWxCCwoJX3Bhc3N3b3JkIjQKElVwZGF0ZVVzZXJSZXNwb25zZRIeCgR1c2VyGAEgASgLMhAubWFudHJhZS52MS5Vc2VyIigKEURlbGV0ZVVzZXJSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABIhQKEkRlbGV0ZVVzZXJSZXNwb25zZSKxAQoQTGlzdFVzZXJzUmVxdWVzdBJqCgVsaW1pdBgBIAEoA0JWukhTugFQCgtsaW1pdC52YWxpZBIpbGltaXQgbXVzdCBiZSBlaXRoZXIgLTEgb3IgZ3JlYXRlciB0aGFuIDAaFnRoaXMgPT0gLTEgfHwgdGhpcyA+IDBIAIgBARIcCgZvZmZzZXQYAiABKANCB7pIBCICKABIAYgBAUIICgZfbGltaXRCCQoHX29mZnNldCJJChFMaXN0VXNlcnNSZXNwb25zZRIfCgV1c2VycxgBIAMoCzIQLm1hbnRyYWUudjEuVXNlchITCgt0b3RhbF9jb3VudBgCIAEoAyIWChRHZXRPSURDU3RhdHVzUmVxdWVzdCJWChVHZXRPSURDU3RhdHVzUmVzcG9uc2USFAoMb2lkY19lbmFibGVkGAEgASgIEhUKDWxvZ2luX2VuYWJsZWQYAiABKAgSEAoIcHJvdmlkZXIYAyABKAky+QQKC1VzZXJTZXJ2aWNlEkgKCUxvZ2luVXNlchIcLm1hbnRyYWUudjEuTG9naW5Vc2VyUmVxdWVzdBodLm1hbnRyYWUudjEuTG9naW5Vc2VyUmVzcG9uc2USSwoKTG9nb3V0VXNlchIdLm1hbnRyYWUudjEuTG9nb3V0VXNlclJlcXVlc3QaHi5tYW50cmFlLnYxLkxvZ291dFVzZXJSZXNwb25zZRJHCgdHZXRVc2VyEhoubWFudHJhZS52MS5HZXRVc2VyUmVxdWVzdBobLm1hbnRyYWUudjEuR2V0VXNlclJlc3BvbnNlIgOQAgESSwoKQ3JlYXRlVXNlchIdLm1hbnRyYWUudjEuQ3JlYXRlVXNlclJlcXVlc3QaHi5tYW50cmFlLnYxLkNyZWF0ZVVzZXJSZXNwb25zZRJLCgpVcGRhdGVVc2VyEh0ubWFudHJhZS52MS5VcGRhdGVVc2VyUmVxdWVzdBoeLm1hbnRyYWUudjEuVXBkYXRlVXNlclJlc3BvbnNlEksKCkRlbGV0ZVVzZXISHS5tYW50cmFlLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0Gh4ubWFudHJhZS52MS5EZWxldGVVc2VyUmVzcG9uc2USTQoJTGlzdFVzZXJzEhwubWFudHJhZS52MS5MaXN0VXNlcnNSZXF1ZXN0Gh0ubWFudHJhZS52MS5MaXN0VXNlcnNSZXNwb25zZSIDkAIBElQKDUdldE9JRENTdGF0dXMSIC5tYW50cmFlLnYxLkdldE9JRENTdGF0dXNSZXF1ZXN0GiEubWFudHJhZS52MS5HZXRPSURDU3RhdHVzUmVzcG9uc2VCpgEKDmNvbS5tYW50cmFlLnYxQglVc2VyUHJvdG9QAVpAZ2l0aHViLmNvbS9taXp1Y2hpbGFicy9tYW50cmFlL2ludGVybmFsL2dlbi9tYW50cmFlL3YxO21hbnRyYWV2MaICA01YWKoCCk1hbnRyYWUuVjHKAgpNYW50cmFlXFYx4gIWTWFudHJhZVxWMVxHUEJNZXRhZGF0YeoCC01hbnRyYWU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+  fileDesc("ChVtYW50cmFlL3YxL3VzZXIucHJvdG8SCm1hbnRyYWUudjEi/wEKBFVzZXISCgoCaWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSDQoFZW1haWwYAyABKAkSLgoKbGFzdF9sb2dpbhgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHgoEcm9sZRgJIAEoDjIQLm1hbnRyYWUudjEuUm9sZRIaChJ0d29fZmFjdG9yX2VuYWJsZWQYCiABKAgifAoQTG9naW5Vc2VyUmVxdWVzdBIbCgh1c2VybmFtZRgBIAEoCUIHukgEcgIQA0gAEhgKBWVtYWlsGAIgASgJQge6SARyAmABSAASHAoIcGFzc3dvcmQYAyABKAlCCrpIB8gBAXICEAhCEwoKaWRlbnRpZmllchIFukgCCAEiewoRTG9naW5Vc2VyUmVzcG9uc2USDQoFdG9rZW4YASABKAkSGwoTdHdvX2ZhY3Rvcl9yZXF1aXJlZBgCIAEoCBIXCg9jaGFsbGVuZ2VfdG9rZW4YAyABKAkSIQoZdHdvX2ZhY3Rvcl9zZXR1cF9yZXF1aXJlZBgEIAEoCCITChFMb2dvdXRVc2VyUmVxdWVzdCIUChJMb2dvdXRVc2VyUmVzcG9uc2UibAoOR2V0VXNlclJlcXVlc3QSFQoCaWQYASABKAlCB7pIBHICEAFIABIbCgh1c2VybmFtZRgCIAEoCUIHukgEcgIQA0gAEhgKBWVtYWlsGAMgASgJQge6SARyAmABSABCDAoKaWRlbnRpZmllciIxCg9HZXRVc2VyUmVzcG9uc2USHgoEdXNlchgBIAEoCzIQLm1hbnRyYWUudjEuVXNlciKdAQoRQ3JlYXRlVXNlclJlcXVlc3QSGQoIdXNlcm5hbWUYASABKAlCB7pIBHICEAMSGQoIcGFzc3dvcmQYAiABKAlCB7pIBHICEAgSHgoFZW1haWwYAyABKAlCCrpIB9gBAHICYAFIAIgBARIoCgRyb2xlGAQgASgOMhAubWFudHJhZS52MS5Sb2xlQgi6SAWCAQIQAUIICgZfZW1haWwiNAoSQ3JlYXRlVXNlclJlc3BvbnNlEh4KBHVzZXIYASABKAsyEC5tYW50cmFlLnYxLlVzZXIinQEKEVVwZGF0ZVVzZXJSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABEhkKCHVzZXJuYW1lGAIgASgJQge6SARyAhADEh4KBWVtYWlsGAMgASgJQgq6SAfYAQByAmABSACIAQESIQoIcGFzc3dvcmQYBCABKAlCCrpIB9gBAHICEAhIAYgBAUIICgZfZW1haWxCCwoJX3Bhc3N3b3JkIjQKElVwZGF0ZVVzZXJSZXNwb25zZRIeCgR1c2VyGAEgASgLMhAubWFudHJhZS52MS5Vc2VyIigKEURlbGV0ZVVzZXJSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABIhQKEkRlbGV0ZVVzZXJSZXNwb25zZSKxAQoQTGlzdFVzZXJzUmVxdWVzdBJqCgVsaW1pdBgBIAEoA0JWukhTugFQCgtsaW1pdC52YWxpZBIpbGltaXQgbXVzdCBiZSBlaXRoZXIgLTEgb3IgZ3JlYXRlciB0aGFuIDAaFnRoaXMgPT0gLTEgfHwgdGhpcyA+IDBIAIgBARIcCgZvZmZzZXQYAiABKANCB7pIBCICKABIAYgBAUIICgZfbGltaXRCCQoHX29mZnNldCJJChFMaXN0VXNlcnNSZXNwb25zZRIfCgV1c2VycxgBIAMoCzIQLm1hbnRyYWUudjEuVXNlchITCgt0b3RhbF9jb3VudBgCIAEoAyIWChRHZXRPSURDU3RhdHVzUmVxdWVzdCJWChVHZXRPSURDU3RhdHVzUmVzcG9uc2USFAoMb2lkY19lbmFibGVkGAEgASgIEhUKDWxvZ2luX2VuYWJsZWQYAiABKAgSEAoIcHJvdmlkZXIYAyABKAkiVgoVVXBkYXRlVXNlclJvbGVSZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABEigKBHJvbGUYAiABKA4yEC5tYW50cmFlLnYxLlJvbGVCCLpIBYIBAhABIjgKFlVwZGF0ZVVzZXJSb2xlUmVzcG9uc2USHgoEdXNlchgBIAEoCzIQLm1hbnRyYWUudjEuVXNlciLWAQoIQVBJVG9rZW4SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIeCgRyb2xlGAMgASgOMhAubWFudHJhZS52MS5Sb2xlEi4KCmV4cGlyZXNfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGxhc3RfdXNlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiiAEKFUNyZWF0ZUFQSVRva2VuUmVxdWVzdBIVCgRuYW1lGAEgASgJQge6SARyAhABEigKBHJvbGUYAiABKA4yEC5tYW50cmFlLnYxLlJvbGVCCLpIBYIBAhABEi4KCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlAKFkNyZWF0ZUFQSVRva2VuUmVzcG9uc2USJwoJYXBpX3Rva2VuGAEgASgLMhQubWFudHJhZS52MS5BUElUb2tlbhINCgV0b2tlbhgCIAEoCSIWChRMaXN0QVBJVG9rZW5zUmVxdWVzdCJBChVMaXN0QVBJVG9rZW5zUmVzcG9uc2USKAoKYXBpX3Rva2VucxgBIAMoCzIULm1hbnRyYWUudjEuQVBJVG9rZW4iLAoVRGVsZXRlQVBJVG9rZW5SZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABIhgKFkRlbGV0ZUFQSVRva2VuUmVzcG9uc2Ui8QEKB1Nlc3Npb24SCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRISCgp1c2VyX2FnZW50GAMgASgJEhIKCmlwX2FkZHJlc3MYBCABKAkSLgoKZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbGFzdF9zZWVuX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdjdXJyZW50GAggASgIIjcKE0xpc3RTZXNzaW9uc1JlcXVlc3QSFAoHdXNlcl9pZBgBIAEoCUgAiAEBQgoKCF91c2VyX2lkIj0KFExpc3RTZXNzaW9uc1Jlc3BvbnNlEiUKCHNlc3Npb25zGAEgAygLMhMubWFudHJhZS52MS5TZXNzaW9uIisKFFJldm9rZVNlc3Npb25SZXF1ZXN0EhMKAmlkGAEgASgJQge6SARyAhABIhcKFVJldm9rZVNlc3Npb25SZXNwb25zZSI8ChhSZXZva2VBbGxTZXNzaW9uc1JlcXVlc3QSFAoHdXNlcl9pZBgBIAEoCUgAiAEBQgoKCF91c2VyX2lkIhsKGVJldm9rZUFsbFNlc3Npb25zUmVzcG9uc2UiVgobVmVyaWZ5VHdvRmFjdG9yTG9naW5SZXF1ZXN0EiAKD2NoYWxsZW5nZV90b2tlbhgBIAEoCUIHukgEcgIQARIVCgRjb2RlGAIgASgJQge6SARyAhAGIi0KHFZlcmlmeVR3b0ZhY3RvckxvZ2luUmVzcG9uc2USDQoFdG9rZW4YASABKAkiFwoVU2V0dXBUd29GYWN0b3JSZXF1ZXN0Ij0KFlNldHVwVHdvRmFjdG9yUmVzcG9uc2USDgoGc2VjcmV0GAEgASgJEhMKC290cGF1dGhfdXJpGAIgASgJIi8KFkVuYWJsZVR3b0ZhY3RvclJlcXVlc3QSFQoEY29kZRgBIAEoCUIHukgEcgIQBiIxChdFbmFibGVUd29GYWN0b3JSZXNwb25zZRIWCg5yZWNvdmVyeV9jb2RlcxgBIAMoCSJXChdEaXNhYmxlVHdvRmFjdG9yUmVxdWVzdBIUCgd1c2VyX2lkGAEgASgJSACIAQESEQoEY29kZRgCIAEoCUgBiAEBQgoKCF91c2VyX2lkQgcKBV9jb2RlIhoKGERpc2FibGVUd29GYWN0b3JSZXNwb25zZSI3Ch5SZWdlbmVyYXRlUmVjb3ZlcnlDb2Rlc1JlcXVlc3QSFQoEY29kZRgBIAEoCUIHukgEcgIQBiI5Ch9SZWdlbmVyYXRlUmVjb3ZlcnlDb2Rlc1Jlc3BvbnNlEhYKDnJlY292ZXJ5X2NvZGVzGAEgAygJKk4KBFJvbGUSFAoQUk9MRV9VTlNQRUNJRklFRBAAEg4KClJPTEVfQURNSU4QARIPCgtST0xFX0VESVRPUhACEg8KC1JPTEVfVklFV0VSEAMy4g0KC1VzZXJTZXJ2aWNlEkgKCUxvZ2luVXNlchIcLm1hbnRyYWUudjEuTG9naW5Vc2VyUmVxdWVzdBodLm1hbnRyYWUudjEuTG9naW5Vc2VyUmVzcG9uc2USSwoKTG9nb3V0VXNlchIdLm1hbnRyYWUudjEuTG9nb3V0VXNlclJlcXVlc3QaHi5tYW50cmFlLnYxLkxvZ291dFVzZXJSZXNwb25zZRJHCgdHZXRVc2VyEhoubWFudHJhZS52MS5HZXRVc2VyUmVxdWVzdBobLm1hbnRyYWUudjEuR2V0VXNlclJlc3BvbnNlIgOQAgESSwoKQ3JlYXRlVXNlchIdLm1hbnRyYWUudjEuQ3JlYXRlVXNlclJlcXVlc3QaHi5tYW50cmFlLnYxLkNyZWF0ZVVzZXJSZXNwb25zZRJLCgpVcGRhdGVVc2VyEh0ubWFudHJhZS52MS5VcGRhdGVVc2VyUmVxdWVzdBoeLm1hbnRyYWUudjEuVXBkYXRlVXNlclJlc3BvbnNlEksKCkRlbGV0ZVVzZXISHS5tYW50cmFlLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0Gh4ubWFudHJhZS52MS5EZWxldGVVc2VyUmVzcG9uc2USTQoJTGlzdFVzZXJzEhwubWFudHJhZS52MS5MaXN0VXNlcnNSZXF1ZXN0Gh0ubWFudHJhZS52MS5MaXN0VXNlcnNSZXNwb25zZSIDkAIBElQKDUdldE9JRENTdGF0dXMSIC5tYW50cmFlLnYxLkdldE9JRENTdGF0dXNSZXF1ZXN0GiEubWFudHJhZS52MS5HZXRPSURDU3RhdHVzUmVzcG9uc2USVwoOVXBkYXRlVXNlclJvbGUSIS5tYW50cmFlLnYxLlVwZGF0ZVVzZXJSb2xlUmVxdWVzdBoiLm1hbnRyYWUudjEuVXBkYXRlVXNlclJvbGVSZXNwb25zZRJXCg5DcmVhdGVBUElUb2tlbhIhLm1hbnRyYWUudjEuQ3JlYXRlQVBJVG9rZW5SZXF1ZXN0GiIubWFudHJhZS52MS5DcmVhdGVBUElUb2tlblJlc3BvbnNlElkKDUxpc3RBUElUb2tlbnMSIC5tYW50cmFlLnYxLkxpc3RBUElUb2tlbnNSZXF1ZXN0GiEubWFudHJhZS52MS5MaXN0QVBJVG9rZW5zUmVzcG9uc2UiA5ACARJXCg5EZWxldGVBUElUb2tlbhIhLm1hbnRyYWUudjEuRGVsZXRlQVBJVG9rZW5SZXF1ZXN0GiIubWFudHJhZS52MS5EZWxldGVBUElUb2tlblJlc3BvbnNlElYKDExpc3RTZXNzaW9ucxIfLm1hbnRyYWUudjEuTGlzdFNlc3Npb25zUmVxdWVzdBogLm1hbnRyYWUudjEuTGlzdFNlc3Npb25zUmVzcG9uc2UiA5ACARJUCg1SZXZva2VTZXNzaW9uEiAubWFudHJhZS52MS5SZXZva2VTZXNzaW9uUmVxdWVzdBohLm1hbnRyYWUudjEuUmV2b2tlU2Vzc2lvblJlc3BvbnNlEmAKEVJldm9rZUFsbFNlc3Npb25zEiQubWFudHJhZS52MS5SZXZva2VBbGxTZXNzaW9uc1JlcXVlc3QaJS5tYW50cmFlLnYxLlJldm9rZUFsbFNlc3Npb25zUmVzcG9uc2USaQoUVmVyaWZ5VHdvRmFjdG9yTG9naW4SJy5tYW50cmFlLnYxLlZlcmlmeVR3b0ZhY3RvckxvZ2luUmVxdWVzdBooLm1hbnRyYWUudjEuVmVyaWZ5VHdvRmFjdG9yTG9naW5SZXNwb25zZRJXCg5TZXR1cFR3b0ZhY3RvchIhLm1hbnRyYWUudjEuU2V0dXBUd29GYWN0b3JSZXF1ZXN0GiIubWFudHJhZS52MS5TZXR1cFR3b0ZhY3RvclJlc3BvbnNlEloKD0VuYWJsZVR3b0ZhY3RvchIiLm1hbnRyYWUudjEuRW5hYmxlVHdvRmFjdG9yUmVxdWVzdBojLm1hbnRyYWUudjEuRW5hYmxlVHdvRmFjdG9yUmVzcG9uc2USXQoQRGlzYWJsZVR3b0ZhY3RvchIjLm1hbnRyYWUudjEuRGlzYWJsZVR3b0ZhY3RvclJlcXVlc3QaJC5tYW50cmFlLnYxLkRpc2FibGVUd29GYWN0b3JSZXNwb25zZRJyChdSZWdlbmVyYXRlUmVjb3ZlcnlDb2RlcxIqLm1hbnRyYWUudjEuUmVnZW5lcmF0ZVJlY292ZXJ5Q29kZXNSZXF1ZXN0GisubWFudHJhZS52MS5SZWdlbmVyYXRlUmVjb3ZlcnlDb2Rlc1Jlc3BvbnNlQqYBCg5jb20ubWFudHJhZS52MUIJVXNlclByb3RvUAFaQGdpdGh1Yi5jb20vbWl6dWNoaWxhYnMvbWFudHJhZS9pbnRlcm5hbC9nZW4vbWFudHJhZS92MTttYW50cmFldjGiAgNNWFiqAgpNYW50cmFlLlYxygIKTWFudHJhZVxWMeICFk1hbnRyYWVcVjFcR1BCTWV0YWRhdGHqAgtNYW50cmFlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message mantrae.v1.User
@@ -48,6 +48,16 @@ export type User = Message<"mantrae.v1.User"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 8;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: mantrae.v1.Role role = 9;
+   */
+  role: Role;
+
+  /**
+   * @generated from field: bool two_factor_enabled = 10;
+   */
+  twoFactorEnabled: boolean;
 };
 
 /**
@@ -99,6 +109,21 @@ export type LoginUserResponse = Message<"mantrae.v1.LoginUserResponse"> & {
    * @generated from field: string token = 1;
    */
   token: string;
+
+  /**
+   * @generated from field: bool two_factor_required = 2;
+   */
+  twoFactorRequired: boolean;
+
+  /**
+   * @generated from field: string challenge_token = 3;
+   */
+  challengeToken: string;
+
+  /**
+   * @generated from field: bool two_factor_setup_required = 4;
+   */
+  twoFactorSetupRequired: boolean;
 };
 
 /**
@@ -204,6 +229,11 @@ export type CreateUserRequest = Message<"mantrae.v1.CreateUserRequest"> & {
    * @generated from field: optional string email = 3;
    */
   email?: string;
+
+  /**
+   * @generated from field: mantrae.v1.Role role = 4;
+   */
+  role: Role;
 };
 
 /**
@@ -393,6 +423,550 @@ export type GetOIDCStatusResponse = Message<"mantrae.v1.GetOIDCStatusResponse">
 export const GetOIDCStatusResponseSchema: GenMessage<GetOIDCStatusResponse> = /*@__PURE__*/
   messageDesc(file_mantrae_v1_user, 16);
 
+/**
+ * @generated from message mantrae.v1.UpdateUserRoleRequest
+ */
+export type UpdateUserRoleRequest = Message<"mantrae.v1.UpdateUserRoleRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: mantrae.v1.Role role = 2;
+   */
+  role: Role;
+};
+
+/**
+ * Describes the message mantrae.v1.UpdateUserRoleRequest.
+ * Use `create(UpdateUserRoleRequestSchema)` to create a new message.
+ */
+export const UpdateUserRoleRequestSchema: GenMessage<UpdateUserRoleRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 17);
+
+/**
+ * @generated from message mantrae.v1.UpdateUserRoleResponse
+ */
+export type UpdateUserRoleResponse = Message<"mantrae.v1.UpdateUserRoleResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message mantrae.v1.UpdateUserRoleResponse.
+ * Use `create(UpdateUserRoleResponseSchema)` to create a new message.
+ */
+export const UpdateUserRoleResponseSchema: GenMessage<UpdateUserRoleResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 18);
+
+/**
+ * @generated from message mantrae.v1.APIToken
+ */
+export type APIToken = Message<"mantrae.v1.APIToken"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: mantrae.v1.Role role = 3;
+   */
+  role: Role;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 4;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 5;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message mantrae.v1.APIToken.
+ * Use `create(APITokenSchema)` to create a new message.
+ */
+export const APITokenSchema: GenMessage<APIToken> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 19);
+
+/**
+ * @generated from message mantrae.v1.CreateAPITokenRequest
+ */
+export type CreateAPITokenRequest = Message<"mantrae.v1.CreateAPITokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: mantrae.v1.Role role = 2;
+   */
+  role: Role;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message mantrae.v1.CreateAPITokenRequest.
+ * Use `create(CreateAPITokenRequestSchema)` to create a new message.
+ */
+export const CreateAPITokenRequestSchema: GenMessage<CreateAPITokenRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 20);
+
+/**
+ * @generated from message mantrae.v1.CreateAPITokenResponse
+ */
+export type CreateAPITokenResponse = Message<"mantrae.v1.CreateAPITokenResponse"> & {
+  /**
+   * @generated from field: mantrae.v1.APIToken api_token = 1;
+   */
+  apiToken?: APIToken;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message mantrae.v1.CreateAPITokenResponse.
+ * Use `create(CreateAPITokenResponseSchema)` to create a new message.
+ */
+export const CreateAPITokenResponseSchema: GenMessage<CreateAPITokenResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 21);
+
+/**
+ * @generated from message mantrae.v1.ListAPITokensRequest
+ */
+export type ListAPITokensRequest = Message<"mantrae.v1.ListAPITokensRequest"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.ListAPITokensRequest.
+ * Use `create(ListAPITokensRequestSchema)` to create a new message.
+ */
+export const ListAPITokensRequestSchema: GenMessage<ListAPITokensRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 22);
+
+/**
+ * @generated from message mantrae.v1.ListAPITokensResponse
+ */
+export type ListAPITokensResponse = Message<"mantrae.v1.ListAPITokensResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.APIToken api_tokens = 1;
+   */
+  apiTokens: APIToken[];
+};
+
+/**
+ * Describes the message mantrae.v1.ListAPITokensResponse.
+ * Use `create(ListAPITokensResponseSchema)` to create a new message.
+ */
+export const ListAPITokensResponseSchema: GenMessage<ListAPITokensResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 23);
+
+/**
+ * @generated from message mantrae.v1.DeleteAPITokenRequest
+ */
+export type DeleteAPITokenRequest = Message<"mantrae.v1.DeleteAPITokenRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message mantrae.v1.DeleteAPITokenRequest.
+ * Use `create(DeleteAPITokenRequestSchema)` to create a new message.
+ */
+export const DeleteAPITokenRequestSchema: GenMessage<DeleteAPITokenRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 24);
+
+/**
+ * @generated from message mantrae.v1.DeleteAPITokenResponse
+ */
+export type DeleteAPITokenResponse = Message<"mantrae.v1.DeleteAPITokenResponse"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.DeleteAPITokenResponse.
+ * Use `create(DeleteAPITokenResponseSchema)` to create a new message.
+ */
+export const DeleteAPITokenResponseSchema: GenMessage<DeleteAPITokenResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 25);
+
+/**
+ * @generated from message mantrae.v1.Session
+ */
+export type Session = Message<"mantrae.v1.Session"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string user_agent = 3;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: string ip_address = 4;
+   */
+  ipAddress: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 6;
+   */
+  lastSeenAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: bool current = 8;
+   */
+  current: boolean;
+};
+
+/**
+ * Describes the message mantrae.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema: GenMessage<Session> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 26);
+
+/**
+ * @generated from message mantrae.v1.ListSessionsRequest
+ */
+export type ListSessionsRequest = Message<"mantrae.v1.ListSessionsRequest"> & {
+  /**
+   * @generated from field: optional string user_id = 1;
+   */
+  userId?: string;
+};
+
+/**
+ * Describes the message mantrae.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export const ListSessionsRequestSchema: GenMessage<ListSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 27);
+
+/**
+ * @generated from message mantrae.v1.ListSessionsResponse
+ */
+export type ListSessionsResponse = Message<"mantrae.v1.ListSessionsResponse"> & {
+  /**
+   * @generated from field: repeated mantrae.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+};
+
+/**
+ * Describes the message mantrae.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export const ListSessionsResponseSchema: GenMessage<ListSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 28);
+
+/**
+ * @generated from message mantrae.v1.RevokeSessionRequest
+ */
+export type RevokeSessionRequest = Message<"mantrae.v1.RevokeSessionRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message mantrae.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export const RevokeSessionRequestSchema: GenMessage<RevokeSessionRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 29);
+
+/**
+ * @generated from message mantrae.v1.RevokeSessionResponse
+ */
+export type RevokeSessionResponse = Message<"mantrae.v1.RevokeSessionResponse"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export const RevokeSessionResponseSchema: GenMessage<RevokeSessionResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 30);
+
+/**
+ * @generated from message mantrae.v1.RevokeAllSessionsRequest
+ */
+export type RevokeAllSessionsRequest = Message<"mantrae.v1.RevokeAllSessionsRequest"> & {
+  /**
+   * @generated from field: optional string user_id = 1;
+   */
+  userId?: string;
+};
+
+/**
+ * Describes the message mantrae.v1.RevokeAllSessionsRequest.
+ * Use `create(RevokeAllSessionsRequestSchema)` to create a new message.
+ */
+export const RevokeAllSessionsRequestSchema: GenMessage<RevokeAllSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 31);
+
+/**
+ * @generated from message mantrae.v1.RevokeAllSessionsResponse
+ */
+export type RevokeAllSessionsResponse = Message<"mantrae.v1.RevokeAllSessionsResponse"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.RevokeAllSessionsResponse.
+ * Use `create(RevokeAllSessionsResponseSchema)` to create a new message.
+ */
+export const RevokeAllSessionsResponseSchema: GenMessage<RevokeAllSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 32);
+
+/**
+ * @generated from message mantrae.v1.VerifyTwoFactorLoginRequest
+ */
+export type VerifyTwoFactorLoginRequest = Message<"mantrae.v1.VerifyTwoFactorLoginRequest"> & {
+  /**
+   * @generated from field: string challenge_token = 1;
+   */
+  challengeToken: string;
+
+  /**
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message mantrae.v1.VerifyTwoFactorLoginRequest.
+ * Use `create(VerifyTwoFactorLoginRequestSchema)` to create a new message.
+ */
+export const VerifyTwoFactorLoginRequestSchema: GenMessage<VerifyTwoFactorLoginRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 33);
+
+/**
+ * @generated from message mantrae.v1.VerifyTwoFactorLoginResponse
+ */
+export type VerifyTwoFactorLoginResponse = Message<"mantrae.v1.VerifyTwoFactorLoginResponse"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message mantrae.v1.VerifyTwoFactorLoginResponse.
+ * Use `create(VerifyTwoFactorLoginResponseSchema)` to create a new message.
+ */
+export const VerifyTwoFactorLoginResponseSchema: GenMessage<VerifyTwoFactorLoginResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 34);
+
+/**
+ * @generated from message mantrae.v1.SetupTwoFactorRequest
+ */
+export type SetupTwoFactorRequest = Message<"mantrae.v1.SetupTwoFactorRequest"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.SetupTwoFactorRequest.
+ * Use `create(SetupTwoFactorRequestSchema)` to create a new message.
+ */
+export const SetupTwoFactorRequestSchema: GenMessage<SetupTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 35);
+
+/**
+ * @generated from message mantrae.v1.SetupTwoFactorResponse
+ */
+export type SetupTwoFactorResponse = Message<"mantrae.v1.SetupTwoFactorResponse"> & {
+  /**
+   * @generated from field: string secret = 1;
+   */
+  secret: string;
+
+  /**
+   * @generated from field: string otpauth_uri = 2;
+   */
+  otpauthUri: string;
+};
+
+/**
+ * Describes the message mantrae.v1.SetupTwoFactorResponse.
+ * Use `create(SetupTwoFactorResponseSchema)` to create a new message.
+ */
+export const SetupTwoFactorResponseSchema: GenMessage<SetupTwoFactorResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 36);
+
+/**
+ * @generated from message mantrae.v1.EnableTwoFactorRequest
+ */
+export type EnableTwoFactorRequest = Message<"mantrae.v1.EnableTwoFactorRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message mantrae.v1.EnableTwoFactorRequest.
+ * Use `create(EnableTwoFactorRequestSchema)` to create a new message.
+ */
+export const EnableTwoFactorRequestSchema: GenMessage<EnableTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 37);
+
+/**
+ * @generated from message mantrae.v1.EnableTwoFactorResponse
+ */
+export type EnableTwoFactorResponse = Message<"mantrae.v1.EnableTwoFactorResponse"> & {
+  /**
+   * @generated from field: repeated string recovery_codes = 1;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message mantrae.v1.EnableTwoFactorResponse.
+ * Use `create(EnableTwoFactorResponseSchema)` to create a new message.
+ */
+export const EnableTwoFactorResponseSchema: GenMessage<EnableTwoFactorResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 38);
+
+/**
+ * @generated from message mantrae.v1.DisableTwoFactorRequest
+ */
+export type DisableTwoFactorRequest = Message<"mantrae.v1.DisableTwoFactorRequest"> & {
+  /**
+   * @generated from field: optional string user_id = 1;
+   */
+  userId?: string;
+
+  /**
+   * @generated from field: optional string code = 2;
+   */
+  code?: string;
+};
+
+/**
+ * Describes the message mantrae.v1.DisableTwoFactorRequest.
+ * Use `create(DisableTwoFactorRequestSchema)` to create a new message.
+ */
+export const DisableTwoFactorRequestSchema: GenMessage<DisableTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 39);
+
+/**
+ * @generated from message mantrae.v1.DisableTwoFactorResponse
+ */
+export type DisableTwoFactorResponse = Message<"mantrae.v1.DisableTwoFactorResponse"> & {
+};
+
+/**
+ * Describes the message mantrae.v1.DisableTwoFactorResponse.
+ * Use `create(DisableTwoFactorResponseSchema)` to create a new message.
+ */
+export const DisableTwoFactorResponseSchema: GenMessage<DisableTwoFactorResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 40);
+
+/**
+ * @generated from message mantrae.v1.RegenerateRecoveryCodesRequest
+ */
+export type RegenerateRecoveryCodesRequest = Message<"mantrae.v1.RegenerateRecoveryCodesRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message mantrae.v1.RegenerateRecoveryCodesRequest.
+ * Use `create(RegenerateRecoveryCodesRequestSchema)` to create a new message.
+ */
+export const RegenerateRecoveryCodesRequestSchema: GenMessage<RegenerateRecoveryCodesRequest> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 41);
+
+/**
+ * @generated from message mantrae.v1.RegenerateRecoveryCodesResponse
+ */
+export type RegenerateRecoveryCodesResponse = Message<"mantrae.v1.RegenerateRecoveryCodesResponse"> & {
+  /**
+   * @generated from field: repeated string recovery_codes = 1;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message mantrae.v1.RegenerateRecoveryCodesResponse.
+ * Use `create(RegenerateRecoveryCodesResponseSchema)` to create a new message.
+ */
+export const RegenerateRecoveryCodesResponseSchema: GenMessage<RegenerateRecoveryCodesResponse> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_user, 42);
+
+/**
+ * @generated from enum mantrae.v1.Role
+ */
+export enum Role {
+  /**
+   * @generated from enum value: ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ROLE_ADMIN = 1;
+   */
+  ADMIN = 1,
+
+  /**
+   * @generated from enum value: ROLE_EDITOR = 2;
+   */
+  EDITOR = 2,
+
+  /**
+   * @generated from enum value: ROLE_VIEWER = 3;
+   */
+  VIEWER = 3,
+}
+
+/**
+ * Describes the enum mantrae.v1.Role.
+ */
+export const RoleSchema: GenEnum<Role> = /*@__PURE__*/
+  enumDesc(file_mantrae_v1_user, 0);
+
 /**
  * @generated from service mantrae.v1.UserService
  */
@@ -461,6 +1035,102 @@ export const UserService: GenService<{
     input: typeof GetOIDCStatusRequestSchema;
     output: typeof GetOIDCStatusResponseSchema;
   },
+  /**
+   * @generated from rpc mantrae.v1.UserService.UpdateUserRole
+   */
+  updateUserRole: {
+    methodKind: "unary";
+    input: typeof UpdateUserRoleRequestSchema;
+    output: typeof UpdateUserRoleResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.CreateAPIToken
+   */
+  createAPIToken: {
+    methodKind: "unary";
+    input: typeof CreateAPITokenRequestSchema;
+    output: typeof CreateAPITokenResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.ListAPITokens
+   */
+  listAPITokens: {
+    methodKind: "unary";
+    input: typeof ListAPITokensRequestSchema;
+    output: typeof ListAPITokensResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.DeleteAPIToken
+   */
+  deleteAPIToken: {
+    methodKind: "unary";
+    input: typeof DeleteAPITokenRequestSchema;
+    output: typeof DeleteAPITokenResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.ListSessions
+   */
+  listSessions: {
+    methodKind: "unary";
+    input: typeof ListSessionsRequestSchema;
+    output: typeof ListSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.RevokeSession
+   */
+  revokeSession: {
+    methodKind: "unary";
+    input: typeof RevokeSessionRequestSchema;
+    output: typeof RevokeSessionResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.RevokeAllSessions
+   */
+  revokeAllSessions: {
+    methodKind: "unary";
+    input: typeof RevokeAllSessionsRequestSchema;
+    output: typeof RevokeAllSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.VerifyTwoFactorLogin
+   */
+  verifyTwoFactorLogin: {
+    methodKind: "unary";
+    input: typeof VerifyTwoFactorLoginRequestSchema;
+    output: typeof VerifyTwoFactorLoginResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.SetupTwoFactor
+   */
+  setupTwoFactor: {
+    methodKind: "unary";
+    input: typeof SetupTwoFactorRequestSchema;
+    output: typeof SetupTwoFactorResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.EnableTwoFactor
+   */
+  enableTwoFactor: {
+    methodKind: "unary";
+    input: typeof EnableTwoFactorRequestSchema;
+    output: typeof EnableTwoFactorResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.DisableTwoFactor
+   */
+  disableTwoFactor: {
+    methodKind: "unary";
+    input: typeof DisableTwoFactorRequestSchema;
+    output: typeof DisableTwoFactorResponseSchema;
+  },
+  /**
+   * @generated from rpc mantrae.v1.UserService.RegenerateRecoveryCodes
+   */
+  regenerateRecoveryCodes: {
+    methodKind: "unary";
+    input: typeof RegenerateRecoveryCodesRequestSchema;
+    output: typeof RegenerateRecoveryCodesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mantrae_v1_user, 0);
 
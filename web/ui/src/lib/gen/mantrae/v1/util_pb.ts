@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mantrae/v1/util.proto.
  */
 export const file_mantrae_v1_util: GenFile = /*@__PURE__*/
-  fileDesc("ChVtYW50cmFlL3YxL3V0aWwucHJvdG8SCm1hbnRyYWUudjEiEwoRR2V0VmVyc2lvblJlcXVlc3QiJQoSR2V0VmVyc2lvblJlc3BvbnNlEg8KB3ZlcnNpb24YASABKAkiLQoXR2V0RHluYW1pY0NvbmZpZ1JlcXVlc3QSEgoKcHJvZmlsZV9pZBgBIAEoAyIqChhHZXREeW5hbWljQ29uZmlnUmVzcG9uc2USDgoGY29uZmlnGAEgASgJIhQKEkdldFB1YmxpY0lQUmVxdWVzdCIxChNHZXRQdWJsaWNJUFJlc3BvbnNlEgwKBGlwdjQYASABKAkSDAoEaXB2NhgCIAEoCTKJAgoLVXRpbFNlcnZpY2USSwoKR2V0VmVyc2lvbhIdLm1hbnRyYWUudjEuR2V0VmVyc2lvblJlcXVlc3QaHi5tYW50cmFlLnYxLkdldFZlcnNpb25SZXNwb25zZRJdChBHZXREeW5hbWljQ29uZmlnEiMubWFudHJhZS52MS5HZXREeW5hbWljQ29uZmlnUmVxdWVzdBokLm1hbnRyYWUudjEuR2V0RHluYW1pY0NvbmZpZ1Jlc3BvbnNlEk4KC0dldFB1YmxpY0lQEh4ubWFudHJhZS52MS5HZXRQdWJsaWNJUFJlcXVlc3QaHy5tYW50cmFlLnYxLkdldFB1YmxpY0lQUmVzcG9uc2VCpgEKDmNvbS5tYW50cmFlLnYxQglVdGlsUHJvdG9QAVpAZ2l0aHViLmNvbS9taXp1Y2hpbGFicy9tYW50cmFlL2ludGVybmFsL2dlbi9tYW50cmFlL3YxO21hbnRyYWV2MaICA01YWKoCCk1hbnRyYWUuVjHKAgpNYW50cmFlXFYx4gIWTWFudHJhZVxWMVxHUEJNZXRhZGF0YeoCC01hbnRyYWU6OlYxYgZwcm90bzM");
+  fileDesc("ChVtYW50cmFlL3YxL3V0aWwucHJvdG8SCm1hbnRyYWUudjEiEwoRR2V0VmVyc2lvblJlcXVlc3QiJQoSR2V0VmVyc2lvblJlc3BvbnNlEg8KB3ZlcnNpb24YASABKAkiPQoXR2V0RHluYW1pY0NvbmZpZ1JlcXVlc3QSEgoKcHJvZmlsZV9pZBgBIAEoAxIOCgZmb3JtYXQYAiABKAkiKgoYR2V0RHluYW1pY0NvbmZpZ1Jlc3BvbnNlEg4KBmNvbmZpZxgBIAEoCSIUChJHZXRQdWJsaWNJUFJlcXVlc3QiMQoTR2V0UHVibGljSVBSZXNwb25zZRIMCgRpcHY0GAEgASgJEgwKBGlwdjYYAiABKAkyiQIKC1V0aWxTZXJ2aWNlEksKCkdldFZlcnNpb24SHS5tYW50cmFlLnYxLkdldFZlcnNpb25SZXF1ZXN0Gh4ubWFudHJhZS52MS5HZXRWZXJzaW9uUmVzcG9uc2USXQoQR2V0RHluYW1pY0NvbmZpZxIjLm1hbnRyYWUudjEuR2V0RHluYW1pY0NvbmZpZ1JlcXVlc3QaJC5tYW50cmFlLnYxLkdldER5bmFtaWNDb25maWdSZXNwb25zZRJOCgtHZXRQdWJsaWNJUBIeLm1hbnRyYWUudjEuR2V0UHVibGljSVBSZXF1ZXN0Gh8ubWFudHJhZS52MS5HZXRQdWJsaWNJUFJlc3BvbnNlQqYBCg5jb20ubWFudHJhZS52MUIJVXRpbFByb3RvUAFaQGdpdGh1Yi5jb20vbWl6dWNoaWxhYnMvbWFudHJhZS9pbnRlcm5hbC9nZW4vbWFudHJhZS92MTttYW50cmFldjGiAgNNWFiqAgpNYW50cmFlLlYxygIKTWFudHJhZVxWMeICFk1hbnRyYWVcVjFcR1BCTWV0YWRhdGHqAgtNYW50cmFlOjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message mantrae.v1.GetVersionRequest
@@ -50,6 +50,11 @@ export type GetDynamicConfigRequest = Message<"mantrae.v1.GetDynamicConfigReques
    * @generated from field: int64 profile_id = 1;
    */
   profileId: bigint;
+
+  /**
+   * @generated from field: string format = 2;
+   */
+  format: string;
 };
 
 /**
@@ -0,0 +1,104 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file mantrae/v1/validation.proto (package mantrae.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mantrae/v1/validation.proto.
+ */
+export const file_mantrae_v1_validation: GenFile = /*@__PURE__*/
+  fileDesc("ChttYW50cmFlL3YxL3ZhbGlkYXRpb24ucHJvdG8SCm1hbnRyYWUudjEiawoPVmFsaWRhdGlvbklzc3VlEiYKCHNldmVyaXR5GAEgASgOMhQubWFudHJhZS52MS5TZXZlcml0eRIMCgRwYXRoGAIgASgJEhEKCXJlZmVyZW5jZRgDIAEoCRIPCgdtZXNzYWdlGAQgASgJIkgKCVJ1bGVFcnJvchIMCgRydWxlGAEgASgJEgwKBGxpbmUYAiABKAUSDgoGY29sdW1uGAMgASgFEg8KB21lc3NhZ2UYBCABKAkqTgoIU2V2ZXJpdHkSGAoUU0VWRVJJVFlfVU5TUEVDSUZJRUQQABISCg5TRVZFUklUWV9FUlJPUhABEhQKEFNFVkVSSVRZX1dBUk5JTkcQAkKsAQoOY29tLm1hbnRyYWUudjFCD1ZhbGlkYXRpb25Qcm90b1ABWkBnaXRodWIuY29tL21penVjaGlsYWJzL21hbnRyYWUvaW50ZXJuYWwvZ2VuL21hbnRyYWUvdjE7bWFudHJhZXYxogIDTVhYqgIKTWFudHJhZS5WMcoCCk1hbnRyYWVcVjHiAhZNYW50cmFlXFYxXEdQQk1ldGFkYXRh6gILTWFudHJhZTo6VjFiBnByb3RvMw");
+
+/**
+ * @generated from message mantrae.v1.ValidationIssue
+ */
+export type ValidationIssue = Message<"mantrae.v1.ValidationIssue"> & {
+  /**
+   * @generated from field: mantrae.v1.Severity severity = 1;
+   */
+  severity: Severity;
+
+  /**
+   * @generated from field: string path = 2;
+   */
+  path: string;
+
+  /**
+   * @generated from field: string reference = 3;
+   */
+  reference: string;
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message mantrae.v1.ValidationIssue.
+ * Use `create(ValidationIssueSchema)` to create a new message.
+ */
+export const ValidationIssueSchema: GenMessage<ValidationIssue> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_validation, 0);
+
+/**
+ * @generated from message mantrae.v1.RuleError
+ */
+export type RuleError = Message<"mantrae.v1.RuleError"> & {
+  /**
+   * @generated from field: string rule = 1;
+   */
+  rule: string;
+
+  /**
+   * @generated from field: int32 line = 2;
+   */
+  line: number;
+
+  /**
+   * @generated from field: int32 column = 3;
+   */
+  column: number;
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message mantrae.v1.RuleError.
+ * Use `create(RuleErrorSchema)` to create a new message.
+ */
+export const RuleErrorSchema: GenMessage<RuleError> = /*@__PURE__*/
+  messageDesc(file_mantrae_v1_validation, 1);
+
+/**
+ * @generated from enum mantrae.v1.Severity
+ */
+export enum Severity {
+  /**
+   * @generated from enum value: SEVERITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SEVERITY_ERROR = 1;
+   */
+  ERROR = 1,
+
+  /**
+   * @generated from enum value: SEVERITY_WARNING = 2;
+   */
+  WARNING = 2,
+}
+
+/**
+ * Describes the enum mantrae.v1.Severity.
+ */
+export const SeveritySchema: GenEnum<Severity> = /*@__PURE__*/
+  enumDesc(file_mantrae_v1_validation, 0);
+
@@ -140,6 +140,18 @@ export const settingGroups = {
 				type: 'boolean',
 				description: 'Force users to log in only via OIDC when disabled (no local passwords).'
 			},
+			{
+				key: 'session_lifetime',
+				label: 'Session Lifetime',
+				type: 'duration',
+				description: 'How long a login stays valid before users have to sign in again (e.g., 24h).'
+			},
+			{
+				key: 'two_factor_required',
+				label: 'Require Two-Factor',
+				type: 'boolean',
+				description: 'Require users logging in with a password to set up an authenticator app.'
+			},
 			{
 				key: 'oidc_client_id',
 				label: 'Client ID',
@@ -176,6 +188,12 @@ export const settingGroups = {
 				label: 'Use PKCE',
 				type: 'boolean',
 				description: 'Enable PKCE (Proof Key for Code Exchange) for better security.'
+			},
+			{
+				key: 'oidc_default_role',
+				label: 'Default Role',
+				type: 'text',
+				description: 'Role given to users created on their first OIDC login (admin, editor or viewer).'
 			}
 		]
 	},
@@ -196,6 +214,50 @@ export const settingGroups = {
 				description: 'Frequency of cleanup jobs (e.g., 1h, 24h).'
 			}
 		]
+	},
+	health: {
+		title: 'Service Health',
+		description: 'Probe the servers of HTTP and TCP services and keep their status history.',
+		keys: [
+			{
+				key: 'service_health_enabled',
+				label: 'Enable Probing',
+				type: 'boolean',
+				description: 'Periodically check that the servers of enabled services are reachable.'
+			},
+			{
+				key: 'service_health_interval',
+				label: 'Probe Interval',
+				type: 'duration',
+				description:
+					'How often servers are probed (e.g., 30s). Services with a health check interval are not probed more often than it.'
+			},
+			{
+				key: 'service_health_retention',
+				label: 'History Retention',
+				type: 'duration',
+				description: 'How long probe results are kept (e.g., 24h).'
+			}
+		]
+	},
+	traefik: {
+		title: 'Traefik Instances',
+		description: 'Read the runtime status of the Traefik instances fetching their configuration.',
+		keys: [
+			{
+				key: 'traefik_poll_enabled',
+				label: 'Enable Polling',
+				type: 'boolean',
+				description:
+					'Periodically query the API of instances sending a Traefik-Instance-Url header for the routers, middlewares and services they loaded, and report drift from the configuration served to them.'
+			},
+			{
+				key: 'traefik_sync_interval',
+				label: 'Poll Interval',
+				type: 'duration',
+				description: 'How often instances are queried (e.g., 20s).'
+			}
+		]
 	}
 };
 